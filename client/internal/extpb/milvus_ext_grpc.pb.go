@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.4
+// source: milvus_ext.proto
+
+// The definitions mirror the MilvusExt service of milvus in pkg/proto/root_coord.proto,
+// which serves the ddl not defined by milvus-proto yet.
+
+package extpb
+
+import (
+	context "context"
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MilvusExt_RenamePartition_FullMethodName = "/milvus.proto.rootcoord.MilvusExt/RenamePartition"
+	MilvusExt_RenameField_FullMethodName     = "/milvus.proto.rootcoord.MilvusExt/RenameField"
+)
+
+// MilvusExtClient is the client API for MilvusExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MilvusExtClient interface {
+	RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type milvusExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMilvusExtClient(cc grpc.ClientConnInterface) MilvusExtClient {
+	return &milvusExtClient{cc}
+}
+
+func (c *milvusExtClient) RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_RenamePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_RenameField_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusExtServer is the server API for MilvusExt service.
+// All implementations should embed UnimplementedMilvusExtServer
+// for forward compatibility
+type MilvusExtServer interface {
+	RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error)
+	RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedMilvusExtServer should be embedded to have forward compatible implementations.
+type UnimplementedMilvusExtServer struct {
+}
+
+func (UnimplementedMilvusExtServer) RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePartition not implemented")
+}
+func (UnimplementedMilvusExtServer) RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameField not implemented")
+}
+
+// UnsafeMilvusExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MilvusExtServer will
+// result in compilation errors.
+type UnsafeMilvusExtServer interface {
+	mustEmbedUnimplementedMilvusExtServer()
+}
+
+func RegisterMilvusExtServer(s grpc.ServiceRegistrar, srv MilvusExtServer) {
+	s.RegisterService(&MilvusExt_ServiceDesc, srv)
+}
+
+func _MilvusExt_RenamePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).RenamePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_RenamePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).RenamePartition(ctx, req.(*RenamePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_RenameField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).RenameField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_RenameField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).RenameField(ctx, req.(*RenameFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MilvusExt_ServiceDesc is the grpc.ServiceDesc for MilvusExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MilvusExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.rootcoord.MilvusExt",
+	HandlerType: (*MilvusExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RenamePartition",
+			Handler:    _MilvusExt_RenamePartition_Handler,
+		},
+		{
+			MethodName: "RenameField",
+			Handler:    _MilvusExt_RenameField_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milvus_ext.proto",
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/client/v2/common"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
@@ -45,7 +46,9 @@ import (
 type Client struct {
 	conn    *grpc.ClientConn
 	service milvuspb.MilvusServiceClient
-	config  *ClientConfig
+	// extService serves the ddl which are not defined by milvus-proto yet.
+	extService extpb.MilvusExtClient
+	config     *ClientConfig
 
 	// mutable status
 	stateMut   sync.RWMutex
@@ -146,6 +149,7 @@ func (c *Client) Close(ctx context.Context) error {
 	}
 	c.conn = nil
 	c.service = nil
+	c.extService = nil
 	return nil
 }
 
@@ -172,6 +176,7 @@ func (c *Client) connect(ctx context.Context, addr string, options ...grpc.DialO
 
 	c.conn = conn
 	c.service = milvuspb.NewMilvusServiceClient(c.conn)
+	c.extService = extpb.NewMilvusExtClient(c.conn)
 
 	if !c.config.DisableConn {
 		err = c.connectInternal(ctx)
@@ -238,6 +243,15 @@ func (c *Client) callService(fn func(milvusService milvuspb.MilvusServiceClient)
 	return fn(c.service)
 }
 
+func (c *Client) callExtService(fn func(extService extpb.MilvusExtClient) error) error {
+	service := c.extService
+	if service == nil {
+		return merr.WrapErrServiceNotReady("SDK", 0, "not connected")
+	}
+
+	return fn(service)
+}
+
 func (c *Client) GetService() milvuspb.MilvusServiceClient {
 	return c.service
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 )
 
 const (
@@ -28,6 +29,7 @@ type MockSuiteBase struct {
 	lis  *bufconn.Listener
 	svr  *grpc.Server
 	mock *MilvusServiceServer
+	ext  *MilvusExtServer
 
 	client *Client
 }
@@ -40,6 +42,9 @@ func (s *MockSuiteBase) SetupSuite() {
 
 	milvuspb.RegisterMilvusServiceServer(s.svr, s.mock)
 
+	s.ext = &MilvusExtServer{}
+	extpb.RegisterMilvusExtServer(s.svr, s.ext)
+
 	go func() {
 		s.T().Log("start mock server")
 		if err := s.svr.Serve(s.lis); err != nil {
@@ -85,6 +90,28 @@ func (s *MockSuiteBase) resetMock() {
 		s.mock.ExpectedCalls = nil
 		s.setupConnect()
 	}
+	if s.ext != nil {
+		s.ext.Calls = nil
+		s.ext.ExpectedCalls = nil
+	}
+}
+
+// MilvusExtServer mocks the MilvusExt service of milvus, which is not a part of milvus-proto.
+type MilvusExtServer struct {
+	extpb.UnimplementedMilvusExtServer
+	mock.Mock
+}
+
+func (m *MilvusExtServer) RenamePartition(ctx context.Context, req *extpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	ret := m.Called(req)
+	status, _ := ret.Get(0).(*commonpb.Status)
+	return status, ret.Error(1)
+}
+
+func (m *MilvusExtServer) RenameField(ctx context.Context, req *extpb.RenameFieldRequest) (*commonpb.Status, error) {
+	ret := m.Called(req)
+	status, _ := ret.Get(0).(*commonpb.Status)
+	return status, ret.Error(1)
 }
 
 func (s *MockSuiteBase) setupConnect() {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
@@ -140,6 +141,21 @@ func (c *Client) RenameCollection(ctx context.Context, option RenameCollectionOp
 	})
 }
 
+// RenameField renames a field of the collection.
+func (c *Client) RenameField(ctx context.Context, option RenameFieldOption, callOptions ...grpc.CallOption) error {
+	req := option.Request()
+
+	err := c.callExtService(func(extService extpb.MilvusExtClient) error {
+		resp, err := extService.RenameField(ctx, req, callOptions...)
+		return merr.CheckRPCCall(resp, err)
+	})
+	if err == nil {
+		// the cached schema still holds the old field name.
+		c.collCache.Evict(req.CollectionName)
+	}
+	return err
+}
+
 func (c *Client) AlterCollectionProperties(ctx context.Context, option AlterCollectionPropertiesOption, callOptions ...grpc.CallOption) error {
 	req := option.Request()
 
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/client/v2/entity"
 	"github.com/milvus-io/milvus/client/v2/index"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 )
 
 // CreateCollectionOption is the interface builds CreateCollectionRequest.
@@ -306,6 +307,32 @@ func NewRenameCollectionOption(oldName, newName string) *renameCollectionOption
 	}
 }
 
+type RenameFieldOption interface {
+	Request() *extpb.RenameFieldRequest
+}
+
+type renameFieldOption struct {
+	collectionName string
+	fieldName      string
+	newFieldName   string
+}
+
+func (opt *renameFieldOption) Request() *extpb.RenameFieldRequest {
+	return &extpb.RenameFieldRequest{
+		CollectionName: opt.collectionName,
+		OldName:        opt.fieldName,
+		NewName:        opt.newFieldName,
+	}
+}
+
+func NewRenameFieldOption(collectionName, fieldName, newFieldName string) *renameFieldOption {
+	return &renameFieldOption{
+		collectionName: collectionName,
+		fieldName:      fieldName,
+		newFieldName:   newFieldName,
+	}
+}
+
 type AlterCollectionPropertiesOption interface {
 	Request() *milvuspb.AlterCollectionRequest
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
@@ -287,6 +288,31 @@ func (s *CollectionSuite) TestRenameCollection() {
 	})
 }
 
+func (s *CollectionSuite) TestRenameField() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collName := fmt.Sprintf("test_collection_%s", s.randString(6))
+	fieldName := fmt.Sprintf("field_%s", s.randString(6))
+	newFieldName := fmt.Sprintf("%s_new", fieldName)
+
+	s.Run("success", func() {
+		s.ext.On("RenameField", mock.MatchedBy(func(req *extpb.RenameFieldRequest) bool {
+			return req.GetCollectionName() == collName && req.GetOldName() == fieldName && req.GetNewName() == newFieldName
+		})).Return(merr.Success(), nil).Once()
+
+		err := s.client.RenameField(ctx, NewRenameFieldOption(collName, fieldName, newFieldName))
+		s.NoError(err)
+	})
+
+	s.Run("failure", func() {
+		s.ext.On("RenameField", mock.Anything).Return(nil, merr.WrapErrServiceInternal("mocked")).Once()
+
+		err := s.client.RenameField(ctx, NewRenameFieldOption(collName, fieldName, newFieldName))
+		s.Error(err)
+	})
+}
+
 func (s *CollectionSuite) TestAlterCollectionProperties() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
@@ -48,6 +49,16 @@ func (c *Client) DropPartition(ctx context.Context, opt DropPartitionOption, cal
 	return err
 }
 
+// RenamePartition renames a partition of the collection.
+func (c *Client) RenamePartition(ctx context.Context, opt RenamePartitionOption, callOptions ...grpc.CallOption) error {
+	req := opt.Request()
+
+	return c.callExtService(func(extService extpb.MilvusExtClient) error {
+		resp, err := extService.RenamePartition(ctx, req, callOptions...)
+		return merr.CheckRPCCall(resp, err)
+	})
+}
+
 func (c *Client) HasPartition(ctx context.Context, opt HasPartitionOption, callOptions ...grpc.CallOption) (has bool, err error) {
 	req := opt.Request()
 
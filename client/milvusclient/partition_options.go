@@ -16,7 +16,10 @@
 
 package milvusclient
 
-import "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
+)
 
 // CreatePartitionOption is the interface builds Create Partition request.
 type CreatePartitionOption interface {
@@ -68,6 +71,34 @@ func NewDropPartitionOption(collectionName string, partitionName string) *dropPa
 	}
 }
 
+// RenamePartitionOption is the interface that builds RenamePartition request.
+type RenamePartitionOption interface {
+	// Request is the method returns the composed request.
+	Request() *extpb.RenamePartitionRequest
+}
+
+type renamePartitionOpt struct {
+	collectionName   string
+	partitionName    string
+	newPartitionName string
+}
+
+func (opt *renamePartitionOpt) Request() *extpb.RenamePartitionRequest {
+	return &extpb.RenamePartitionRequest{
+		CollectionName: opt.collectionName,
+		OldName:        opt.partitionName,
+		NewName:        opt.newPartitionName,
+	}
+}
+
+func NewRenamePartitionOption(collectionName string, partitionName string, newPartitionName string) *renamePartitionOpt {
+	return &renamePartitionOpt{
+		collectionName:   collectionName,
+		partitionName:    partitionName,
+		newPartitionName: newPartitionName,
+	}
+}
+
 // HasPartitionOption is the interface builds HasPartition request.
 type HasPartitionOption interface {
 	// Request is the method returns the composed request.
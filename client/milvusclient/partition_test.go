@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/internal/extpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
@@ -162,6 +163,31 @@ func (s *PartitionSuite) TestDropPartition() {
 	})
 }
 
+func (s *PartitionSuite) TestRenamePartition() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		partitionName := fmt.Sprintf("part_%s", s.randString(6))
+		newPartitionName := fmt.Sprintf("part_%s", s.randString(6))
+		s.ext.On("RenamePartition", mock.MatchedBy(func(req *extpb.RenamePartitionRequest) bool {
+			return req.GetCollectionName() == collectionName && req.GetOldName() == partitionName && req.GetNewName() == newPartitionName
+		})).Return(merr.Success(), nil).Once()
+
+		err := s.client.RenamePartition(ctx, NewRenamePartitionOption(collectionName, partitionName, newPartitionName))
+		s.NoError(err)
+	})
+
+	s.Run("failure", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.ext.On("RenamePartition", mock.Anything).Return(merr.Status(merr.WrapErrPartitionNotFound("part")), nil).Once()
+
+		err := s.client.RenamePartition(ctx, NewRenamePartitionOption(collectionName, "part", "new_part"))
+		s.Error(err)
+	})
+}
+
 func (s *PartitionSuite) TestGetPartitionStats() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// slowqueryreplay re-runs the requests captured in the proxy slow query log against a cluster,
+// and prints the original latency and the replayed latency of each request.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+var (
+	file     = flag.String("file", "", "The slow query log file to replay")
+	addr     = flag.String("addr", "127.0.0.1:19530", "The address of the proxy to replay the requests against")
+	user     = flag.String("user", "", "The user name to connect with, the user recorded in the log is used if empty")
+	password = flag.String("password", "", "The password of the user")
+	typ      = flag.String("type", "", "Only replay the requests of the type, Search, HybridSearch or Query")
+	timeout  = flag.Duration("timeout", time.Minute, "The timeout of each replayed request")
+)
+
+func main() {
+	flag.Parse()
+	if len(*file) == 0 {
+		log.Fatal("the slow query log file must be specified by -file")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal("failed to open slow query log", zap.String("file", *file), zap.Error(err))
+	}
+	defer f.Close()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("failed to connect to milvus", zap.String("addr", *addr), zap.Error(err))
+	}
+	defer conn.Close()
+	client := milvuspb.NewMilvusServiceClient(conn)
+
+	scanner := bufio.NewScanner(f)
+	// the request with the placeholder group could be large.
+	scanner.Buffer(make([]byte, 0, 1024*1024), 256*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		entry := &slowlog.Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			fmt.Printf("line %d: failed to parse entry, %v\n", line, err)
+			continue
+		}
+		if len(*typ) > 0 && entry.Type != *typ {
+			continue
+		}
+
+		elapsed, err := replay(client, entry)
+		if err != nil {
+			fmt.Printf("line %d: %s on %s.%s failed, %v\n", line, entry.Type, entry.Database, entry.Collection, err)
+			continue
+		}
+		fmt.Printf("line %d: %s on %s.%s, original: %.2fms, replay: %.2fms\n",
+			line, entry.Type, entry.Database, entry.Collection, entry.Duration, float64(elapsed)/float64(time.Millisecond))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal("failed to read slow query log", zap.String("file", *file), zap.Error(err))
+	}
+}
+
+func replay(client milvuspb.MilvusServiceClient, entry *slowlog.Entry) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	name := *user
+	if len(name) == 0 {
+		name = entry.User
+	}
+	if len(name) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, util.HeaderAuthorize, crypto.Base64Encode(name+util.CredentialSeperator+*password))
+	}
+
+	var status *commonpb.Status
+	start := time.Now()
+	switch entry.Type {
+	case slowlog.TypeSearch:
+		request := &milvuspb.SearchRequest{}
+		if err := protojson.Unmarshal(entry.Request, request); err != nil {
+			return 0, err
+		}
+		resp, err := client.Search(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		status = resp.GetStatus()
+	case slowlog.TypeHybridSearch:
+		request := &milvuspb.HybridSearchRequest{}
+		if err := protojson.Unmarshal(entry.Request, request); err != nil {
+			return 0, err
+		}
+		resp, err := client.HybridSearch(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		status = resp.GetStatus()
+	case slowlog.TypeQuery:
+		request := &milvuspb.QueryRequest{}
+		if err := protojson.Unmarshal(entry.Request, request); err != nil {
+			return 0, err
+		}
+		resp, err := client.Query(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		status = resp.GetStatus()
+	default:
+		return 0, fmt.Errorf("unknown request type %s", entry.Type)
+	}
+	if err := merr.Error(status); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// mirroredMetaPrefixes are the prefixes of the rootcoord and datacoord meta mirrored from the primary cluster,
+// the meta of querycoord and streamingcoord is owned by the follower cluster itself and never mirrored.
+var mirroredMetaPrefixes = []string{
+	kvrootcoord.ComponentPrefix + "/",
+	kvrootcoord.SnapshotPrefix + "/",
+	kvdatacoord.MetaPrefix + "/",
+	util.FieldIndexPrefix + "/",
+	util.SegmentIndexPrefix + "/",
+}
+
+func isMirroredMeta(key string) bool {
+	return lo.ContainsBy(mirroredMetaPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// metaMirror mirrors the coordinator meta of the primary cluster into the meta kv of the follower cluster.
+// The meta is fully copied before the coordinators load it, and then kept up to date by watching the primary etcd.
+// The mirrored keys are handed to reload periodically to refresh the in-memory meta of the coordinators.
+type metaMirror struct {
+	source      *clientv3.Client
+	sourceRoot  string
+	closeSource bool
+	target      kv.MetaKv
+	revision    int64
+
+	mu      sync.Mutex
+	changed typeutil.Set[string]
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newMetaMirror(source *clientv3.Client, sourceRoot string, target kv.MetaKv) *metaMirror {
+	return &metaMirror{
+		source:     source,
+		sourceRoot: strings.TrimSuffix(sourceRoot, "/"),
+		target:     target,
+		changed:    typeutil.NewSet[string](),
+	}
+}
+
+// sync copies all the mirrored meta of the primary cluster into the follower cluster and removes the keys
+// which no longer exist at the primary cluster, the changes after it are watched from the recorded revision.
+func (m *metaMirror) sync(ctx context.Context) error {
+	resp, err := m.source.Get(ctx, m.sourceRoot, clientv3.WithCountOnly())
+	if err != nil {
+		return err
+	}
+	revision := resp.Header.GetRevision()
+
+	sourceKV := etcdkv.NewEtcdKV(m.source, m.sourceRoot)
+	pageSize := paramtable.Get().MetaStoreCfg.PaginationSize.GetAsInt()
+	for _, prefix := range mirroredMetaPrefixes {
+		synced := typeutil.NewSet[string]()
+		saves := make(map[string]string)
+		if err := sourceKV.WalkWithPrefix(ctx, prefix, pageSize, func(key []byte, value []byte) error {
+			k := strings.TrimPrefix(string(key), m.sourceRoot+"/")
+			if !strings.HasPrefix(k, prefix) {
+				return nil
+			}
+			saves[k] = string(value)
+			synced.Insert(k)
+			if len(saves) < pageSize {
+				return nil
+			}
+			err := m.apply(ctx, saves, nil)
+			saves = make(map[string]string)
+			return err
+		}); err != nil {
+			return err
+		}
+		if err := m.apply(ctx, saves, nil); err != nil {
+			return err
+		}
+
+		removals := make([]string, 0)
+		if err := m.target.WalkWithPrefix(ctx, prefix, pageSize, func(key []byte, _ []byte) error {
+			k := strings.TrimPrefix(string(key), m.target.GetPath("")+"/")
+			if strings.HasPrefix(k, prefix) && !synced.Contain(k) {
+				removals = append(removals, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := m.apply(ctx, nil, removals); err != nil {
+			return err
+		}
+	}
+	m.revision = revision
+	log.Ctx(ctx).Info("meta of the primary cluster mirrored",
+		zap.String("primaryMetaRoot", m.sourceRoot), zap.Int64("revision", revision))
+	return nil
+}
+
+// apply writes the mirrored changes into the follower cluster and records the changed keys to be reloaded.
+func (m *metaMirror) apply(ctx context.Context, saves map[string]string, removals []string) error {
+	if err := etcd.SaveByBatchWithLimit(saves, util.MaxEtcdTxnNum, func(partialKvs map[string]string) error {
+		return m.target.MultiSave(ctx, partialKvs)
+	}); err != nil {
+		return err
+	}
+	if err := etcd.RemoveByBatchWithLimit(removals, util.MaxEtcdTxnNum, func(partialKeys []string) error {
+		return m.target.MultiRemove(ctx, partialKeys)
+	}); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changed.Insert(lo.Keys(saves)...)
+	m.changed.Insert(removals...)
+	return nil
+}
+
+// applyEvents applies the watched events, only the last change of a key in the events takes effect.
+func (m *metaMirror) applyEvents(ctx context.Context, events []*clientv3.Event) error {
+	saves := make(map[string]string)
+	removals := typeutil.NewSet[string]()
+	for _, event := range events {
+		key := strings.TrimPrefix(string(event.Kv.Key), m.sourceRoot+"/")
+		if !isMirroredMeta(key) {
+			continue
+		}
+		switch event.Type {
+		case mvccpb.PUT:
+			saves[key] = string(event.Kv.Value)
+			removals.Remove(key)
+		case mvccpb.DELETE:
+			delete(saves, key)
+			removals.Insert(key)
+		}
+	}
+	return m.apply(ctx, saves, removals.Collect())
+}
+
+func (m *metaMirror) takeChanged() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := m.changed.Collect()
+	m.changed = typeutil.NewSet[string]()
+	return keys
+}
+
+// start keeps the mirrored meta up to date and reloads the changed keys by reload periodically,
+// it must be called after sync and the coordinators are started.
+func (m *metaMirror) start(ctx context.Context, reload func(ctx context.Context, keys []string) error) {
+	// the meta synced before start is already loaded by the coordinators.
+	m.takeChanged()
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wg.Add(2)
+	go m.watchLoop(ctx)
+	go m.reloadLoop(ctx, reload)
+}
+
+func (m *metaMirror) watchLoop(ctx context.Context) {
+	defer m.wg.Done()
+	log := log.Ctx(ctx).With(zap.String("primaryMetaRoot", m.sourceRoot))
+	for {
+		watchCh := m.source.Watch(ctx, m.sourceRoot+"/", clientv3.WithPrefix(), clientv3.WithRev(m.revision+1))
+		for resp := range watchCh {
+			if err := resp.Err(); err != nil {
+				log.Warn("failed to watch the meta of the primary cluster", zap.Error(err))
+				break
+			}
+			if err := m.applyEvents(ctx, resp.Events); err != nil {
+				log.Warn("failed to mirror the meta of the primary cluster", zap.Error(err))
+				break
+			}
+			m.revision = resp.Header.GetRevision()
+		}
+
+		// the watched revision may be compacted, resync the whole meta before watching again.
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			err := m.sync(ctx)
+			if err == nil {
+				break
+			}
+			log.Warn("failed to resync the meta of the primary cluster", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+func (m *metaMirror) reloadLoop(ctx context.Context, reload func(ctx context.Context, keys []string) error) {
+	defer m.wg.Done()
+	interval := paramtable.Get().CommonCfg.FollowerMetaSyncInterval.GetAsDuration(time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ticker.Reset(paramtable.Get().CommonCfg.FollowerMetaSyncInterval.GetAsDuration(time.Second))
+			keys := m.takeChanged()
+			if len(keys) == 0 {
+				continue
+			}
+			if err := reload(ctx, keys); err != nil {
+				log.Ctx(ctx).Warn("failed to reload the mirrored meta, retry later", zap.Int("keys", len(keys)), zap.Error(err))
+				m.mu.Lock()
+				m.changed.Insert(keys...)
+				m.mu.Unlock()
+			}
+		}
+	}
+}
+
+func (m *metaMirror) stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+	if m.closeSource {
+		m.source.Close()
+	}
+}
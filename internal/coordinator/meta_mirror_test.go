@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestMetaMirror(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.CommonCfg.FollowerMetaSyncInterval.Key, "1")
+	defer paramtable.Get().Reset(Params.CommonCfg.FollowerMetaSyncInterval.Key)
+
+	etcdCli, err := etcd.GetEtcdClient(
+		Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		Params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		Params.EtcdCfg.Endpoints.GetAsStrings(),
+		Params.EtcdCfg.EtcdTLSCert.GetValue(),
+		Params.EtcdCfg.EtcdTLSKey.GetValue(),
+		Params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		Params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	require.NoError(t, err)
+	defer etcdCli.Close()
+
+	ctx := context.Background()
+	randVal := rand.Int()
+	source := etcdkv.NewEtcdKV(etcdCli, fmt.Sprintf("/test-meta-mirror-primary-%d/meta", randVal))
+	target := etcdkv.NewEtcdKV(etcdCli, fmt.Sprintf("/test-meta-mirror-follower-%d/meta", randVal))
+	defer source.RemoveWithPrefix(ctx, "")
+	defer target.RemoveWithPrefix(ctx, "")
+
+	require.NoError(t, source.MultiSave(ctx, map[string]string{
+		"root-coord/database/db-info/1":    "db",
+		"datacoord-meta/s/100/1/2":         "segment",
+		"querycoord-collection-loadinfo/1": "load info",
+	}))
+	require.NoError(t, target.Save(ctx, "root-coord/database/db-info/2", "stale"))
+
+	mirror := newMetaMirror(etcdCli, source.GetPath(""), target)
+	require.NoError(t, mirror.sync(ctx))
+
+	value, err := target.Load(ctx, "root-coord/database/db-info/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "db", value)
+	value, err = target.Load(ctx, "datacoord-meta/s/100/1/2")
+	assert.NoError(t, err)
+	assert.Equal(t, "segment", value)
+	has, err := target.Has(ctx, "querycoord-collection-loadinfo/1")
+	assert.NoError(t, err)
+	assert.False(t, has)
+	has, err = target.Has(ctx, "root-coord/database/db-info/2")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	reloaded := typeutil.NewConcurrentSet[string]()
+	mirror.start(ctx, func(ctx context.Context, keys []string) error {
+		reloaded.Upsert(keys...)
+		return nil
+	})
+	defer mirror.stop()
+
+	require.NoError(t, source.Save(ctx, "datacoord-meta/s/100/1/3", "new segment"))
+	require.NoError(t, source.Remove(ctx, "datacoord-meta/s/100/1/2"))
+	require.NoError(t, source.Save(ctx, "querycoord-collection-loadinfo/2", "load info"))
+
+	assert.Eventually(t, func() bool {
+		return reloaded.Contain("datacoord-meta/s/100/1/3") && reloaded.Contain("datacoord-meta/s/100/1/2")
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.False(t, reloaded.Contain("querycoord-collection-loadinfo/2"))
+	value, err = target.Load(ctx, "datacoord-meta/s/100/1/3")
+	assert.NoError(t, err)
+	assert.Equal(t, "new segment", value)
+	has, err = target.Has(ctx, "datacoord-meta/s/100/1/2")
+	assert.NoError(t, err)
+	assert.False(t, has)
+}
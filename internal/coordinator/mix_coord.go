@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/tikv/client-go/v2/txnkv"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
@@ -18,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/querycoordv2"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	streamingcoord "github.com/milvus-io/milvus/internal/streamingcoord/server"
@@ -36,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -77,6 +81,8 @@ type mixCoordImpl struct {
 
 	metaKVCreator  func() kv.MetaKv
 	mixCoordClient types.MixCoordClient
+
+	metaMirror *metaMirror
 }
 
 func NewMixCoordServer(c context.Context, factory dependency.Factory) (*mixCoordImpl, error) {
@@ -165,6 +171,11 @@ func (s *mixCoordImpl) initInternal() error {
 	s.datacoordServer.SetMixCoord(s)
 	s.queryCoordServer.SetMixCoord(s)
 
+	if err := s.initMetaMirror(); err != nil {
+		log.Error("meta mirror init failed", zap.Error(err))
+		return err
+	}
+
 	if err := s.streamingCoord.Start(s.ctx); err != nil {
 		log.Error("streamCoord start failed", zap.Error(err))
 		return err
@@ -199,9 +210,62 @@ func (s *mixCoordImpl) initInternal() error {
 		log.Error("queryCoord start failed", zap.Error(err))
 		return err
 	}
+
+	if s.metaMirror != nil {
+		s.metaMirror.start(s.ctx, s.reloadMirroredMeta)
+	}
 	return nil
 }
 
+// initMetaMirror mirrors the rootcoord and datacoord meta of the primary cluster into a follower cluster
+// before the coordinators load their meta, the mirrored meta is read-only at the follower cluster.
+func (s *mixCoordImpl) initMetaMirror() error {
+	primaryMetaRoot := Params.CommonCfg.FollowerPrimaryMetaRoot.GetValue()
+	if !Params.CommonCfg.FollowerEnabled.GetAsBool() || primaryMetaRoot == "" {
+		return nil
+	}
+	if Params.MetaStoreCfg.MetaStoreType.GetValue() != util.MetaStoreTypeEtcd {
+		return merr.WrapErrParameterInvalidMsg("meta mirroring of the follower cluster only supports the etcd meta store")
+	}
+
+	source, closeSource := s.etcdCli, false
+	if endpoints := Params.CommonCfg.FollowerPrimaryEndpoints.GetAsStrings(); len(endpoints) > 0 {
+		etcdConfig := &Params.EtcdCfg
+		var err error
+		source, err = etcd.CreateEtcdClient(
+			false,
+			etcdConfig.EtcdEnableAuth.GetAsBool(),
+			etcdConfig.EtcdAuthUserName.GetValue(),
+			etcdConfig.EtcdAuthPassword.GetValue(),
+			etcdConfig.EtcdUseSSL.GetAsBool(),
+			endpoints,
+			etcdConfig.EtcdTLSCert.GetValue(),
+			etcdConfig.EtcdTLSKey.GetValue(),
+			etcdConfig.EtcdTLSCACert.GetValue(),
+			etcdConfig.EtcdTLSMinVersion.GetValue())
+		if err != nil {
+			return err
+		}
+		closeSource = true
+	}
+	s.metaMirror = newMetaMirror(source, primaryMetaRoot, s.metaKVCreator())
+	s.metaMirror.closeSource = closeSource
+	return s.metaMirror.sync(s.ctx)
+}
+
+// reloadMirroredMeta reloads the meta of rootcoord and datacoord changed by the mirrored keys,
+// rootcoord is reloaded first since datacoord refreshes its cached collections from rootcoord.
+func (s *mixCoordImpl) reloadMirroredMeta(ctx context.Context, keys []string) error {
+	if lo.ContainsBy(keys, func(key string) bool {
+		return strings.HasPrefix(key, kvrootcoord.ComponentPrefix+"/")
+	}) {
+		if err := s.rootcoordServer.ReloadMirroredMeta(ctx); err != nil {
+			return err
+		}
+	}
+	return s.datacoordServer.ReloadMirroredMeta(ctx, keys)
+}
+
 func (s *mixCoordImpl) initKVCreator() {
 	if s.metaKVCreator == nil {
 		if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
@@ -229,6 +293,10 @@ func (s *mixCoordImpl) Stop() error {
 	s.GracefulStop()
 	log.Info("graceful stop done")
 
+	if s.metaMirror != nil {
+		s.metaMirror.stop()
+	}
+
 	if err := s.queryCoordServer.Stop(); err != nil {
 		log.Error("Failed to stop queryCoord", zap.Error(err))
 	}
@@ -487,6 +555,34 @@ func (s *mixCoordImpl) RenameCollection(ctx context.Context, req *milvuspb.Renam
 	return s.rootcoordServer.RenameCollection(ctx, req)
 }
 
+func (s *mixCoordImpl) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.RenameField(ctx, req)
+}
+
+func (s *mixCoordImpl) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.AddCollectionFunction(ctx, req)
+}
+
+func (s *mixCoordImpl) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.RenamePartition(ctx, req)
+}
+
+func (s *mixCoordImpl) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.TransferPartition(ctx, req)
+}
+
+func (s *mixCoordImpl) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.CreateProjection(ctx, req)
+}
+
+func (s *mixCoordImpl) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.DropProjection(ctx, req)
+}
+
+func (s *mixCoordImpl) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	return s.rootcoordServer.ListProjections(ctx, req)
+}
+
 func (s *mixCoordImpl) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return s.rootcoordServer.CreateDatabase(ctx, req)
 }
@@ -878,6 +974,22 @@ func (s *mixCoordImpl) UpdateLoadConfig(ctx context.Context, req *querypb.Update
 	return s.queryCoordServer.UpdateLoadConfig(ctx, req)
 }
 
+func (s *mixCoordImpl) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	return s.queryCoordServer.ReportCollectionAccess(ctx, req)
+}
+
+func (s *mixCoordImpl) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	return s.queryCoordServer.BeginReadSession(ctx, req)
+}
+
+func (s *mixCoordImpl) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	return s.queryCoordServer.EndReadSession(ctx, req)
+}
+
+func (s *mixCoordImpl) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	return s.queryCoordServer.GetReadSession(ctx, req)
+}
+
 // DataCoordServer
 func (s *mixCoordImpl) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
 	return s.datacoordServer.GetSegmentInfo(ctx, req)
@@ -927,6 +1039,10 @@ func (s *mixCoordImpl) GetChannelRecoveryInfo(ctx context.Context, req *datapb.G
 	return s.datacoordServer.GetChannelRecoveryInfo(ctx, req)
 }
 
+func (s *mixCoordImpl) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	return s.datacoordServer.TransferSegments(ctx, req)
+}
+
 func (s *mixCoordImpl) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	return s.datacoordServer.GetFlushedSegments(ctx, req)
 }
@@ -980,7 +1096,15 @@ func (s *mixCoordImpl) MarkSegmentsDropped(ctx context.Context, req *datapb.Mark
 }
 
 func (s *mixCoordImpl) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
-	return s.datacoordServer.BroadcastAlteredCollection(ctx, req)
+	status, err := s.datacoordServer.BroadcastAlteredCollection(ctx, req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		return status, err
+	}
+	// the idle release ttl is cached in the load meta of querycoord.
+	if err := s.queryCoordServer.RefreshCollectionProperties(ctx, req.GetCollectionID(), req.GetProperties()); err != nil {
+		return merr.Status(err), nil
+	}
+	return status, nil
 }
 
 func (s *mixCoordImpl) GcConfirm(ctx context.Context, req *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
@@ -1023,6 +1147,10 @@ func (s *mixCoordImpl) GetIndexBuildProgress(ctx context.Context, req *indexpb.G
 	return s.datacoordServer.GetIndexBuildProgress(ctx, req)
 }
 
+func (s *mixCoordImpl) GetFunctionBackfillProgress(ctx context.Context, req *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return s.datacoordServer.GetFunctionBackfillProgress(ctx, req)
+}
+
 func (s *mixCoordImpl) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return s.datacoordServer.ReportDataNodeTtMsgs(ctx, req)
 }
@@ -30,6 +30,7 @@
 #include "exec/operator/RandomSampleNode.h"
 #include "exec/operator/GroupByNode.h"
 #include "exec/Task.h"
+#include "futures/Cancellation.h"
 
 namespace milvus {
 namespace exec {
@@ -179,6 +180,8 @@ Driver::Next(std::shared_ptr<BlockingState>& blocking_state) {
 #define CALL_OPERATOR(call_func, operator, method_name)            \
     try {                                                          \
         call_func;                                                 \
+    } catch (folly::FutureCancellation&) {                         \
+        throw;                                                     \
     } catch (std::exception & e) {                                 \
         std::string stack_trace = milvus::impl::EasyStackTrace();  \
         auto err_msg = fmt::format(                                \
@@ -202,6 +205,8 @@ Driver::RunInternal(std::shared_ptr<Driver>& self,
         ContinueFuture future;
 
         for (;;) {
+            // stop the canceled query between the batches.
+            milvus::futures::CheckCancellation();
             for (int32_t i = num_operators - 1; i >= 0; --i) {
                 auto op = operators_[i].get();
 
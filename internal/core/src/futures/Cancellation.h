@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <folly/CancellationToken.h>
+#include <folly/futures/FutureException.h>
+
+namespace milvus::futures {
+
+namespace internal {
+/// @brief the cancellation token of the async function running on current thread.
+inline thread_local const folly::CancellationToken* current_cancellation_token =
+    nullptr;
+}  // namespace internal
+
+/// @brief CancellationScope binds the cancellation token of the async function to current thread,
+/// so the long running operations inside the async function can check the cancellation cooperatively,
+/// without passing the token through all the call stack.
+class CancellationScope {
+ public:
+    explicit CancellationScope(const folly::CancellationToken& token)
+        : prev_(internal::current_cancellation_token) {
+        internal::current_cancellation_token = &token;
+    }
+
+    CancellationScope(const CancellationScope&) = delete;
+
+    CancellationScope&
+    operator=(const CancellationScope&) = delete;
+
+    ~CancellationScope() {
+        internal::current_cancellation_token = prev_;
+    }
+
+ private:
+    const folly::CancellationToken* prev_;
+};
+
+/// @brief check if the async function running on current thread is cancelled,
+/// throw a FutureCancellation exception if it is.
+/// It's a no-op if current thread is not running an async function.
+inline void
+CheckCancellation() {
+    auto token = internal::current_cancellation_token;
+    if (token != nullptr && token->isCancellationRequested()) {
+        throw folly::FutureCancellation();
+    }
+}
+
+}  // namespace milvus::futures
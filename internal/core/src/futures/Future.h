@@ -16,11 +16,13 @@
 #include <folly/CancellationToken.h>
 #include <folly/futures/Future.h>
 #include <folly/futures/SharedPromise.h>
+#include "Cancellation.h"
 #include "future_c_types.h"
 #include "LeakyResult.h"
 #include "Ready.h"
 #include "pb/cgo_msg.pb.h"
 #include <chrono>
+#include <ctime>
 #include "monitor/prometheus_client.h"
 
 namespace milvus::futures {
@@ -56,6 +58,8 @@ class Metrics {
         : time_point_(std::chrono::steady_clock::now()),
           queue_duration_(0),
           execute_duration_(0),
+          cpu_time_point_(0),
+          cpu_duration_(0),
           cancelled_before_execute_(false) {
         milvus::monitor::internal_cgo_inflight_task_total_all.Increment();
     }
@@ -94,6 +98,7 @@ class Metrics {
         queue_duration_ =
             std::chrono::duration_cast<Duration>(now - time_point_);
         time_point_ = now;
+        cpu_time_point_ = threadCPUTime();
         milvus::monitor::internal_cgo_executing_task_total_all.Increment();
     }
 
@@ -102,12 +107,33 @@ class Metrics {
         auto now = std::chrono::steady_clock::now();
         execute_duration_ =
             std::chrono::duration_cast<Duration>(now - time_point_);
+        cpu_duration_ = std::chrono::duration_cast<Duration>(
+            threadCPUTime() - cpu_time_point_);
+    }
+
+    /// @brief the cpu time of the executor thread spent on the execution,
+    /// the time of the other threads the execution waits for is not included.
+    Duration
+    cpuDuration() const {
+        return cpu_duration_;
     }
 
  private:
+    static std::chrono::nanoseconds
+    threadCPUTime() {
+        struct timespec ts;
+        if (clock_gettime(CLOCK_THREAD_CPUTIME_ID, &ts) != 0) {
+            return std::chrono::nanoseconds(0);
+        }
+        return std::chrono::seconds(ts.tv_sec) +
+               std::chrono::nanoseconds(ts.tv_nsec);
+    }
+
     std::chrono::steady_clock::time_point time_point_;
     Duration queue_duration_;
     Duration execute_duration_;
+    std::chrono::nanoseconds cpu_time_point_;
+    Duration cpu_duration_;
     bool cancelled_before_execute_;
 };
 
@@ -147,6 +173,11 @@ class IFuture {
     virtual std::pair<void*, CStatus>
     leakyGet() = 0;
 
+    /// @brief get the cpu time of the executor thread in microseconds spent on the async function.
+    /// It should be called after the future is ready.
+    virtual int64_t
+    cpuTimeUs() = 0;
+
     /// @brief leaked future object created by method `Future<R>::createLeakedFuture` can be droped by these method.
     static void
     releaseLeakedFuture(IFuture* future) {
@@ -243,6 +274,14 @@ class Future : public IFuture {
         return result.leakyGet();
     }
 
+    /// @brief see `IFuture::cpuTimeUs`
+    int64_t
+    cpuTimeUs() noexcept override {
+        return std::chrono::duration_cast<std::chrono::microseconds>(
+                   metrics_.cpuDuration())
+            .count();
+    }
+
  private:
     /// @brief set the interrupt handler for the promise used in async produce arm.
     void
@@ -283,6 +322,8 @@ class Future : public IFuture {
 
             auto executionGuard =
                 Metrics<std::chrono::microseconds>::ExecutionGuard(metrics_);
+            // the long running operations check the cancellation between the batches or chunks.
+            auto cancellationScope = CancellationScope(cancellation_token);
             return fn(cancellation_token);
         };
 
@@ -47,6 +47,12 @@ future_leak_and_get(CFuture* future, void** result) {
     return s;
 }
 
+extern "C" int64_t
+future_cpu_time_us(CFuture* future) {
+    return static_cast<milvus::futures::IFuture*>(static_cast<void*>(future))
+        ->cpuTimeUs();
+}
+
 extern "C" void
 future_destroy(CFuture* future) {
     milvus::futures::IFuture::releaseLeakedFuture(
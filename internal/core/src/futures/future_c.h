@@ -32,6 +32,9 @@ future_register_ready_callback(CFuture* future,
 CStatus
 future_leak_and_get(CFuture* future, void** result);
 
+int64_t
+future_cpu_time_us(CFuture* future);
+
 // TODO: only for testing, add test macro for this function.
 CFuture*
 future_create_test_case(int interval, int loop_cnt, int caseNo);
@@ -22,6 +22,7 @@
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnIndex.h"
 #include "exec/operator/Utils.h"
+#include "futures/Cancellation.h"
 
 namespace milvus::query {
 
@@ -143,6 +144,7 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
 
         for (int chunk_id = current_chunk_id; chunk_id < max_chunk;
              ++chunk_id) {
+            milvus::futures::CheckCancellation();
             auto chunk_data = vec_ptr->get_chunk_data(chunk_id);
 
             auto element_begin = chunk_id * vec_size_per_chunk;
@@ -23,6 +23,7 @@
 #include "query/SearchOnSealed.h"
 #include "query/helper.h"
 #include "exec/operator/Utils.h"
+#include "futures/Cancellation.h"
 
 namespace milvus::query {
 
@@ -132,6 +133,7 @@ SearchOnSealedColumn(const Schema& schema,
 
     auto offset = 0;
     for (int i = 0; i < num_chunk; ++i) {
+        milvus::futures::CheckCancellation();
         auto pw = column->DataOfChunk(i);
         auto vec_data = pw.get();
         auto chunk_size = column->chunk_row_nums(i);
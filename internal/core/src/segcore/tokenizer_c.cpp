@@ -78,3 +78,28 @@ validate_text_schema(const uint8_t* field_schema, uint64_t length) {
         return milvus::FailureCStatus(&e);
     }
 }
+
+void
+register_go_tokenizer(CGoTokenizerCreateFn create,
+                      CGoTokenizerCloneFn clone,
+                      CGoTokenizerDestroyFn destroy,
+                      CGoTokenizerTokenizeFn tokenize) {
+    tantivy_register_go_tokenizer(create, clone, destroy, tokenize);
+}
+
+void
+append_go_token(void* sink,
+                const char* token,
+                uintptr_t token_len,
+                int64_t start_offset,
+                int64_t end_offset,
+                int64_t position,
+                int64_t position_length) {
+    tantivy_append_go_token(sink,
+                            token,
+                            token_len,
+                            start_offset,
+                            end_offset,
+                            position,
+                            position_length);
+}
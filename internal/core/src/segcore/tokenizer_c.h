@@ -41,6 +41,32 @@ create_token_stream(CTokenizer tokenizer, const char* text, uint32_t text_len);
 CStatus
 validate_text_schema(const uint8_t* field_schema, uint64_t length);
 
+// The callbacks of the go analyzers, which are registered by the go side when
+// the process starts, so the text index can be built with the go analyzers.
+typedef uintptr_t (*CGoTokenizerCreateFn)(const char* params, char** error);
+typedef uintptr_t (*CGoTokenizerCloneFn)(uintptr_t tokenizer);
+typedef void (*CGoTokenizerDestroyFn)(uintptr_t tokenizer);
+typedef void (*CGoTokenizerTokenizeFn)(uintptr_t tokenizer,
+                                       const char* text,
+                                       uintptr_t text_len,
+                                       void* sink);
+
+void
+register_go_tokenizer(CGoTokenizerCreateFn create,
+                      CGoTokenizerCloneFn clone,
+                      CGoTokenizerDestroyFn destroy,
+                      CGoTokenizerTokenizeFn tokenize);
+
+// append_go_token is called in the tokenize callback to output the tokens.
+void
+append_go_token(void* sink,
+                const char* token,
+                uintptr_t token_len,
+                int64_t start_offset,
+                int64_t end_offset,
+                int64_t position,
+                int64_t position_length);
+
 #ifdef __cplusplus
 }
 #endif
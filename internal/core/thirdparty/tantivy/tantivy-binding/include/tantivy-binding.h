@@ -74,6 +74,14 @@ struct RustResult {
 
 using SetBitsetFn = void(*)(void*, const uint32_t*, uintptr_t);
 
+using GoTokenizerCreateFn = uintptr_t(*)(const char*, char**);
+
+using GoTokenizerCloneFn = uintptr_t(*)(uintptr_t);
+
+using GoTokenizerDestroyFn = void(*)(uintptr_t);
+
+using GoTokenizerTokenizeFn = void(*)(uintptr_t, const char*, uintptr_t, void*);
+
 struct TantivyToken {
   const char *token;
   int64_t start_offset;
@@ -476,6 +484,19 @@ void *tantivy_clone_analyzer(void *ptr);
 
 void tantivy_free_analyzer(void *tokenizer);
 
+void tantivy_register_go_tokenizer(GoTokenizerCreateFn create,
+                                   GoTokenizerCloneFn clone,
+                                   GoTokenizerDestroyFn destroy,
+                                   GoTokenizerTokenizeFn tokenize);
+
+void tantivy_append_go_token(void *sink,
+                             const char *token,
+                             uintptr_t token_len,
+                             int64_t start_offset,
+                             int64_t end_offset,
+                             int64_t position,
+                             int64_t position_length);
+
 bool tantivy_index_exist(const char *path);
 
 } // extern "C"
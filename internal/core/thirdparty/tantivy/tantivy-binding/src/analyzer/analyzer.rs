@@ -2,7 +2,11 @@ use serde_json as json;
 use std::collections::HashMap;
 use tantivy::tokenizer::*;
 
-use super::{build_in_analyzer::*, filter::*, tokenizers::get_builder_with_tokenizer};
+use super::{
+    build_in_analyzer::*,
+    filter::*,
+    tokenizers::{get_builder_with_tokenizer, GoTokenizer},
+};
 use crate::error::Result;
 use crate::error::TantivyBindingError;
 
@@ -156,6 +160,10 @@ impl AnalyzerBuilder<'_> {
                         "analyzer type shoud be string"
                     )));
                 }
+                // the go analyzers tokenize the text and apply the filters in go
+                if type_.as_str().unwrap() == "go" {
+                    return Ok(TextAnalyzer::builder(GoTokenizer::from_json(self.params)?).build());
+                }
                 return self.build_template(type_.as_str().unwrap());
             }
             _ => {}
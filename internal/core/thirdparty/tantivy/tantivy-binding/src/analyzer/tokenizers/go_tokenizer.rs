@@ -0,0 +1,231 @@
+use lazy_static::lazy_static;
+use libc::{c_char, c_void};
+use log::warn;
+use serde_json as json;
+use std::ffi::{CStr, CString};
+use std::sync::RwLock;
+use tantivy::tokenizer::{Token, TokenStream, Tokenizer};
+
+use crate::error::{Result, TantivyBindingError};
+
+// The go analyzers are implemented in go, the callbacks are registered by the go side
+// when the process starts, and the tokenizers are referenced by the handles returned by it.
+
+// Create the go tokenizer by the analyzer params, return the handle of it,
+// or 0 with the error message allocated by malloc.
+pub type GoTokenizerCreateFn = extern "C" fn(*const c_char, *mut *mut c_char) -> usize;
+// Clone the go tokenizer, return 0 if failed.
+pub type GoTokenizerCloneFn = extern "C" fn(usize) -> usize;
+pub type GoTokenizerDestroyFn = extern "C" fn(usize);
+// Tokenize the text, the tokens are appended to the sink by append_go_token.
+pub type GoTokenizerTokenizeFn = extern "C" fn(usize, *const c_char, usize, *mut c_void);
+
+#[derive(Clone, Copy)]
+struct GoTokenizerCallbacks {
+    create: GoTokenizerCreateFn,
+    clone: GoTokenizerCloneFn,
+    destroy: GoTokenizerDestroyFn,
+    tokenize: GoTokenizerTokenizeFn,
+}
+
+lazy_static! {
+    static ref GO_TOKENIZER_CALLBACKS: RwLock<Option<GoTokenizerCallbacks>> = RwLock::new(None);
+}
+
+pub fn register_go_tokenizer(
+    create: GoTokenizerCreateFn,
+    clone: GoTokenizerCloneFn,
+    destroy: GoTokenizerDestroyFn,
+    tokenize: GoTokenizerTokenizeFn,
+) {
+    *GO_TOKENIZER_CALLBACKS.write().unwrap() = Some(GoTokenizerCallbacks {
+        create,
+        clone,
+        destroy,
+        tokenize,
+    });
+}
+
+pub fn append_go_token(
+    sink: *mut c_void,
+    token: *const c_char,
+    token_len: usize,
+    start_offset: i64,
+    end_offset: i64,
+    position: i64,
+    position_length: i64,
+) {
+    let tokens = unsafe { &mut *(sink as *mut Vec<Token>) };
+    let text = unsafe { std::slice::from_raw_parts(token as *const u8, token_len) };
+    tokens.push(Token {
+        offset_from: start_offset as usize,
+        offset_to: end_offset as usize,
+        position: position as usize,
+        text: String::from_utf8_lossy(text).into_owned(),
+        position_length: position_length as usize,
+    });
+}
+
+pub struct GoTokenizer {
+    handle: usize,
+    callbacks: GoTokenizerCallbacks,
+}
+
+impl GoTokenizer {
+    pub fn from_json(params: &json::Map<String, json::Value>) -> Result<GoTokenizer> {
+        let callbacks =
+            GO_TOKENIZER_CALLBACKS
+                .read()
+                .unwrap()
+                .ok_or(TantivyBindingError::InternalError(format!(
+                    "go analyzer is not registered"
+                )))?;
+
+        let params = CString::new(json::to_string(params)?).map_err(|e| {
+            TantivyBindingError::InvalidArgument(format!("invalid go analyzer params: {}", e))
+        })?;
+        let mut error: *mut c_char = std::ptr::null_mut();
+        let handle = (callbacks.create)(params.as_ptr(), &mut error);
+        if handle == 0 {
+            let msg = if error.is_null() {
+                "unknown error".to_string()
+            } else {
+                let msg = unsafe { CStr::from_ptr(error).to_string_lossy().into_owned() };
+                unsafe { libc::free(error as *mut c_void) };
+                msg
+            };
+            return Err(TantivyBindingError::InternalError(format!(
+                "create go analyzer failed: {}",
+                msg
+            )));
+        }
+
+        Ok(GoTokenizer { handle, callbacks })
+    }
+}
+
+impl Clone for GoTokenizer {
+    fn clone(&self) -> Self {
+        let mut handle = 0;
+        if self.handle != 0 {
+            handle = (self.callbacks.clone)(self.handle);
+            if handle == 0 {
+                warn!("clone go analyzer failed, the clone will output no tokens");
+            }
+        }
+        GoTokenizer {
+            handle,
+            callbacks: self.callbacks,
+        }
+    }
+}
+
+impl Drop for GoTokenizer {
+    fn drop(&mut self) {
+        if self.handle != 0 {
+            (self.callbacks.destroy)(self.handle);
+        }
+    }
+}
+
+#[derive(Clone)]
+pub struct GoTokenStream {
+    tokens: Vec<Token>,
+    index: usize,
+}
+
+impl TokenStream for GoTokenStream {
+    fn advance(&mut self) -> bool {
+        if self.index < self.tokens.len() {
+            self.index += 1;
+            true
+        } else {
+            false
+        }
+    }
+
+    fn token(&self) -> &Token {
+        &self.tokens[self.index - 1]
+    }
+
+    fn token_mut(&mut self) -> &mut Token {
+        &mut self.tokens[self.index - 1]
+    }
+}
+
+impl Tokenizer for GoTokenizer {
+    type TokenStream<'a> = GoTokenStream;
+
+    fn token_stream(&mut self, text: &str) -> GoTokenStream {
+        let mut tokens: Vec<Token> = vec![];
+        if self.handle != 0 {
+            (self.callbacks.tokenize)(
+                self.handle,
+                text.as_ptr() as *const c_char,
+                text.len(),
+                &mut tokens as *mut Vec<Token> as *mut c_void,
+            );
+        }
+        GoTokenStream {
+            tokens: tokens,
+            index: 0,
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use libc::{c_char, c_void};
+    use serde_json as json;
+    use tantivy::tokenizer::{TokenStream, Tokenizer};
+
+    use super::{append_go_token, register_go_tokenizer, GoTokenizer};
+
+    extern "C" fn create(_params: *const c_char, _error: *mut *mut c_char) -> usize {
+        1
+    }
+
+    extern "C" fn clone(handle: usize) -> usize {
+        handle
+    }
+
+    extern "C" fn destroy(_handle: usize) {}
+
+    // split the text by whitespace like the go side does
+    extern "C" fn tokenize(_handle: usize, text: *const c_char, len: usize, sink: *mut c_void) {
+        let text = unsafe {
+            std::str::from_utf8_unchecked(std::slice::from_raw_parts(text as *const u8, len))
+        };
+        let mut offset = 0;
+        for (position, word) in text.split(' ').enumerate() {
+            append_go_token(
+                sink,
+                word.as_ptr() as *const c_char,
+                word.len(),
+                offset as i64,
+                (offset + word.len()) as i64,
+                position as i64,
+                1,
+            );
+            offset += word.len() + 1;
+        }
+    }
+
+    #[test]
+    fn test_go_tokenizer() {
+        register_go_tokenizer(create, clone, destroy, tokenize);
+
+        let params =
+            json::from_str::<json::Value>(r#"{"type": "go", "name": "whitespace"}"#).unwrap();
+        let tokenizer = GoTokenizer::from_json(params.as_object().unwrap()).unwrap();
+        let mut tokenizer = tokenizer.clone();
+        let mut stream = tokenizer.token_stream("go tokenizer test");
+
+        let mut results = Vec::<String>::new();
+        while stream.advance() {
+            let token = stream.token();
+            results.push(token.text.clone());
+        }
+        assert_eq!(results, vec!["go", "tokenizer", "test"]);
+    }
+}
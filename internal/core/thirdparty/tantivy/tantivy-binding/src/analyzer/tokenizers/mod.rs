@@ -1,12 +1,18 @@
+mod go_tokenizer;
 mod icu_tokneizer;
 mod jieba_tokenizer;
 mod lang_ident_tokenizer;
 mod lindera_tokenizer;
 mod tokenizer;
 
+pub use self::go_tokenizer::GoTokenizer;
 pub use self::icu_tokneizer::IcuTokenizer;
 pub use self::jieba_tokenizer::JiebaTokenizer;
 pub use self::lang_ident_tokenizer::LangIdentTokenizer;
 pub use self::lindera_tokenizer::LinderaTokenizer;
 
+pub(crate) use self::go_tokenizer::{
+    append_go_token, register_go_tokenizer, GoTokenizerCloneFn, GoTokenizerCreateFn,
+    GoTokenizerDestroyFn, GoTokenizerTokenizeFn,
+};
 pub(crate) use self::tokenizer::*;
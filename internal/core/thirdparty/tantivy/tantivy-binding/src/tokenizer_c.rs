@@ -2,7 +2,13 @@ use libc::{c_char, c_void};
 use tantivy::tokenizer::TextAnalyzer;
 
 use crate::{
-    analyzer::create_analyzer,
+    analyzer::{
+        create_analyzer,
+        tokenizers::{
+            append_go_token, register_go_tokenizer, GoTokenizerCloneFn, GoTokenizerCreateFn,
+            GoTokenizerDestroyFn, GoTokenizerTokenizeFn,
+        },
+    },
     array::RustResult,
     log::init_log,
     string_c::c_str_to_str,
@@ -34,3 +40,34 @@ pub extern "C" fn tantivy_clone_analyzer(ptr: *mut c_void) -> *mut c_void {
 pub extern "C" fn tantivy_free_analyzer(tokenizer: *mut c_void) {
     free_binding::<TextAnalyzer>(tokenizer);
 }
+
+#[no_mangle]
+pub extern "C" fn tantivy_register_go_tokenizer(
+    create: GoTokenizerCreateFn,
+    clone: GoTokenizerCloneFn,
+    destroy: GoTokenizerDestroyFn,
+    tokenize: GoTokenizerTokenizeFn,
+) {
+    register_go_tokenizer(create, clone, destroy, tokenize);
+}
+
+#[no_mangle]
+pub extern "C" fn tantivy_append_go_token(
+    sink: *mut c_void,
+    token: *const c_char,
+    token_len: usize,
+    start_offset: i64,
+    end_offset: i64,
+    position: i64,
+    position_length: i64,
+) {
+    append_go_token(
+        sink,
+        token,
+        token_len,
+        start_offset,
+        end_offset,
+        position,
+        position_length,
+    );
+}
@@ -125,7 +125,8 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 			!segment.isCompacting && // not compacting now
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
-			!segment.GetIsInvisible()
+			!segment.GetIsInvisible() &&
+			!policy.meta.isPendingFunctionBackfill(segment) // function outputs are backfilled
 	}))
 
 	views := make([]CompactionView, 0)
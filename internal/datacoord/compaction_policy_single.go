@@ -92,7 +92,8 @@ func (policy *singleCompactionPolicy) triggerOneCollection(ctx context.Context,
 			!segment.isCompacting && // not compacting now
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() == datapb.SegmentLevel_L2 && // only support L2 for now
-			!segment.GetIsInvisible()
+			!segment.GetIsInvisible() &&
+			!policy.meta.isPendingFunctionBackfill(segment) // function outputs are backfilled
 	}))
 
 	views := make([]CompactionView, 0)
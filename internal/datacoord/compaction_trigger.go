@@ -549,7 +549,8 @@ func (t *compactionTrigger) getCandidates(signal *compactionSignal) ([]chanPartS
 				!segment.GetIsImporting() && // not importing now
 				segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
 				segment.GetLevel() != datapb.SegmentLevel_L2 && // ignore l2 segment
-				!segment.GetIsInvisible()
+				!segment.GetIsInvisible() &&
+				!t.meta.isPendingFunctionBackfill(segment) // function outputs are backfilled
 		}),
 	}
 
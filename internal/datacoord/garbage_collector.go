@@ -55,6 +55,7 @@ type GcOption struct {
 	scanInterval     time.Duration        // interval for scan residue for interupted log wrttien
 
 	broker           broker.Broker
+	importMeta       ImportMeta
 	removeObjectPool *conc.Pool[struct{}]
 }
 
@@ -288,8 +289,14 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 		},
 	}
 
+	// the binlogs shared by the transferred segments are still in use after the segment owning them is gone.
+	sharedLogs := gc.meta.GetSharedBinlogs(ctx)
 	for _, task := range scanTasks {
-		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, task.checker)
+		checker := task.checker
+		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
+			_, shared := sharedLogs[objectInfo.FilePath]
+			return shared || checker(objectInfo, segment)
+		})
 	}
 	metrics.GarbageCollectorRunCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(1)
 }
@@ -469,6 +476,10 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		loadedSegments.Insert(segmentID)
 	}
 
+	sharedLogs := gc.meta.GetSharedBinlogs(ctx)
+	// the binlogs of the transferred segments are read by the import job after the source segments are dropped.
+	transferring := getTransferringSegments(ctx, gc.option.importMeta)
+
 	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
 	for segmentID, segment := range drops {
 		if ctx.Err() != nil {
@@ -482,6 +493,10 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 			log.Info("skip GC segment since it is loaded", zap.Int64("segmentID", segmentID))
 			continue
 		}
+		if transferring.Contain(segmentID) {
+			log.Info("skip GC segment since it is being transferred", zap.Int64("segmentID", segmentID))
+			continue
+		}
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
@@ -497,6 +512,7 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		for key := range getJSONKeyLogs(cloned, gc) {
 			logs[key] = struct{}{}
 		}
+		gc.excludeSharedLogs(ctx, segmentID, logs, sharedLogs)
 
 		log.Info("GC segment start...", zap.Int("insert_logs", len(cloned.GetBinlogs())),
 			zap.Int("delta_logs", len(cloned.GetDeltalogs())),
@@ -520,6 +536,21 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	}
 }
 
+// excludeSharedLogs removes the logs still used by other segments from the logs of the dropped segment,
+// the binlogs are shared between the transferred segment and the segment it is transferred from.
+func (gc *garbageCollector) excludeSharedLogs(ctx context.Context, segmentID int64, logs map[string]struct{}, sharedLogs map[string]typeutil.UniqueSet) {
+	for logPath := range logs {
+		if sharers, ok := sharedLogs[logPath]; ok && (sharers.Len() > 1 || !sharers.Contain(segmentID)) {
+			delete(logs, logPath)
+			continue
+		}
+		ownerID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), logPath)
+		if err == nil && ownerID != segmentID && gc.meta.GetSegment(ctx, ownerID) != nil {
+			delete(logs, logPath)
+		}
+	}
+}
+
 func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 	log := log.Ctx(ctx)
 	channelCPs, err := gc.meta.catalog.ListChannelCheckpoint(ctx)
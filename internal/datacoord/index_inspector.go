@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type indexInspector struct {
@@ -153,9 +154,20 @@ func (i *indexInspector) createIndexesForSegment(ctx context.Context, segment *S
 		return nil
 	}
 
+	// the index of the function outputs is built after the outputs are backfilled.
+	var pendingFields typeutil.Set[int64]
+	if collection := i.meta.GetCollection(segment.GetCollectionID()); collection != nil {
+		pendingFields = typeutil.NewSet(missingFunctionOutputs(segment, functionBackfillFieldIDs(collection.Schema))...)
+	}
+
 	indexes := i.meta.indexMeta.GetIndexesForCollection(segment.CollectionID, "")
 	indexIDToSegIndexes := i.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
 	for _, index := range indexes {
+		if pendingFields.Contain(index.FieldID) {
+			log.Ctx(ctx).Debug("function output of segment is not backfilled, skip create index",
+				zap.Int64("segmentID", segment.GetID()), zap.Int64("fieldID", index.FieldID))
+			continue
+		}
 		if _, ok := indexIDToSegIndexes[index.IndexID]; !ok {
 			if err := i.createIndexForSegment(ctx, segment, index.IndexID); err != nil {
 				log.Ctx(ctx).Warn("create index for segment fail", zap.Int64("segmentID", segment.ID),
@@ -171,6 +171,48 @@ func (m *indexMeta) reloadFromKV() error {
 	return nil
 }
 
+// reload reloads the indexes and segment indexes from the catalog,
+// it's used by a follower cluster to catch up the indexes mirrored from the primary cluster.
+func (m *indexMeta) reload() error {
+	fieldIndexes, err := m.catalog.ListIndexes(m.ctx)
+	if err != nil {
+		return err
+	}
+	segmentIndexes, err := m.catalog.ListSegmentIndexes(m.ctx)
+	if err != nil {
+		return err
+	}
+
+	m.fieldIndexLock.Lock()
+	defer m.fieldIndexLock.Unlock()
+	m.indexes = make(map[UniqueID]map[UniqueID]*model.Index)
+	for _, fieldIndex := range fieldIndexes {
+		m.updateCollectionIndex(fieldIndex)
+	}
+
+	reloaded := typeutil.NewUniqueSet()
+	for _, segIdx := range segmentIndexes {
+		if segIdx.IndexMemSize == 0 {
+			segIdx.IndexMemSize = segIdx.IndexSerializedSize * paramtable.Get().DataCoordCfg.IndexMemSizeEstimateMultiplier.GetAsUint64()
+		}
+		m.updateSegmentIndex(segIdx)
+		reloaded.Insert(segIdx.BuildID)
+	}
+	for _, segIdx := range m.segmentBuildInfo.List() {
+		if reloaded.Contain(segIdx.BuildID) {
+			continue
+		}
+		m.segmentBuildInfo.Remove(segIdx.BuildID)
+		if indexes, ok := m.segmentIndexes.Get(segIdx.SegmentID); ok {
+			indexes.Remove(segIdx.IndexID)
+			if indexes.Len() == 0 {
+				m.segmentIndexes.Remove(segIdx.SegmentID)
+			}
+		}
+	}
+	return nil
+}
+
 func (m *indexMeta) updateCollectionIndex(index *model.Index) {
 	if _, ok := m.indexes[index.CollectionID]; !ok {
 		m.indexes[index.CollectionID] = make(map[UniqueID]*model.Index)
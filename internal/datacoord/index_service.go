@@ -149,6 +149,9 @@ func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := checkWritable("CreateIndex"); err != nil {
+		return merr.Status(err), nil
+	}
 	metrics.IndexRequestCounter.WithLabelValues(metrics.TotalLabel).Inc()
 
 	schema, err := s.getSchema(ctx, req.GetCollectionID())
@@ -331,6 +334,9 @@ func (s *Server) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest)
 		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := checkWritable("AlterIndex"); err != nil {
+		return merr.Status(err), nil
+	}
 
 	indexes := s.meta.indexMeta.GetIndexesForCollection(req.GetCollectionID(), req.GetIndexName())
 	if len(indexes) == 0 {
@@ -732,6 +738,69 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	}, nil
 }
 
+// GetFunctionBackfillProgress gets the progress of backfilling the outputs of the functions
+// added to an existing collection, by num rows of the flushed segments.
+func (s *Server) GetFunctionBackfillProgress(ctx context.Context, req *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("functionName", req.GetFunctionName()),
+	)
+	log.Info("receive GetFunctionBackfillProgress request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &indexpb.GetFunctionBackfillProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	collection, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("GetFunctionBackfillProgress fail to get collection", zap.Error(err))
+		return &indexpb.GetFunctionBackfillProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	schema := collection.Schema
+	if req.GetFunctionName() != "" {
+		function, ok := lo.Find(schema.GetFunctions(), func(function *schemapb.FunctionSchema) bool {
+			return function.GetName() == req.GetFunctionName()
+		})
+		if !ok {
+			err := merr.WrapErrParameterInvalidMsg("function %s not found", req.GetFunctionName())
+			log.Warn("GetFunctionBackfillProgress fail", zap.Error(err))
+			return &indexpb.GetFunctionBackfillProgressResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		schema = &schemapb.CollectionSchema{Functions: []*schemapb.FunctionSchema{function}}
+	}
+	fieldIDs := functionBackfillFieldIDs(schema)
+
+	resp := &indexpb.GetFunctionBackfillProgressResponse{
+		Status: merr.Success(),
+	}
+	segments := s.meta.SelectSegments(ctx, WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(info *SegmentInfo) bool {
+		return isSegmentHealthy(info) && isFlush(info) && info.GetLevel() != datapb.SegmentLevel_L0
+	}))
+	for _, segment := range segments {
+		resp.TotalRows += segment.GetNumOfRows()
+		if len(missingFunctionOutputs(segment, fieldIDs)) == 0 {
+			resp.BackfilledRows += segment.GetNumOfRows()
+			continue
+		}
+		resp.PendingRows += segment.GetNumOfRows()
+		if resp.FailReason == "" && s.meta.statsTaskMeta.GetStatsTaskStateBySegmentID(segment.GetID(), indexpb.StatsSubJob_FunctionBackfillJob) == indexpb.JobState_JobStateFailed {
+			resp.FailReason = s.meta.statsTaskMeta.GetStatsTaskBySegmentID(segment.GetID(), indexpb.StatsSubJob_FunctionBackfillJob).GetFailReason()
+		}
+	}
+
+	log.Info("GetFunctionBackfillProgress success", zap.Int64("backfilledRows", resp.GetBackfilledRows()),
+		zap.Int64("totalRows", resp.GetTotalRows()), zap.Int64("pendingRows", resp.GetPendingRows()))
+	return resp, nil
+}
+
 // indexStats just for indexing statistics.
 // Please use it judiciously.
 type indexStats struct {
@@ -871,6 +940,9 @@ func (s *Server) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (
 		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := checkWritable("DropIndex"); err != nil {
+		return merr.Status(err), nil
+	}
 
 	indexes := s.meta.indexMeta.GetIndexesForCollection(req.GetCollectionID(), req.GetIndexName())
 	if len(indexes) == 0 {
@@ -36,12 +36,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
@@ -303,6 +305,54 @@ func (m *meta) reloadFromKV(ctx context.Context, broker broker.Broker) error {
 	return nil
 }
 
+// reloadCollectionSegments reloads the segments of the collections from the catalog,
+// it's used by a follower cluster to catch up the segments mirrored from the primary cluster.
+func (m *meta) reloadCollectionSegments(ctx context.Context, collectionIDs []int64) error {
+	collectionSegments := make(map[int64][]*datapb.SegmentInfo, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		segments, err := m.catalog.ListSegments(ctx, collectionID)
+		if err != nil {
+			return err
+		}
+		collectionSegments[collectionID] = segments
+	}
+
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	for collectionID, segments := range collectionSegments {
+		reloaded := typeutil.NewUniqueSet()
+		for _, segment := range segments {
+			m.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+			reloaded.Insert(segment.GetID())
+		}
+		for _, segment := range m.segments.GetSegmentsBySelector(WithCollection(collectionID)) {
+			if !reloaded.Contain(segment.GetID()) {
+				m.segments.DropSegment(segment.GetID())
+			}
+		}
+		log.Ctx(ctx).Info("meta update: reload collection segments",
+			zap.Int64("collectionID", collectionID), zap.Int("numSegments", len(segments)))
+	}
+	return nil
+}
+
+// reloadChannelCheckpoints reloads all the channel checkpoints from the catalog,
+// it's used by a follower cluster to catch up the checkpoints mirrored from the primary cluster.
+func (m *meta) reloadChannelCheckpoints(ctx context.Context) error {
+	channelCPs, err := m.catalog.ListChannelCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	for vChannel, pos := range channelCPs {
+		pos.ChannelName = vChannel
+	}
+
+	m.channelCPs.Lock()
+	defer m.channelCPs.Unlock()
+	m.channelCPs.checkpoints = channelCPs
+	return nil
+}
+
 func (m *meta) reloadCollectionsFromRootcoord(ctx context.Context, broker broker.Broker) error {
 	resp, err := broker.ListDatabases(ctx)
 	if err != nil {
@@ -367,6 +417,16 @@ func (m *meta) GetCollection(collectionID UniqueID) *collectionInfo {
 	return collection
 }
 
+// isPendingFunctionBackfill checks whether the outputs of the functions added to the collection
+// are not backfilled for the segment yet, such segment can't be compacted or indexed until backfilled.
+func (m *meta) isPendingFunctionBackfill(segment *SegmentInfo) bool {
+	collection := m.GetCollection(segment.GetCollectionID())
+	if collection == nil {
+		return false
+	}
+	return len(missingFunctionOutputs(segment, functionBackfillFieldIDs(collection.Schema))) > 0
+}
+
 // GetCollections returns collections from local cache
 func (m *meta) GetCollections() []*collectionInfo {
 	return m.collections.Values()
@@ -644,6 +704,34 @@ func (m *meta) GetSegment(ctx context.Context, segID UniqueID) *SegmentInfo {
 	return m.segments.GetSegment(segID)
 }
 
+// GetSharedBinlogs returns the binlog paths shared from other segments, and the ids of the segments sharing each of them.
+func (m *meta) GetSharedBinlogs(ctx context.Context) map[string]typeutil.UniqueSet {
+	m.segMu.RLock()
+	defer m.segMu.RUnlock()
+	shared := make(map[string]typeutil.UniqueSet)
+	for _, segment := range m.segments.GetSegments() {
+		for binlogType, fieldBinlogs := range map[storage.BinlogType][]*datapb.FieldBinlog{
+			storage.InsertBinlog: segment.GetBinlogs(),
+			storage.DeleteBinlog: segment.GetDeltalogs(),
+			storage.StatsBinlog:  segment.GetStatslogs(),
+			storage.BM25Binlog:   segment.GetBm25Statslogs(),
+		} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, l := range fieldBinlog.GetBinlogs() {
+					if l.GetLogPath() == "" || !binlog.IsSharedBinlogPath(binlogType, segment.GetID(), l.GetLogID(), l.GetLogPath()) {
+						continue
+					}
+					if _, ok := shared[l.GetLogPath()]; !ok {
+						shared[l.GetLogPath()] = typeutil.NewUniqueSet()
+					}
+					shared[l.GetLogPath()].Insert(segment.GetID())
+				}
+			}
+		}
+	}
+	return shared
+}
+
 // GetAllSegmentsUnsafe returns all segments
 func (m *meta) GetAllSegmentsUnsafe() []*SegmentInfo {
 	m.segMu.RLock()
@@ -994,6 +1082,27 @@ func AddBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs, bm25logs
 	}
 }
 
+// AddPendingFunctionOutputsOperator marks the output fields of the functions added to the collection
+// as pending for the segment, they are filled in by the function backfill stats task.
+func AddPendingFunctionOutputsOperator(segmentID int64, fieldIDs []int64) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		segment := modPack.Get(segmentID)
+		if segment == nil || !isSegmentHealthy(segment) {
+			log.Ctx(context.TODO()).Warn("meta update: add pending function outputs failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		pending := typeutil.NewSet(segment.GetPendingFunctionOutputs()...)
+		for _, fieldID := range fieldIDs {
+			if !pending.Contain(fieldID) {
+				segment.PendingFunctionOutputs = append(segment.PendingFunctionOutputs, fieldID)
+			}
+		}
+		return true
+	}
+}
+
 func UpdateBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs, bm25logs []*datapb.FieldBinlog) UpdateOperator {
 	return func(modPack *updateSegmentPack) bool {
 		segment := modPack.Get(segmentID)
@@ -1575,6 +1684,19 @@ func getMinPosition(positions []*msgpb.MsgPosition) *msgpb.MsgPosition {
 	return minPos
 }
 
+// unionPendingFunctionOutputs returns the function outputs pending in any of the segments,
+// the segments compacted from them still lack the outputs.
+func unionPendingFunctionOutputs(segments []*SegmentInfo) []int64 {
+	pending := typeutil.NewSet[int64]()
+	for _, segment := range segments {
+		pending.Insert(segment.GetPendingFunctionOutputs()...)
+	}
+	if pending.Len() == 0 {
+		return nil
+	}
+	return pending.Collect()
+}
+
 func (m *meta) completeClusterCompactionMutation(t *datapb.CompactionTask, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
 	log := log.Ctx(context.TODO()).With(zap.Int64("planID", t.GetPlanID()),
 		zap.String("type", t.GetType().String()),
@@ -1622,7 +1744,8 @@ func (m *meta) completeClusterCompactionMutation(t *datapb.CompactionTask, resul
 				return info.GetDmlPosition()
 			})),
 			// visible after stats and index
-			IsInvisible: true,
+			IsInvisible:            true,
+			PendingFunctionOutputs: unionPendingFunctionOutputs(compactFromSegInfos),
 		}
 		segment := NewSegmentInfo(segmentInfo)
 		compactToSegInfos = append(compactToSegInfos, segment)
@@ -1709,7 +1832,8 @@ func (m *meta) completeMixCompactionMutation(t *datapb.CompactionTask, result *d
 				DmlPosition: getMinPosition(lo.Map(compactFromSegInfos, func(info *SegmentInfo, _ int) *msgpb.MsgPosition {
 					return info.GetDmlPosition()
 				})),
-				IsSorted: compactToSegment.GetIsSorted(),
+				IsSorted:               compactToSegment.GetIsSorted(),
+				PendingFunctionOutputs: unionPendingFunctionOutputs(compactFromSegInfos),
 			})
 
 		if compactToSegmentInfo.GetNumOfRows() == 0 {
@@ -1938,7 +2062,8 @@ func (m *meta) GetCompactableSegmentGroupByCollection() map[int64][]*SegmentInfo
 		return isSegmentHealthy(segment) &&
 			isFlushed(segment) && // sealed segment
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			!m.isPendingFunctionBackfill(segment) // function outputs are backfilled
 	}))
 
 	ret := make(map[int64][]*SegmentInfo)
@@ -2114,7 +2239,7 @@ func (m *meta) CleanPartitionStatsInfo(ctx context.Context, info *datapb.Partiti
 	return nil
 }
 
-func (m *meta) SaveStatsResultSegment(oldSegmentID int64, result *workerpb.StatsResult) (*segMetricMutation, error) {
+func (m *meta) SaveStatsResultSegment(oldSegmentID int64, subJobType indexpb.StatsSubJob, result *workerpb.StatsResult) (*segMetricMutation, error) {
 	m.segMu.Lock()
 	defer m.segMu.Unlock()
 
@@ -2143,6 +2268,12 @@ func (m *meta) SaveStatsResultSegment(oldSegmentID int64, result *workerpb.Stats
 		resultInvisible = false
 	}
 
+	// the function outputs are still pending after sort, they are filled in by the function backfill job.
+	pendingFunctionOutputs := oldSegment.GetPendingFunctionOutputs()
+	if subJobType == indexpb.StatsSubJob_FunctionBackfillJob {
+		pendingFunctionOutputs = nil
+	}
+
 	segmentInfo := &datapb.SegmentInfo{
 		CollectionID:              oldSegment.GetCollectionID(),
 		PartitionID:               oldSegment.GetPartitionID(),
@@ -2170,6 +2301,7 @@ func (m *meta) SaveStatsResultSegment(oldSegmentID int64, result *workerpb.Stats
 		Deltalogs:                 nil,
 		CompactionFrom:            []int64{oldSegmentID},
 		IsSorted:                  true,
+		PendingFunctionOutputs:    pendingFunctionOutputs,
 	}
 	segment := NewSegmentInfo(segmentInfo)
 	if segment.GetNumOfRows() > 0 {
@@ -943,6 +943,23 @@ func TestUpdateSegmentsInfo(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("add pending function outputs", func(t *testing.T) {
+		meta, err := newMemoryMeta(t)
+		assert.NoError(t, err)
+		err = meta.AddSegment(context.TODO(), &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:                     1,
+			State:                  commonpb.SegmentState_Flushed,
+			PendingFunctionOutputs: []int64{102},
+		}})
+		assert.NoError(t, err)
+
+		err = meta.UpdateSegmentsInfo(context.TODO(), AddPendingFunctionOutputsOperator(1, []int64{102, 103}))
+		assert.NoError(t, err)
+
+		segment := meta.GetHealthySegment(context.TODO(), 1)
+		assert.ElementsMatch(t, []int64{102, 103}, segment.GetPendingFunctionOutputs())
+	})
+
 	t.Run("update empty segment into flush", func(t *testing.T) {
 		meta, err := newMemoryMeta(t)
 		assert.NoError(t, err)
@@ -169,6 +169,41 @@ func (m *mockMixCoord) RenameCollection(ctx context.Context, req *milvuspb.Renam
 	panic("implement me")
 }
 
+func (m *mockMixCoord) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *mockMixCoord) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (m *mockMixCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -718,6 +753,22 @@ func (s *mockMixCoord) UpdateLoadConfig(ctx context.Context, req *querypb.Update
 	panic("implement me")
 }
 
+func (s *mockMixCoord) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 // DataCoordServer
 func (s *mockMixCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
 	panic("implement me")
@@ -767,6 +818,10 @@ func (s *mockMixCoord) GetChannelRecoveryInfo(ctx context.Context, req *datapb.G
 	panic("implement me")
 }
 
+func (s *mockMixCoord) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	panic("implement me")
+}
+
 func (s *mockMixCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	panic("implement me")
 }
@@ -863,6 +918,10 @@ func (s *mockMixCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.G
 	panic("implement me")
 }
 
+func (s *mockMixCoord) GetFunctionBackfillProgress(ctx context.Context, req *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	panic("implement me")
+}
+
 func (s *mockMixCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	panic("implement me")
 }
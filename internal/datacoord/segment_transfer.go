@@ -0,0 +1,382 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"path"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// transferSegments transfers the flushed segments of the source partition into the target partition.
+// The segments are re-parented by meta only, the target segments share the binlogs of the source segments
+// until the stats task sorts them into the binlogs of their own.
+// If the schemas of the collections differ, the segments are copied by a binlog import job in background instead,
+// the deletes of the L0 segments are always re-parented.
+// The collection level deletes are not transferred, they may hit the rows of the target partition
+// which are not from the source partition.
+// The growing data of the source partition is not transferred,
+// the replay positions of the source vchannels are returned to the caller to replay it from wal.
+func (s *Server) transferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+		zap.Int64("targetPartitionID", req.GetTargetPartitionID()),
+	)
+
+	channelMapping := req.GetChannelMapping()
+	segments := s.meta.SelectSegments(ctx, WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		_, ok := channelMapping[segment.GetInsertChannel()]
+		return ok && segment.GetState() == commonpb.SegmentState_Flushed && !segment.GetIsImporting() &&
+			segment.GetPartitionID() == req.GetPartitionID()
+	}))
+	segmentIDs := lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })
+	// prevent the source segments from being compacted during transferring.
+	exist, canDo := s.meta.CheckAndSetSegmentsCompacting(ctx, segmentIDs)
+	if !exist || !canDo {
+		return nil, merr.WrapErrCompactionPlanConflict("the segments to transfer are being compacted, retry later")
+	}
+	defer s.meta.SetSegmentsCompacting(ctx, segmentIDs, false)
+
+	// the index of the re-parented segment is built after sorting, which needs the stats task.
+	copyBinlogs := req.GetCopyBinlogs() || !Params.DataCoordCfg.EnableStatsTask.GetAsBool()
+	reparented := make([]*SegmentInfo, 0, len(segments))
+	copied := make([]*SegmentInfo, 0)
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 || !copyBinlogs {
+			reparented = append(reparented, segment)
+		} else {
+			copied = append(copied, segment)
+		}
+	}
+
+	resp := &datapb.TransferSegmentsResponse{
+		Status:          merr.Success(),
+		ReplayPositions: s.getTransferReplayPositions(ctx, req),
+	}
+	for _, segment := range reparented {
+		targetSegment, err := s.reparentSegment(ctx, segment, req.GetTargetCollectionID(), req.GetTargetPartitionID(), channelMapping[segment.GetInsertChannel()])
+		if err != nil {
+			log.Warn("failed to re-parent segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			s.dropTransferredSegments(ctx, resp.GetSegmentIDs())
+			return nil, err
+		}
+		resp.SegmentIDs = append(resp.SegmentIDs, targetSegment.GetID())
+	}
+	if len(copied) > 0 {
+		jobID, err := s.importTransferredSegments(ctx, req, copied)
+		if err != nil {
+			log.Warn("failed to copy segments", zap.Error(err))
+			s.dropTransferredSegments(ctx, resp.GetSegmentIDs())
+			return nil, err
+		}
+		resp.ImportJobID = jobID
+	}
+
+	sourceIDs := typeutil.NewUniqueSet()
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			continue
+		}
+		// the insert messages in wal refer to the segments before compaction.
+		sourceIDs.Insert(segment.GetID())
+		sourceIDs.Insert(s.getCompactionAncestors(ctx, segment)...)
+	}
+	for _, segmentID := range resp.GetSegmentIDs() {
+		select {
+		case getStatsTaskChSingleton() <- segmentID:
+		default:
+		}
+	}
+	resp.SourceSegmentIDs = sourceIDs.Collect()
+	log.Info("transfer segments done",
+		zap.Int64s("sourceSegmentIDs", segmentIDs),
+		zap.Int64s("targetSegmentIDs", resp.GetSegmentIDs()),
+		zap.Int64("importJobID", resp.GetImportJobID()),
+		zap.Int("replayChannels", len(resp.GetReplayPositions())))
+	return resp, nil
+}
+
+// rollbackTransferSegments drops the target segments and aborts the import job created by a failed transferring.
+func (s *Server) rollbackTransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) error {
+	if jobID := req.GetRollbackImportJobID(); jobID != 0 {
+		if err := s.importMeta.UpdateJob(ctx, jobID, UpdateJobState(internalpb.ImportJobState_Failed),
+			UpdateJobReason("the partition transferring is rolled back")); err != nil {
+			return err
+		}
+	}
+	return s.dropTransferredSegments(ctx, req.GetRollbackSegmentIDs())
+}
+
+// dropTransferredSegments marks the target segments of the transferring dropped.
+func (s *Server) dropTransferredSegments(ctx context.Context, segmentIDs []int64) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	operators := lo.Map(segmentIDs, func(segmentID int64, _ int) UpdateOperator {
+		return UpdateStatusOperator(segmentID, commonpb.SegmentState_Dropped)
+	})
+	if err := s.meta.UpdateSegmentsInfo(ctx, operators...); err != nil {
+		log.Ctx(ctx).Warn("failed to drop the transferred segments", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// getTransferReplayPositions returns the position of each source vchannel,
+// the data of the source partition after the position is not in the flushed segments.
+func (s *Server) getTransferReplayPositions(ctx context.Context, req *datapb.TransferSegmentsRequest) []*msgpb.MsgPosition {
+	positions := make([]*msgpb.MsgPosition, 0, len(req.GetChannelMapping()))
+	for channel := range req.GetChannelMapping() {
+		position := s.meta.GetChannelCheckpoint(channel)
+		unflushed := s.meta.SelectSegments(ctx, WithChannel(channel), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+			return segment.GetPartitionID() == req.GetPartitionID() &&
+				segment.GetLevel() != datapb.SegmentLevel_L0 &&
+				isSegmentHealthy(segment) &&
+				!isFlushState(segment.GetState())
+		}))
+		for _, segment := range unflushed {
+			startPosition := segment.GetStartPosition()
+			if startPosition.GetMsgID() == nil {
+				continue
+			}
+			if position == nil || startPosition.GetTimestamp() < position.GetTimestamp() {
+				position = startPosition
+			}
+		}
+		if position != nil {
+			positions = append(positions, position)
+		}
+	}
+	return positions
+}
+
+// getCompactionAncestors returns the ids of the segments compacted into the segment.
+func (s *Server) getCompactionAncestors(ctx context.Context, segment *SegmentInfo) []int64 {
+	ancestors := make([]int64, 0)
+	queue := segment.GetCompactionFrom()
+	for len(queue) > 0 {
+		segmentID := queue[0]
+		queue = queue[1:]
+		ancestors = append(ancestors, segmentID)
+		if ancestor := s.meta.GetSegment(ctx, segmentID); ancestor != nil {
+			queue = append(queue, ancestor.GetCompactionFrom()...)
+		}
+	}
+	return ancestors
+}
+
+// reparentSegment adds a segment of the target partition sharing the binlogs of the source segment.
+// The binlogs keep the full paths of the source segment, which cannot be derived from the target segment.
+func (s *Server) reparentSegment(ctx context.Context, segment *SegmentInfo, collectionID, partitionID int64, channel string) (*SegmentInfo, error) {
+	segmentID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rootPath := s.meta.chunkManager.RootPath()
+	binlogs, err := shareFieldBinlogs(rootPath, storage.InsertBinlog, segment, segment.GetBinlogs())
+	if err != nil {
+		return nil, err
+	}
+	deltalogs, err := shareFieldBinlogs(rootPath, storage.DeleteBinlog, segment, segment.GetDeltalogs())
+	if err != nil {
+		return nil, err
+	}
+	statslogs, err := shareFieldBinlogs(rootPath, storage.StatsBinlog, segment, segment.GetStatslogs())
+	if err != nil {
+		return nil, err
+	}
+	bm25logs, err := shareFieldBinlogs(rootPath, storage.BM25Binlog, segment, segment.GetBm25Statslogs())
+	if err != nil {
+		return nil, err
+	}
+
+	startPosition := &msgpb.MsgPosition{
+		ChannelName: channel,
+		Timestamp:   segment.GetStartPosition().GetTimestamp(),
+	}
+	dmlPosition := &msgpb.MsgPosition{
+		ChannelName: channel,
+		Timestamp:   segment.GetDmlPosition().GetTimestamp(),
+	}
+	targetSegment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:                   segmentID,
+		CollectionID:         collectionID,
+		PartitionID:          partitionID,
+		InsertChannel:        channel,
+		NumOfRows:            segment.GetNumOfRows(),
+		State:                commonpb.SegmentState_Flushed,
+		MaxRowNum:            segment.GetMaxRowNum(),
+		LastExpireTime:       segment.GetLastExpireTime(),
+		StartPosition:        startPosition,
+		DmlPosition:          dmlPosition,
+		Binlogs:              binlogs,
+		Statslogs:            statslogs,
+		Deltalogs:            deltalogs,
+		Bm25Statslogs:        bm25logs,
+		Level:                segment.GetLevel(),
+		StorageVersion:       segment.GetStorageVersion(),
+		IsCreatedByStreaming: segment.GetIsCreatedByStreaming(),
+		// the index, text and json key stats are bound to the segment binlog paths,
+		// sort the segment again to write the binlogs of its own and rebuild them.
+		IsSorted: false,
+	})
+	if err := s.meta.AddSegment(ctx, targetSegment); err != nil {
+		return nil, err
+	}
+	return targetSegment, nil
+}
+
+// shareFieldBinlogs clones the binlogs of the segment with the full paths.
+func shareFieldBinlogs(rootPath string, binlogType storage.BinlogType, segment *SegmentInfo, fieldBinlogs []*datapb.FieldBinlog) ([]*datapb.FieldBinlog, error) {
+	shared := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldBinlog = proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+		if err := binlog.DecompressBinLogWithRootPath(rootPath, binlogType, segment.GetCollectionID(), segment.GetPartitionID(),
+			segment.GetID(), []*datapb.FieldBinlog{fieldBinlog}); err != nil {
+			return nil, err
+		}
+		shared = append(shared, fieldBinlog)
+	}
+	return shared, nil
+}
+
+// transferSourceSegmentsKey is the import option recording the source segments of a transferring import job,
+// the binlogs of the source segments are kept until the job is done.
+const transferSourceSegmentsKey = "transfer_source_segments"
+
+// importTransferredSegments copies the segments into the target partition by a binlog import job,
+// which reads the binlogs with the schema of the target collection.
+func (s *Server) importTransferredSegments(ctx context.Context, req *datapb.TransferSegmentsRequest, segments []*SegmentInfo) (int64, error) {
+	rootPath := s.meta.chunkManager.RootPath()
+	files := make([]*internalpb.ImportFile, 0, len(segments))
+	for _, segment := range segments {
+		if hasSharedBinlogs(segment) {
+			// the binlog import reads the binlogs under the segment path.
+			return 0, merr.WrapErrCompactionPlanConflict("the segments transferred recently are not sorted yet, retry later")
+		}
+		idPath := metautil.JoinIDPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+		files = append(files, &internalpb.ImportFile{Paths: []string{
+			path.Join(rootPath, common.SegmentInsertLogPath, idPath),
+			path.Join(rootPath, common.SegmentDeltaLogPath, idPath),
+		}})
+	}
+	target, err := s.handler.GetCollection(ctx, req.GetTargetCollectionID())
+	if err != nil {
+		return 0, err
+	}
+	if target == nil {
+		return 0, merr.WrapErrCollectionNotFound(req.GetTargetCollectionID())
+	}
+
+	sourceSegments, err := json.Marshal(lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() }))
+	if err != nil {
+		return 0, err
+	}
+	options := []*commonpb.KeyValuePair{
+		{Key: importutilv2.BackupFlag, Value: "true"},
+		{Key: transferSourceSegmentsKey, Value: string(sourceSegments)},
+	}
+	timeoutTs, err := importutilv2.GetTimeoutTs(options)
+	if err != nil {
+		return 0, err
+	}
+	idStart, _, err := s.allocator.AllocN(int64(len(files)) + 1)
+	if err != nil {
+		return 0, err
+	}
+	for i, file := range files {
+		file.Id = idStart + int64(i) + 1
+	}
+	job := &importJob{
+		ImportJob: &datapb.ImportJob{
+			JobID:          idStart,
+			CollectionID:   req.GetTargetCollectionID(),
+			CollectionName: req.GetTargetSchema().GetName(),
+			PartitionIDs:   []int64{req.GetTargetPartitionID()},
+			Vchannels:      target.VChannelNames,
+			Schema:         req.GetTargetSchema(),
+			TimeoutTs:      timeoutTs,
+			CleanupTs:      math.MaxUint64,
+			State:          internalpb.ImportJobState_Pending,
+			Files:          files,
+			Options:        options,
+			StartTime:      time.Now().Format("2006-01-02T15:04:05Z07:00"),
+			ReadyVchannels: target.VChannelNames,
+		},
+		tr: timerecord.NewTimeRecorder("import job"),
+	}
+	if err := s.importMeta.AddJob(ctx, job); err != nil {
+		return 0, err
+	}
+	return job.GetJobID(), nil
+}
+
+func hasSharedBinlogs(segment *SegmentInfo) bool {
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			if l.GetLogPath() != "" && binlog.IsSharedBinlogPath(storage.InsertBinlog, segment.GetID(), l.GetLogID(), l.GetLogPath()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getTransferringSegments returns the source segments read by the unfinished transferring import jobs.
+func getTransferringSegments(ctx context.Context, importMeta ImportMeta) typeutil.UniqueSet {
+	segmentIDs := typeutil.NewUniqueSet()
+	if importMeta == nil {
+		return segmentIDs
+	}
+	jobs := importMeta.GetJobBy(ctx, WithoutJobStates(internalpb.ImportJobState_Completed, internalpb.ImportJobState_Failed))
+	for _, job := range jobs {
+		value, err := funcutil.GetAttrByKeyFromRepeatedKV(transferSourceSegmentsKey, job.GetOptions())
+		if err != nil {
+			continue
+		}
+		var ids []int64
+		if err := json.Unmarshal([]byte(value), &ids); err != nil {
+			log.Ctx(ctx).Warn("invalid transferring source segments", zap.Int64("jobID", job.GetJobID()), zap.String("value", value))
+			continue
+		}
+		segmentIDs.Insert(ids...)
+	}
+	return segmentIDs
+}
@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+)
+
+func newTransferTestServer(t *testing.T) *Server {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	catalog := datacoord.NewCatalog(NewMetaMemoryKV(), "", "")
+	broker := broker.NewMockBroker(t)
+	broker.EXPECT().ShowCollectionIDs(mock.Anything).Return(nil, nil)
+	meta, err := newMeta(ctx, catalog, cm, broker)
+	assert.NoError(t, err)
+
+	alloc := newMockAllocator(t)
+	importMeta, err := NewImportMeta(ctx, catalog, alloc, meta)
+	assert.NoError(t, err)
+
+	s := &Server{meta: meta, allocator: alloc, importMeta: importMeta, handler: newMockHandler()}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	return s
+}
+
+func TestTransferSegments(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("compacting", func(t *testing.T) {
+		s := newTransferTestServer(t)
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1000, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, NumOfRows: 1,
+		})))
+		s.meta.SetSegmentCompacting(1000, true)
+		resp, err := s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+			CollectionID: 1, PartitionID: 10, TargetCollectionID: 2, TargetPartitionID: 20,
+			ChannelMapping: map[string]string{"ch1": "ch2"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCompactionPlanConflict)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		s := newTransferTestServer(t)
+		cm := s.meta.chunkManager
+		srcPath := metautil.BuildInsertLogPath(cm.RootPath(), 1, 10, 1000, 100, 1)
+
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1000, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, NumOfRows: 1,
+			CompactionFrom: []int64{900},
+			StartPosition:  &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 100},
+			DmlPosition:    &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 200},
+			Binlogs: []*datapb.FieldBinlog{{
+				FieldID: 100,
+				Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 1}},
+			}},
+		})))
+		// the growing segment is replayed from wal instead.
+		growingPosition := &msgpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: 300}
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1001, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Growing,
+			StartPosition: growingPosition,
+		})))
+		// the segment of other partition is not transferred.
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1002, CollectionID: 1, PartitionID: 11, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, NumOfRows: 1,
+		})))
+
+		resp, err := s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+			CollectionID: 1, PartitionID: 10, TargetCollectionID: 2, TargetPartitionID: 20,
+			ChannelMapping: map[string]string{"ch1": "ch2"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetSegmentIDs(), 1)
+		assert.Zero(t, resp.GetImportJobID())
+		assert.ElementsMatch(t, []int64{1000, 900}, resp.GetSourceSegmentIDs())
+		assert.Len(t, resp.GetReplayPositions(), 1)
+		assert.Equal(t, growingPosition.GetTimestamp(), resp.GetReplayPositions()[0].GetTimestamp())
+
+		segment := s.meta.GetSegment(ctx, resp.GetSegmentIDs()[0])
+		assert.NotNil(t, segment)
+		assert.Equal(t, int64(2), segment.GetCollectionID())
+		assert.Equal(t, int64(20), segment.GetPartitionID())
+		assert.Equal(t, "ch2", segment.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.Equal(t, int64(1), segment.GetNumOfRows())
+		assert.False(t, segment.GetIsSorted())
+		// the target segment shares the binlogs of the source segment.
+		assert.Equal(t, srcPath, segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		sharedLogs := s.meta.GetSharedBinlogs(ctx)
+		assert.True(t, sharedLogs[srcPath].Contain(segment.GetID()))
+
+		// the source segments are released after transferring.
+		assert.False(t, s.meta.GetSegment(ctx, 1000).isCompacting)
+
+		// rollback drops the target segments.
+		resp, err = s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+			RollbackSegmentIDs: resp.GetSegmentIDs(),
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, commonpb.SegmentState_Dropped, s.meta.GetSegment(ctx, segment.GetID()).GetState())
+	})
+
+	t.Run("copy binlogs", func(t *testing.T) {
+		s := newTransferTestServer(t)
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1000, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, NumOfRows: 1,
+			Binlogs: []*datapb.FieldBinlog{{
+				FieldID: 100,
+				Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 1}},
+			}},
+		})))
+		// the deletes are always re-parented.
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1001, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			Level: datapb.SegmentLevel_L0,
+			Deltalogs: []*datapb.FieldBinlog{{
+				Binlogs: []*datapb.Binlog{{LogID: 2, EntriesNum: 1}},
+			}},
+		})))
+		// the collection level deletes are not transferred.
+		assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1002, CollectionID: 1, PartitionID: common.AllPartitionsID, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			Level: datapb.SegmentLevel_L0,
+			Deltalogs: []*datapb.FieldBinlog{{
+				Binlogs: []*datapb.Binlog{{LogID: 3, EntriesNum: 1}},
+			}},
+		})))
+
+		resp, err := s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+			CollectionID: 1, PartitionID: 10, TargetCollectionID: 2, TargetPartitionID: 20,
+			ChannelMapping: map[string]string{"ch1": "ch2"},
+			CopyBinlogs:    true,
+			TargetSchema:   &schemapb.CollectionSchema{Name: "target"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetSegmentIDs(), 1)
+		assert.Equal(t, datapb.SegmentLevel_L0, s.meta.GetSegment(ctx, resp.GetSegmentIDs()[0]).GetLevel())
+		assert.ElementsMatch(t, []int64{1000}, resp.GetSourceSegmentIDs())
+
+		job := s.importMeta.GetJob(ctx, resp.GetImportJobID())
+		assert.NotNil(t, job)
+		assert.Equal(t, int64(2), job.GetCollectionID())
+		assert.Equal(t, []int64{20}, job.GetPartitionIDs())
+		assert.True(t, importutilv2.IsBackup(job.GetOptions()))
+		assert.Len(t, job.GetFiles(), 1)
+		assert.Equal(t, metautil.BuildInsertLogPath(s.meta.chunkManager.RootPath(), 1, 10, 1000, 0, 0),
+			path.Join(job.GetFiles()[0].GetPaths()[0], "0", "0"))
+		// the source segment is kept from gc until the import job is done.
+		assert.True(t, getTransferringSegments(ctx, s.importMeta).Contain(1000))
+
+		// rollback aborts the import job.
+		resp, err = s.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+			RollbackSegmentIDs:  resp.GetSegmentIDs(),
+			RollbackImportJobID: resp.GetImportJobID(),
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, internalpb.ImportJobState_Failed, s.importMeta.GetJob(ctx, job.GetJobID()).GetState())
+		assert.False(t, getTransferringSegments(ctx, s.importMeta).Contain(1000))
+	})
+}
+
+func TestGarbageCollector_excludeSharedLogs(t *testing.T) {
+	ctx := context.Background()
+	s := newTransferTestServer(t)
+	cm := s.meta.chunkManager
+	sharedPath := metautil.BuildInsertLogPath(cm.RootPath(), 1, 10, 1000, 100, 1)
+	ownPath := metautil.BuildInsertLogPath(cm.RootPath(), 2, 20, 2000, 100, 2)
+
+	assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 1000, CollectionID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Dropped,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1}}}},
+	})))
+	assert.NoError(t, s.meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 2000, CollectionID: 2, PartitionID: 20, InsertChannel: "ch2", State: commonpb.SegmentState_Dropped,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: sharedPath}, {LogID: 2}}}},
+	})))
+	gc := newGarbageCollector(s.meta, newMockHandler(), GcOption{cli: cm})
+	sharedLogs := s.meta.GetSharedBinlogs(ctx)
+
+	// the binlog of the source segment is still used by the transferred segment.
+	logs := map[string]struct{}{sharedPath: {}}
+	gc.excludeSharedLogs(ctx, 1000, logs, sharedLogs)
+	assert.Empty(t, logs)
+
+	// the shared binlog is kept while the source segment is in meta.
+	logs = map[string]struct{}{sharedPath: {}, ownPath: {}}
+	gc.excludeSharedLogs(ctx, 2000, logs, sharedLogs)
+	assert.Equal(t, map[string]struct{}{ownPath: {}}, logs)
+
+	// the last segment using the binlog removes it.
+	assert.NoError(t, s.meta.DropSegment(ctx, 1000))
+	logs = map[string]struct{}{sharedPath: {}, ownPath: {}}
+	gc.excludeSharedLogs(ctx, 2000, logs, sharedLogs)
+	assert.Len(t, logs, 2)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -46,6 +47,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/internal/types"
@@ -499,10 +501,13 @@ func (s *Server) newChunkManagerFactory() (storage.ChunkManager, error) {
 }
 
 func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
+	// the segment files are owned by the primary cluster, never collect them at a follower cluster.
+	enabled := Params.DataCoordCfg.EnableGarbageCollection.GetAsBool() && !Params.CommonCfg.FollowerEnabled.GetAsBool()
 	s.garbageCollector = newGarbageCollector(s.meta, s.handler, GcOption{
 		cli:              cli,
 		broker:           s.broker,
-		enabled:          Params.DataCoordCfg.EnableGarbageCollection.GetAsBool(),
+		importMeta:       s.importMeta,
+		enabled:          enabled,
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
@@ -702,7 +707,7 @@ func (s *Server) startCompaction() {
 }
 
 func (s *Server) startServerLoop() {
-	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+	if Params.DataCoordCfg.EnableCompaction.GetAsBool() && !Params.CommonCfg.FollowerEnabled.GetAsBool() {
 		s.startCompaction()
 	}
 
@@ -742,9 +747,12 @@ func (s *Server) collectMetaMetrics(ctx context.Context) {
 }
 
 func (s *Server) startTaskScheduler() {
-	s.statsInspector.Start()
-	s.indexInspector.Start()
-	s.analyzeInspector.Start()
+	// the stats and indexes of the shared segments are built by the primary cluster.
+	if !Params.CommonCfg.FollowerEnabled.GetAsBool() {
+		s.statsInspector.Start()
+		s.indexInspector.Start()
+		s.analyzeInspector.Start()
+	}
 	s.startCollectMetaMetrics(s.serverLoopCtx)
 }
 
@@ -1171,6 +1179,57 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 	return nil
 }
 
+// ReloadMirroredMeta reloads the meta changed by the keys mirrored from the primary cluster,
+// it's used by a follower cluster whose coordinator meta is mirrored read-only from the primary cluster.
+func (s *Server) ReloadMirroredMeta(ctx context.Context, keys []string) error {
+	collectionIDs := typeutil.NewUniqueSet()
+	var collectionChanged, channelCPChanged, indexChanged bool
+	for _, key := range keys {
+		if collectionID, ok := datacoord.ParseSegmentKeyCollectionID(key); ok {
+			collectionIDs.Insert(collectionID)
+			continue
+		}
+		switch {
+		case strings.HasPrefix(key, rootcoord.ComponentPrefix+"/"):
+			collectionChanged = true
+		case strings.HasPrefix(key, datacoord.ChannelCheckpointPrefix+"/"):
+			channelCPChanged = true
+		case strings.HasPrefix(key, util.FieldIndexPrefix+"/"), strings.HasPrefix(key, util.SegmentIndexPrefix+"/"):
+			indexChanged = true
+		}
+	}
+
+	if collectionChanged {
+		// the rootcoord meta is reloaded before, refresh the cached collections from it.
+		for _, collectionID := range s.meta.collections.Keys() {
+			err := s.loadCollectionFromRootCoord(ctx, collectionID)
+			if errors.Is(err, merr.ErrCollectionNotFound) {
+				s.meta.DropCollection(collectionID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if collectionIDs.Len() > 0 {
+		if err := s.meta.reloadCollectionSegments(ctx, collectionIDs.Collect()); err != nil {
+			return err
+		}
+	}
+	if channelCPChanged {
+		if err := s.meta.reloadChannelCheckpoints(ctx); err != nil {
+			return err
+		}
+	}
+	if indexChanged {
+		if err := s.meta.indexMeta.reload(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Server) updateBalanceConfigLoop(ctx context.Context) {
 	success := s.updateBalanceConfig()
 	if success {
@@ -84,6 +84,11 @@ func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.F
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkWritable("Flush"); err != nil {
+		return &datapb.FlushResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	channelCPs := make(map[string]*msgpb.MsgPosition, 0)
 	coll, err := s.handler.GetCollection(ctx, req.GetCollectionID())
@@ -201,6 +206,11 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkWritable("AssignSegmentID"); err != nil {
+		return &datapb.AssignSegmentIDResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	assigns := make([]*datapb.SegmentIDAssignment, 0, len(req.SegmentIDRequests))
 
@@ -260,6 +270,9 @@ func (s *Server) AllocSegment(ctx context.Context, req *datapb.AllocSegmentReque
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &datapb.AllocSegmentResponse{Status: merr.Status(err)}, nil
 	}
+	if err := checkWritable("AllocSegment"); err != nil {
+		return &datapb.AllocSegmentResponse{Status: merr.Status(err)}, nil
+	}
 	// !!! SegmentId must be allocated from rootCoord id allocation.
 	if req.GetCollectionId() == 0 || req.GetPartitionId() == 0 || req.GetVchannel() == "" || req.GetSegmentId() == 0 {
 		return &datapb.AllocSegmentResponse{Status: merr.Status(merr.ErrParameterInvalid)}, nil
@@ -1055,6 +1068,37 @@ func (s *Server) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	return resp, nil
 }
 
+// TransferSegments copies the flushed segments of a partition into another partition which has the same schema.
+func (s *Server) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+		zap.Int64("targetPartitionID", req.GetTargetPartitionID()),
+	)
+	log.Info("receive transfer segments request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.TransferSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if len(req.GetRollbackSegmentIDs()) > 0 || req.GetRollbackImportJobID() != 0 {
+		return &datapb.TransferSegmentsResponse{
+			Status: merr.Status(s.rollbackTransferSegments(ctx, req)),
+		}, nil
+	}
+
+	resp, err := s.transferSegments(ctx, req)
+	if err != nil {
+		log.Warn("failed to transfer segments", zap.Error(err))
+		return &datapb.TransferSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return resp, nil
+}
+
 // GetFlushedSegments returns all segment matches provided criterion and in state Flushed or Dropped (compacted but not GCed yet)
 // If requested partition id < 0, ignores the partition id filter
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
@@ -1214,6 +1258,11 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkWritable("ManualCompaction"); err != nil {
+		return &milvuspb.ManualCompactionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
 		resp.Status = merr.Status(merr.WrapErrServiceUnavailable("compaction disabled"))
@@ -1680,6 +1729,26 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 		return merr.Success(), nil
 	}
 
+	// the segments flushed before the functions were added don't have the outputs,
+	// mark them to be backfilled by the stats tasks.
+	existedOutputs := typeutil.NewSet(functionBackfillFieldIDs(clonedColl.Schema)...)
+	addedOutputs := lo.Filter(functionBackfillFieldIDs(req.GetSchema()), func(fieldID int64, _ int) bool {
+		return !existedOutputs.Contain(fieldID)
+	})
+	if len(addedOutputs) > 0 {
+		segments := s.meta.SelectSegments(ctx, WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+			return isSegmentHealthy(segment) && segment.GetLevel() != datapb.SegmentLevel_L0
+		}))
+		operators := lo.Map(segments, func(segment *SegmentInfo, _ int) UpdateOperator {
+			return AddPendingFunctionOutputsOperator(segment.GetID(), addedOutputs)
+		})
+		if err := s.meta.UpdateSegmentsInfo(ctx, operators...); err != nil {
+			log.Ctx(ctx).Warn("failed to mark function outputs pending for segments",
+				zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("fieldIDs", addedOutputs), zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
 	clonedColl.Properties = properties
 	// add field will change the schema
 	clonedColl.Schema = req.GetSchema()
@@ -1773,6 +1842,11 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkWritable("ImportV2"); err != nil {
+		return &internalpb.ImportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	resp := &internalpb.ImportResponse{
 		Status: merr.Success(),
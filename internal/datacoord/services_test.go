@@ -768,6 +768,32 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.True(t, ok)
 		assert.NotNil(t, coll.Properties)
 	})
+
+	t.Run("mark function outputs pending", func(t *testing.T) {
+		meta, err := newMemoryMeta(t)
+		assert.NoError(t, err)
+		meta.AddCollection(&collectionInfo{ID: 1, Schema: &schemapb.CollectionSchema{}})
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L1},
+			{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0},
+			{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Dropped, Level: datapb.SegmentLevel_L1},
+		} {
+			assert.NoError(t, meta.AddSegment(context.TODO(), NewSegmentInfo(segment)))
+		}
+		s := &Server{meta: meta}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.BroadcastAlteredCollection(context.Background(), &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema: &schemapb.CollectionSchema{
+				Functions: []*schemapb.FunctionSchema{{Name: "bm25", Type: schemapb.FunctionType_BM25, InputFieldIds: []int64{101}, OutputFieldIds: []int64{102}}},
+			},
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, []int64{102}, meta.GetSegment(context.TODO(), 1).GetPendingFunctionOutputs())
+		assert.Empty(t, meta.GetSegment(context.TODO(), 2).GetPendingFunctionOutputs())
+		assert.Empty(t, meta.GetSegment(context.TODO(), 3).GetPendingFunctionOutputs())
+	})
 }
 
 func TestServer_GcConfirm(t *testing.T) {
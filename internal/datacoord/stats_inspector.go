@@ -22,9 +22,11 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -138,6 +140,7 @@ func (si *statsInspector) triggerStatsTaskLoop() {
 			si.triggerSortStatsTask()
 			si.triggerTextStatsTask()
 			si.triggerBM25StatsTask()
+			si.triggerFunctionBackfillStatsTask()
 			lastJSONStatsLastTrigger, maxJSONStatsTaskCount = si.triggerJsonKeyIndexStatsTask(lastJSONStatsLastTrigger, maxJSONStatsTaskCount)
 
 		case segID := <-getStatsTaskChSingleton():
@@ -230,6 +233,65 @@ func needDoBM25(segment *SegmentInfo, fieldIDs []UniqueID) bool {
 	return false
 }
 
+// functionBackfillFieldIDs returns the output fields of the functions in the schema,
+// the outputs of the functions added to an existing collection are backfilled by the stats task.
+func functionBackfillFieldIDs(schema *schemapb.CollectionSchema) []UniqueID {
+	fieldIDs := make([]UniqueID, 0)
+	for _, function := range schema.GetFunctions() {
+		fieldIDs = append(fieldIDs, function.GetOutputFieldIds()...)
+	}
+	return fieldIDs
+}
+
+// missingFunctionOutputs returns the function output fields which are not backfilled in the segment yet,
+// the segments flushed before the function was added are marked when the schema is altered.
+func missingFunctionOutputs(segment *SegmentInfo, fieldIDs []UniqueID) []UniqueID {
+	if len(segment.GetPendingFunctionOutputs()) == 0 {
+		return nil
+	}
+	pending := typeutil.NewSet(segment.GetPendingFunctionOutputs()...)
+	return lo.Filter(fieldIDs, func(fieldID UniqueID, _ int) bool {
+		return pending.Contain(fieldID)
+	})
+}
+
+func needDoFunctionBackfill(segment *SegmentInfo, fieldIDs []UniqueID) bool {
+	if !(isFlush(segment) && segment.GetLevel() != datapb.SegmentLevel_L0 &&
+		segment.GetIsSorted() && segment.GetNumOfRows() > 0) {
+		return false
+	}
+	return len(missingFunctionOutputs(segment, fieldIDs)) > 0
+}
+
+func (si *statsInspector) triggerFunctionBackfillStatsTask() {
+	collections := si.mt.GetCollections()
+	for _, collection := range collections {
+		needTriggerFieldIDs := functionBackfillFieldIDs(collection.Schema)
+		if len(needTriggerFieldIDs) == 0 {
+			continue
+		}
+		segments := si.mt.SelectSegments(si.ctx, WithCollection(collection.ID), SegmentFilterFunc(func(seg *SegmentInfo) bool {
+			return isSegmentHealthy(seg) && !seg.GetIsImporting() && needDoFunctionBackfill(seg, needTriggerFieldIDs)
+		}))
+
+		for _, segment := range segments {
+			// the segment is rewritten with the outputs like sort, so that the storage v2 column groups
+			// and the bm25 stats of the outputs are generated along with the other fields.
+			targetSegmentID, err := si.allocator.AllocID(si.ctx)
+			if err != nil {
+				log.Warn("allocID for segment function backfill task failed",
+					zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+				continue
+			}
+			if err := si.SubmitStatsTask(segment.GetID(), targetSegmentID, indexpb.StatsSubJob_FunctionBackfillJob, true); err != nil {
+				log.Warn("create stats task with function backfill for segment failed, wait for retry",
+					zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+				continue
+			}
+		}
+	}
+}
+
 func (si *statsInspector) triggerTextStatsTask() {
 	collections := si.mt.GetCollections()
 	for _, collection := range collections {
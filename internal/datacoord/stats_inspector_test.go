@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/session"
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
@@ -397,6 +398,69 @@ func (s *statsInspectorSuite) TestNeedDoTextIndex() {
 	s.False(result, "Unsorted segment should not need text index")
 }
 
+func (s *statsInspectorSuite) TestNeedDoFunctionBackfill() {
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:                     40,
+			State:                  commonpb.SegmentState_Flushed,
+			IsSorted:               true,
+			NumOfRows:              1000,
+			Level:                  datapb.SegmentLevel_L1,
+			StorageVersion:         storage.StorageV2,
+			PendingFunctionOutputs: []int64{102, 103},
+		},
+	}
+	s.True(needDoFunctionBackfill(segment, []int64{102}))
+	s.Equal([]int64{102}, missingFunctionOutputs(segment, []int64{101, 102}))
+
+	// the outputs are already backfilled
+	segment.PendingFunctionOutputs = nil
+	s.False(needDoFunctionBackfill(segment, []int64{102}))
+
+	// unsorted segment is sorted first
+	segment.PendingFunctionOutputs = []int64{102}
+	segment.IsSorted = false
+	s.False(needDoFunctionBackfill(segment, []int64{102}))
+}
+
+func (s *statsInspectorSuite) TestFunctionBackfillFieldIDs() {
+	schema := &schemapb.CollectionSchema{
+		Functions: []*schemapb.FunctionSchema{
+			{Name: "bm25", Type: schemapb.FunctionType_BM25, InputFieldIds: []int64{101}, OutputFieldIds: []int64{102}},
+			{Name: "embed", Type: schemapb.FunctionType_TextEmbedding, InputFieldIds: []int64{101}, OutputFieldIds: []int64{103}},
+		},
+	}
+	s.Equal([]int64{102, 103}, functionBackfillFieldIDs(schema))
+}
+
+func (s *statsInspectorSuite) TestTriggerFunctionBackfillStatsTask() {
+	collection := s.mt.GetCollection(1)
+	collection.Schema.Fields = append(collection.Schema.Fields, &schemapb.FieldSchema{
+		FieldID:          102,
+		Name:             "sparse",
+		DataType:         schemapb.DataType_SparseFloatVector,
+		IsFunctionOutput: true,
+	})
+	collection.Schema.Functions = []*schemapb.FunctionSchema{{
+		Name:           "bm25",
+		Type:           schemapb.FunctionType_BM25,
+		InputFieldIds:  []int64{101},
+		OutputFieldIds: []int64{102},
+	}}
+	for _, segID := range []int64{10, 20} {
+		s.mt.segments.segments[segID].PendingFunctionOutputs = []int64{102}
+		s.mt.segments.secondaryIndexes.coll2Segments[1][segID].PendingFunctionOutputs = []int64{102}
+	}
+
+	s.inspector.triggerFunctionBackfillStatsTask()
+
+	s.Equal(indexpb.JobState_JobStateInit,
+		s.mt.statsTaskMeta.GetStatsTaskStateBySegmentID(20, indexpb.StatsSubJob_FunctionBackfillJob))
+	// the unsorted segment is backfilled after sorted
+	s.Equal(indexpb.JobState_JobStateNone,
+		s.mt.statsTaskMeta.GetStatsTaskStateBySegmentID(10, indexpb.StatsSubJob_FunctionBackfillJob))
+}
+
 func (s *statsInspectorSuite) TestEnableBM25() {
 	// Test if BM25 is enabled
 	result := s.inspector.enableBM25()
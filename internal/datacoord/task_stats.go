@@ -22,8 +22,11 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
 	"github.com/milvus-io/milvus/internal/datacoord/session"
 	globalTask "github.com/milvus-io/milvus/internal/datacoord/task"
@@ -34,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type statsTask struct {
@@ -127,7 +131,7 @@ func (st *statsTask) UpdateTaskVersion(nodeID int64) error {
 
 func (st *statsTask) resetTask(ctx context.Context, reason string) {
 	// reset isCompacting
-	if st.GetSubJobType() == indexpb.StatsSubJob_Sort {
+	if st.rewritesSegment() {
 		st.meta.SetSegmentsCompacting(ctx, []UniqueID{st.GetSegmentID()}, false)
 		st.meta.SetSegmentStating(st.GetSegmentID(), false)
 	}
@@ -154,8 +158,20 @@ func (st *statsTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
 		zap.String("subJobType", st.GetSubJobType().String()),
 	)
 
+	if st.GetSubJobType() == indexpb.StatsSubJob_FunctionBackfillJob {
+		if segment := st.meta.GetHealthySegment(ctx, st.GetSegmentID()); segment != nil && !st.meta.isPendingFunctionBackfill(segment) {
+			log.Info("function outputs of segment are already backfilled, skipping stats task")
+			if err := st.meta.statsTaskMeta.DropStatsTask(ctx, st.GetTaskID()); err != nil {
+				log.Warn("remove stats task failed, will retry later", zap.Error(err))
+				return
+			}
+			st.SetState(indexpb.JobState_JobStateNone, "function outputs are already backfilled")
+			return
+		}
+	}
+
 	// Check segment compaction state
-	if st.GetSubJobType() == indexpb.StatsSubJob_Sort {
+	if st.rewritesSegment() {
 		if exist, canCompact := st.meta.CheckAndSetSegmentsCompacting(ctx, []UniqueID{st.GetSegmentID()}); !exist || !canCompact {
 			log.Warn("segment is not exist or is compacting, skip stats and remove stats task",
 				zap.Bool("exist", exist), zap.Bool("canCompact", canCompact))
@@ -341,13 +357,21 @@ func (st *statsTask) prepareJobRequest(ctx context.Context, segment *SegmentInfo
 		return nil, fmt.Errorf("failed to get collection TTL: %w", err)
 	}
 
+	// the segments flushed before the functions were added don't have the output fields,
+	// sort them without the outputs, and compute the missing outputs when backfilling.
+	schema := collInfo.Schema
+	missing := missingFunctionOutputs(segment, functionBackfillFieldIDs(schema))
+	if len(missing) > 0 && st.GetSubJobType() != indexpb.StatsSubJob_FunctionBackfillJob {
+		schema = schemaWithoutFunctionOutputs(schema, missing)
+	}
+
 	// Calculate binlog allocation
 	binlogNum := (segment.getSegmentSize()/Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() + 1) *
-		int64(len(collInfo.Schema.GetFields())) *
+		int64(len(schema.GetFields())) *
 		paramtable.Get().DataCoordCfg.CompactionPreAllocateIDExpansionFactor.GetAsInt64()
 
 	// Allocate IDs
-	start, end, err := st.allocator.AllocN(binlogNum + int64(len(collInfo.Schema.GetFunctions())) + 1)
+	start, end, err := st.allocator.AllocN(binlogNum + int64(len(schema.GetFunctions())) + 1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to allocate log IDs: %w", err)
 	}
@@ -361,7 +385,7 @@ func (st *statsTask) prepareJobRequest(ctx context.Context, segment *SegmentInfo
 		InsertChannel:   segment.GetInsertChannel(),
 		SegmentID:       segment.GetID(),
 		StorageConfig:   createStorageConfig(),
-		Schema:          collInfo.Schema,
+		Schema:          schema,
 		SubJobType:      st.GetSubJobType(),
 		TargetSegmentID: st.GetTargetSegmentID(),
 		InsertLogs:      segment.GetBinlogs(),
@@ -382,6 +406,9 @@ func (st *statsTask) prepareJobRequest(ctx context.Context, segment *SegmentInfo
 		StorageVersion:            segment.StorageVersion,
 		CurrentScalarIndexVersion: st.ievm.GetCurrentScalarIndexEngineVersion(),
 	}
+	if st.GetSubJobType() == indexpb.StatsSubJob_FunctionBackfillJob {
+		req.FunctionBackfillFieldIDs = missing
+	}
 
 	return req, nil
 }
@@ -392,7 +419,7 @@ func (st *statsTask) SetJobInfo(ctx context.Context, result *workerpb.StatsResul
 	case indexpb.StatsSubJob_Sort:
 		// first update segment, failed state cannot generate new segment
 		var metricMutation *segMetricMutation
-		metricMutation, err = st.meta.SaveStatsResultSegment(st.GetSegmentID(), result)
+		metricMutation, err = st.meta.SaveStatsResultSegment(st.GetSegmentID(), st.GetSubJobType(), result)
 		if err != nil {
 			log.Ctx(ctx).Warn("save sort stats result failed", zap.Int64("taskID", st.GetTaskID()),
 				zap.Int64("segmentID", st.GetSegmentID()), zap.Error(err))
@@ -420,6 +447,21 @@ func (st *statsTask) SetJobInfo(ctx context.Context, result *workerpb.StatsResul
 		}
 	case indexpb.StatsSubJob_BM25Job:
 		// bm25 logs are generated during with segment flush.
+	case indexpb.StatsSubJob_FunctionBackfillJob:
+		// the target segment replaces the old one with the outputs backfilled.
+		var metricMutation *segMetricMutation
+		metricMutation, err = st.meta.SaveStatsResultSegment(st.GetSegmentID(), st.GetSubJobType(), result)
+		if err != nil {
+			log.Ctx(ctx).Warn("save function backfill stats result failed", zap.Int64("taskID", st.GetTaskID()),
+				zap.Int64("segmentID", st.GetSegmentID()), zap.Error(err))
+			break
+		}
+		metricMutation.commit()
+
+		select {
+		case getBuildIndexChSingleton() <- result.GetSegmentID():
+		default:
+		}
 	}
 
 	// if segment is not found, it means the segment is already dropped,
@@ -428,7 +470,7 @@ func (st *statsTask) SetJobInfo(ctx context.Context, result *workerpb.StatsResul
 		return err
 	}
 	// Reset isCompacting flag after stats task is finished
-	if st.GetSubJobType() == indexpb.StatsSubJob_Sort {
+	if st.rewritesSegment() {
 		st.meta.SetSegmentsCompacting(ctx, []UniqueID{st.GetSegmentID()}, false)
 		st.meta.SetSegmentStating(st.GetSegmentID(), false)
 	}
@@ -437,3 +479,23 @@ func (st *statsTask) SetJobInfo(ctx context.Context, result *workerpb.StatsResul
 		zap.String("subJobType", st.GetSubJobType().String()), zap.String("state", st.GetState().String()))
 	return nil
 }
+
+// schemaWithoutFunctionOutputs returns a copy of the schema without the given output fields
+// and the functions outputting them.
+func schemaWithoutFunctionOutputs(schema *schemapb.CollectionSchema, fieldIDs []UniqueID) *schemapb.CollectionSchema {
+	outputs := typeutil.NewSet(fieldIDs...)
+	cloned := proto.Clone(schema).(*schemapb.CollectionSchema)
+	cloned.Fields = lo.Filter(cloned.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return !outputs.Contain(field.GetFieldID())
+	})
+	cloned.Functions = lo.Filter(cloned.GetFunctions(), func(function *schemapb.FunctionSchema, _ int) bool {
+		return !outputs.Contain(function.GetOutputFieldIds()...)
+	})
+	return cloned
+}
+
+// rewritesSegment returns whether the task writes the segment into the target segment,
+// the old segment can't be compacted until the task is done.
+func (st *statsTask) rewritesSegment() bool {
+	return st.GetSubJobType() == indexpb.StatsSubJob_Sort || st.GetSubJobType() == indexpb.StatsSubJob_FunctionBackfillJob
+}
@@ -406,3 +406,12 @@ func calculateStatsTaskSlot(segmentSize int64) int64 {
 	}
 	return max(defaultSlots/8, 1)
 }
+
+// checkWritable rejects the operation which mutates the segments or indexes
+// shared with the primary cluster when the cluster runs as a follower.
+func checkWritable(operation string) error {
+	if Params.CommonCfg.FollowerEnabled.GetAsBool() {
+		return merr.WrapErrServiceReadOnly(operation, "the cluster is a follower")
+	}
+	return nil
+}
@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/apache/arrow/go/v17/arrow"
 	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -36,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/flushcommon/io"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -45,8 +49,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	_ "github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -232,7 +238,24 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		log.Warn("sort task only support int64 and varchar pk field")
 	}
 
-	rr, err := storage.NewBinlogRecordReader(ctx, st.req.InsertLogs, st.req.Schema,
+	// the outputs of the functions added to the collection are missing in the segment,
+	// they are computed from the inputs when backfilling.
+	readSchema := st.req.GetSchema()
+	insertLogs := st.req.GetInsertLogs()
+	backfillIDs := typeutil.NewSet(st.req.GetFunctionBackfillFieldIDs()...)
+	if backfillIDs.Len() > 0 {
+		readSchema = proto.Clone(readSchema).(*schemapb.CollectionSchema)
+		readSchema.Fields = lo.Filter(readSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return !backfillIDs.Contain(field.GetFieldID())
+		})
+		// the binlogs of storage v2 are grouped by column groups, the outputs are not read by the read schema.
+		if st.req.GetStorageVersion() != storage.StorageV2 {
+			insertLogs = lo.Filter(insertLogs, func(fieldBinlog *datapb.FieldBinlog, _ int) bool {
+				return !backfillIDs.Contain(fieldBinlog.GetFieldID())
+			})
+		}
+	}
+	rr, err := storage.NewBinlogRecordReader(ctx, insertLogs, readSchema,
 		storage.WithVersion(st.req.StorageVersion),
 		storage.WithDownloader(st.binlogIO.Download),
 		storage.WithBucketName(st.req.StorageConfig.BucketName),
@@ -242,6 +265,13 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		log.Warn("error creating insert binlog reader", zap.Error(err))
 		return nil, err
 	}
+	if backfillIDs.Len() > 0 {
+		rr, err = newFunctionOutputRecordReader(ctx, rr, st.req.GetSchema(), backfillIDs.Collect())
+		if err != nil {
+			log.Warn("error creating function output reader", zap.Error(err))
+			return nil, err
+		}
+	}
 
 	rrs := []storage.RecordReader{rr}
 	numValidRows, err := storage.Sort(st.req.GetBinlogMaxSize(), st.req.GetSchema(), rrs, srw, predicate)
@@ -254,7 +284,7 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 	}
 
 	binlogs, stats, bm25stats := srw.GetLogs()
-	insertLogs := lo.Values(binlogs)
+	insertLogs = lo.Values(binlogs)
 	if err := binlog.CompressFieldBinlogs(insertLogs); err != nil {
 		return nil, err
 	}
@@ -298,7 +328,9 @@ func (st *statsTask) Execute(ctx context.Context) error {
 
 	insertLogs := st.req.GetInsertLogs()
 	var err error
-	if st.req.GetSubJobType() == indexpb.StatsSubJob_Sort {
+	// the segment is rewritten with the function outputs backfilled like sort.
+	rewrite := st.req.GetSubJobType() == indexpb.StatsSubJob_Sort || st.req.GetSubJobType() == indexpb.StatsSubJob_FunctionBackfillJob
+	if rewrite {
 		insertLogs, err = st.sort(ctx)
 		if err != nil {
 			return err
@@ -310,7 +342,7 @@ func (st *statsTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
-	if st.req.GetSubJobType() == indexpb.StatsSubJob_Sort || st.req.GetSubJobType() == indexpb.StatsSubJob_TextIndexJob {
+	if rewrite || st.req.GetSubJobType() == indexpb.StatsSubJob_TextIndexJob {
 		err = st.createTextIndex(ctx,
 			st.req.GetStorageConfig(),
 			st.req.GetCollectionID(),
@@ -324,7 +356,7 @@ func (st *statsTask) Execute(ctx context.Context) error {
 			return err
 		}
 	}
-	if (st.req.EnableJsonKeyStatsInSort && st.req.GetSubJobType() == indexpb.StatsSubJob_Sort) || st.req.GetSubJobType() == indexpb.StatsSubJob_JsonKeyIndexJob {
+	if (st.req.EnableJsonKeyStatsInSort && rewrite) || st.req.GetSubJobType() == indexpb.StatsSubJob_JsonKeyIndexJob {
 		if !st.req.GetEnableJsonKeyStats() {
 			return nil
 		}
@@ -587,6 +619,237 @@ func (st *statsTask) createJSONKeyStats(ctx context.Context,
 	return nil
 }
 
+var (
+	functionBackfillLimiter     *ratelimitutil.Limiter
+	functionBackfillLimiterOnce sync.Once
+)
+
+// getFunctionBackfillLimiter returns the limiter shared by all function backfill tasks of this node,
+// so that the external services called by the functions are not overloaded by the backfill.
+func getFunctionBackfillLimiter() *ratelimitutil.Limiter {
+	functionBackfillLimiterOnce.Do(func() {
+		functionBackfillLimiter = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+	})
+	limit := ratelimitutil.Inf
+	if rowsPerSecond := paramtable.Get().DataNodeCfg.FunctionBackfillMaxRowsPerSecond.GetAsFloat(); rowsPerSecond > 0 {
+		limit = ratelimitutil.Limit(rowsPerSecond)
+	}
+	if functionBackfillLimiter.Limit() != limit {
+		functionBackfillLimiter.SetLimit(limit)
+	}
+	return functionBackfillLimiter
+}
+
+func waitFunctionBackfillQuota(ctx context.Context, rows int) error {
+	limiter := getFunctionBackfillLimiter()
+	for !limiter.AllowN(time.Now(), rows) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return nil
+}
+
+// functionOutputRecordReader computes the outputs of the functions added to the collection
+// for the records read from the segment, and appends them to the records.
+type functionOutputRecordReader struct {
+	ctx          context.Context
+	rr           storage.RecordReader
+	fields       []*schemapb.FieldSchema
+	inputFields  []*schemapb.FieldSchema
+	outputFields []*schemapb.FieldSchema
+	outputSchema *arrow.Schema
+	runners      []function.FunctionRunner
+	executor     *function.FunctionExecutor
+	batchSize    int
+
+	last storage.Record
+}
+
+var _ storage.RecordReader = (*functionOutputRecordReader)(nil)
+
+func newFunctionOutputRecordReader(ctx context.Context, rr storage.RecordReader, schema *schemapb.CollectionSchema, outputIDs []int64) (*functionOutputRecordReader, error) {
+	outputs := typeutil.NewSet(outputIDs...)
+	functions := lo.Filter(schema.GetFunctions(), func(fSchema *schemapb.FunctionSchema, _ int) bool {
+		return outputs.Contain(fSchema.GetOutputFieldIds()...)
+	})
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	// the row id, timestamp and primary key are required by the deserializer.
+	inputIDs := typeutil.NewSet(common.RowIDField, common.TimeStampField, pkField.GetFieldID())
+	runners := make([]function.FunctionRunner, 0)
+	closeRunners := func() {
+		for _, runner := range runners {
+			runner.Close()
+		}
+	}
+	for _, fSchema := range functions {
+		inputIDs.Insert(fSchema.GetInputFieldIds()...)
+		runner, err := function.NewFunctionRunner(schema, fSchema)
+		if err != nil {
+			closeRunners()
+			return nil, err
+		}
+		if runner != nil {
+			runners = append(runners, runner)
+		}
+	}
+
+	// only the functions outputting the missing fields are run, the others may call the external services.
+	executorSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	executorSchema.Functions = lo.Filter(functions, func(fSchema *schemapb.FunctionSchema, _ int) bool {
+		return fSchema.GetType() != schemapb.FunctionType_BM25
+	})
+	executor, err := function.NewFunctionExecutor(executorSchema)
+	if err != nil {
+		closeRunners()
+		return nil, err
+	}
+
+	outputFields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return outputs.Contain(field.GetFieldID())
+	})
+	outputSchema, err := storage.ConvertToArrowSchema(outputFields)
+	if err != nil {
+		closeRunners()
+		return nil, err
+	}
+
+	batchSize := paramtable.Get().DataNodeCfg.FunctionBackfillBatchSize.GetAsInt()
+	if batchSize <= 0 {
+		batchSize = statsBatchSize
+	}
+	return &functionOutputRecordReader{
+		ctx:    ctx,
+		rr:     rr,
+		fields: schema.GetFields(),
+		inputFields: lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return inputIDs.Contain(field.GetFieldID())
+		}),
+		outputFields: outputFields,
+		outputSchema: outputSchema,
+		runners:      runners,
+		executor:     executor,
+		batchSize:    batchSize,
+	}, nil
+}
+
+func (r *functionOutputRecordReader) Next() (storage.Record, error) {
+	if r.last != nil {
+		r.last.Release()
+		r.last = nil
+	}
+	rec, err := r.rr.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	outputRec, err := r.computeOutputs(rec)
+	if err != nil {
+		return nil, err
+	}
+	defer outputRec.Release()
+
+	arrowFields := make([]arrow.Field, 0, len(r.fields))
+	columns := make([]arrow.Array, 0, len(r.fields))
+	field2Col := make(map[storage.FieldID]int, len(r.fields))
+	for i, field := range r.fields {
+		column := rec.Column(field.GetFieldID())
+		if idx := lo.IndexOf(r.outputFields, field); idx >= 0 {
+			column = outputRec.Column(idx)
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     column.DataType(),
+			Nullable: field.GetNullable(),
+		})
+		columns = append(columns, column)
+		field2Col[field.GetFieldID()] = i
+	}
+	r.last = storage.NewSimpleArrowRecord(array.NewRecord(arrow.NewSchema(arrowFields, nil), columns, int64(rec.Len())), field2Col)
+	return r.last, nil
+}
+
+// computeOutputs runs the functions over the record batch by batch,
+// the rate of the rows is limited by the node-wide backfill limiter.
+func (r *functionOutputRecordReader) computeOutputs(rec storage.Record) (arrow.Record, error) {
+	values := make([]*storage.Value, rec.Len())
+	if err := storage.ValueDeserializer(rec, values, r.inputFields); err != nil {
+		return nil, err
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, r.outputSchema)
+	defer builder.Release()
+	inputSchema := &schemapb.CollectionSchema{Fields: r.inputFields}
+	for start := 0; start < len(values); start += r.batchSize {
+		end := min(start+r.batchSize, len(values))
+		block, err := storage.NewInsertData(inputSchema)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values[start:end] {
+			if err := block.Append(value.Value.(map[storage.FieldID]interface{})); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := waitFunctionBackfillQuota(r.ctx, end-start); err != nil {
+			return nil, err
+		}
+		if err := r.runBM25(block); err != nil {
+			return nil, err
+		}
+		if err := r.executor.ProcessBulkInsert(block); err != nil {
+			return nil, err
+		}
+		if err := storage.BuildRecord(builder, block, r.outputFields); err != nil {
+			return nil, err
+		}
+	}
+	return builder.NewRecord(), nil
+}
+
+func (r *functionOutputRecordReader) runBM25(data *storage.InsertData) error {
+	for _, runner := range r.runners {
+		inputs := lo.Map(runner.GetInputFields(), func(field *schemapb.FieldSchema, _ int) any {
+			return data.Data[field.GetFieldID()].GetDataRows()
+		})
+		outputs, err := runner.BatchRun(inputs...)
+		if err != nil {
+			return err
+		}
+		for i, outputID := range runner.GetSchema().GetOutputFieldIds() {
+			sparseArray, ok := outputs[i].(*schemapb.SparseFloatArray)
+			if !ok {
+				return merr.WrapErrServiceInternal(fmt.Sprintf("unexpected output of function %s", runner.GetSchema().GetName()))
+			}
+			data.Data[outputID] = &storage.SparseFloatVectorFieldData{
+				SparseFloatArray: schemapb.SparseFloatArray{
+					Dim:      sparseArray.GetDim(),
+					Contents: sparseArray.GetContents(),
+				},
+			}
+		}
+	}
+	return nil
+}
+
+func (r *functionOutputRecordReader) Close() error {
+	if r.last != nil {
+		r.last.Release()
+		r.last = nil
+	}
+	for _, runner := range r.runners {
+		runner.Close()
+	}
+	return r.rr.Close()
+}
+
 func buildIndexParams(
 	req *workerpb.CreateStatsRequest,
 	files []string,
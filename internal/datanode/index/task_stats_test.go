@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/compactor"
 	"github.com/milvus-io/milvus/internal/mocks/flushcommon/mock_util"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexcgopb"
@@ -198,6 +199,131 @@ func (s *TaskStatsSuite) TestBuildIndexParams() {
 	})
 }
 
+func (s *TaskStatsSuite) TestBackfillFunctionOutputs() {
+	// genBinlogs writes the rows of the segment flushed before the function was added.
+	genBinlogs := func(schema *schemapb.CollectionSchema, outputIDs []int64) (map[string][]byte, []*datapb.FieldBinlog) {
+		outputs := typeutil.NewSet(outputIDs...)
+		flushedSchema := &schemapb.CollectionSchema{
+			Name: schema.GetName(),
+			Fields: lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+				return !outputs.Contain(field.GetFieldID())
+			}),
+		}
+		segWriter, err := compactor.NewSegmentWriter(flushedSchema, 100, statsBatchSize, 0, s.partitionID, s.collectionID, nil)
+		s.Require().NoError(err)
+		for i := int64(0); i < 5; i++ {
+			row := genRowWithBM25(i)
+			for _, outputID := range outputIDs {
+				delete(row, outputID)
+			}
+			s.Require().NoError(segWriter.Write(&storage.Value{
+				PK:        storage.NewInt64PrimaryKey(i),
+				Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+				Value:     row,
+			}))
+		}
+		segWriter.FlushAndIsFull()
+		_, kvs, fBinlogs, err := serializeWrite(context.TODO(), "root_path", 0, segWriter)
+		s.Require().NoError(err)
+		return kvs, lo.Values(fBinlogs)
+	}
+
+	newTask := func(ctx context.Context, cancel context.CancelFunc, manager *TaskManager, schema *schemapb.CollectionSchema, insertLogs []*datapb.FieldBinlog) *statsTask {
+		return NewStatsTask(ctx, cancel, &workerpb.CreateStatsRequest{
+			CollectionID:             s.collectionID,
+			PartitionID:              s.partitionID,
+			ClusterID:                s.clusterID,
+			TaskID:                   100,
+			TargetSegmentID:          2,
+			SubJobType:               indexpb.StatsSubJob_FunctionBackfillJob,
+			InsertLogs:               insertLogs,
+			Schema:                   schema,
+			FunctionBackfillFieldIDs: []int64{102},
+			NumRows:                  5,
+			StartLogID:               0,
+			EndLogID:                 100,
+			BinlogMaxSize:            64 * 1024 * 1024,
+			StorageConfig: &indexpb.StorageConfig{
+				RootPath: "root_path",
+			},
+		}, manager, s.mockBinlogIO)
+	}
+
+	s.Run("bm25", func() {
+		paramtable.Get().Save(paramtable.Get().DataNodeCfg.FunctionBackfillBatchSize.Key, "3")
+		defer paramtable.Get().Reset(paramtable.Get().DataNodeCfg.FunctionBackfillBatchSize.Key)
+
+		schema := genCollectionSchemaWithBM25()
+		kvs, insertLogs := genBinlogs(schema, []int64{102})
+		s.Require().Equal(4, len(insertLogs))
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, paths []string) ([][]byte, error) {
+			return lo.Map(paths, func(path string, _ int) []byte { return kvs[path] }), nil
+		})
+		s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewTaskManager(ctx)
+		manager.LoadOrStoreStatsTask(s.clusterID, 100, &StatsTaskInfo{})
+		task := newTask(ctx, cancel, manager, schema, insertLogs)
+		s.Require().NoError(task.PreExecute(ctx))
+		binlogs, err := task.sort(ctx)
+		s.Require().NoError(err)
+		s.Equal(5, len(binlogs))
+
+		info := manager.GetStatsTaskInfo(s.clusterID, 100)
+		s.Equal(int64(2), info.SegID)
+		s.Equal(int64(5), info.NumRows)
+		s.Equal(1, len(info.Bm25Logs))
+	})
+
+	s.Run("function failed", func() {
+		paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+			return map[string]string{"mock.apikey": "mock"}
+		}
+		ts := function.CreateErrorEmbeddingServer()
+		defer ts.Close()
+		paramtable.Get().FunctionCfg.TextEmbeddingProviders.GetFunc = func() map[string]string {
+			return map[string]string{"openai.url": ts.URL}
+		}
+
+		schema := genCollectionSchemaWithBM25()
+		schema.Fields[4] = &schemapb.FieldSchema{
+			FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, IsFunctionOutput: true,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+		}
+		schema.Functions = []*schemapb.FunctionSchema{{
+			Name:             "embedding",
+			Id:               100,
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{"text"},
+			InputFieldIds:    []int64{101},
+			OutputFieldNames: []string{"vec"},
+			OutputFieldIds:   []int64{102},
+			Params: []*commonpb.KeyValuePair{
+				{Key: "provider", Value: "openai"},
+				{Key: "model_name", Value: "text-embedding-ada-002"},
+				{Key: "credential", Value: "mock"},
+				{Key: "dim", Value: "4"},
+			},
+		}}
+		kvs, insertLogs := genBinlogs(schema, []int64{102})
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, paths []string) ([][]byte, error) {
+			return lo.Map(paths, func(path string, _ int) []byte { return kvs[path] }), nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewTaskManager(ctx)
+		manager.LoadOrStoreStatsTask(s.clusterID, 100, &StatsTaskInfo{})
+		task := newTask(ctx, cancel, manager, schema, insertLogs)
+		s.Require().NoError(task.PreExecute(ctx))
+		_, err := task.sort(ctx)
+		s.Error(err)
+		s.Empty(manager.GetStatsTaskInfo(s.clusterID, 100).InsertLogs)
+	})
+}
+
 func genCollectionSchemaWithBM25() *schemapb.CollectionSchema {
 	return &schemapb.CollectionSchema{
 		Name:        "schema",
@@ -689,6 +689,83 @@ func (c *Client) RenameCollection(ctx context.Context, req *milvuspb.RenameColle
 	})
 }
 
+func (c *Client) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.RenameField(ctx, req)
+	})
+}
+
+func (c *Client) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.AddCollectionFunction(ctx, req)
+	})
+}
+
+func (c *Client) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.RenamePartition(ctx, req)
+	})
+}
+
+func (c *Client) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.TransferPartition(ctx, req)
+	})
+}
+
+func (c *Client) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.CreateProjection(ctx, req)
+	})
+}
+
+func (c *Client) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DropProjection(ctx, req)
+	})
+}
+
+func (c *Client) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*rootcoordpb.ListProjectionsResponse, error) {
+		return client.ListProjections(ctx, req)
+	})
+}
+
 func (c *Client) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	in = typeutil.Clone(in)
 	commonpbutil.UpdateMsgBase(
@@ -1062,6 +1139,18 @@ func (c *Client) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	})
 }
 
+// TransferSegments copies the flushed segments of a partition into another partition.
+func (c *Client) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest, opts ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.sess.ServerID)),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.TransferSegmentsResponse, error) {
+		return client.TransferSegments(ctx, req)
+	})
+}
+
 // GetFlushedSegments returns flushed segment list of requested collection/parition
 //
 // ctx is the context to control request deadline and cancellation
@@ -1436,6 +1525,13 @@ func (c *Client) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	})
 }
 
+// GetFunctionBackfillProgress gets the backfill progress of the functions added to the collection.
+func (c *Client) GetFunctionBackfillProgress(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+		return client.GetFunctionBackfillProgress(ctx, in)
+	})
+}
+
 func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*indexpb.ListIndexesResponse, error) {
 		return client.ListIndexes(ctx, in)
@@ -1828,6 +1924,50 @@ func (c *Client) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadCo
 	})
 }
 
+func (c *Client) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*querypb.ReportCollectionAccessResponse, error) {
+		return client.ReportCollectionAccess(ctx, req)
+	})
+}
+
+func (c *Client) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*querypb.BeginReadSessionResponse, error) {
+		return client.BeginReadSession(ctx, req)
+	})
+}
+
+func (c *Client) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest, opts ...grpc.CallOption) (*querypb.GetReadSessionResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*querypb.GetReadSessionResponse, error) {
+		return client.GetReadSession(ctx, req)
+	})
+}
+
+func (c *Client) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.EndReadSession(ctx, req)
+	})
+}
+
 func (c *Client) GetQuotaMetrics(ctx context.Context, req *internalpb.GetQuotaMetricsRequest, opts ...grpc.CallOption) (*internalpb.GetQuotaMetricsResponse, error) {
 	req = typeutil.Clone(req)
 	commonpbutil.UpdateMsgBase(
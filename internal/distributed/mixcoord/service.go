@@ -534,6 +534,34 @@ func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameC
 	return s.mixCoord.RenameCollection(ctx, request)
 }
 
+func (s *Server) RenameField(ctx context.Context, request *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	return s.mixCoord.RenameField(ctx, request)
+}
+
+func (s *Server) AddCollectionFunction(ctx context.Context, request *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.AddCollectionFunction(ctx, request)
+}
+
+func (s *Server) RenamePartition(ctx context.Context, request *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.RenamePartition(ctx, request)
+}
+
+func (s *Server) TransferPartition(ctx context.Context, request *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.TransferPartition(ctx, request)
+}
+
+func (s *Server) CreateProjection(ctx context.Context, request *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CreateProjection(ctx, request)
+}
+
+func (s *Server) DropProjection(ctx context.Context, request *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.DropProjection(ctx, request)
+}
+
+func (s *Server) ListProjections(ctx context.Context, request *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	return s.mixCoord.ListProjections(ctx, request)
+}
+
 func (s *Server) BackupRBAC(ctx context.Context, request *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
 	return s.mixCoord.BackupRBAC(ctx, request)
 }
@@ -702,6 +730,22 @@ func (s *Server) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadCo
 	return s.mixCoord.UpdateLoadConfig(ctx, req)
 }
 
+func (s *Server) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	return s.mixCoord.ReportCollectionAccess(ctx, req)
+}
+
+func (s *Server) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	return s.mixCoord.BeginReadSession(ctx, req)
+}
+
+func (s *Server) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.EndReadSession(ctx, req)
+}
+
+func (s *Server) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	return s.mixCoord.GetReadSession(ctx, req)
+}
+
 // GetSegmentInfo gets segment information according to segment id
 func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
 	return s.mixCoord.GetSegmentInfo(ctx, req)
@@ -767,6 +811,11 @@ func (s *Server) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	return s.mixCoord.GetChannelRecoveryInfo(ctx, req)
 }
 
+// TransferSegments copies the flushed segments of a partition into another partition.
+func (s *Server) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	return s.mixCoord.TransferSegments(ctx, req)
+}
+
 // GetFlushedSegments get all flushed segments of a partition
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	return s.mixCoord.GetFlushedSegments(ctx, req)
@@ -884,6 +933,10 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	return s.mixCoord.GetIndexBuildProgress(ctx, req)
 }
 
+func (s *Server) GetFunctionBackfillProgress(ctx context.Context, req *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return s.mixCoord.GetFunctionBackfillProgress(ctx, req)
+}
+
 func (s *Server) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return s.mixCoord.ReportDataNodeTtMsgs(ctx, req)
 }
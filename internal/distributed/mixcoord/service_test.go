@@ -76,6 +76,18 @@ func (m *mockMix) RenameCollection(ctx context.Context, request *milvuspb.Rename
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockMix) RenameField(ctx context.Context, request *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockMix) AddCollectionFunction(ctx context.Context, request *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockMix) RenamePartition(ctx context.Context, request *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (m *mockMix) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -23,40 +23,45 @@ import (
 // v2
 const (
 	// --- category ---
-	DataBaseCategory        = "/databases/"
-	CollectionCategory      = "/collections/"
-	EntityCategory          = "/entities/"
-	PartitionCategory       = "/partitions/"
-	UserCategory            = "/users/"
-	RoleCategory            = "/roles/"
-	IndexCategory           = "/indexes/"
-	AliasCategory           = "/aliases/"
-	ImportJobCategory       = "/jobs/import/"
-	PrivilegeGroupCategory  = "/privilege_groups/"
-	CollectionFieldCategory = "/collections/fields/"
-	ResourceGroupCategory   = "/resource_groups/"
-	SegmentCategory         = "/segments/"
-	QuotaCenterCategory     = "/quotacenter/"
-
-	ListAction           = "list"
-	HasAction            = "has"
-	DescribeAction       = "describe"
-	CreateAction         = "create"
-	DropAction           = "drop"
-	StatsAction          = "get_stats"
-	LoadStateAction      = "get_load_state"
-	RenameAction         = "rename"
-	LoadAction           = "load"
-	RefreshLoadAction    = "refresh_load"
-	ReleaseAction        = "release"
-	QueryAction          = "query"
-	GetAction            = "get"
-	DeleteAction         = "delete"
-	InsertAction         = "insert"
-	UpsertAction         = "upsert"
-	SearchAction         = "search"
-	AdvancedSearchAction = "advanced_search"
-	HybridSearchAction   = "hybrid_search"
+	DataBaseCategory           = "/databases/"
+	CollectionCategory         = "/collections/"
+	EntityCategory             = "/entities/"
+	PartitionCategory          = "/partitions/"
+	UserCategory               = "/users/"
+	RoleCategory               = "/roles/"
+	IndexCategory              = "/indexes/"
+	AliasCategory              = "/aliases/"
+	ImportJobCategory          = "/jobs/import/"
+	PrivilegeGroupCategory     = "/privilege_groups/"
+	CollectionFieldCategory    = "/collections/fields/"
+	CollectionFunctionCategory = "/collections/functions/"
+	ResourceGroupCategory      = "/resource_groups/"
+	SegmentCategory            = "/segments/"
+	QuotaCenterCategory        = "/quotacenter/"
+	ProjectionCategory         = "/projections/"
+	ReadSessionCategory        = "/read_sessions/"
+
+	ListAction            = "list"
+	HasAction             = "has"
+	DescribeAction        = "describe"
+	CreateAction          = "create"
+	DropAction            = "drop"
+	StatsAction           = "get_stats"
+	LoadStateAction       = "get_load_state"
+	RenameAction          = "rename"
+	AddAction             = "add"
+	LoadAction            = "load"
+	RefreshLoadAction     = "refresh_load"
+	ReleaseAction         = "release"
+	QueryAction           = "query"
+	GetAction             = "get"
+	DeleteAction          = "delete"
+	InsertAction          = "insert"
+	UpsertAction          = "upsert"
+	SearchAction          = "search"
+	AdvancedSearchAction  = "advanced_search"
+	HybridSearchAction    = "hybrid_search"
+	FederatedSearchAction = "federated_search"
 
 	UpdatePasswordAction            = "update_password"
 	GrantRoleAction                 = "grant_role"
@@ -75,6 +80,10 @@ const (
 	AddPrivilegesToGroupAction      = "add_privileges_to_group"
 	RemovePrivilegesFromGroupAction = "remove_privileges_from_group"
 	TransferReplicaAction           = "transfer_replica"
+	BackfillProgressAction          = "get_backfill_progress"
+	TransferAction                  = "transfer"
+	BeginAction                     = "begin"
+	EndAction                       = "end"
 )
 
 const (
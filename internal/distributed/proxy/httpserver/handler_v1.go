@@ -19,7 +19,9 @@ package httpserver
 import (
 	"context"
 	"net/http"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
@@ -85,6 +87,18 @@ func NewHandlersV1(proxyComponent types.ProxyComponent) *HandlersV1 {
 				return handler(ctx, req)
 			})
 	}
+	if proxy.Params.CommonCfg.FollowerEnabled.GetAsBool() {
+		h.interceptors = append(h.interceptors,
+			// reject the dml and ddl at a follower cluster, the v1 requests are all served by the MilvusService method of the same name.
+			func(ctx context.Context, ginCtx *gin.Context, req any, handler func(reqCtx context.Context, req any) (any, error)) (any, error) {
+				fullMethod := "/milvus.proto.milvus.MilvusService/" + strings.TrimSuffix(reflect.TypeOf(req).Elem().Name(), "Request")
+				if err := proxy.CheckFollowerPermitted(fullMethod); err != nil {
+					HTTPAbortReturn(ginCtx, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+					return nil, RestRequestInterceptorErr
+				}
+				return handler(ctx, req)
+			})
+	}
 	h.interceptors = append(h.interceptors,
 		// check database
 		func(ctx context.Context, ginCtx *gin.Context, req any, handler func(reqCtx context.Context, req any) (any, error)) (any, error) {
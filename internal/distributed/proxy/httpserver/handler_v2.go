@@ -47,7 +47,11 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -87,6 +91,10 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(CollectionCategory+FlushAction, timeoutMiddleware(wrapperPost(func() any { return &FlushReq{} }, wrapperTraceLog(h.flush))))
 
 	router.POST(CollectionFieldCategory+AlterPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionFieldReqWithParams{} }, wrapperTraceLog(h.alterCollectionFieldProperties))))
+	router.POST(CollectionFieldCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenameFieldReq{} }, wrapperTraceLog(h.renameField))))
+
+	router.POST(CollectionFunctionCategory+AddAction, timeoutMiddleware(wrapperPost(func() any { return &AddCollectionFunctionReq{} }, wrapperTraceLog(h.addCollectionFunction))))
+	router.POST(CollectionFunctionCategory+BackfillProgressAction, timeoutMiddleware(wrapperPost(func() any { return &FunctionBackfillProgressReq{} }, wrapperTraceLog(h.getFunctionBackfillProgress))))
 
 	router.POST(DataBaseCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqWithProperties{} }, wrapperTraceLog(h.createDatabase))))
 	router.POST(DataBaseCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqRequiredName{} }, wrapperTraceLog(h.dropDatabase))))
@@ -126,6 +134,12 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			Limit: 100,
 		}
 	}, wrapperTraceLog(h.search))), true))
+	// FederatedSearch
+	router.POST(EntityCategory+FederatedSearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+		return &FederatedSearchReq{
+			Limit: 100,
+		}
+	}, wrapperTraceLog(h.federatedSearch))), true))
 	// advanced_search, backward compatible uri
 	router.POST(EntityCategory+AdvancedSearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
 		return &HybridSearchReq{
@@ -145,9 +159,18 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 
 	router.POST(PartitionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.createPartition))))
 	router.POST(PartitionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.dropPartition))))
+	router.POST(PartitionCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenamePartitionReq{} }, wrapperTraceLog(h.renamePartition))))
+	router.POST(PartitionCategory+TransferAction, timeoutMiddleware(wrapperPost(func() any { return &TransferPartitionReq{} }, wrapperTraceLog(h.transferPartition))))
 	router.POST(PartitionCategory+LoadAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionsReq{} }, wrapperTraceLog(h.loadPartitions))))
 	router.POST(PartitionCategory+ReleaseAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionsReq{} }, wrapperTraceLog(h.releasePartitions))))
 
+	router.POST(ProjectionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listProjections))))
+	router.POST(ProjectionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &CreateProjectionReq{} }, wrapperTraceLog(h.createProjection))))
+	router.POST(ProjectionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &ProjectionReq{} }, wrapperTraceLog(h.dropProjection))))
+
+	router.POST(ReadSessionCategory+BeginAction, timeoutMiddleware(wrapperPost(func() any { return &BeginReadSessionReq{} }, wrapperTraceLog(h.beginReadSession))))
+	router.POST(ReadSessionCategory+EndAction, timeoutMiddleware(wrapperPost(func() any { return &EndReadSessionReq{} }, wrapperTraceLog(h.endReadSession))))
+
 	router.POST(UserCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listUsers))))
 	router.POST(UserCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &UserReq{} }, wrapperTraceLog(h.describeUser))))
 
@@ -331,6 +354,27 @@ func wrapperProxy(ctx context.Context, c *gin.Context, req any, checkAuth bool,
 	return wrapperProxyWithLimit(ctx, c, req, checkAuth, ignoreErr, fullMethod, false, nil, handler)
 }
 
+// followerPermittedRoutes are the read-only restful routes which have no grpc method,
+// they are permitted by the route instead of the method at a follower cluster.
+var followerPermittedRoutes = []string{
+	SegmentCategory + DescribeAction,
+	QuotaCenterCategory + DescribeAction,
+	ProjectionCategory + ListAction,
+}
+
+func checkFollowerPermitted(ginCtx *gin.Context, fullMethod string) error {
+	err := proxy.CheckFollowerPermitted(fullMethod)
+	if err == nil {
+		return nil
+	}
+	for _, route := range followerPermittedRoutes {
+		if strings.HasSuffix(ginCtx.FullPath(), route) {
+			return nil
+		}
+	}
+	return err
+}
+
 func wrapperProxyWithLimit(ctx context.Context, ginCtx *gin.Context, req any, checkAuth bool, ignoreErr bool, fullMethod string, checkLimit bool, pxy types.ProxyComponent, handler func(reqCtx context.Context, req any) (any, error)) (interface{}, error) {
 	if baseGetter, ok := req.(BaseGetter); ok {
 		span := trace.SpanFromContext(ctx)
@@ -342,6 +386,13 @@ func wrapperProxyWithLimit(ctx context.Context, ginCtx *gin.Context, req any, ch
 			return nil, err
 		}
 	}
+	if err := checkFollowerPermitted(ginCtx, fullMethod); err != nil {
+		log.Ctx(ctx).Warn("high level restful api, rejected by follower cluster", zap.Error(err), zap.String("method", fullMethod))
+		if !ignoreErr {
+			HTTPAbortReturn(ginCtx, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		}
+		return nil, err
+	}
 	if checkLimit {
 		_, err := CheckLimiter(ctx, req, pxy)
 		if err != nil {
@@ -775,6 +826,89 @@ func (h *HandlersV2) alterCollectionFieldProperties(ctx context.Context, c *gin.
 	return resp, err
 }
 
+func (h *HandlersV2) renameField(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RenameFieldReq)
+	req := &rootcoordpb.RenameFieldRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		OldName:        httpReq.FieldName,
+		NewName:        httpReq.NewFieldName,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, rootcoordpb.MilvusExt_RenameField_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RenameField(reqCtx, req.(*rootcoordpb.RenameFieldRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) addCollectionFunction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*AddCollectionFunctionReq)
+	function, err := genFunctionSchema(ctx, &httpReq.Function)
+	if err != nil {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	outputFields := make([]*schemapb.FieldSchema, 0, len(httpReq.OutputFields))
+	for _, field := range httpReq.OutputFields {
+		fieldSchema, err := genFunctionOutputFieldSchema(&field)
+		if err != nil {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(err),
+				HTTPReturnMessage: err.Error(),
+			})
+			return nil, err
+		}
+		outputFields = append(outputFields, fieldSchema)
+	}
+	req := &rootcoordpb.AddCollectionFunctionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		Function:       function,
+		OutputFields:   outputFields,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, rootcoordpb.MilvusExt_AddCollectionFunction_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AddCollectionFunction(reqCtx, req.(*rootcoordpb.AddCollectionFunctionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) getFunctionBackfillProgress(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*FunctionBackfillProgressReq)
+	req := &rootcoordpb.GetFunctionBackfillProgressRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		FunctionName:   httpReq.FunctionName,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, rootcoordpb.MilvusExt_GetFunctionBackfillProgress_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetFunctionBackfillProgress(reqCtx, req.(*rootcoordpb.GetFunctionBackfillProgressRequest))
+	})
+	if err == nil {
+		resp := resp.(*indexpb.GetFunctionBackfillProgressResponse)
+		HTTPReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode: merr.Code(nil),
+			HTTPReturnData: gin.H{
+				"functionName":   httpReq.FunctionName,
+				"backfilledRows": resp.GetBackfilledRows(),
+				"totalRows":      resp.GetTotalRows(),
+				"pendingRows":    resp.GetPendingRows(),
+				"failReason":     resp.GetFailReason(),
+			},
+		})
+	}
+	return resp, err
+}
+
 // copy from internal/proxy/task_query.go
 func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
@@ -808,6 +942,9 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	if httpReq.Limit > 0 && !matchCountRule(httpReq.OutputFields) {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
+	if httpReq.ReadSessionID != "" {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.ReadSessionKey, Value: httpReq.ReadSessionID})
+	}
 	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Query", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
 	})
@@ -1109,8 +1246,8 @@ func generatePlaceholderGroup(ctx context.Context, body string, collSchema *sche
 
 	if vectorField.GetIsFunctionOutput() {
 		for _, function := range collSchema.Functions {
-			if function.Type == schemapb.FunctionType_BM25 || function.Type == schemapb.FunctionType_TextEmbedding {
-				// TODO: currently only BM25 & text embedding function is supported, thus guarantees one input field to one output field
+			if function.Type == schemapb.FunctionType_BM25 || function.Type == schemapb.FunctionType_TextEmbedding || function.Type == typeutil.FunctionTypeMultimodalEmbedding || function.Type == typeutil.FunctionTypeSparseEmbedding {
+				// TODO: currently only BM25, text & multimodal embedding function is supported, thus guarantees one input field to one output field
 				if function.OutputFieldNames[0] == vectorField.Name {
 					dataType = schemapb.DataType_VarChar
 				}
@@ -1131,6 +1268,41 @@ func generatePlaceholderGroup(ctx context.Context, body string, collSchema *sche
 
 func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*SearchReqV2)
+	req, err := h.generateSearchRequest(ctx, c, httpReq, dbName)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Search", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
+	})
+	if err == nil {
+		searchResp := resp.(*milvuspb.SearchResults)
+		cost := proxy.GetCostValue(searchResp.GetStatus())
+		if searchResp.Results.TopK == int64(0) {
+			HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: []interface{}{}, HTTPReturnCost: cost})
+		} else {
+			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+			outputData, err := buildQueryResp(0, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
+			if err != nil {
+				log.Ctx(ctx).Warn("high level restful api, fail to deal with search result", zap.Any("result", searchResp.Results), zap.Error(err))
+				HTTPReturn(c, http.StatusOK, gin.H{
+					HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+				})
+			} else {
+				if len(searchResp.Results.Recalls) > 0 {
+					HTTPReturnStream(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnCost: cost, HTTPReturnRecalls: searchResp.Results.Recalls, HTTPReturnTopks: searchResp.Results.Topks})
+				} else {
+					HTTPReturnStream(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnCost: cost, HTTPReturnTopks: searchResp.Results.Topks})
+				}
+			}
+		}
+	}
+	return resp, err
+}
+
+// generateSearchRequest converts the restful search request to the search request, the http response is written if it fails.
+func (h *HandlersV2) generateSearchRequest(ctx context.Context, c *gin.Context, httpReq *SearchReqV2, dbName string) (*milvuspb.SearchRequest, error) {
 	req := &milvuspb.SearchRequest{
 		DbName:         dbName,
 		CollectionName: httpReq.CollectionName,
@@ -1182,6 +1354,9 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 		}
 	}
 
+	if httpReq.ReadSessionID != "" {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.ReadSessionKey, Value: httpReq.ReadSessionID})
+	}
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
 	body, _ := c.Get(gin.BodyBytesKey)
 	placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
@@ -1196,29 +1371,71 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	req.SearchParams = searchParams
 	req.PlaceholderGroup = placeholderGroup
 	req.ExprTemplateValues = generateExpressionTemplate(httpReq.ExprParams)
-	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Search", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
-		return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
+	return req, nil
+}
+
+func (h *HandlersV2) federatedSearch(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*FederatedSearchReq)
+	if len(httpReq.CollectionNames) == 0 {
+		err := merr.WrapErrParameterInvalidMsg("at least one collection is required in federated search")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	// the search request is built with the schema of the first collection, the others are checked by the proxy.
+	searchReq, err := h.generateSearchRequest(ctx, c, &SearchReqV2{
+		CollectionName:   httpReq.CollectionNames[0],
+		Data:             httpReq.Data,
+		AnnsField:        httpReq.AnnsField,
+		Filter:           httpReq.Filter,
+		Limit:            httpReq.Limit,
+		Offset:           httpReq.Offset,
+		OutputFields:     httpReq.OutputFields,
+		SearchParams:     httpReq.SearchParams,
+		ConsistencyLevel: httpReq.ConsistencyLevel,
+		ExprParams:       httpReq.ExprParams,
+		FunctionScore:    httpReq.FunctionScore,
+	}, dbName)
+	if err != nil {
+		return nil, err
+	}
+	req := &proxypb.FederatedSearchRequest{
+		DbName:          dbName,
+		CollectionNames: httpReq.CollectionNames,
+		Request:         searchReq,
+	}
+	c.Set(ContextRequest, req)
+	if h.checkAuth {
+		for _, collectionName := range httpReq.CollectionNames {
+			if err := checkAuthorizationV2(ctx, c, false, &milvuspb.SearchRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, rootcoordpb.MilvusExt_FederatedSearch_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.FederatedSearch(reqCtx, req.(*proxypb.FederatedSearchRequest))
 	})
 	if err == nil {
-		searchResp := resp.(*milvuspb.SearchResults)
-		cost := proxy.GetCostValue(searchResp.GetStatus())
-		if searchResp.Results.TopK == int64(0) {
-			HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: []interface{}{}, HTTPReturnCost: cost})
+		searchResp := resp.(*proxypb.FederatedSearchResults)
+		if searchResp.GetResults().GetTopK() == int64(0) {
+			HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: []interface{}{}})
 		} else {
 			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
-			outputData, err := buildQueryResp(0, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
+			results := searchResp.GetResults()
+			outputData, err := buildQueryResp(0, results.GetOutputFields(), results.GetFieldsData(), results.GetIds(), results.GetScores(), allowJS)
 			if err != nil {
-				log.Ctx(ctx).Warn("high level restful api, fail to deal with search result", zap.Any("result", searchResp.Results), zap.Error(err))
+				log.Ctx(ctx).Warn("high level restful api, fail to deal with federated search result", zap.Any("result", results), zap.Error(err))
 				HTTPReturn(c, http.StatusOK, gin.H{
 					HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
 					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
 				})
 			} else {
-				if len(searchResp.Results.Recalls) > 0 {
-					HTTPReturnStream(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnCost: cost, HTTPReturnRecalls: searchResp.Results.Recalls, HTTPReturnTopks: searchResp.Results.Topks})
-				} else {
-					HTTPReturnStream(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnCost: cost, HTTPReturnTopks: searchResp.Results.Topks})
+				for i, row := range outputData {
+					row[HTTPCollectionName] = searchResp.GetCollectionNames()[i]
 				}
+				HTTPReturnStream(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnTopks: results.GetTopks()})
 			}
 		}
 	}
@@ -1301,6 +1518,9 @@ func (h *HandlersV2) advancedSearch(ctx context.Context, c *gin.Context, anyReq
 		req.RankParams = append(req.RankParams, &commonpb.KeyValuePair{Key: ParamGroupSize, Value: strconv.FormatInt(int64(httpReq.GroupSize), 10)})
 		req.RankParams = append(req.RankParams, &commonpb.KeyValuePair{Key: ParamStrictGroupSize, Value: strconv.FormatBool(httpReq.StrictGroupSize)})
 	}
+	if httpReq.ReadSessionID != "" {
+		req.RankParams = append(req.RankParams, &commonpb.KeyValuePair{Key: proxy.ReadSessionKey, Value: httpReq.ReadSessionID})
+	}
 	if len(httpReq.FunctionScore.Functions) != 0 {
 		if req.FunctionScore, err = genFunctionScore(ctx, &httpReq.FunctionScore); err != nil {
 			HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrParameterInvalid), HTTPReturnMessage: err.Error()})
@@ -1858,6 +2078,211 @@ func (h *HandlersV2) dropPartition(ctx context.Context, c *gin.Context, anyReq a
 	return resp, err
 }
 
+func (h *HandlersV2) renamePartition(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RenamePartitionReq)
+	req := &rootcoordpb.RenamePartitionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		OldName:        httpReq.PartitionName,
+		NewName:        httpReq.NewPartitionName,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, rootcoordpb.MilvusExt_RenamePartition_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RenamePartition(reqCtx, req.(*rootcoordpb.RenamePartitionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) transferPartition(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransferPartitionReq)
+	targetDbName := httpReq.TargetDbName
+	if targetDbName == "" {
+		targetDbName = dbName
+	}
+	targetPartitionName := httpReq.TargetPartitionName
+	if targetPartitionName == "" {
+		targetPartitionName = httpReq.PartitionName
+	}
+	req := &rootcoordpb.TransferPartitionRequest{
+		DbName:               dbName,
+		CollectionName:       httpReq.CollectionName,
+		PartitionName:        httpReq.PartitionName,
+		TargetDbName:         targetDbName,
+		TargetCollectionName: httpReq.TargetCollectionName,
+		TargetPartitionName:  targetPartitionName,
+		Copy:                 httpReq.Copy,
+	}
+	c.Set(ContextRequest, req)
+	// moving a partition requires the privilege to drop the source partition, copying requires the privilege to read it,
+	// and both require the privilege to insert into the target collection.
+	if h.checkAuth {
+		var sourceReq any = &milvuspb.DropPartitionRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+			PartitionName:  httpReq.PartitionName,
+		}
+		if httpReq.Copy {
+			sourceReq = &milvuspb.QueryRequest{
+				DbName:         dbName,
+				CollectionName: httpReq.CollectionName,
+				PartitionNames: []string{httpReq.PartitionName},
+			}
+		}
+		if err := checkAuthorizationV2(ctx, c, false, sourceReq); err != nil {
+			return nil, err
+		}
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.InsertRequest{
+			DbName:         targetDbName,
+			CollectionName: httpReq.TargetCollectionName,
+			PartitionName:  targetPartitionName,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/TransferPartition", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.TransferPartition(reqCtx, req.(*rootcoordpb.TransferPartitionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createProjection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CreateProjectionReq)
+	req := &rootcoordpb.CreateProjectionRequest{
+		DbName:               dbName,
+		ProjectionName:       httpReq.ProjectionName,
+		SourceCollectionName: httpReq.SourceCollectionName,
+		TargetCollectionName: httpReq.TargetCollectionName,
+	}
+	c.Set(ContextRequest, req)
+	// the projection reads the source collection and upserts into the target collection on behalf of the user.
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.QueryRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.SourceCollectionName,
+		}); err != nil {
+			return nil, err
+		}
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.UpsertRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.TargetCollectionName,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/CreateProjection", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateProjection(reqCtx, req.(*rootcoordpb.CreateProjectionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropProjection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ProjectionReq)
+	req := &rootcoordpb.DropProjectionRequest{
+		DbName:         dbName,
+		ProjectionName: httpReq.ProjectionName,
+	}
+	c.Set(ContextRequest, req)
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.AlterDatabaseRequest{DbName: dbName}); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/DropProjection", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropProjection(reqCtx, req.(*rootcoordpb.DropProjectionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) beginReadSession(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*BeginReadSessionReq)
+	req := &querypb.BeginReadSessionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		TtlSeconds:     httpReq.TTLSeconds,
+	}
+	c.Set(ContextRequest, req)
+	// the session pins the snapshot read by the following searches and queries.
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.QueryRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, rootcoordpb.MilvusExt_BeginReadSession_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.BeginReadSession(reqCtx, req.(*querypb.BeginReadSessionRequest))
+	})
+	if err == nil {
+		sessionResp := resp.(*querypb.BeginReadSessionResponse)
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{
+			"sessionId": sessionResp.GetSessionId(),
+			"timestamp": sessionResp.GetTimestamp(),
+			"expireAt":  sessionResp.GetExpireAt(),
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) endReadSession(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*EndReadSessionReq)
+	req := &querypb.EndReadSessionRequest{
+		DbName:    dbName,
+		SessionId: httpReq.SessionID,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, false, false, rootcoordpb.MilvusExt_EndReadSession_FullMethodName, true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.EndReadSession(reqCtx, req.(*querypb.EndReadSessionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) listProjections(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &rootcoordpb.ListProjectionsRequest{
+		DbName: dbName,
+	}
+	c.Set(ContextRequest, req)
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, &milvuspb.DescribeDatabaseRequest{DbName: dbName}); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/ListProjections", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListProjections(reqCtx, req.(*rootcoordpb.ListProjectionsRequest))
+	})
+	if err == nil {
+		projections := make([]gin.H, 0)
+		for _, projection := range resp.(*rootcoordpb.ListProjectionsResponse).GetProjections() {
+			projections = append(projections, gin.H{
+				"projectionName":       projection.GetName(),
+				"sourceCollectionName": projection.GetSourceCollectionName(),
+				"targetCollectionName": projection.GetTargetCollectionName(),
+				"state":                projection.GetState().String(),
+				"reason":               projection.GetReason(),
+				"consistentTs":         projection.GetConsistentTs(),
+				"lagMs":                projection.GetLagMs(),
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: projections})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) loadPartitions(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*PartitionsReq)
 	req := &milvuspb.LoadPartitionsRequest{
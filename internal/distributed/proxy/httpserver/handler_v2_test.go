@@ -39,6 +39,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -645,6 +648,44 @@ func TestSearchWithRerank(t *testing.T) {
 	sendReqAndVerify(t, testEngine, testcase.path, http.MethodPost, testcase)
 }
 
+func TestFederatedSearch(t *testing.T) {
+	paramtable.Init()
+	// disable rate limit
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	mp := mocks.NewMockProxy(t)
+	testEngine := initHTTPServerV2(mp, false)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64, true, true),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().FederatedSearch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error) {
+		assert.Equal(t, []string{"book", "book_2024"}, req.GetCollectionNames())
+		assert.Equal(t, "book", req.GetRequest().GetCollectionName())
+		return &proxypb.FederatedSearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{
+			TopK:         int64(3),
+			OutputFields: []string{FieldWordCount},
+			FieldsData:   generateFieldData(),
+			Ids:          generateIDs(schemapb.DataType_Int64, 3),
+			Scores:       DefaultScores,
+		}, CollectionNames: []string{"book", "book_2024", "book"}}, nil
+	}).Once()
+
+	testcase := requestBodyTestCase{
+		path: versionalV2(EntityCategory, FederatedSearchAction),
+		requestBody: []byte(`{
+                                         "collectionNames": ["book", "book_2024"],
+                                         "data": [[0.1, 0.2]],
+                                         "limit": 3,
+                                         "offset": 1,
+                                         "outputFields": ["word_count"]}`),
+	}
+	sendReqAndVerify(t, testEngine, testcase.path, http.MethodPost, testcase)
+}
+
 func TestHybridSearchWithRerank(t *testing.T) {
 	paramtable.Init()
 	// disable rate limit
@@ -1898,6 +1939,22 @@ func TestMethodPost(t *testing.T) {
 	mp.EXPECT().LoadCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Times(3)
 	mp.EXPECT().ReleaseCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().CreatePartition(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().RenamePartition(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().TransferPartition(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().CreateProjection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().DropProjection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().BeginReadSession(mock.Anything, mock.Anything).Return(&querypb.BeginReadSessionResponse{
+		Status:    commonSuccessStatus,
+		SessionId: "1-2-3",
+		Timestamp: 2,
+		ExpireAt:  3,
+	}, nil).Once()
+	mp.EXPECT().EndReadSession(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().ListProjections(mock.Anything, mock.Anything).Return(&rootcoordpb.ListProjectionsResponse{
+		Status:      commonSuccessStatus,
+		Projections: []*rootcoordpb.ProjectionStatus{{Name: "proj", SourceCollectionName: DefaultCollectionName, TargetCollectionName: "test"}},
+	}, nil).Once()
+	mp.EXPECT().RenameField(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().LoadPartitions(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().ReleasePartitions(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().CreateCredential(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
@@ -1999,6 +2056,30 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(PartitionCategory, CreateAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(PartitionCategory, RenameAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(PartitionCategory, TransferAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ProjectionCategory, CreateAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ProjectionCategory, ListAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ProjectionCategory, DropAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ReadSessionCategory, BeginAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ReadSessionCategory, EndAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(CollectionFieldCategory, RenameAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(PartitionCategory, LoadAction),
 	})
@@ -2074,14 +2155,16 @@ func TestMethodPost(t *testing.T) {
 	for _, testcase := range queryTestCases {
 		t.Run(testcase.path, func(t *testing.T) {
 			bodyReader := bytes.NewReader([]byte(`{` +
-				`"collectionName": "` + DefaultCollectionName + `", "newCollectionName": "test", "newDbName": "",` +
-				`"partitionName": "` + DefaultPartitionName + `", "partitionNames": ["` + DefaultPartitionName + `"],` +
+				`"collectionName": "` + DefaultCollectionName + `", "newCollectionName": "test", "newDbName": "", "targetCollectionName": "test",` +
+				`"partitionName": "` + DefaultPartitionName + `", "partitionNames": ["` + DefaultPartitionName + `"], "newPartitionName": "test",` +
 				`"schema": {"fields": [{"fieldName": "book_id", "dataType": "Int64", "elementTypeParams": {}}, {"fieldName": "book_intro", "dataType": "FloatVector", "elementTypeParams": {"dim": 2}}]},` +
 				`"indexParams": [{"indexName": "` + DefaultIndexName + `", "fieldName": "book_intro", "metricType": "L2", "params": {"nlist": 30, "index_type": "IVF_FLAT"}}],` +
+				`"fieldName": "book_intro", "newFieldName": "intro",` +
 				`"userName": "` + util.UserRoot + `", "password": "Milvus", "newPassword": "milvus", "roleName": "` + util.RoleAdmin + `",` +
 				`"roleName": "` + util.RoleAdmin + `", "objectType": "Global", "objectName": "*", "privilege": "*",` +
 				`"privilegeGroupName": "pg", "privileges": ["create", "drop"],` +
 				`"aliasName": "` + DefaultAliasName + `",` +
+				`"projectionName": "proj", "sourceCollectionName": "` + DefaultCollectionName + `", "sessionId": "1-2-3",` +
 				`"jobId": "1234567890",` +
 				`"files": [["book.json"]]` +
 				`}`))
@@ -2753,3 +2836,40 @@ func TestGetQuotaMetrics(t *testing.T) {
 	}
 	fmt.Println(w.Body.String())
 }
+
+func TestCheckFollowerPermitted(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(proxy.Params.CommonCfg.FollowerEnabled.Key, "true")
+	defer paramtable.Get().Reset(proxy.Params.CommonCfg.FollowerEnabled.Key)
+
+	testcases := []struct {
+		route      string
+		fullMethod string
+		permitted  bool
+	}{
+		{CollectionCategory + DescribeAction, milvuspb.MilvusService_DescribeCollection_FullMethodName, true},
+		{SegmentCategory + DescribeAction, "/milvus.proto.milvus.MilvusService/GetSegmentsInfo", true},
+		{QuotaCenterCategory + DescribeAction, "/milvus.proto.milvus.MilvusService/GetQuotaMetrics", true},
+		{ProjectionCategory + ListAction, "/milvus.proto.milvus.MilvusService/ListProjections", true},
+		{ProjectionCategory + CreateAction, "/milvus.proto.milvus.MilvusService/CreateProjection", false},
+		{ReadSessionCategory + BeginAction, rootcoordpb.MilvusExt_BeginReadSession_FullMethodName, true},
+		{CollectionCategory + CreateAction, milvuspb.MilvusService_CreateCollection_FullMethodName, false},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.route, func(t *testing.T) {
+			var err error
+			ginHandler := gin.Default()
+			ginHandler.Group("/v2/vectordb").POST(testcase.route, func(c *gin.Context) {
+				err = checkFollowerPermitted(c, testcase.fullMethod)
+			})
+			req := httptest.NewRequest(http.MethodPost, versionalV2(testcase.route, ""), nil)
+			w := httptest.NewRecorder()
+			ginHandler.ServeHTTP(w, req)
+			if testcase.permitted {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, merr.ErrServiceReadOnly)
+			}
+		})
+	}
+}
@@ -159,6 +159,35 @@ func (req *CollectionFieldReqWithParams) GetCollectionName() string {
 	return req.CollectionName
 }
 
+type RenameFieldReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	FieldName      string `json:"fieldName" binding:"required"`
+	NewFieldName   string `json:"newFieldName" binding:"required"`
+}
+
+func (req *RenameFieldReq) GetDbName() string         { return req.DbName }
+func (req *RenameFieldReq) GetCollectionName() string { return req.CollectionName }
+
+type AddCollectionFunctionReq struct {
+	DbName         string         `json:"dbName"`
+	CollectionName string         `json:"collectionName" binding:"required"`
+	Function       FunctionSchema `json:"function" binding:"required"`
+	OutputFields   []FieldSchema  `json:"outputFields" binding:"required"`
+}
+
+func (req *AddCollectionFunctionReq) GetDbName() string         { return req.DbName }
+func (req *AddCollectionFunctionReq) GetCollectionName() string { return req.CollectionName }
+
+type FunctionBackfillProgressReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	FunctionName   string `json:"functionName" binding:"required"`
+}
+
+func (req *FunctionBackfillProgressReq) GetDbName() string         { return req.DbName }
+func (req *FunctionBackfillProgressReq) GetCollectionName() string { return req.CollectionName }
+
 func (req *CollectionFieldReqWithParams) GetFieldName() string {
 	return req.FieldName
 }
@@ -174,6 +203,63 @@ func (req *PartitionReq) GetDbName() string         { return req.DbName }
 func (req *PartitionReq) GetCollectionName() string { return req.CollectionName }
 func (req *PartitionReq) GetPartitionName() string  { return req.PartitionName }
 
+type RenamePartitionReq struct {
+	DbName           string `json:"dbName"`
+	CollectionName   string `json:"collectionName" binding:"required"`
+	PartitionName    string `json:"partitionName" binding:"required"`
+	NewPartitionName string `json:"newPartitionName" binding:"required"`
+}
+
+func (req *RenamePartitionReq) GetDbName() string         { return req.DbName }
+func (req *RenamePartitionReq) GetCollectionName() string { return req.CollectionName }
+func (req *RenamePartitionReq) GetPartitionName() string  { return req.PartitionName }
+
+type TransferPartitionReq struct {
+	DbName               string `json:"dbName"`
+	CollectionName       string `json:"collectionName" binding:"required"`
+	PartitionName        string `json:"partitionName" binding:"required"`
+	TargetDbName         string `json:"targetDbName"`
+	TargetCollectionName string `json:"targetCollectionName" binding:"required"`
+	TargetPartitionName  string `json:"targetPartitionName"`
+	Copy                 bool   `json:"copy"`
+}
+
+func (req *TransferPartitionReq) GetDbName() string         { return req.DbName }
+func (req *TransferPartitionReq) GetCollectionName() string { return req.CollectionName }
+func (req *TransferPartitionReq) GetPartitionName() string  { return req.PartitionName }
+
+type ProjectionReq struct {
+	DbName         string `json:"dbName"`
+	ProjectionName string `json:"projectionName" binding:"required"`
+}
+
+func (req *ProjectionReq) GetDbName() string { return req.DbName }
+
+type BeginReadSessionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	TTLSeconds     int64  `json:"ttlSeconds"`
+}
+
+func (req *BeginReadSessionReq) GetDbName() string         { return req.DbName }
+func (req *BeginReadSessionReq) GetCollectionName() string { return req.CollectionName }
+
+type EndReadSessionReq struct {
+	DbName    string `json:"dbName"`
+	SessionID string `json:"sessionId" binding:"required"`
+}
+
+func (req *EndReadSessionReq) GetDbName() string { return req.DbName }
+
+type CreateProjectionReq struct {
+	DbName               string `json:"dbName"`
+	ProjectionName       string `json:"projectionName" binding:"required"`
+	SourceCollectionName string `json:"sourceCollectionName" binding:"required"`
+	TargetCollectionName string `json:"targetCollectionName" binding:"required"`
+}
+
+func (req *CreateProjectionReq) GetDbName() string { return req.DbName }
+
 type ImportReq struct {
 	DbName         string            `json:"dbName"`
 	CollectionName string            `json:"collectionName" binding:"required"`
@@ -218,6 +304,7 @@ type QueryReqV2 struct {
 	Offset           int32                  `json:"offset"`
 	ExprParams       map[string]interface{} `json:"exprParams"`
 	ConsistencyLevel string                 `json:"consistencyLevel"`
+	ReadSessionID    string                 `json:"readSessionId"`
 }
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
@@ -270,12 +357,30 @@ type SearchReqV2 struct {
 	ConsistencyLevel string                 `json:"consistencyLevel"`
 	ExprParams       map[string]interface{} `json:"exprParams"`
 	FunctionScore    FunctionScore          `json:"functionScore"`
+	ReadSessionID    string                 `json:"readSessionId"`
 	// not use Params any more, just for compatibility
 	Params map[string]float64 `json:"params"`
 }
 
 func (req *SearchReqV2) GetDbName() string { return req.DbName }
 
+type FederatedSearchReq struct {
+	DbName           string                 `json:"dbName"`
+	CollectionNames  []string               `json:"collectionNames" binding:"required"`
+	Data             []interface{}          `json:"data" binding:"required"`
+	AnnsField        string                 `json:"annsField"`
+	Filter           string                 `json:"filter"`
+	Limit            int32                  `json:"limit"`
+	Offset           int32                  `json:"offset"`
+	OutputFields     []string               `json:"outputFields"`
+	SearchParams     map[string]interface{} `json:"searchParams"`
+	ConsistencyLevel string                 `json:"consistencyLevel"`
+	ExprParams       map[string]interface{} `json:"exprParams"`
+	FunctionScore    FunctionScore          `json:"functionScore"`
+}
+
+func (req *FederatedSearchReq) GetDbName() string { return req.DbName }
+
 type Rand struct {
 	Strategy string                 `json:"strategy"`
 	Params   map[string]interface{} `json:"params"`
@@ -306,6 +411,7 @@ type HybridSearchReq struct {
 	OutputFields     []string       `json:"outputFields"`
 	ConsistencyLevel string         `json:"consistencyLevel"`
 	FunctionScore    FunctionScore  `json:"functionScore"`
+	ReadSessionID    string         `json:"readSessionId"`
 }
 
 func (req *HybridSearchReq) GetDbName() string { return req.DbName }
@@ -2004,12 +2004,11 @@ func generateSearchParams(reqSearchParams map[string]interface{}) ([]*commonpb.K
 }
 
 func genFunctionSchema(ctx context.Context, function *FunctionSchema) (*schemapb.FunctionSchema, error) {
-	functionTypeValue, ok := schemapb.FunctionType_value[function.FunctionType]
+	functionType, ok := typeutil.ParseFunctionType(function.FunctionType)
 	if !ok {
 		log.Ctx(ctx).Warn("function's data type is invalid(case sensitive).", zap.Any("function.DataType", function.FunctionType), zap.Any("function", function))
 		return nil, merr.WrapErrParameterInvalidMsg("Unsupported function type: %s", function.FunctionType)
 	}
-	functionType := schemapb.FunctionType(functionTypeValue)
 	description := function.Description
 	params := []*commonpb.KeyValuePair{}
 	for key, value := range function.Params {
@@ -2025,6 +2024,30 @@ func genFunctionSchema(ctx context.Context, function *FunctionSchema) (*schemapb
 	}, nil
 }
 
+// genFunctionOutputFieldSchema converts the output field of a function added to an existing collection,
+// which can only be a vector field.
+func genFunctionOutputFieldSchema(field *FieldSchema) (*schemapb.FieldSchema, error) {
+	dataType, ok := schemapb.DataType_value[field.DataType]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("data type %s is invalid(case sensitive)", field.DataType)
+	}
+	fieldSchema := &schemapb.FieldSchema{
+		Name:             field.FieldName,
+		DataType:         schemapb.DataType(dataType),
+		TypeParams:       []*commonpb.KeyValuePair{},
+		Nullable:         field.Nullable,
+		IsFunctionOutput: true,
+	}
+	for key, fieldParam := range field.ElementTypeParams {
+		value, err := getElementTypeParams(fieldParam)
+		if err != nil {
+			return nil, err
+		}
+		fieldSchema.TypeParams = append(fieldSchema.TypeParams, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	return fieldSchema, nil
+}
+
 func genFunctionScore(ctx context.Context, functionScore *FunctionScore) (*schemapb.FunctionScore, error) {
 	fScore := schemapb.FunctionScore{
 		Functions: []*schemapb.FunctionSchema{},
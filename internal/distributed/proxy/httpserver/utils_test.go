@@ -2548,6 +2548,17 @@ func TestGenFunctionSchem(t *testing.T) {
 		_, err := genFunctionSchema(context.Background(), funcSchema)
 		assert.NoError(t, err)
 	}
+	{
+		funcSchema := &FunctionSchema{
+			FunctionName:    "test",
+			Description:     "",
+			FunctionType:    "MultimodalEmbedding",
+			InputFieldNames: []string{"test"},
+		}
+		schema, err := genFunctionSchema(context.Background(), funcSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, typeutil.FunctionTypeMultimodalEmbedding, schema.GetType())
+	}
 }
 
 func TestGenFunctionScore(t *testing.T) {
@@ -54,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
@@ -62,8 +63,12 @@ import (
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/tracer"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
@@ -235,13 +240,14 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 	}
 	log.Debug("Get proxy rate limiter done")
 
-	var unaryServerOption grpc.ServerOption
+	var unaryServerOption, streamServerOption grpc.ServerOption
 	if enableCustomInterceptor {
 		unaryServerOption = grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			accesslog.UnaryAccessLogInterceptor,
 			proxy.GrpcAuthInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
 			proxy.UnaryServerHookInterceptor(),
+			proxy.FollowerInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
 			proxy.RateLimitInterceptor(limiter),
@@ -249,8 +255,12 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 			proxy.TraceLogInterceptor,
 			connection.KeepActiveInterceptor,
 		))
+		streamServerOption = grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			proxy.GrpcAuthStreamInterceptor(proxy.AuthenticationInterceptor),
+		))
 	} else {
 		unaryServerOption = grpc.EmptyServerOption{}
+		streamServerOption = grpc.EmptyServerOption{}
 	}
 
 	grpcOpts := []grpc.ServerOption{
@@ -259,6 +269,7 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
 		unaryServerOption,
+		streamServerOption,
 		grpc.StatsHandler(tracer.GetDynamicOtelGrpcServerStatsHandler()),
 		grpc.StatsHandler(metrics.NewGRPCSizeStatsHandler().
 			// both inbound and outbound
@@ -317,6 +328,8 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 	}
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	cdcpb.RegisterChangeDataCaptureServer(s.grpcExternalServer, s)
+	rootcoordpb.RegisterMilvusExtServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -430,6 +443,7 @@ func (s *Server) init() error {
 	log.Info("Proxy init http server's parameter table done")
 
 	accesslog.InitAccessLogger(paramtable.Get())
+	slowlog.InitSlowQueryLogger(paramtable.Get())
 	serviceName := fmt.Sprintf("Proxy ip: %s, port: %d", Params.IP, Params.Port.GetAsInt())
 	log.Info("init Proxy's tracer done", zap.String("service name", serviceName))
 
@@ -1025,6 +1039,34 @@ func (s *Server) RenameCollection(ctx context.Context, req *milvuspb.RenameColle
 	return s.proxy.RenameCollection(ctx, req)
 }
 
+func (s *Server) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	return s.proxy.RenamePartition(ctx, req)
+}
+
+func (s *Server) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameField(ctx, req)
+}
+
+func (s *Server) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionFunction(ctx, req)
+}
+
+func (s *Server) GetFunctionBackfillProgress(ctx context.Context, req *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return s.proxy.GetFunctionBackfillProgress(ctx, req)
+}
+
+func (s *Server) FederatedSearch(ctx context.Context, req *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error) {
+	return s.proxy.FederatedSearch(ctx, req)
+}
+
+func (s *Server) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	return s.proxy.BeginReadSession(ctx, req)
+}
+
+func (s *Server) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	return s.proxy.EndReadSession(ctx, req)
+}
+
 func (s *Server) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateResourceGroup(ctx, req)
 }
@@ -1128,3 +1170,7 @@ func (s *Server) GetSegmentsInfo(ctx context.Context, req *internalpb.GetSegment
 func (s *Server) GetQuotaMetrics(ctx context.Context, req *internalpb.GetQuotaMetricsRequest) (*internalpb.GetQuotaMetricsResponse, error) {
 	return s.proxy.GetQuotaMetrics(ctx, req)
 }
+
+func (s *Server) WatchChanges(req *cdcpb.WatchChangesRequest, stream cdcpb.ChangeDataCapture_WatchChangesServer) error {
+	return s.proxy.WatchChanges(req, stream)
+}
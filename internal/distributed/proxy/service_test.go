@@ -599,6 +599,36 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("RenamePartition", func(t *testing.T) {
+		mockProxy.EXPECT().RenamePartition(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.RenamePartition(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RenameField", func(t *testing.T) {
+		mockProxy.EXPECT().RenameField(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.RenameField(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FederatedSearch", func(t *testing.T) {
+		mockProxy.EXPECT().FederatedSearch(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.FederatedSearch(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BeginReadSession", func(t *testing.T) {
+		mockProxy.EXPECT().BeginReadSession(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.BeginReadSession(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("EndReadSession", func(t *testing.T) {
+		mockProxy.EXPECT().EndReadSession(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.EndReadSession(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("CreateResourceGroup", func(t *testing.T) {
 		mockProxy.EXPECT().CreateResourceGroup(mock.Anything, mock.Anything).Return(nil, nil)
 		_, err := server.CreateResourceGroup(ctx, nil)
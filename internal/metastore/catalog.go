@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
@@ -91,6 +92,11 @@ type RootCoordCatalog interface {
 	SavePrivilegeGroup(ctx context.Context, data *milvuspb.PrivilegeGroupInfo) error
 	ListPrivilegeGroups(ctx context.Context) ([]*milvuspb.PrivilegeGroupInfo, error)
 
+	// SaveProjection saves the definition and the checkpoints of the projection.
+	SaveProjection(ctx context.Context, projection *etcdpb.ProjectionInfo) error
+	DropProjection(ctx context.Context, dbID int64, projectionName string) error
+	ListProjections(ctx context.Context) ([]*etcdpb.ProjectionInfo, error)
+
 	Close()
 }
 
@@ -199,6 +205,10 @@ type QueryCoordCatalog interface {
 	SaveCollectionTargets(ctx context.Context, target ...*querypb.CollectionTarget) error
 	RemoveCollectionTarget(ctx context.Context, collectionID int64) error
 	GetCollectionTargets(ctx context.Context) (map[int64]*querypb.CollectionTarget, error)
+
+	SaveIdleReleasedCollection(ctx context.Context, req *querypb.LoadCollectionRequest) error
+	RemoveIdleReleasedCollection(ctx context.Context, collectionID int64) error
+	GetIdleReleasedCollections(ctx context.Context) ([]*querypb.LoadCollectionRequest, error)
 }
 
 // StreamingCoordCataLog is the interface for streamingcoord catalog
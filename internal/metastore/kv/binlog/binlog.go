@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
@@ -98,6 +99,65 @@ func CompressFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog) error {
 	return nil
 }
 
+// CompressSegmentFieldBinlogs compresses the binlogs of the segment like CompressFieldBinlogs,
+// but keeps the paths of the binlogs shared from other segments, which cannot be derived from the segment.
+func CompressSegmentFieldBinlogs(binlogType storage.BinlogType, segmentID typeutil.UniqueID, fieldBinlogs []*datapb.FieldBinlog) error {
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.Binlogs {
+			logPath := binlog.GetLogPath()
+			if len(logPath) == 0 {
+				continue
+			}
+			logID, err := GetLogIDFromBingLogPath(logPath)
+			if err != nil {
+				return err
+			}
+			binlog.LogID = logID
+			if !IsSharedBinlogPath(binlogType, segmentID, logID, logPath) {
+				binlog.LogPath = ""
+			}
+		}
+	}
+	return nil
+}
+
+// IsSharedBinlogPath returns whether the binlog path belongs to a segment other than the given one.
+// The segments re-parented into another collection or partition share the binlogs of the source segments.
+func IsSharedBinlogPath(binlogType storage.BinlogType, segmentID, logID typeutil.UniqueID, logPath string) bool {
+	var logType string
+	// [log_type]/collID/partID/segID/fieldID/logID, and the delta log has no fieldID.
+	idNum := 5
+	switch binlogType {
+	case storage.InsertBinlog:
+		logType = common.SegmentInsertLogPath
+	case storage.DeleteBinlog:
+		logType, idNum = common.SegmentDeltaLogPath, 4
+	case storage.StatsBinlog:
+		logType = common.SegmentStatslogPath
+	case storage.BM25Binlog:
+		logType = common.SegmentBm25LogPath
+	default:
+		return false
+	}
+	keys := strings.Split(logPath, "/")
+	if len(keys) < idNum+1 {
+		return false
+	}
+	keys = keys[len(keys)-idNum-1:]
+	if keys[0] != logType {
+		return false
+	}
+	ids := make([]int64, 0, idNum)
+	for _, key := range keys[1:] {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return false
+		}
+		ids = append(ids, id)
+	}
+	return ids[2] != segmentID && ids[idNum-1] == logID
+}
+
 func DecompressMultiBinLogs(infos []*datapb.SegmentInfo) error {
 	for _, info := range infos {
 		err := DecompressBinLogs(info)
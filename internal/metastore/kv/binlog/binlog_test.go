@@ -288,3 +288,27 @@ func TestBinlog_Compress(t *testing.T) {
 	err = DecompressBinLog(invaildType, 1, 1, 1, segmentInfo.Binlogs)
 	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 }
+
+func TestBinlog_CompressSegmentFieldBinlogs(t *testing.T) {
+	ownPath := metautil.BuildInsertLogPath("files", 1, 2, 3, 100, 10)
+	sharedPath := metautil.BuildInsertLogPath("files", 1, 2, 4, 100, 11)
+	sharedDeltaPath := metautil.BuildDeltaLogPath("files", 1, 2, 4, 12)
+
+	assert.False(t, IsSharedBinlogPath(storage.InsertBinlog, 3, 10, ownPath))
+	assert.True(t, IsSharedBinlogPath(storage.InsertBinlog, 3, 11, sharedPath))
+	assert.False(t, IsSharedBinlogPath(storage.InsertBinlog, 3, 12, sharedPath))
+	assert.False(t, IsSharedBinlogPath(storage.StatsBinlog, 3, 11, sharedPath))
+	assert.True(t, IsSharedBinlogPath(storage.DeleteBinlog, 3, 12, sharedDeltaPath))
+	assert.False(t, IsSharedBinlogPath(storage.InsertBinlog, 3, 1, "invalid/1"))
+
+	fieldBinlogs := []*datapb.FieldBinlog{{
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogPath: ownPath}, {LogPath: sharedPath}},
+	}}
+	err := CompressSegmentFieldBinlogs(storage.InsertBinlog, 3, fieldBinlogs)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), fieldBinlogs[0].GetBinlogs()[0].GetLogID())
+	assert.Empty(t, fieldBinlogs[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, int64(11), fieldBinlogs[0].GetBinlogs()[1].GetLogID())
+	assert.Equal(t, sharedPath, fieldBinlogs[0].GetBinlogs()[1].GetLogPath())
+}
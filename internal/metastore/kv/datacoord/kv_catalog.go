@@ -218,28 +218,28 @@ func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, d
 		if len(segmentInfo.Binlogs) == 0 {
 			segmentInfo.Binlogs = insertLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Binlogs); err != nil {
+		if err = binlog.CompressSegmentFieldBinlogs(storage.InsertBinlog, segmentInfo.ID, segmentInfo.Binlogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Deltalogs) == 0 {
 			segmentInfo.Deltalogs = deltaLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Deltalogs); err != nil {
+		if err = binlog.CompressSegmentFieldBinlogs(storage.DeleteBinlog, segmentInfo.ID, segmentInfo.Deltalogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Statslogs) == 0 {
 			segmentInfo.Statslogs = statsLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Statslogs); err != nil {
+		if err = binlog.CompressSegmentFieldBinlogs(storage.StatsBinlog, segmentInfo.ID, segmentInfo.Statslogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Bm25Statslogs) == 0 {
 			segmentInfo.Bm25Statslogs = bm25Logs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Bm25Statslogs); err != nil {
+		if err = binlog.CompressSegmentFieldBinlogs(storage.BM25Binlog, segmentInfo.ID, segmentInfo.Bm25Statslogs); err != nil {
 			return err
 		}
 	}
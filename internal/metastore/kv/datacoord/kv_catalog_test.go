@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -357,6 +358,21 @@ func Test_AddSegments(t *testing.T) {
 		segment.Deltalogs = invalidFieldBinlog
 		err = catalog.AddSegment(context.TODO(), segment)
 		assert.Error(t, err)
+		segment.Deltalogs = validFieldBinlog
+
+		// the path of the binlog shared from another segment is kept.
+		segment.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 1,
+			Binlogs: []*datapb.Binlog{
+				{
+					LogID:   1,
+					LogPath: metautil.BuildInsertLogPath(rootPath, collectionID, partitionID, segmentID+1, 1, 1),
+				},
+			},
+		}}
+		metakv.EXPECT().MultiSave(mock.Anything, mock.Anything).Return(nil).Once()
+		err = catalog.AddSegment(context.TODO(), segment)
+		assert.NoError(t, err)
 	})
 }
 
@@ -1806,3 +1822,23 @@ func Test_StatsTasks(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestParseSegmentKeyCollectionID(t *testing.T) {
+	keys := []string{
+		buildSegmentPath(1, 2, 3),
+		buildFieldBinlogPath(1, 2, 3, 100),
+		buildFieldDeltalogPath(1, 2, 3, 100),
+		buildFieldStatslogPath(1, 2, 3, 100),
+		buildFieldBM25StatslogPath(1, 2, 3, 100),
+	}
+	for _, key := range keys {
+		collectionID, ok := ParseSegmentKeyCollectionID(key)
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, collectionID)
+	}
+
+	for _, key := range []string{buildChannelCPKey("ch"), BuildIndexKey(1, 2), SegmentPrefix + "/abc/2/3"} {
+		_, ok := ParseSegmentKeyCollectionID(key)
+		assert.False(t, ok)
+	}
+}
@@ -18,10 +18,13 @@ package datacoord
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -143,13 +146,14 @@ func cloneLogs(binlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog) (map[string]string, error) {
 	kv := make(map[string]string)
 
-	checkLogID := func(fieldBinlog *datapb.FieldBinlog) error {
-		for _, binlog := range fieldBinlog.GetBinlogs() {
-			if binlog.GetLogID() == 0 {
-				return fmt.Errorf("invalid log id, binlog:%v", binlog)
+	checkLogID := func(binlogType storage.BinlogType, fieldBinlog *datapb.FieldBinlog) error {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			if l.GetLogID() == 0 {
+				return fmt.Errorf("invalid log id, binlog:%v", l)
 			}
-			if binlog.GetLogPath() != "" {
-				return fmt.Errorf("fieldBinlog no need to store logpath, binlog:%v", binlog)
+			// the path of the binlog shared from another segment cannot be derived from the segment.
+			if l.GetLogPath() != "" && !binlog.IsSharedBinlogPath(binlogType, segmentID, l.GetLogID(), l.GetLogPath()) {
+				return fmt.Errorf("fieldBinlog no need to store logpath, binlog:%v", l)
 			}
 		}
 		return nil
@@ -157,7 +161,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// binlog kv
 	for _, binlog := range binlogs {
-		if err := checkLogID(binlog); err != nil {
+		if err := checkLogID(storage.InsertBinlog, binlog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(binlog)
@@ -170,7 +174,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// deltalog
 	for _, deltalog := range deltalogs {
-		if err := checkLogID(deltalog); err != nil {
+		if err := checkLogID(storage.DeleteBinlog, deltalog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(deltalog)
@@ -183,7 +187,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// statslog
 	for _, statslog := range statslogs {
-		if err := checkLogID(statslog); err != nil {
+		if err := checkLogID(storage.StatsBinlog, statslog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(statslog)
@@ -196,7 +200,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// bm25log
 	for _, bm25log := range bm25logs {
-		if err := checkLogID(bm25log); err != nil {
+		if err := checkLogID(storage.BM25Binlog, bm25log); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(bm25log)
@@ -308,6 +312,19 @@ func buildFieldStatslogPathPrefix(collectionID typeutil.UniqueID, partitionID ty
 	return fmt.Sprintf("%s/%d/%d/%d", SegmentStatslogPathPrefix, collectionID, partitionID, segmentID)
 }
 
+// ParseSegmentKeyCollectionID parses the collection id from the key of a segment or its binlogs,
+// false is returned if the key doesn't belong to a segment.
+func ParseSegmentKeyCollectionID(key string) (typeutil.UniqueID, bool) {
+	for _, prefix := range []string{SegmentPrefix, SegmentBinlogPathPrefix, SegmentDeltalogPathPrefix, SegmentStatslogPathPrefix, SegmentBM25logPathPrefix} {
+		if !strings.HasPrefix(key, prefix+"/") {
+			continue
+		}
+		collectionID, err := strconv.ParseInt(strings.SplitN(strings.TrimPrefix(key, prefix+"/"), "/", 2)[0], 10, 64)
+		return collectionID, err == nil
+	}
+	return 0, false
+}
+
 // buildChannelRemovePath builds vchannel remove flag path
 func buildChannelRemovePath(channel string) string {
 	return fmt.Sprintf("%s/%s", ChannelRemovePrefix, channel)
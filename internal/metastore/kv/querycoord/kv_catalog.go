@@ -33,6 +33,8 @@ const (
 
 	MetaOpsBatchSize       = 128
 	CollectionTargetPrefix = "queryCoord-Collection-Target"
+
+	IdleReleasedCollectionPrefix = "queryCoord-Idle-Released-Collection"
 )
 
 type Catalog struct {
@@ -349,6 +351,37 @@ func (s Catalog) GetCollectionTargets(ctx context.Context) (map[int64]*querypb.C
 	return ret, nil
 }
 
+// SaveIdleReleasedCollection saves the load request of the collection released for idle,
+// which is used to reload the collection once it's accessed again.
+func (s Catalog) SaveIdleReleasedCollection(ctx context.Context, req *querypb.LoadCollectionRequest) error {
+	value, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(ctx, encodeIdleReleasedCollectionKey(req.GetCollectionID()), string(value))
+}
+
+func (s Catalog) RemoveIdleReleasedCollection(ctx context.Context, collectionID int64) error {
+	return s.cli.Remove(ctx, encodeIdleReleasedCollectionKey(collectionID))
+}
+
+func (s Catalog) GetIdleReleasedCollections(ctx context.Context) ([]*querypb.LoadCollectionRequest, error) {
+	_, values, err := s.cli.LoadWithPrefix(ctx, IdleReleasedCollectionPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.LoadCollectionRequest, 0, len(values))
+	for _, value := range values {
+		req := &querypb.LoadCollectionRequest{}
+		if err := proto.Unmarshal([]byte(value), req); err != nil {
+			return nil, err
+		}
+		ret = append(ret, req)
+	}
+	return ret, nil
+}
+
 func EncodeCollectionLoadInfoKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionLoadInfoPrefix, collection)
 }
@@ -376,3 +409,7 @@ func encodeResourceGroupKey(rgName string) string {
 func encodeCollectionTargetKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionTargetPrefix, collection)
 }
+
+func encodeIdleReleasedCollectionKey(collection int64) string {
+	return fmt.Sprintf("%s/%d", IdleReleasedCollectionPrefix, collection)
+}
@@ -258,6 +258,28 @@ func (suite *CatalogTestSuite) TestResourceGroup() {
 	suite.Equal([]int64{4, 5}, groups[1].GetNodes())
 }
 
+func (suite *CatalogTestSuite) TestIdleReleasedCollection() {
+	ctx := context.Background()
+	suite.NoError(suite.catalog.SaveIdleReleasedCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:   1,
+		ReplicaNumber:  2,
+		ResourceGroups: []string{"rg1", "rg2"},
+	}))
+	suite.NoError(suite.catalog.SaveIdleReleasedCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:  2,
+		ReplicaNumber: 1,
+		LoadFields:    []int64{100, 101},
+	}))
+	suite.NoError(suite.catalog.RemoveIdleReleasedCollection(ctx, 2))
+
+	reqs, err := suite.catalog.GetIdleReleasedCollections(ctx)
+	suite.NoError(err)
+	suite.Len(reqs, 1)
+	suite.Equal(int64(1), reqs[0].GetCollectionID())
+	suite.Equal(int32(2), reqs[0].GetReplicaNumber())
+	suite.Equal([]string{"rg1", "rg2"}, reqs[0].GetResourceGroups())
+}
+
 func (suite *CatalogTestSuite) TestCollectionTarget() {
 	ctx := context.Background()
 	suite.catalog.SaveCollectionTargets(ctx, &querypb.CollectionTarget{
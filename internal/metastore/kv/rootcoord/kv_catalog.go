@@ -647,6 +647,7 @@ func (kc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 	oldCollClone.State = newColl.State
 	oldCollClone.Properties = newColl.Properties
 	oldCollClone.Fields = newColl.Fields
+	oldCollClone.Functions = newColl.Functions
 	oldCollClone.UpdateTimestamp = newColl.UpdateTimestamp
 
 	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
@@ -667,6 +668,16 @@ func (kc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		}
 		saves[k] = string(v)
 	}
+	// save functions info, the input and output field names of functions may be changed.
+	for _, function := range newColl.Functions {
+		k := BuildFunctionKey(newColl.CollectionID, function.ID)
+		functionInfo := model.MarshalFunctionModel(function)
+		v, err := proto.Marshal(functionInfo)
+		if err != nil {
+			return err
+		}
+		saves[k] = string(v)
+	}
 	if oldKey == newKey {
 		return etcd.SaveByBatchWithLimit(saves, util.MaxEtcdTxnNum/2, func(partialKvs map[string]string) error {
 			return kc.Snapshot.MultiSave(ctx, partialKvs, ts)
@@ -1722,6 +1733,47 @@ func (kc *Catalog) ListPrivilegeGroups(ctx context.Context) ([]*milvuspb.Privile
 	return privGroups, nil
 }
 
+func (kc *Catalog) SaveProjection(ctx context.Context, projection *pb.ProjectionInfo) error {
+	k := BuildProjectionKey(projection.GetDbID(), projection.GetName())
+	v, err := proto.Marshal(projection)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to marshal projection info", zap.Error(err))
+		return err
+	}
+	if err = kc.Txn.Save(ctx, k, string(v)); err != nil {
+		log.Ctx(ctx).Warn("fail to put projection", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) DropProjection(ctx context.Context, dbID int64, projectionName string) error {
+	k := BuildProjectionKey(dbID, projectionName)
+	if err := kc.Txn.Remove(ctx, k); err != nil {
+		log.Ctx(ctx).Warn("fail to drop projection", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListProjections(ctx context.Context) ([]*pb.ProjectionInfo, error) {
+	_, vals, err := kc.Txn.LoadWithPrefix(ctx, ProjectionPrefix)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to list projections", zap.String("prefix", ProjectionPrefix), zap.Error(err))
+		return nil, err
+	}
+	projections := make([]*pb.ProjectionInfo, 0, len(vals))
+	for _, val := range vals {
+		projection := &pb.ProjectionInfo{}
+		if err := proto.Unmarshal([]byte(val), projection); err != nil {
+			log.Ctx(ctx).Error("failed to unmarshal projection info", zap.Error(err))
+			return nil, err
+		}
+		projections = append(projections, projection)
+	}
+	return projections, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
@@ -1061,6 +1061,33 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, newC.UpdateTimestamp, got.UpdateTimestamp)
 	})
 
+	t.Run("modify functions", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(ctx context.Context, saveKvs map[string]string, _ typeutil.Timestamp) error {
+			for k, v := range saveKvs {
+				kvs[k] = v
+			}
+			return nil
+		}
+		kc := NewCatalog(nil, snapshot).(*Catalog)
+		ctx := context.Background()
+		var collectionID int64 = 1
+		oldC := &model.Collection{CollectionID: collectionID, Functions: []*model.Function{
+			{ID: 100, Name: "bm25", InputFieldNames: []string{"text"}, OutputFieldNames: []string{"sparse"}},
+		}}
+		newC := oldC.Clone()
+		newC.Functions[0].InputFieldNames = []string{"content"}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		value, ok := kvs[BuildFunctionKey(collectionID, 100)]
+		assert.True(t, ok)
+		functionSchema := &schemapb.FunctionSchema{}
+		err = proto.Unmarshal([]byte(value), functionSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"content"}, functionSchema.GetInputFieldNames())
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := NewCatalog(nil, nil)
 		ctx := context.Background()
@@ -3085,3 +3112,55 @@ func TestCatalog_listFunctionError(t *testing.T) {
 	_, err = kc.listFunctions(context.TODO(), 1, 1)
 	assert.Error(t, err)
 }
+
+func TestCatalog_Projection(t *testing.T) {
+	ctx := context.TODO()
+	projection := &pb.ProjectionInfo{
+		Name:               "p1",
+		DbID:               1,
+		SourceCollectionID: 100,
+		TargetCollectionID: 101,
+		Checkpoints:        []*pb.ProjectionCheckpoint{{Vchannel: "v1", MessageId: "id", TimeTick: 10}},
+	}
+	key := BuildProjectionKey(1, "p1")
+	value, err := proto.Marshal(projection)
+	assert.NoError(t, err)
+
+	t.Run("save", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := NewCatalog(kvmock, nil)
+		kvmock.EXPECT().Save(mock.Anything, key, string(value)).Return(nil).Once()
+		assert.NoError(t, c.SaveProjection(ctx, projection))
+
+		kvmock.EXPECT().Save(mock.Anything, key, mock.Anything).Return(errors.New("mock")).Once()
+		assert.Error(t, c.SaveProjection(ctx, projection))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := NewCatalog(kvmock, nil)
+		kvmock.EXPECT().Remove(mock.Anything, key).Return(nil).Once()
+		assert.NoError(t, c.DropProjection(ctx, 1, "p1"))
+
+		kvmock.EXPECT().Remove(mock.Anything, key).Return(errors.New("mock")).Once()
+		assert.Error(t, c.DropProjection(ctx, 1, "p1"))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := NewCatalog(kvmock, nil)
+		kvmock.EXPECT().LoadWithPrefix(mock.Anything, ProjectionPrefix).Return([]string{key}, []string{string(value)}, nil).Once()
+		projections, err := c.ListProjections(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, projections, 1)
+		assert.True(t, proto.Equal(projection, projections[0]))
+
+		kvmock.EXPECT().LoadWithPrefix(mock.Anything, ProjectionPrefix).Return([]string{key}, []string{"invalid bytes"}, nil).Once()
+		_, err = c.ListProjections(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(mock.Anything, ProjectionPrefix).Return(nil, nil, errors.New("mock")).Once()
+		_, err = c.ListProjections(ctx)
+		assert.Error(t, err)
+	})
+}
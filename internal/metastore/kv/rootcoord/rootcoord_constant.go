@@ -53,6 +53,9 @@ const (
 
 	// PrivilegeGroupPrefix prefix for privilege group
 	PrivilegeGroupPrefix = ComponentPrefix + "/privilege-group"
+
+	// ProjectionPrefix prefix for projection
+	ProjectionPrefix = ComponentPrefix + "/projection"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -77,3 +80,7 @@ func getDatabasePrefix(dbID int64) string {
 func BuildPrivilegeGroupkey(groupName string) string {
 	return fmt.Sprintf("%s/%s", PrivilegeGroupPrefix, groupName)
 }
+
+func BuildProjectionKey(dbID int64, projectionName string) string {
+	return fmt.Sprintf("%s/%d/%s", ProjectionPrefix, dbID, projectionName)
+}
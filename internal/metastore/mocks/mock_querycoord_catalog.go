@@ -139,6 +139,64 @@ func (_c *QueryCoordCatalog_GetCollections_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetIdleReleasedCollections provides a mock function with given fields: ctx
+func (_m *QueryCoordCatalog) GetIdleReleasedCollections(ctx context.Context) ([]*querypb.LoadCollectionRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdleReleasedCollections")
+	}
+
+	var r0 []*querypb.LoadCollectionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*querypb.LoadCollectionRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*querypb.LoadCollectionRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*querypb.LoadCollectionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetIdleReleasedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdleReleasedCollections'
+type QueryCoordCatalog_GetIdleReleasedCollections_Call struct {
+	*mock.Call
+}
+
+// GetIdleReleasedCollections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *QueryCoordCatalog_Expecter) GetIdleReleasedCollections(ctx interface{}) *QueryCoordCatalog_GetIdleReleasedCollections_Call {
+	return &QueryCoordCatalog_GetIdleReleasedCollections_Call{Call: _e.mock.On("GetIdleReleasedCollections", ctx)}
+}
+
+func (_c *QueryCoordCatalog_GetIdleReleasedCollections_Call) Run(run func(ctx context.Context)) *QueryCoordCatalog_GetIdleReleasedCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetIdleReleasedCollections_Call) Return(_a0 []*querypb.LoadCollectionRequest, _a1 error) *QueryCoordCatalog_GetIdleReleasedCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetIdleReleasedCollections_Call) RunAndReturn(run func(context.Context) ([]*querypb.LoadCollectionRequest, error)) *QueryCoordCatalog_GetIdleReleasedCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields: ctx, collectionIDs
 func (_m *QueryCoordCatalog) GetPartitions(ctx context.Context, collectionIDs []int64) (map[int64][]*querypb.PartitionLoadInfo, error) {
 	ret := _m.Called(ctx, collectionIDs)
@@ -579,6 +637,53 @@ func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RemoveIdleReleasedCollection provides a mock function with given fields: ctx, collectionID
+func (_m *QueryCoordCatalog) RemoveIdleReleasedCollection(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIdleReleasedCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveIdleReleasedCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveIdleReleasedCollection'
+type QueryCoordCatalog_RemoveIdleReleasedCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveIdleReleasedCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *QueryCoordCatalog_Expecter) RemoveIdleReleasedCollection(ctx interface{}, collectionID interface{}) *QueryCoordCatalog_RemoveIdleReleasedCollection_Call {
+	return &QueryCoordCatalog_RemoveIdleReleasedCollection_Call{Call: _e.mock.On("RemoveIdleReleasedCollection", ctx, collectionID)}
+}
+
+func (_c *QueryCoordCatalog_RemoveIdleReleasedCollection_Call) Run(run func(ctx context.Context, collectionID int64)) *QueryCoordCatalog_RemoveIdleReleasedCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveIdleReleasedCollection_Call) Return(_a0 error) *QueryCoordCatalog_RemoveIdleReleasedCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveIdleReleasedCollection_Call) RunAndReturn(run func(context.Context, int64) error) *QueryCoordCatalog_RemoveIdleReleasedCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveResourceGroup provides a mock function with given fields: ctx, rgName
 func (_m *QueryCoordCatalog) RemoveResourceGroup(ctx context.Context, rgName string) error {
 	ret := _m.Called(ctx, rgName)
@@ -749,6 +854,53 @@ func (_c *QueryCoordCatalog_SaveCollectionTargets_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// SaveIdleReleasedCollection provides a mock function with given fields: ctx, req
+func (_m *QueryCoordCatalog) SaveIdleReleasedCollection(ctx context.Context, req *querypb.LoadCollectionRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdleReleasedCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveIdleReleasedCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdleReleasedCollection'
+type QueryCoordCatalog_SaveIdleReleasedCollection_Call struct {
+	*mock.Call
+}
+
+// SaveIdleReleasedCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.LoadCollectionRequest
+func (_e *QueryCoordCatalog_Expecter) SaveIdleReleasedCollection(ctx interface{}, req interface{}) *QueryCoordCatalog_SaveIdleReleasedCollection_Call {
+	return &QueryCoordCatalog_SaveIdleReleasedCollection_Call{Call: _e.mock.On("SaveIdleReleasedCollection", ctx, req)}
+}
+
+func (_c *QueryCoordCatalog_SaveIdleReleasedCollection_Call) Run(run func(ctx context.Context, req *querypb.LoadCollectionRequest)) *QueryCoordCatalog_SaveIdleReleasedCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.LoadCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveIdleReleasedCollection_Call) Return(_a0 error) *QueryCoordCatalog_SaveIdleReleasedCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveIdleReleasedCollection_Call) RunAndReturn(run func(context.Context, *querypb.LoadCollectionRequest) error) *QueryCoordCatalog_SaveIdleReleasedCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePartition provides a mock function with given fields: ctx, info
 func (_m *QueryCoordCatalog) SavePartition(ctx context.Context, info ...*querypb.PartitionLoadInfo) error {
 	_va := make([]interface{}, len(info))
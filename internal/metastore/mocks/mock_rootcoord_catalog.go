@@ -7,6 +7,7 @@ import (
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	metastore "github.com/milvus-io/milvus/internal/metastore"
+	etcdpb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -1134,6 +1135,54 @@ func (_c *RootCoordCatalog_DropPrivilegeGroup_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: ctx, dbID, projectionName
+func (_m *RootCoordCatalog) DropProjection(ctx context.Context, dbID int64, projectionName string) error {
+	ret := _m.Called(ctx, dbID, projectionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, dbID, projectionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type RootCoordCatalog_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - projectionName string
+func (_e *RootCoordCatalog_Expecter) DropProjection(ctx interface{}, dbID interface{}, projectionName interface{}) *RootCoordCatalog_DropProjection_Call {
+	return &RootCoordCatalog_DropProjection_Call{Call: _e.mock.On("DropProjection", ctx, dbID, projectionName)}
+}
+
+func (_c *RootCoordCatalog_DropProjection_Call) Run(run func(ctx context.Context, dbID int64, projectionName string)) *RootCoordCatalog_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropProjection_Call) Return(_a0 error) *RootCoordCatalog_DropProjection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropProjection_Call) RunAndReturn(run func(context.Context, int64, string) error) *RootCoordCatalog_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropRole provides a mock function with given fields: ctx, tenant, roleName
 func (_m *RootCoordCatalog) DropRole(ctx context.Context, tenant string, roleName string) error {
 	ret := _m.Called(ctx, tenant, roleName)
@@ -1894,6 +1943,64 @@ func (_c *RootCoordCatalog_ListPrivilegeGroups_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListProjections(ctx context.Context) ([]*etcdpb.ProjectionInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 []*etcdpb.ProjectionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*etcdpb.ProjectionInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*etcdpb.ProjectionInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etcdpb.ProjectionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type RootCoordCatalog_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListProjections(ctx interface{}) *RootCoordCatalog_ListProjections_Call {
+	return &RootCoordCatalog_ListProjections_Call{Call: _e.mock.On("ListProjections", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListProjections_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListProjections_Call) Return(_a0 []*etcdpb.ProjectionInfo, _a1 error) *RootCoordCatalog_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListProjections_Call) RunAndReturn(run func(context.Context) ([]*etcdpb.ProjectionInfo, error)) *RootCoordCatalog_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListRole provides a mock function with given fields: ctx, tenant, entity, includeUserInfo
 func (_m *RootCoordCatalog) ListRole(ctx context.Context, tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
 	ret := _m.Called(ctx, tenant, entity, includeUserInfo)
@@ -2170,6 +2277,53 @@ func (_c *RootCoordCatalog_SavePrivilegeGroup_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SaveProjection provides a mock function with given fields: ctx, projection
+func (_m *RootCoordCatalog) SaveProjection(ctx context.Context, projection *etcdpb.ProjectionInfo) error {
+	ret := _m.Called(ctx, projection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProjection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etcdpb.ProjectionInfo) error); ok {
+		r0 = rf(ctx, projection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProjection'
+type RootCoordCatalog_SaveProjection_Call struct {
+	*mock.Call
+}
+
+// SaveProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projection *etcdpb.ProjectionInfo
+func (_e *RootCoordCatalog_Expecter) SaveProjection(ctx interface{}, projection interface{}) *RootCoordCatalog_SaveProjection_Call {
+	return &RootCoordCatalog_SaveProjection_Call{Call: _e.mock.On("SaveProjection", ctx, projection)}
+}
+
+func (_c *RootCoordCatalog_SaveProjection_Call) Run(run func(ctx context.Context, projection *etcdpb.ProjectionInfo)) *RootCoordCatalog_SaveProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*etcdpb.ProjectionInfo))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveProjection_Call) Return(_a0 error) *RootCoordCatalog_SaveProjection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveProjection_Call) RunAndReturn(run func(context.Context, *etcdpb.ProjectionInfo) error) *RootCoordCatalog_SaveProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
@@ -1157,6 +1157,65 @@ func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetFunctionBackfillProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFunctionBackfillProgress(_a0 context.Context, _a1 *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunctionBackfillProgress")
+	}
+
+	var r0 *indexpb.GetFunctionBackfillProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) *indexpb.GetFunctionBackfillProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetFunctionBackfillProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFunctionBackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunctionBackfillProgress'
+type MockDataCoord_GetFunctionBackfillProgress_Call struct {
+	*mock.Call
+}
+
+// GetFunctionBackfillProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetFunctionBackfillProgressRequest
+func (_e *MockDataCoord_Expecter) GetFunctionBackfillProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFunctionBackfillProgress_Call {
+	return &MockDataCoord_GetFunctionBackfillProgress_Call{Call: _e.mock.On("GetFunctionBackfillProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFunctionBackfillProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetFunctionBackfillProgressRequest)) *MockDataCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetFunctionBackfillProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFunctionBackfillProgress_Call) Return(_a0 *indexpb.GetFunctionBackfillProgressResponse, _a1 error) *MockDataCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFunctionBackfillProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)) *MockDataCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2918,6 +2977,65 @@ func (_c *MockDataCoord_Stop_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// TransferSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) TransferSegments(_a0 context.Context, _a1 *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferSegments")
+	}
+
+	var r0 *datapb.TransferSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest) *datapb.TransferSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.TransferSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TransferSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_TransferSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegments'
+type MockDataCoord_TransferSegments_Call struct {
+	*mock.Call
+}
+
+// TransferSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.TransferSegmentsRequest
+func (_e *MockDataCoord_Expecter) TransferSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_TransferSegments_Call {
+	return &MockDataCoord_TransferSegments_Call{Call: _e.mock.On("TransferSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_TransferSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.TransferSegmentsRequest)) *MockDataCoord_TransferSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.TransferSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_TransferSegments_Call) Return(_a0 *datapb.TransferSegmentsResponse, _a1 error) *MockDataCoord_TransferSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_TransferSegments_Call) RunAndReturn(run func(context.Context, *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)) *MockDataCoord_TransferSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) UpdateChannelCheckpoint(_a0 context.Context, _a1 *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1484,6 +1484,80 @@ func (_c *MockDataCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetFunctionBackfillProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetFunctionBackfillProgress(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunctionBackfillProgress")
+	}
+
+	var r0 *indexpb.GetFunctionBackfillProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) *indexpb.GetFunctionBackfillProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetFunctionBackfillProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetFunctionBackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunctionBackfillProgress'
+type MockDataCoordClient_GetFunctionBackfillProgress_Call struct {
+	*mock.Call
+}
+
+// GetFunctionBackfillProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetFunctionBackfillProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetFunctionBackfillProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFunctionBackfillProgress_Call {
+	return &MockDataCoordClient_GetFunctionBackfillProgress_Call{Call: _e.mock.On("GetFunctionBackfillProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetFunctionBackfillProgress_Call) Run(run func(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetFunctionBackfillProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetFunctionBackfillProgress_Call) Return(_a0 *indexpb.GetFunctionBackfillProgressResponse, _a1 error) *MockDataCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetFunctionBackfillProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)) *MockDataCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3260,6 +3334,80 @@ func (_c *MockDataCoordClient_ShowConfigurations_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// TransferSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) TransferSegments(ctx context.Context, in *datapb.TransferSegmentsRequest, opts ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferSegments")
+	}
+
+	var r0 *datapb.TransferSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) *datapb.TransferSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.TransferSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_TransferSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegments'
+type MockDataCoordClient_TransferSegments_Call struct {
+	*mock.Call
+}
+
+// TransferSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.TransferSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) TransferSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_TransferSegments_Call {
+	return &MockDataCoordClient_TransferSegments_Call{Call: _e.mock.On("TransferSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_TransferSegments_Call) Run(run func(ctx context.Context, in *datapb.TransferSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_TransferSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.TransferSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_TransferSegments_Call) Return(_a0 *datapb.TransferSegmentsResponse, _a1 error) *MockDataCoordClient_TransferSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_TransferSegments_Call) RunAndReturn(run func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error)) *MockDataCoordClient_TransferSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *datapb.UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -162,6 +162,65 @@ func (_c *MixCoord_AddCollectionField_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// AddCollectionFunction provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) AddCollectionFunction(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCollectionFunction")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_AddCollectionFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionFunction'
+type MixCoord_AddCollectionFunction_Call struct {
+	*mock.Call
+}
+
+// AddCollectionFunction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.AddCollectionFunctionRequest
+func (_e *MixCoord_Expecter) AddCollectionFunction(_a0 interface{}, _a1 interface{}) *MixCoord_AddCollectionFunction_Call {
+	return &MixCoord_AddCollectionFunction_Call{Call: _e.mock.On("AddCollectionFunction", _a0, _a1)}
+}
+
+func (_c *MixCoord_AddCollectionFunction_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest)) *MixCoord_AddCollectionFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.AddCollectionFunctionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_AddCollectionFunction_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_AddCollectionFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_AddCollectionFunction_Call) RunAndReturn(run func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)) *MixCoord_AddCollectionFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -752,6 +811,65 @@ func (_c *MixCoord_BackupRBAC_Call) RunAndReturn(run func(context.Context, *milv
 	return _c
 }
 
+// BeginReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) BeginReadSession(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReadSession")
+	}
+
+	var r0 *querypb.BeginReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) *querypb.BeginReadSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BeginReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BeginReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_BeginReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReadSession'
+type MixCoord_BeginReadSession_Call struct {
+	*mock.Call
+}
+
+// BeginReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.BeginReadSessionRequest
+func (_e *MixCoord_Expecter) BeginReadSession(_a0 interface{}, _a1 interface{}) *MixCoord_BeginReadSession_Call {
+	return &MixCoord_BeginReadSession_Call{Call: _e.mock.On("BeginReadSession", _a0, _a1)}
+}
+
+func (_c *MixCoord_BeginReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest)) *MixCoord_BeginReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.BeginReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_BeginReadSession_Call) Return(_a0 *querypb.BeginReadSessionResponse, _a1 error) *MixCoord_BeginReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_BeginReadSession_Call) RunAndReturn(run func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)) *MixCoord_BeginReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BroadcastAlteredCollection provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) BroadcastAlteredCollection(_a0 context.Context, _a1 *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1401,6 +1519,65 @@ func (_c *MixCoord_CreatePrivilegeGroup_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CreateProjection provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CreateProjection(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CreateProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProjection'
+type MixCoord_CreateProjection_Call struct {
+	*mock.Call
+}
+
+// CreateProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateProjectionRequest
+func (_e *MixCoord_Expecter) CreateProjection(_a0 interface{}, _a1 interface{}) *MixCoord_CreateProjection_Call {
+	return &MixCoord_CreateProjection_Call{Call: _e.mock.On("CreateProjection", _a0, _a1)}
+}
+
+func (_c *MixCoord_CreateProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest)) *MixCoord_CreateProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CreateProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CreateProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CreateProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)) *MixCoord_CreateProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2345,6 +2522,65 @@ func (_c *MixCoord_DropPrivilegeGroup_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) DropProjection(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type MixCoord_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.DropProjectionRequest
+func (_e *MixCoord_Expecter) DropProjection(_a0 interface{}, _a1 interface{}) *MixCoord_DropProjection_Call {
+	return &MixCoord_DropProjection_Call{Call: _e.mock.On("DropProjection", _a0, _a1)}
+}
+
+func (_c *MixCoord_DropProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest)) *MixCoord_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_DropProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_DropProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_DropProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)) *MixCoord_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2522,6 +2758,65 @@ func (_c *MixCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// EndReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) EndReadSession(_a0 context.Context, _a1 *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndReadSession")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.EndReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_EndReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndReadSession'
+type MixCoord_EndReadSession_Call struct {
+	*mock.Call
+}
+
+// EndReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.EndReadSessionRequest
+func (_e *MixCoord_Expecter) EndReadSession(_a0 interface{}, _a1 interface{}) *MixCoord_EndReadSession_Call {
+	return &MixCoord_EndReadSession_Call{Call: _e.mock.On("EndReadSession", _a0, _a1)}
+}
+
+func (_c *MixCoord_EndReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.EndReadSessionRequest)) *MixCoord_EndReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.EndReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_EndReadSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_EndReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_EndReadSession_Call) RunAndReturn(run func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)) *MixCoord_EndReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3289,6 +3584,65 @@ func (_c *MixCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetFunctionBackfillProgress provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetFunctionBackfillProgress(_a0 context.Context, _a1 *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunctionBackfillProgress")
+	}
+
+	var r0 *indexpb.GetFunctionBackfillProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) *indexpb.GetFunctionBackfillProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetFunctionBackfillProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetFunctionBackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunctionBackfillProgress'
+type MixCoord_GetFunctionBackfillProgress_Call struct {
+	*mock.Call
+}
+
+// GetFunctionBackfillProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetFunctionBackfillProgressRequest
+func (_e *MixCoord_Expecter) GetFunctionBackfillProgress(_a0 interface{}, _a1 interface{}) *MixCoord_GetFunctionBackfillProgress_Call {
+	return &MixCoord_GetFunctionBackfillProgress_Call{Call: _e.mock.On("GetFunctionBackfillProgress", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetFunctionBackfillProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetFunctionBackfillProgressRequest)) *MixCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetFunctionBackfillProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetFunctionBackfillProgress_Call) Return(_a0 *indexpb.GetFunctionBackfillProgressResponse, _a1 error) *MixCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetFunctionBackfillProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)) *MixCoord_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4098,19 +4452,78 @@ func (_e *MixCoord_Expecter) GetQuotaMetrics(_a0 interface{}, _a1 interface{}) *
 	return &MixCoord_GetQuotaMetrics_Call{Call: _e.mock.On("GetQuotaMetrics", _a0, _a1)}
 }
 
-func (_c *MixCoord_GetQuotaMetrics_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetQuotaMetricsRequest)) *MixCoord_GetQuotaMetrics_Call {
+func (_c *MixCoord_GetQuotaMetrics_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetQuotaMetricsRequest)) *MixCoord_GetQuotaMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetQuotaMetricsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetQuotaMetrics_Call) Return(_a0 *internalpb.GetQuotaMetricsResponse, _a1 error) *MixCoord_GetQuotaMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetQuotaMetrics_Call) RunAndReturn(run func(context.Context, *internalpb.GetQuotaMetricsRequest) (*internalpb.GetQuotaMetricsResponse, error)) *MixCoord_GetQuotaMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetReadSession(_a0 context.Context, _a1 *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadSession")
+	}
+
+	var r0 *querypb.GetReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest) *querypb.GetReadSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadSession'
+type MixCoord_GetReadSession_Call struct {
+	*mock.Call
+}
+
+// GetReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetReadSessionRequest
+func (_e *MixCoord_Expecter) GetReadSession(_a0 interface{}, _a1 interface{}) *MixCoord_GetReadSession_Call {
+	return &MixCoord_GetReadSession_Call{Call: _e.mock.On("GetReadSession", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetReadSessionRequest)) *MixCoord_GetReadSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetQuotaMetricsRequest))
+		run(args[0].(context.Context), args[1].(*querypb.GetReadSessionRequest))
 	})
 	return _c
 }
 
-func (_c *MixCoord_GetQuotaMetrics_Call) Return(_a0 *internalpb.GetQuotaMetricsResponse, _a1 error) *MixCoord_GetQuotaMetrics_Call {
+func (_c *MixCoord_GetReadSession_Call) Return(_a0 *querypb.GetReadSessionResponse, _a1 error) *MixCoord_GetReadSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MixCoord_GetQuotaMetrics_Call) RunAndReturn(run func(context.Context, *internalpb.GetQuotaMetricsRequest) (*internalpb.GetQuotaMetricsResponse, error)) *MixCoord_GetQuotaMetrics_Call {
+func (_c *MixCoord_GetReadSession_Call) RunAndReturn(run func(context.Context, *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error)) *MixCoord_GetReadSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5608,6 +6021,65 @@ func (_c *MixCoord_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListProjections(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 *rootcoordpb.ListProjectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) *rootcoordpb.ListProjectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListProjectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListProjectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type MixCoord_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListProjectionsRequest
+func (_e *MixCoord_Expecter) ListProjections(_a0 interface{}, _a1 interface{}) *MixCoord_ListProjections_Call {
+	return &MixCoord_ListProjections_Call{Call: _e.mock.On("ListProjections", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListProjections_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest)) *MixCoord_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListProjectionsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListProjections_Call) Return(_a0 *rootcoordpb.ListProjectionsResponse, _a1 error) *MixCoord_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListProjections_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)) *MixCoord_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListQueryNode provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ListQueryNode(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6501,6 +6973,183 @@ func (_c *MixCoord_RenameCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RenameField provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) RenameField(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameField")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenameFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_RenameField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameField'
+type MixCoord_RenameField_Call struct {
+	*mock.Call
+}
+
+// RenameField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenameFieldRequest
+func (_e *MixCoord_Expecter) RenameField(_a0 interface{}, _a1 interface{}) *MixCoord_RenameField_Call {
+	return &MixCoord_RenameField_Call{Call: _e.mock.On("RenameField", _a0, _a1)}
+}
+
+func (_c *MixCoord_RenameField_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest)) *MixCoord_RenameField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenameFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_RenameField_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_RenameField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_RenameField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)) *MixCoord_RenameField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenamePartition provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) RenamePartition(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenamePartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenamePartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_RenamePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenamePartition'
+type MixCoord_RenamePartition_Call struct {
+	*mock.Call
+}
+
+// RenamePartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenamePartitionRequest
+func (_e *MixCoord_Expecter) RenamePartition(_a0 interface{}, _a1 interface{}) *MixCoord_RenamePartition_Call {
+	return &MixCoord_RenamePartition_Call{Call: _e.mock.On("RenamePartition", _a0, _a1)}
+}
+
+func (_c *MixCoord_RenamePartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest)) *MixCoord_RenamePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenamePartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_RenamePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_RenamePartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_RenamePartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)) *MixCoord_RenamePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportCollectionAccess provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ReportCollectionAccess(_a0 context.Context, _a1 *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportCollectionAccess")
+	}
+
+	var r0 *querypb.ReportCollectionAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest) *querypb.ReportCollectionAccessResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ReportCollectionAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReportCollectionAccessRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ReportCollectionAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportCollectionAccess'
+type MixCoord_ReportCollectionAccess_Call struct {
+	*mock.Call
+}
+
+// ReportCollectionAccess is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ReportCollectionAccessRequest
+func (_e *MixCoord_Expecter) ReportCollectionAccess(_a0 interface{}, _a1 interface{}) *MixCoord_ReportCollectionAccess_Call {
+	return &MixCoord_ReportCollectionAccess_Call{Call: _e.mock.On("ReportCollectionAccess", _a0, _a1)}
+}
+
+func (_c *MixCoord_ReportCollectionAccess_Call) Run(run func(_a0 context.Context, _a1 *querypb.ReportCollectionAccessRequest)) *MixCoord_ReportCollectionAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ReportCollectionAccessRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ReportCollectionAccess_Call) Return(_a0 *querypb.ReportCollectionAccessResponse, _a1 error) *MixCoord_ReportCollectionAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ReportCollectionAccess_Call) RunAndReturn(run func(context.Context, *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error)) *MixCoord_ReportCollectionAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -8021,6 +8670,65 @@ func (_c *MixCoord_TransferNode_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// TransferPartition provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) TransferPartition(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.TransferPartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_TransferPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPartition'
+type MixCoord_TransferPartition_Call struct {
+	*mock.Call
+}
+
+// TransferPartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.TransferPartitionRequest
+func (_e *MixCoord_Expecter) TransferPartition(_a0 interface{}, _a1 interface{}) *MixCoord_TransferPartition_Call {
+	return &MixCoord_TransferPartition_Call{Call: _e.mock.On("TransferPartition", _a0, _a1)}
+}
+
+func (_c *MixCoord_TransferPartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest)) *MixCoord_TransferPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.TransferPartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_TransferPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_TransferPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_TransferPartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)) *MixCoord_TransferPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferReplica provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) TransferReplica(_a0 context.Context, _a1 *querypb.TransferReplicaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -8139,6 +8847,65 @@ func (_c *MixCoord_TransferSegment_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// TransferSegments provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) TransferSegments(_a0 context.Context, _a1 *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferSegments")
+	}
+
+	var r0 *datapb.TransferSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest) *datapb.TransferSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.TransferSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TransferSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_TransferSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegments'
+type MixCoord_TransferSegments_Call struct {
+	*mock.Call
+}
+
+// TransferSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.TransferSegmentsRequest
+func (_e *MixCoord_Expecter) TransferSegments(_a0 interface{}, _a1 interface{}) *MixCoord_TransferSegments_Call {
+	return &MixCoord_TransferSegments_Call{Call: _e.mock.On("TransferSegments", _a0, _a1)}
+}
+
+func (_c *MixCoord_TransferSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.TransferSegmentsRequest)) *MixCoord_TransferSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.TransferSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_TransferSegments_Call) Return(_a0 *datapb.TransferSegmentsResponse, _a1 error) *MixCoord_TransferSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_TransferSegments_Call) RunAndReturn(run func(context.Context, *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)) *MixCoord_TransferSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) UpdateChannelCheckpoint(_a0 context.Context, _a1 *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -187,6 +187,80 @@ func (_c *MockMixCoordClient_AddCollectionField_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// AddCollectionFunction provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) AddCollectionFunction(ctx context.Context, in *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCollectionFunction")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_AddCollectionFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionFunction'
+type MockMixCoordClient_AddCollectionFunction_Call struct {
+	*mock.Call
+}
+
+// AddCollectionFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.AddCollectionFunctionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) AddCollectionFunction(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_AddCollectionFunction_Call {
+	return &MockMixCoordClient_AddCollectionFunction_Call{Call: _e.mock.On("AddCollectionFunction",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_AddCollectionFunction_Call) Run(run func(ctx context.Context, in *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_AddCollectionFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.AddCollectionFunctionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_AddCollectionFunction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_AddCollectionFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_AddCollectionFunction_Call) RunAndReturn(run func(context.Context, *rootcoordpb.AddCollectionFunctionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_AddCollectionFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocIDResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -927,6 +1001,80 @@ func (_c *MockMixCoordClient_BackupRBAC_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// BeginReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) BeginReadSession(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReadSession")
+	}
+
+	var r0 *querypb.BeginReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) *querypb.BeginReadSessionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BeginReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_BeginReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReadSession'
+type MockMixCoordClient_BeginReadSession_Call struct {
+	*mock.Call
+}
+
+// BeginReadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.BeginReadSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) BeginReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_BeginReadSession_Call {
+	return &MockMixCoordClient_BeginReadSession_Call{Call: _e.mock.On("BeginReadSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_BeginReadSession_Call) Run(run func(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_BeginReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.BeginReadSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_BeginReadSession_Call) Return(_a0 *querypb.BeginReadSessionResponse, _a1 error) *MockMixCoordClient_BeginReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_BeginReadSession_Call) RunAndReturn(run func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error)) *MockMixCoordClient_BeginReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BroadcastAlteredCollection provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) BroadcastAlteredCollection(ctx context.Context, in *datapb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1786,6 +1934,80 @@ func (_c *MockMixCoordClient_CreatePrivilegeGroup_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CreateProjection provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CreateProjection(ctx context.Context, in *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateProjectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CreateProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProjection'
+type MockMixCoordClient_CreateProjection_Call struct {
+	*mock.Call
+}
+
+// CreateProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.CreateProjectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CreateProjection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CreateProjection_Call {
+	return &MockMixCoordClient_CreateProjection_Call{Call: _e.mock.On("CreateProjection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CreateProjection_Call) Run(run func(ctx context.Context, in *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CreateProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateProjectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CreateProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateProjectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CreateProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResourceGroup provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2970,6 +3192,80 @@ func (_c *MockMixCoordClient_DropPrivilegeGroup_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) DropProjection(ctx context.Context, in *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropProjectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type MockMixCoordClient_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.DropProjectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) DropProjection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_DropProjection_Call {
+	return &MockMixCoordClient_DropProjection_Call{Call: _e.mock.On("DropProjection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_DropProjection_Call) Run(run func(ctx context.Context, in *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropProjectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_DropProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropProjectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3192,8 +3488,8 @@ func (_c *MockMixCoordClient_DropVirtualChannel_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// Flush provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
+// EndReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) EndReadSession(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3204,23 +3500,23 @@ func (_m *MockMixCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Flush")
+		panic("no return value specified for EndReadSession")
 	}
 
-	var r0 *datapb.FlushResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) *datapb.FlushResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.FlushResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3229,21 +3525,21 @@ func (_m *MockMixCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest
 	return r0, r1
 }
 
-// MockMixCoordClient_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
-type MockMixCoordClient_Flush_Call struct {
+// MockMixCoordClient_EndReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndReadSession'
+type MockMixCoordClient_EndReadSession_Call struct {
 	*mock.Call
 }
 
-// Flush is a helper method to define mock.On call
+// EndReadSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.FlushRequest
+//   - in *querypb.EndReadSessionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) Flush(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_Flush_Call {
-	return &MockMixCoordClient_Flush_Call{Call: _e.mock.On("Flush",
+func (_e *MockMixCoordClient_Expecter) EndReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_EndReadSession_Call {
+	return &MockMixCoordClient_EndReadSession_Call{Call: _e.mock.On("EndReadSession",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_Flush_Call) Run(run func(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption)) *MockMixCoordClient_Flush_Call {
+func (_c *MockMixCoordClient_EndReadSession_Call) Run(run func(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_EndReadSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -3251,23 +3547,23 @@ func (_c *MockMixCoordClient_Flush_Call) Run(run func(ctx context.Context, in *d
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.FlushRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.EndReadSessionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockMixCoordClient_Flush_Call {
+func (_c *MockMixCoordClient_EndReadSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_EndReadSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)) *MockMixCoordClient_Flush_Call {
+func (_c *MockMixCoordClient_EndReadSession_Call) RunAndReturn(run func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_EndReadSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcConfirm provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
+// Flush provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3278,7 +3574,81 @@ func (_m *MockMixCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfir
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GcConfirm")
+		panic("no return value specified for Flush")
+	}
+
+	var r0 *datapb.FlushResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) *datapb.FlushResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.FlushResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type MockMixCoordClient_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.FlushRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) Flush(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_Flush_Call {
+	return &MockMixCoordClient_Flush_Call{Call: _e.mock.On("Flush",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_Flush_Call) Run(run func(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption)) *MockMixCoordClient_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.FlushRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockMixCoordClient_Flush_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)) *MockMixCoordClient_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GcConfirm provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GcConfirm")
 	}
 
 	var r0 *datapb.GcConfirmResponse
@@ -4080,6 +4450,80 @@ func (_c *MockMixCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetFunctionBackfillProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetFunctionBackfillProgress(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunctionBackfillProgress")
+	}
+
+	var r0 *indexpb.GetFunctionBackfillProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) *indexpb.GetFunctionBackfillProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetFunctionBackfillProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_GetFunctionBackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunctionBackfillProgress'
+type MockMixCoordClient_GetFunctionBackfillProgress_Call struct {
+	*mock.Call
+}
+
+// GetFunctionBackfillProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetFunctionBackfillProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) GetFunctionBackfillProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetFunctionBackfillProgress_Call {
+	return &MockMixCoordClient_GetFunctionBackfillProgress_Call{Call: _e.mock.On("GetFunctionBackfillProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_GetFunctionBackfillProgress_Call) Run(run func(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetFunctionBackfillProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetFunctionBackfillProgress_Call) Return(_a0 *indexpb.GetFunctionBackfillProgressResponse, _a1 error) *MockMixCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetFunctionBackfillProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetFunctionBackfillProgressRequest, ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)) *MockMixCoordClient_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -5042,6 +5486,80 @@ func (_c *MockMixCoordClient_GetQuotaMetrics_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetReadSession(ctx context.Context, in *querypb.GetReadSessionRequest, opts ...grpc.CallOption) (*querypb.GetReadSessionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadSession")
+	}
+
+	var r0 *querypb.GetReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) (*querypb.GetReadSessionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) *querypb.GetReadSessionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_GetReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadSession'
+type MockMixCoordClient_GetReadSession_Call struct {
+	*mock.Call
+}
+
+// GetReadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetReadSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) GetReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetReadSession_Call {
+	return &MockMixCoordClient_GetReadSession_Call{Call: _e.mock.On("GetReadSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_GetReadSession_Call) Run(run func(ctx context.Context, in *querypb.GetReadSessionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetReadSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetReadSession_Call) Return(_a0 *querypb.GetReadSessionResponse, _a1 error) *MockMixCoordClient_GetReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetReadSession_Call) RunAndReturn(run func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) (*querypb.GetReadSessionResponse, error)) *MockMixCoordClient_GetReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRecoveryInfo provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) GetRecoveryInfo(ctx context.Context, in *datapb.GetRecoveryInfoRequest, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -6818,6 +7336,80 @@ func (_c *MockMixCoordClient_ListPrivilegeGroups_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListProjections(ctx context.Context, in *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 *rootcoordpb.ListProjectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest, ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest, ...grpc.CallOption) *rootcoordpb.ListProjectionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListProjectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListProjectionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type MockMixCoordClient_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.ListProjectionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListProjections(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListProjections_Call {
+	return &MockMixCoordClient_ListProjections_Call{Call: _e.mock.On("ListProjections",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListProjections_Call) Run(run func(ctx context.Context, in *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListProjectionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListProjections_Call) Return(_a0 *rootcoordpb.ListProjectionsResponse, _a1 error) *MockMixCoordClient_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListProjections_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListProjectionsRequest, ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error)) *MockMixCoordClient_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListQueryNode provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListQueryNode(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -7780,8 +8372,8 @@ func (_c *MockMixCoordClient_RenameCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RenameField provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) RenameField(ctx context.Context, in *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -7792,15 +8384,15 @@ func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *data
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReportDataNodeTtMsgs")
+		panic("no return value specified for RenameField")
 	}
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -7808,7 +8400,7 @@ func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *data
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenameFieldRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -7817,21 +8409,21 @@ func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *data
 	return r0, r1
 }
 
-// MockMixCoordClient_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
-type MockMixCoordClient_ReportDataNodeTtMsgs_Call struct {
+// MockMixCoordClient_RenameField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameField'
+type MockMixCoordClient_RenameField_Call struct {
 	*mock.Call
 }
 
-// ReportDataNodeTtMsgs is a helper method to define mock.On call
+// RenameField is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.ReportDataNodeTtMsgsRequest
+//   - in *rootcoordpb.RenameFieldRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) ReportDataNodeTtMsgs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ReportDataNodeTtMsgs_Call {
-	return &MockMixCoordClient_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs",
+func (_e *MockMixCoordClient_Expecter) RenameField(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_RenameField_Call {
+	return &MockMixCoordClient_RenameField_Call{Call: _e.mock.On("RenameField",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ReportDataNodeTtMsgs_Call {
+func (_c *MockMixCoordClient_RenameField_Call) Run(run func(ctx context.Context, in *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption)) *MockMixCoordClient_RenameField_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -7839,7 +8431,229 @@ func (_c *MockMixCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenameFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_RenameField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_RenameField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_RenameField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenameFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_RenameField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenamePartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) RenamePartition(ctx context.Context, in *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenamePartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenamePartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_RenamePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenamePartition'
+type MockMixCoordClient_RenamePartition_Call struct {
+	*mock.Call
+}
+
+// RenamePartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.RenamePartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) RenamePartition(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_RenamePartition_Call {
+	return &MockMixCoordClient_RenamePartition_Call{Call: _e.mock.On("RenamePartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_RenamePartition_Call) Run(run func(ctx context.Context, in *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_RenamePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenamePartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_RenamePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_RenamePartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_RenamePartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenamePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_RenamePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportCollectionAccess provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ReportCollectionAccess(ctx context.Context, in *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportCollectionAccess")
+	}
+
+	var r0 *querypb.ReportCollectionAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) *querypb.ReportCollectionAccessResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ReportCollectionAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ReportCollectionAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportCollectionAccess'
+type MockMixCoordClient_ReportCollectionAccess_Call struct {
+	*mock.Call
+}
+
+// ReportCollectionAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ReportCollectionAccessRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ReportCollectionAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ReportCollectionAccess_Call {
+	return &MockMixCoordClient_ReportCollectionAccess_Call{Call: _e.mock.On("ReportCollectionAccess",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ReportCollectionAccess_Call) Run(run func(ctx context.Context, in *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ReportCollectionAccessRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportCollectionAccess_Call) Return(_a0 *querypb.ReportCollectionAccessResponse, _a1 error) *MockMixCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportCollectionAccess_Call) RunAndReturn(run func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error)) *MockMixCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportDataNodeTtMsgs")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
+type MockMixCoordClient_ReportDataNodeTtMsgs_Call struct {
+	*mock.Call
+}
+
+// ReportDataNodeTtMsgs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ReportDataNodeTtMsgsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ReportDataNodeTtMsgs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ReportDataNodeTtMsgs_Call {
+	return &MockMixCoordClient_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ReportDataNodeTtMsgs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest), variadicArgs...)
 	})
 	return _c
 }
@@ -9408,6 +10222,80 @@ func (_c *MockMixCoordClient_TransferNode_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// TransferPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) TransferPartition(ctx context.Context, in *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.TransferPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_TransferPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPartition'
+type MockMixCoordClient_TransferPartition_Call struct {
+	*mock.Call
+}
+
+// TransferPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.TransferPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) TransferPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_TransferPartition_Call {
+	return &MockMixCoordClient_TransferPartition_Call{Call: _e.mock.On("TransferPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_TransferPartition_Call) Run(run func(ctx context.Context, in *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_TransferPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.TransferPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_TransferPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_TransferPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_TransferPartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.TransferPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_TransferPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferReplica provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) TransferReplica(ctx context.Context, in *querypb.TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -9556,6 +10444,80 @@ func (_c *MockMixCoordClient_TransferSegment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// TransferSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) TransferSegments(ctx context.Context, in *datapb.TransferSegmentsRequest, opts ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferSegments")
+	}
+
+	var r0 *datapb.TransferSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) *datapb.TransferSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.TransferSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_TransferSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegments'
+type MockMixCoordClient_TransferSegments_Call struct {
+	*mock.Call
+}
+
+// TransferSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.TransferSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) TransferSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_TransferSegments_Call {
+	return &MockMixCoordClient_TransferSegments_Call{Call: _e.mock.On("TransferSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_TransferSegments_Call) Run(run func(ctx context.Context, in *datapb.TransferSegmentsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_TransferSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.TransferSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_TransferSegments_Call) Return(_a0 *datapb.TransferSegmentsResponse, _a1 error) *MockMixCoordClient_TransferSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_TransferSegments_Call) RunAndReturn(run func(context.Context, *datapb.TransferSegmentsRequest, ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error)) *MockMixCoordClient_TransferSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *datapb.UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	cdcpb "github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
+
 	context "context"
 
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -10,6 +12,8 @@ import (
 
 	federpb "github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 
+	indexpb "github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+
 	internalpb "github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -18,6 +22,10 @@ import (
 
 	proxypb "github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 
+	querypb "github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+
+	rootcoordpb "github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+
 	types "github.com/milvus-io/milvus/internal/types"
 )
 
@@ -93,6 +101,65 @@ func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// AddCollectionFunction provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionFunction(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCollectionFunction")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionFunction'
+type MockProxy_AddCollectionFunction_Call struct {
+	*mock.Call
+}
+
+// AddCollectionFunction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.AddCollectionFunctionRequest
+func (_e *MockProxy_Expecter) AddCollectionFunction(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionFunction_Call {
+	return &MockProxy_AddCollectionFunction_Call{Call: _e.mock.On("AddCollectionFunction", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionFunction_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest)) *MockProxy_AddCollectionFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.AddCollectionFunctionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionFunction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionFunction_Call) RunAndReturn(run func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -506,6 +573,65 @@ func (_c *MockProxy_BackupRBAC_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// BeginReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) BeginReadSession(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReadSession")
+	}
+
+	var r0 *querypb.BeginReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) *querypb.BeginReadSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BeginReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BeginReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_BeginReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReadSession'
+type MockProxy_BeginReadSession_Call struct {
+	*mock.Call
+}
+
+// BeginReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.BeginReadSessionRequest
+func (_e *MockProxy_Expecter) BeginReadSession(_a0 interface{}, _a1 interface{}) *MockProxy_BeginReadSession_Call {
+	return &MockProxy_BeginReadSession_Call{Call: _e.mock.On("BeginReadSession", _a0, _a1)}
+}
+
+func (_c *MockProxy_BeginReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest)) *MockProxy_BeginReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.BeginReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_BeginReadSession_Call) Return(_a0 *querypb.BeginReadSessionResponse, _a1 error) *MockProxy_BeginReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_BeginReadSession_Call) RunAndReturn(run func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)) *MockProxy_BeginReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1096,6 +1222,65 @@ func (_c *MockProxy_CreatePrivilegeGroup_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CreateProjection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateProjection(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProjection'
+type MockProxy_CreateProjection_Call struct {
+	*mock.Call
+}
+
+// CreateProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateProjectionRequest
+func (_e *MockProxy_Expecter) CreateProjection(_a0 interface{}, _a1 interface{}) *MockProxy_CreateProjection_Call {
+	return &MockProxy_CreateProjection_Call{Call: _e.mock.On("CreateProjection", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest)) *MockProxy_CreateProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)) *MockProxy_CreateProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2040,6 +2225,65 @@ func (_c *MockProxy_DropPrivilegeGroup_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropProjection(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type MockProxy_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.DropProjectionRequest
+func (_e *MockProxy_Expecter) DropProjection(_a0 interface{}, _a1 interface{}) *MockProxy_DropProjection_Call {
+	return &MockProxy_DropProjection_Call{Call: _e.mock.On("DropProjection", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest)) *MockProxy_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)) *MockProxy_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2217,6 +2461,124 @@ func (_c *MockProxy_Dummy_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// EndReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) EndReadSession(_a0 context.Context, _a1 *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndReadSession")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.EndReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_EndReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndReadSession'
+type MockProxy_EndReadSession_Call struct {
+	*mock.Call
+}
+
+// EndReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.EndReadSessionRequest
+func (_e *MockProxy_Expecter) EndReadSession(_a0 interface{}, _a1 interface{}) *MockProxy_EndReadSession_Call {
+	return &MockProxy_EndReadSession_Call{Call: _e.mock.On("EndReadSession", _a0, _a1)}
+}
+
+func (_c *MockProxy_EndReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.EndReadSessionRequest)) *MockProxy_EndReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.EndReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_EndReadSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_EndReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_EndReadSession_Call) RunAndReturn(run func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)) *MockProxy_EndReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FederatedSearch provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) FederatedSearch(_a0 context.Context, _a1 *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FederatedSearch")
+	}
+
+	var r0 *proxypb.FederatedSearchResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.FederatedSearchRequest) *proxypb.FederatedSearchResults); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.FederatedSearchResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.FederatedSearchRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_FederatedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FederatedSearch'
+type MockProxy_FederatedSearch_Call struct {
+	*mock.Call
+}
+
+// FederatedSearch is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.FederatedSearchRequest
+func (_e *MockProxy_Expecter) FederatedSearch(_a0 interface{}, _a1 interface{}) *MockProxy_FederatedSearch_Call {
+	return &MockProxy_FederatedSearch_Call{Call: _e.mock.On("FederatedSearch", _a0, _a1)}
+}
+
+func (_c *MockProxy_FederatedSearch_Call) Run(run func(_a0 context.Context, _a1 *proxypb.FederatedSearchRequest)) *MockProxy_FederatedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.FederatedSearchRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_FederatedSearch_Call) Return(_a0 *proxypb.FederatedSearchResults, _a1 error) *MockProxy_FederatedSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_FederatedSearch_Call) RunAndReturn(run func(context.Context, *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error)) *MockProxy_FederatedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Flush(_a0 context.Context, _a1 *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2793,6 +3155,65 @@ func (_c *MockProxy_GetFlushState_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetFunctionBackfillProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetFunctionBackfillProgress(_a0 context.Context, _a1 *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunctionBackfillProgress")
+	}
+
+	var r0 *indexpb.GetFunctionBackfillProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetFunctionBackfillProgressRequest) *indexpb.GetFunctionBackfillProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetFunctionBackfillProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetFunctionBackfillProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetFunctionBackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunctionBackfillProgress'
+type MockProxy_GetFunctionBackfillProgress_Call struct {
+	*mock.Call
+}
+
+// GetFunctionBackfillProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.GetFunctionBackfillProgressRequest
+func (_e *MockProxy_Expecter) GetFunctionBackfillProgress(_a0 interface{}, _a1 interface{}) *MockProxy_GetFunctionBackfillProgress_Call {
+	return &MockProxy_GetFunctionBackfillProgress_Call{Call: _e.mock.On("GetFunctionBackfillProgress", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetFunctionBackfillProgress_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.GetFunctionBackfillProgressRequest)) *MockProxy_GetFunctionBackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetFunctionBackfillProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetFunctionBackfillProgress_Call) Return(_a0 *indexpb.GetFunctionBackfillProgressResponse, _a1 error) *MockProxy_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetFunctionBackfillProgress_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)) *MockProxy_GetFunctionBackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4901,6 +5322,65 @@ func (_c *MockProxy_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListProjections(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 *rootcoordpb.ListProjectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) *rootcoordpb.ListProjectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListProjectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListProjectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type MockProxy_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListProjectionsRequest
+func (_e *MockProxy_Expecter) ListProjections(_a0 interface{}, _a1 interface{}) *MockProxy_ListProjections_Call {
+	return &MockProxy_ListProjections_Call{Call: _e.mock.On("ListProjections", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListProjections_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest)) *MockProxy_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListProjectionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListProjections_Call) Return(_a0 *rootcoordpb.ListProjectionsResponse, _a1 error) *MockProxy_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListProjections_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)) *MockProxy_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListResourceGroups provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5831,6 +6311,124 @@ func (_c *MockProxy_RenameCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RenameField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RenameField(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameField")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenameFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RenameField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameField'
+type MockProxy_RenameField_Call struct {
+	*mock.Call
+}
+
+// RenameField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenameFieldRequest
+func (_e *MockProxy_Expecter) RenameField(_a0 interface{}, _a1 interface{}) *MockProxy_RenameField_Call {
+	return &MockProxy_RenameField_Call{Call: _e.mock.On("RenameField", _a0, _a1)}
+}
+
+func (_c *MockProxy_RenameField_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest)) *MockProxy_RenameField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenameFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RenameField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RenameField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RenameField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)) *MockProxy_RenameField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenamePartition provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RenamePartition(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenamePartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenamePartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RenamePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenamePartition'
+type MockProxy_RenamePartition_Call struct {
+	*mock.Call
+}
+
+// RenamePartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenamePartitionRequest
+func (_e *MockProxy_Expecter) RenamePartition(_a0 interface{}, _a1 interface{}) *MockProxy_RenamePartition_Call {
+	return &MockProxy_RenamePartition_Call{Call: _e.mock.On("RenamePartition", _a0, _a1)}
+}
+
+func (_c *MockProxy_RenamePartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest)) *MockProxy_RenamePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenamePartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RenamePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RenamePartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RenamePartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)) *MockProxy_RenamePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplicateMessage provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ReplicateMessage(_a0 context.Context, _a1 *milvuspb.ReplicateMessageRequest) (*milvuspb.ReplicateMessageResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6702,6 +7300,65 @@ func (_c *MockProxy_TransferNode_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// TransferPartition provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) TransferPartition(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.TransferPartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_TransferPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPartition'
+type MockProxy_TransferPartition_Call struct {
+	*mock.Call
+}
+
+// TransferPartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.TransferPartitionRequest
+func (_e *MockProxy_Expecter) TransferPartition(_a0 interface{}, _a1 interface{}) *MockProxy_TransferPartition_Call {
+	return &MockProxy_TransferPartition_Call{Call: _e.mock.On("TransferPartition", _a0, _a1)}
+}
+
+func (_c *MockProxy_TransferPartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest)) *MockProxy_TransferPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.TransferPartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_TransferPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_TransferPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_TransferPartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)) *MockProxy_TransferPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferReplica provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) TransferReplica(_a0 context.Context, _a1 *milvuspb.TransferReplicaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7030,6 +7687,53 @@ func (_c *MockProxy_Upsert_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// WatchChanges provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) WatchChanges(_a0 *cdcpb.WatchChangesRequest, _a1 cdcpb.ChangeDataCapture_WatchChangesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cdcpb.WatchChangesRequest, cdcpb.ChangeDataCapture_WatchChangesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_WatchChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchChanges'
+type MockProxy_WatchChanges_Call struct {
+	*mock.Call
+}
+
+// WatchChanges is a helper method to define mock.On call
+//   - _a0 *cdcpb.WatchChangesRequest
+//   - _a1 cdcpb.ChangeDataCapture_WatchChangesServer
+func (_e *MockProxy_Expecter) WatchChanges(_a0 interface{}, _a1 interface{}) *MockProxy_WatchChanges_Call {
+	return &MockProxy_WatchChanges_Call{Call: _e.mock.On("WatchChanges", _a0, _a1)}
+}
+
+func (_c *MockProxy_WatchChanges_Call) Run(run func(_a0 *cdcpb.WatchChangesRequest, _a1 cdcpb.ChangeDataCapture_WatchChangesServer)) *MockProxy_WatchChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*cdcpb.WatchChangesRequest), args[1].(cdcpb.ChangeDataCapture_WatchChangesServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_WatchChanges_Call) Return(_a0 error) *MockProxy_WatchChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_WatchChanges_Call) RunAndReturn(run func(*cdcpb.WatchChangesRequest, cdcpb.ChangeDataCapture_WatchChangesServer) error) *MockProxy_WatchChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProxy creates a new instance of MockProxy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProxy(t interface {
@@ -93,6 +93,65 @@ func (_c *MockQueryCoord_ActivateChecker_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// BeginReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) BeginReadSession(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReadSession")
+	}
+
+	var r0 *querypb.BeginReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest) *querypb.BeginReadSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BeginReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BeginReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_BeginReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReadSession'
+type MockQueryCoord_BeginReadSession_Call struct {
+	*mock.Call
+}
+
+// BeginReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.BeginReadSessionRequest
+func (_e *MockQueryCoord_Expecter) BeginReadSession(_a0 interface{}, _a1 interface{}) *MockQueryCoord_BeginReadSession_Call {
+	return &MockQueryCoord_BeginReadSession_Call{Call: _e.mock.On("BeginReadSession", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_BeginReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.BeginReadSessionRequest)) *MockQueryCoord_BeginReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.BeginReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_BeginReadSession_Call) Return(_a0 *querypb.BeginReadSessionResponse, _a1 error) *MockQueryCoord_BeginReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_BeginReadSession_Call) RunAndReturn(run func(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)) *MockQueryCoord_BeginReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) CheckBalanceStatus(_a0 context.Context, _a1 *querypb.CheckBalanceStatusRequest) (*querypb.CheckBalanceStatusResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -506,6 +565,65 @@ func (_c *MockQueryCoord_DropResourceGroup_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// EndReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) EndReadSession(_a0 context.Context, _a1 *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndReadSession")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.EndReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_EndReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndReadSession'
+type MockQueryCoord_EndReadSession_Call struct {
+	*mock.Call
+}
+
+// EndReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.EndReadSessionRequest
+func (_e *MockQueryCoord_Expecter) EndReadSession(_a0 interface{}, _a1 interface{}) *MockQueryCoord_EndReadSession_Call {
+	return &MockQueryCoord_EndReadSession_Call{Call: _e.mock.On("EndReadSession", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_EndReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.EndReadSessionRequest)) *MockQueryCoord_EndReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.EndReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_EndReadSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_EndReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_EndReadSession_Call) RunAndReturn(run func(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)) *MockQueryCoord_EndReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLoadSegmentInfo provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetLoadSegmentInfo(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -742,6 +860,65 @@ func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetReadSession provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetReadSession(_a0 context.Context, _a1 *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadSession")
+	}
+
+	var r0 *querypb.GetReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest) *querypb.GetReadSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReadSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadSession'
+type MockQueryCoord_GetReadSession_Call struct {
+	*mock.Call
+}
+
+// GetReadSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetReadSessionRequest
+func (_e *MockQueryCoord_Expecter) GetReadSession(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetReadSession_Call {
+	return &MockQueryCoord_GetReadSession_Call{Call: _e.mock.On("GetReadSession", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetReadSession_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetReadSessionRequest)) *MockQueryCoord_GetReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetReadSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReadSession_Call) Return(_a0 *querypb.GetReadSessionResponse, _a1 error) *MockQueryCoord_GetReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReadSession_Call) RunAndReturn(run func(context.Context, *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error)) *MockQueryCoord_GetReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReplicas provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetReplicas(_a0 context.Context, _a1 *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1481,6 +1658,65 @@ func (_c *MockQueryCoord_ReleasePartitions_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ReportCollectionAccess provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ReportCollectionAccess(_a0 context.Context, _a1 *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportCollectionAccess")
+	}
+
+	var r0 *querypb.ReportCollectionAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest) *querypb.ReportCollectionAccessResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ReportCollectionAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReportCollectionAccessRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ReportCollectionAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportCollectionAccess'
+type MockQueryCoord_ReportCollectionAccess_Call struct {
+	*mock.Call
+}
+
+// ReportCollectionAccess is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ReportCollectionAccessRequest
+func (_e *MockQueryCoord_Expecter) ReportCollectionAccess(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ReportCollectionAccess_Call {
+	return &MockQueryCoord_ReportCollectionAccess_Call{Call: _e.mock.On("ReportCollectionAccess", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ReportCollectionAccess_Call) Run(run func(_a0 context.Context, _a1 *querypb.ReportCollectionAccessRequest)) *MockQueryCoord_ReportCollectionAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ReportCollectionAccessRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ReportCollectionAccess_Call) Return(_a0 *querypb.ReportCollectionAccessResponse, _a1 error) *MockQueryCoord_ReportCollectionAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ReportCollectionAccess_Call) RunAndReturn(run func(context.Context, *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error)) *MockQueryCoord_ReportCollectionAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeBalance provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
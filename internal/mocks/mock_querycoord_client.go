@@ -105,6 +105,80 @@ func (_c *MockQueryCoordClient_ActivateChecker_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// BeginReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) BeginReadSession(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReadSession")
+	}
+
+	var r0 *querypb.BeginReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) *querypb.BeginReadSessionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BeginReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_BeginReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReadSession'
+type MockQueryCoordClient_BeginReadSession_Call struct {
+	*mock.Call
+}
+
+// BeginReadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.BeginReadSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) BeginReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_BeginReadSession_Call {
+	return &MockQueryCoordClient_BeginReadSession_Call{Call: _e.mock.On("BeginReadSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_BeginReadSession_Call) Run(run func(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_BeginReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.BeginReadSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_BeginReadSession_Call) Return(_a0 *querypb.BeginReadSessionResponse, _a1 error) *MockQueryCoordClient_BeginReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_BeginReadSession_Call) RunAndReturn(run func(context.Context, *querypb.BeginReadSessionRequest, ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error)) *MockQueryCoordClient_BeginReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) CheckBalanceStatus(ctx context.Context, in *querypb.CheckBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.CheckBalanceStatusResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -668,6 +742,80 @@ func (_c *MockQueryCoordClient_DropResourceGroup_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// EndReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) EndReadSession(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndReadSession")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_EndReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndReadSession'
+type MockQueryCoordClient_EndReadSession_Call struct {
+	*mock.Call
+}
+
+// EndReadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.EndReadSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) EndReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_EndReadSession_Call {
+	return &MockQueryCoordClient_EndReadSession_Call{Call: _e.mock.On("EndReadSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_EndReadSession_Call) Run(run func(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_EndReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.EndReadSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_EndReadSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_EndReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_EndReadSession_Call) RunAndReturn(run func(context.Context, *querypb.EndReadSessionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_EndReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLoadSegmentInfo provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetLoadSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -964,6 +1112,80 @@ func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetReadSession provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetReadSession(ctx context.Context, in *querypb.GetReadSessionRequest, opts ...grpc.CallOption) (*querypb.GetReadSessionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadSession")
+	}
+
+	var r0 *querypb.GetReadSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) (*querypb.GetReadSessionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) *querypb.GetReadSessionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReadSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetReadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadSession'
+type MockQueryCoordClient_GetReadSession_Call struct {
+	*mock.Call
+}
+
+// GetReadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetReadSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetReadSession(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetReadSession_Call {
+	return &MockQueryCoordClient_GetReadSession_Call{Call: _e.mock.On("GetReadSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetReadSession_Call) Run(run func(ctx context.Context, in *querypb.GetReadSessionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetReadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetReadSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReadSession_Call) Return(_a0 *querypb.GetReadSessionResponse, _a1 error) *MockQueryCoordClient_GetReadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReadSession_Call) RunAndReturn(run func(context.Context, *querypb.GetReadSessionRequest, ...grpc.CallOption) (*querypb.GetReadSessionResponse, error)) *MockQueryCoordClient_GetReadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReplicas provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1778,6 +2000,80 @@ func (_c *MockQueryCoordClient_ReleasePartitions_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ReportCollectionAccess provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ReportCollectionAccess(ctx context.Context, in *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportCollectionAccess")
+	}
+
+	var r0 *querypb.ReportCollectionAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) *querypb.ReportCollectionAccessResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ReportCollectionAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ReportCollectionAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportCollectionAccess'
+type MockQueryCoordClient_ReportCollectionAccess_Call struct {
+	*mock.Call
+}
+
+// ReportCollectionAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ReportCollectionAccessRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ReportCollectionAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReportCollectionAccess_Call {
+	return &MockQueryCoordClient_ReportCollectionAccess_Call{Call: _e.mock.On("ReportCollectionAccess",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ReportCollectionAccess_Call) Run(run func(ctx context.Context, in *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ReportCollectionAccessRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ReportCollectionAccess_Call) Return(_a0 *querypb.ReportCollectionAccessResponse, _a1 error) *MockQueryCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ReportCollectionAccess_Call) RunAndReturn(run func(context.Context, *querypb.ReportCollectionAccessRequest, ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error)) *MockQueryCoordClient_ReportCollectionAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeBalance provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -95,6 +95,65 @@ func (_c *MockRootCoord_AddCollectionField_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// AddCollectionFunction provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) AddCollectionFunction(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCollectionFunction")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_AddCollectionFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionFunction'
+type MockRootCoord_AddCollectionFunction_Call struct {
+	*mock.Call
+}
+
+// AddCollectionFunction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.AddCollectionFunctionRequest
+func (_e *MockRootCoord_Expecter) AddCollectionFunction(_a0 interface{}, _a1 interface{}) *MockRootCoord_AddCollectionFunction_Call {
+	return &MockRootCoord_AddCollectionFunction_Call{Call: _e.mock.On("AddCollectionFunction", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_AddCollectionFunction_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFunctionRequest)) *MockRootCoord_AddCollectionFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.AddCollectionFunctionRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_AddCollectionFunction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_AddCollectionFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_AddCollectionFunction_Call) RunAndReturn(run func(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)) *MockRootCoord_AddCollectionFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -921,6 +980,65 @@ func (_c *MockRootCoord_CreatePrivilegeGroup_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateProjection provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) CreateProjection(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_CreateProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProjection'
+type MockRootCoord_CreateProjection_Call struct {
+	*mock.Call
+}
+
+// CreateProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateProjectionRequest
+func (_e *MockRootCoord_Expecter) CreateProjection(_a0 interface{}, _a1 interface{}) *MockRootCoord_CreateProjection_Call {
+	return &MockRootCoord_CreateProjection_Call{Call: _e.mock.On("CreateProjection", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_CreateProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateProjectionRequest)) *MockRootCoord_CreateProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_CreateProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_CreateProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_CreateProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)) *MockRootCoord_CreateProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRole provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) CreateRole(_a0 context.Context, _a1 *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1570,6 +1688,65 @@ func (_c *MockRootCoord_DropPrivilegeGroup_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) DropProjection(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropProjectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropProjectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type MockRootCoord_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.DropProjectionRequest
+func (_e *MockRootCoord_Expecter) DropProjection(_a0 interface{}, _a1 interface{}) *MockRootCoord_DropProjection_Call {
+	return &MockRootCoord_DropProjection_Call{Call: _e.mock.On("DropProjection", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_DropProjection_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.DropProjectionRequest)) *MockRootCoord_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropProjectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_DropProjection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_DropProjection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_DropProjection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)) *MockRootCoord_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropRole provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) DropRole(_a0 context.Context, _a1 *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2559,6 +2736,65 @@ func (_c *MockRootCoord_ListPrivilegeGroups_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) ListProjections(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 *rootcoordpb.ListProjectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListProjectionsRequest) *rootcoordpb.ListProjectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListProjectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListProjectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type MockRootCoord_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListProjectionsRequest
+func (_e *MockRootCoord_Expecter) ListProjections(_a0 interface{}, _a1 interface{}) *MockRootCoord_ListProjections_Call {
+	return &MockRootCoord_ListProjections_Call{Call: _e.mock.On("ListProjections", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_ListProjections_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListProjectionsRequest)) *MockRootCoord_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListProjectionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_ListProjections_Call) Return(_a0 *rootcoordpb.ListProjectionsResponse, _a1 error) *MockRootCoord_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_ListProjections_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)) *MockRootCoord_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2840,6 +3076,124 @@ func (_c *MockRootCoord_RenameCollection_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RenameField provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) RenameField(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameField")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenameFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_RenameField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameField'
+type MockRootCoord_RenameField_Call struct {
+	*mock.Call
+}
+
+// RenameField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenameFieldRequest
+func (_e *MockRootCoord_Expecter) RenameField(_a0 interface{}, _a1 interface{}) *MockRootCoord_RenameField_Call {
+	return &MockRootCoord_RenameField_Call{Call: _e.mock.On("RenameField", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_RenameField_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenameFieldRequest)) *MockRootCoord_RenameField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenameFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_RenameField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_RenameField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_RenameField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)) *MockRootCoord_RenameField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenamePartition provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) RenamePartition(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenamePartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenamePartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenamePartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_RenamePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenamePartition'
+type MockRootCoord_RenamePartition_Call struct {
+	*mock.Call
+}
+
+// RenamePartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.RenamePartitionRequest
+func (_e *MockRootCoord_Expecter) RenamePartition(_a0 interface{}, _a1 interface{}) *MockRootCoord_RenamePartition_Call {
+	return &MockRootCoord_RenamePartition_Call{Call: _e.mock.On("RenamePartition", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_RenamePartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.RenamePartitionRequest)) *MockRootCoord_RenamePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenamePartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_RenamePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_RenamePartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_RenamePartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)) *MockRootCoord_RenamePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreRBAC provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) RestoreRBAC(_a0 context.Context, _a1 *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3698,6 +4052,65 @@ func (_c *MockRootCoord_Stop_Call) RunAndReturn(run func() error) *MockRootCoord
 	return _c
 }
 
+// TransferPartition provides a mock function with given fields: _a0, _a1
+func (_m *MockRootCoord) TransferPartition(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPartition")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TransferPartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.TransferPartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoord_TransferPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPartition'
+type MockRootCoord_TransferPartition_Call struct {
+	*mock.Call
+}
+
+// TransferPartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.TransferPartitionRequest
+func (_e *MockRootCoord_Expecter) TransferPartition(_a0 interface{}, _a1 interface{}) *MockRootCoord_TransferPartition_Call {
+	return &MockRootCoord_TransferPartition_Call{Call: _e.mock.On("TransferPartition", _a0, _a1)}
+}
+
+func (_c *MockRootCoord_TransferPartition_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.TransferPartitionRequest)) *MockRootCoord_TransferPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.TransferPartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MockRootCoord_TransferPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoord_TransferPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoord_TransferPartition_Call) RunAndReturn(run func(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)) *MockRootCoord_TransferPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: _a0, _a1
 func (_m *MockRootCoord) UpdateChannelTimeTick(_a0 context.Context, _a1 *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -90,6 +90,19 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) interface{} {
 	return ast.Accept(visitor)
 }
 
+// ParseSyntaxTree parses the expression into the syntax tree without binding it to any schema,
+// it's used by the components evaluating the expressions by themselves, such as the expr reranker.
+func ParseSyntaxTree(exprStr string) (planparserv2.IExprContext, error) {
+	if isEmptyExpression(exprStr) {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	ast, err := handleInternal(exprStr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse expression: %s, error: %s", exprStr, err)
+	}
+	return ast, nil
+}
+
 func ParseExpr(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues map[string]*schemapb.TemplateValue) (*planpb.Expr, error) {
 	ret := handleExpr(schema, exprStr)
 
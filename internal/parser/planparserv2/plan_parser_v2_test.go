@@ -14,6 +14,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	planparserv2 "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -157,6 +158,18 @@ func TestExpr_Call(t *testing.T) {
 	assert.Equal(t, int64(20), expr.GetCallExpr().GetFunctionParameters()[2].GetCallExpr().GetFunctionParameters()[0].GetValueExpr().GetValue().GetInt64Val())
 }
 
+func TestParseSyntaxTree(t *testing.T) {
+	ast, err := ParseSyntaxTree("score * if(in_stock, 1, 0.5)")
+	assert.NoError(t, err)
+	_, ok := ast.(*planparserv2.MulDivModContext)
+	assert.True(t, ok)
+
+	_, err = ParseSyntaxTree("")
+	assert.Error(t, err)
+	_, err = ParseSyntaxTree("score * (1")
+	assert.Error(t, err)
+}
+
 func TestExpr_Compare(t *testing.T) {
 	schema := newTestSchema(true)
 	helper, err := typeutil.CreateSchemaHelper(schema)
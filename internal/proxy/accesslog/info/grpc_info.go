@@ -345,3 +345,7 @@ func (i *GrpcAccessInfo) QueryParams() string {
 	}
 	return Unknown
 }
+
+func (i *GrpcAccessInfo) ResourceUsage() string {
+	return resourceUsageToString(i.respStatus())
+}
@@ -232,6 +232,25 @@ func (s *GrpcAccessInfoSuite) TestClusterPrefix() {
 	s.Equal(cluster, result[0])
 }
 
+func (s *GrpcAccessInfoSuite) TestResourceUsage() {
+	result := Get(s.info, "$resource_usage")
+	s.Equal(Unknown, result[0])
+
+	s.info.resp = &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ExtraInfo: map[string]string{
+				"segments_scanned":      "2",
+				"rows_scanned":          "1000",
+				"storage_bytes":         "4096",
+				"querynode_cpu_time_us": "300",
+				"reduce_time_us":        "20",
+			},
+		},
+	}
+	result = Get(s.info, "$resource_usage")
+	s.Equal("{segments_scanned:2,rows_scanned:1000,storage_bytes:4096,querynode_cpu_time_us:300,reduce_time_us:20,}", result[0])
+}
+
 func TestGrpcAccssInfo(t *testing.T) {
 	suite.Run(t, new(GrpcAccessInfoSuite))
 }
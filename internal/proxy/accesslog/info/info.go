@@ -51,6 +51,7 @@ var MetricFuncMap = map[string]getMetricFunc{
 	"$nq":                getNq,
 	"$search_params":     getSearchParams,
 	"$query_params":      getQueryParams,
+	"$resource_usage":    getResourceUsage,
 }
 
 type AccessInfo interface {
@@ -78,6 +79,7 @@ type AccessInfo interface {
 	NQ() string
 	SearchParams() string
 	QueryParams() string
+	ResourceUsage() string
 }
 
 func Get(i AccessInfo, keys ...string) []any {
@@ -192,3 +194,7 @@ func getSearchParams(i AccessInfo) string {
 func getQueryParams(i AccessInfo) string {
 	return i.QueryParams()
 }
+
+func getResourceUsage(i AccessInfo) string {
+	return i.ResourceUsage()
+}
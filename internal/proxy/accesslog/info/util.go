@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
@@ -119,3 +120,16 @@ func kvsToString(kvs []*commonpb.KeyValuePair) string {
 	}
 	return str + "}"
 }
+
+// resourceUsageToString formats the resource usage summary in the status extra info,
+// returns Unknown if the response doesn't carry it.
+func resourceUsageToString(status *commonpb.Status) string {
+	if _, ok := status.GetExtraInfo()[common.ResourceUsageSegmentsScannedKey]; !ok {
+		return Unknown
+	}
+	str := "{"
+	for _, key := range common.ResourceUsageKeys {
+		str += fmt.Sprintf("%s:%s,", key, status.GetExtraInfo()[key])
+	}
+	return str + "}"
+}
@@ -162,28 +162,56 @@ type RotateWriter struct {
 	closeOnce sync.Once
 }
 
+// RotateWriterConfig is the config of a RotateWriter.
+type RotateWriterConfig struct {
+	LocalPath   string
+	FileName    string
+	RotatedTime int64
+	MaxSize     int
+	MaxBackups  int
+
+	MinioEnable   bool
+	RemotePath    string
+	RemoteMaxTime int
+}
+
 func NewRotateWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (*RotateWriter, error) {
+	return NewRotateWriterWithConfig(&RotateWriterConfig{
+		LocalPath:     logCfg.LocalPath.GetValue(),
+		FileName:      logCfg.Filename.GetValue(),
+		RotatedTime:   logCfg.RotatedTime.GetAsInt64(),
+		MaxSize:       logCfg.MaxSize.GetAsInt(),
+		MaxBackups:    logCfg.MaxBackups.GetAsInt(),
+		MinioEnable:   logCfg.MinioEnable.GetAsBool(),
+		RemotePath:    logCfg.RemotePath.GetValue(),
+		RemoteMaxTime: logCfg.RemoteMaxTime.GetAsInt(),
+	}, minioCfg)
+}
+
+// NewRotateWriterWithConfig creates a RotateWriter with the config,
+// the sealed files are uploaded to minio with minioCfg if cfg.MinioEnable is set.
+func NewRotateWriterWithConfig(cfg *RotateWriterConfig, minioCfg *paramtable.MinioConfig) (*RotateWriter, error) {
 	logger := &RotateWriter{
-		localPath:   logCfg.LocalPath.GetValue(),
-		fileName:    logCfg.Filename.GetValue(),
-		rotatedTime: logCfg.RotatedTime.GetAsInt64(),
-		maxSize:     logCfg.MaxSize.GetAsInt(),
-		maxBackups:  logCfg.MaxBackups.GetAsInt(),
+		localPath:   cfg.LocalPath,
+		fileName:    cfg.FileName,
+		rotatedTime: cfg.RotatedTime,
+		maxSize:     cfg.MaxSize,
+		maxBackups:  cfg.MaxBackups,
 		closeCh:     make(chan struct{}),
 	}
-	log.Info("Access log save to " + logger.dir())
-	if logCfg.MinioEnable.GetAsBool() {
+	log.Info("Log save to "+logger.dir(), zap.String("filename", cfg.FileName))
+	if cfg.MinioEnable {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		log.Info("Access log will backup files to minio", zap.String("remote", logCfg.RemotePath.GetValue()), zap.String("maxBackups", logCfg.MaxBackups.GetValue()))
-		handler, err := NewMinioHandler(ctx, minioCfg, logCfg.RemotePath.GetValue(), logCfg.MaxBackups.GetAsInt())
+		log.Info("Log will backup files to minio", zap.String("remote", cfg.RemotePath), zap.Int("maxBackups", cfg.MaxBackups))
+		handler, err := NewMinioHandler(ctx, minioCfg, cfg.RemotePath, cfg.MaxBackups)
 		if err != nil {
 			return nil, err
 		}
 		prefix, ext := logger.prefixAndExt()
-		if logCfg.RemoteMaxTime.GetAsInt() > 0 {
-			handler.retentionPolicy = getTimeRetentionFunc(logCfg.RemoteMaxTime.GetAsInt(), prefix, ext)
+		if cfg.RemoteMaxTime > 0 {
+			handler.retentionPolicy = getTimeRetentionFunc(cfg.RemoteMaxTime, prefix, ext)
 		}
 
 		logger.handler = handler
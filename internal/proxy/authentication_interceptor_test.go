@@ -7,6 +7,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus/internal/util/hookutil"
@@ -140,3 +141,26 @@ func TestAuthenticationInterceptor(t *testing.T) {
 	}
 	hookutil.SetTestHook(hookutil.DefaultHook{})
 }
+
+type authTestKey struct{}
+
+func TestGrpcAuthStreamInterceptor(t *testing.T) {
+	interceptor := GrpcAuthStreamInterceptor(func(ctx context.Context) (context.Context, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get("token")) == 0 {
+			return nil, errors.New("mock")
+		}
+		return context.WithValue(ctx, authTestKey{}, true), nil
+	})
+	info := &grpc.StreamServerInfo{FullMethod: "/milvus.proto.cdc.ChangeDataCapture/WatchChanges"}
+	handler := func(srv any, stream grpc.ServerStream) error {
+		assert.Equal(t, true, stream.Context().Value(authTestKey{}))
+		return nil
+	}
+
+	stream := &changeTestStream{ctx: context.Background()}
+	assert.Error(t, interceptor(nil, stream, info, handler))
+
+	stream = &changeTestStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", "123"))}
+	assert.NoError(t, interceptor(nil, stream, info, handler))
+}
@@ -0,0 +1,282 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// changeMessageTypes are the wal message types delivered to the change subscriptions.
+var changeMessageTypes = []message.MessageType{
+	message.MessageTypeInsert,
+	message.MessageTypeDelete,
+	message.MessageTypeTxn,
+	message.MessageTypeCreateCollection,
+	message.MessageTypeDropCollection,
+	message.MessageTypeCreatePartition,
+	message.MessageTypeDropPartition,
+	message.MessageTypeSchemaChange,
+}
+
+// changeWatcher decodes the wal messages of a collection into the change events.
+// Each vchannel of the collection is read by one goroutine, the responses of all the vchannels are sent by the caller
+// through a bounded buffer, so the wal is not read faster than the consumer receives the changes.
+type changeWatcher struct {
+	collectionID int64
+	vchannels    []string
+	checkpoints  map[string]*cdcpb.ChangeCheckpoint
+}
+
+func newChangeWatcher(collectionID int64, vchannels []string, checkpoints []*cdcpb.ChangeCheckpoint) (*changeWatcher, error) {
+	w := &changeWatcher{
+		collectionID: collectionID,
+		vchannels:    vchannels,
+		checkpoints:  make(map[string]*cdcpb.ChangeCheckpoint, len(checkpoints)),
+	}
+	for _, checkpoint := range checkpoints {
+		if !lo.Contains(vchannels, checkpoint.GetVchannel()) {
+			return nil, merr.WrapErrParameterInvalidMsg("vchannel %s of the checkpoint doesn't belong to the collection", checkpoint.GetVchannel())
+		}
+		w.checkpoints[checkpoint.GetVchannel()] = checkpoint
+	}
+	return w, nil
+}
+
+// Watch sends the changes of the collection until the collection is dropped, the context is done or any error occurs.
+func (w *changeWatcher) Watch(ctx context.Context, send func(*cdcpb.WatchChangesResponse) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	respCh := make(chan *cdcpb.WatchChangesResponse, paramtable.Get().ProxyCfg.WatchChangesBufferSize.GetAsInt())
+	g, gctx := errgroup.WithContext(ctx)
+	for _, vchannel := range w.vchannels {
+		vchannel := vchannel
+		g.Go(func() error {
+			return w.watchVChannel(gctx, vchannel, respCh)
+		})
+	}
+	go func() {
+		g.Wait()
+		close(respCh)
+	}()
+
+	for resp := range respCh {
+		if err := send(resp); err != nil {
+			cancel()
+			for range respCh {
+			}
+			return err
+		}
+	}
+	return g.Wait()
+}
+
+func (w *changeWatcher) watchVChannel(ctx context.Context, vchannel string, respCh chan<- *cdcpb.WatchChangesResponse) error {
+	logger := log.Ctx(ctx).With(zap.Int64("collectionID", w.collectionID), zap.String("vchannel", vchannel))
+	deliverPolicy := options.DeliverPolicyLatest()
+	deliverFilters := []options.DeliverFilter{options.DeliverFilterMessageType(changeMessageTypes...)}
+	if checkpoint, ok := w.checkpoints[vchannel]; ok {
+		startFrom, err := message.UnmarshalMessageID(streaming.WAL().WALName(), checkpoint.GetMessageId())
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("invalid checkpoint of vchannel %s: %s", vchannel, err.Error())
+		}
+		deliverPolicy = options.DeliverPolicyStartFrom(startFrom)
+		deliverFilters = append(deliverFilters, options.DeliverFilterTimeTickGT(checkpoint.GetTimeTick()))
+	}
+
+	// the scanner is blocked until the message is taken, so the handler is not buffered.
+	handler := make(adaptor.ChanMessageHandler)
+	scanner := streaming.WAL().Read(ctx, streaming.ReadOption{
+		VChannel:       vchannel,
+		DeliverPolicy:  deliverPolicy,
+		DeliverFilters: deliverFilters,
+		MessageHandler: handler,
+	})
+	defer scanner.Close()
+	logger.Info("start to watch changes of vchannel")
+
+	for {
+		var msg message.ImmutableMessage
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok = <-handler:
+			if !ok {
+				if err := scanner.Error(); err != nil {
+					return err
+				}
+				return merr.WrapErrServiceInternal("wal scanner is closed unexpectedly")
+			}
+		}
+		if msg.VChannel() != "" && msg.VChannel() != vchannel {
+			continue
+		}
+		events, err := decodeChangeEvents(msg)
+		if err != nil {
+			return err
+		}
+		if len(events) > 0 {
+			resp := &cdcpb.WatchChangesResponse{
+				Vchannel: vchannel,
+				Events:   events,
+				Checkpoint: &cdcpb.ChangeCheckpoint{
+					Vchannel:  vchannel,
+					MessageId: msg.LastConfirmedMessageID().Marshal(),
+					TimeTick:  msg.TimeTick(),
+				},
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case respCh <- resp:
+			}
+		}
+		if msg.MessageType() == message.MessageTypeDropCollection {
+			logger.Info("collection is dropped, stop watching changes of vchannel")
+			return nil
+		}
+	}
+}
+
+// decodeChangeEvents decodes the wal message into the change events, the messages of a txn are decoded in order.
+func decodeChangeEvents(msg message.ImmutableMessage) ([]*cdcpb.ChangeEvent, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeTxn:
+		events := make([]*cdcpb.ChangeEvent, 0)
+		err := message.AsImmutableTxnMessage(msg).RangeOver(func(m message.ImmutableMessage) error {
+			decoded, err := decodeChangeEvents(m)
+			events = append(events, decoded...)
+			return err
+		})
+		return events, err
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := insertMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:          cdcpb.ChangeType_ChangeInsert,
+			Timestamp:     msg.TimeTick(),
+			PartitionName: body.GetPartitionName(),
+			FieldsData:    body.GetFieldsData(),
+			NumRows:       body.GetNumRows(),
+		}}, nil
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := deleteMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:          cdcpb.ChangeType_ChangeDelete,
+			Timestamp:     msg.TimeTick(),
+			PartitionName: body.GetPartitionName(),
+			PrimaryKeys:   body.GetPrimaryKeys(),
+			NumRows:       uint64(body.GetNumRows()),
+		}}, nil
+	case message.MessageTypeCreateCollection:
+		createMsg, err := message.AsImmutableCreateCollectionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := createMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(body.GetSchema(), schema); err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:      cdcpb.ChangeType_ChangeCreateCollection,
+			Timestamp: msg.TimeTick(),
+			Schema:    schema,
+		}}, nil
+	case message.MessageTypeDropCollection:
+		return []*cdcpb.ChangeEvent{{
+			Type:      cdcpb.ChangeType_ChangeDropCollection,
+			Timestamp: msg.TimeTick(),
+		}}, nil
+	case message.MessageTypeCreatePartition:
+		createMsg, err := message.AsImmutableCreatePartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := createMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:          cdcpb.ChangeType_ChangeCreatePartition,
+			Timestamp:     msg.TimeTick(),
+			PartitionName: body.GetPartitionName(),
+		}}, nil
+	case message.MessageTypeDropPartition:
+		dropMsg, err := message.AsImmutableDropPartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := dropMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:          cdcpb.ChangeType_ChangeDropPartition,
+			Timestamp:     msg.TimeTick(),
+			PartitionName: body.GetPartitionName(),
+		}}, nil
+	case message.MessageTypeSchemaChange:
+		schemaMsg, err := message.AsImmutableCollectionSchemaChangeV2(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := schemaMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return []*cdcpb.ChangeEvent{{
+			Type:      cdcpb.ChangeType_ChangeSchema,
+			Timestamp: msg.TimeTick(),
+			Schema:    body.GetSchema(),
+		}}, nil
+	default:
+		return nil, nil
+	}
+}
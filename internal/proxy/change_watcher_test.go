@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func newChangeTestInsert(vchannel string, timeTick uint64) message.ImmutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{
+			CollectionID:  1,
+			PartitionName: "_default",
+			NumRows:       1,
+			FieldsData: []*schemapb.FieldData{
+				{FieldId: 100, FieldName: "pk", Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1}}},
+				}}},
+			},
+		}).
+		MustBuildMutable().
+		WithTimeTick(timeTick).
+		WithLastConfirmed(walimplstest.NewTestMessageID(1)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(2))
+}
+
+func newChangeTestDropCollection(vchannel string, timeTick uint64) message.ImmutableMessage {
+	return message.NewDropCollectionMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.DropCollectionMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.DropCollectionRequest{CollectionID: 1}).
+		MustBuildMutable().
+		WithTimeTick(timeTick).
+		WithLastConfirmed(walimplstest.NewTestMessageID(3)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(4))
+}
+
+type changeTestScanner struct {
+	done chan struct{}
+}
+
+func (s *changeTestScanner) Done() <-chan struct{} { return s.done }
+
+func (s *changeTestScanner) Error() error { return nil }
+
+func (s *changeTestScanner) Close() {}
+
+type changeTestStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*cdcpb.WatchChangesResponse
+}
+
+func (s *changeTestStream) Context() context.Context { return s.ctx }
+
+func (s *changeTestStream) Send(resp *cdcpb.WatchChangesResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestDecodeChangeEvents(t *testing.T) {
+	t.Run("insert", func(t *testing.T) {
+		events, err := decodeChangeEvents(newChangeTestInsert("v0", 100))
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, cdcpb.ChangeType_ChangeInsert, events[0].GetType())
+		assert.Equal(t, uint64(100), events[0].GetTimestamp())
+		assert.Equal(t, "_default", events[0].GetPartitionName())
+		assert.Equal(t, uint64(1), events[0].GetNumRows())
+		assert.Equal(t, "pk", events[0].GetFieldsData()[0].GetFieldName())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		msg := message.NewDeleteMessageBuilderV1().
+			WithVChannel("v0").
+			WithHeader(&message.DeleteMessageHeader{CollectionId: 1, Rows: 2}).
+			WithBody(&msgpb.DeleteRequest{
+				CollectionID: 1,
+				NumRows:      2,
+				PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+			}).
+			MustBuildMutable().
+			WithTimeTick(100).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+		events, err := decodeChangeEvents(msg)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, cdcpb.ChangeType_ChangeDelete, events[0].GetType())
+		assert.Equal(t, []int64{1, 2}, events[0].GetPrimaryKeys().GetIntId().GetData())
+	})
+
+	t.Run("create collection", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{Name: "coll"}
+		schemaBytes, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		msg := message.NewCreateCollectionMessageBuilderV1().
+			WithVChannel("v0").
+			WithHeader(&message.CreateCollectionMessageHeader{CollectionId: 1}).
+			WithBody(&msgpb.CreateCollectionRequest{CollectionID: 1, Schema: schemaBytes}).
+			MustBuildMutable().
+			WithTimeTick(100).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+		events, err := decodeChangeEvents(msg)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, cdcpb.ChangeType_ChangeCreateCollection, events[0].GetType())
+		assert.Equal(t, "coll", events[0].GetSchema().GetName())
+	})
+
+	t.Run("drop partition", func(t *testing.T) {
+		msg := message.NewDropPartitionMessageBuilderV1().
+			WithVChannel("v0").
+			WithHeader(&message.DropPartitionMessageHeader{CollectionId: 1, PartitionId: 2}).
+			WithBody(&msgpb.DropPartitionRequest{CollectionID: 1, PartitionName: "p1"}).
+			MustBuildMutable().
+			WithTimeTick(100).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+		events, err := decodeChangeEvents(msg)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, cdcpb.ChangeType_ChangeDropPartition, events[0].GetType())
+		assert.Equal(t, "p1", events[0].GetPartitionName())
+	})
+
+	t.Run("drop collection", func(t *testing.T) {
+		events, err := decodeChangeEvents(newChangeTestDropCollection("v0", 100))
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, cdcpb.ChangeType_ChangeDropCollection, events[0].GetType())
+	})
+}
+
+func TestChangeWatcher(t *testing.T) {
+	t.Run("invalid checkpoint", func(t *testing.T) {
+		_, err := newChangeWatcher(1, []string{"v0"}, []*cdcpb.ChangeCheckpoint{{Vchannel: "v1"}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("watch until dropped", func(t *testing.T) {
+		wal := mock_streaming.NewMockWALAccesser(t)
+		wal.EXPECT().WALName().Return(walimplstest.WALName).Maybe()
+		wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, option streaming.ReadOption) streaming.Scanner {
+			handler := option.MessageHandler.(adaptor.ChanMessageHandler)
+			go func() {
+				handler <- newChangeTestInsert(option.VChannel, 100)
+				handler <- newChangeTestDropCollection(option.VChannel, 200)
+			}()
+			return &changeTestScanner{done: make(chan struct{})}
+		})
+		streaming.SetWALForTest(wal)
+
+		w, err := newChangeWatcher(1, []string{"v0", "v1"}, []*cdcpb.ChangeCheckpoint{
+			{Vchannel: "v0", MessageId: walimplstest.NewTestMessageID(1).Marshal(), TimeTick: 50},
+		})
+		assert.NoError(t, err)
+		responses := make([]*cdcpb.WatchChangesResponse, 0)
+		err = w.Watch(context.Background(), func(resp *cdcpb.WatchChangesResponse) error {
+			responses = append(responses, resp)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, responses, 4)
+		for _, resp := range responses {
+			assert.Equal(t, resp.GetVchannel(), resp.GetCheckpoint().GetVchannel())
+			if resp.GetEvents()[0].GetType() == cdcpb.ChangeType_ChangeInsert {
+				// resuming from the checkpoint skips the delivered insert by the time tick.
+				assert.Equal(t, uint64(100), resp.GetCheckpoint().GetTimeTick())
+				assert.Equal(t, walimplstest.NewTestMessageID(1).Marshal(), resp.GetCheckpoint().GetMessageId())
+			}
+		}
+	})
+
+	t.Run("send failed", func(t *testing.T) {
+		wal := mock_streaming.NewMockWALAccesser(t)
+		wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, option streaming.ReadOption) streaming.Scanner {
+			handler := option.MessageHandler.(adaptor.ChanMessageHandler)
+			go func() {
+				for i := uint64(1); ; i++ {
+					select {
+					case <-ctx.Done():
+						return
+					case handler <- newChangeTestInsert(option.VChannel, i):
+					}
+				}
+			}()
+			return &changeTestScanner{done: make(chan struct{})}
+		})
+		streaming.SetWALForTest(wal)
+
+		w, err := newChangeWatcher(1, []string{"v0"}, nil)
+		assert.NoError(t, err)
+		err = w.Watch(context.Background(), func(resp *cdcpb.WatchChangesResponse) error {
+			return errors.New("mock")
+		})
+		assert.Error(t, err)
+	})
+}
@@ -166,7 +166,10 @@ func (ticker *channelsTimeTickerImpl) tickLoop() {
 			err := ticker.tick()
 			if err != nil {
 				log.Warn("channelsTimeTickerImpl.tickLoop", zap.Error(err))
+				continue
 			}
+			// the cached read results expire as the time tick goes, so the writes through other proxies become visible.
+			globalResultCache.Tick(ticker.getMinTick())
 		}
 	}
 }
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// collectionAccessReporter collects the collections searched or queried through the proxy,
+// and reports them to the query coord periodically, so the collections with the collection.idleRelease.minutes
+// property stay loaded while they're accessed.
+type collectionAccessReporter struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	mixCoord types.MixCoordClient
+	accessed typeutil.UniqueSet
+}
+
+func newCollectionAccessReporter() *collectionAccessReporter {
+	return &collectionAccessReporter{
+		accessed: typeutil.NewUniqueSet(),
+	}
+}
+
+// Start starts to report the accessed collections periodically.
+func (r *collectionAccessReporter) Start(ctx context.Context, mixCoord types.MixCoordClient) {
+	r.mu.Lock()
+	r.mixCoord = mixCoord
+	r.mu.Unlock()
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(paramtable.Get().ProxyCfg.CollectionAccessReportInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.report(ctx)
+			}
+		}
+	}()
+}
+
+// Record records the collection is accessed.
+func (r *collectionAccessReporter) Record(collectionID int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accessed.Insert(collectionID)
+}
+
+func (r *collectionAccessReporter) report(ctx context.Context) {
+	r.mu.Lock()
+	accessed := r.accessed
+	r.accessed = typeutil.NewUniqueSet()
+	mixCoord := r.mixCoord
+	r.mu.Unlock()
+	if accessed.Len() == 0 {
+		return
+	}
+
+	_, err := r.reportAccess(ctx, mixCoord, accessed.Collect())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to report collection access", zap.Error(err))
+		// report them next time.
+		r.mu.Lock()
+		r.accessed.Insert(accessed.Collect()...)
+		r.mu.Unlock()
+	}
+}
+
+func (r *collectionAccessReporter) reportAccess(ctx context.Context, mixCoord types.MixCoordClient, collectionIDs []int64) ([]int64, error) {
+	resp, err := mixCoord.ReportCollectionAccess(ctx, &querypb.ReportCollectionAccessRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_Undefined),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionIDs: collectionIDs,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	return resp.GetReloadingCollectionIDs(), nil
+}
+
+// Reload reports the access of the collection at once,
+// and returns whether the collection released for idle is reloading.
+func (r *collectionAccessReporter) Reload(ctx context.Context, collectionID int64) (bool, error) {
+	r.mu.Lock()
+	mixCoord := r.mixCoord
+	r.mu.Unlock()
+	if mixCoord == nil {
+		return false, nil
+	}
+	reloading, err := r.reportAccess(ctx, mixCoord, []int64{collectionID})
+	if err != nil {
+		return false, err
+	}
+	return lo.Contains(reloading, collectionID), nil
+}
+
+func (r *collectionAccessReporter) Close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// waitIdleCollectionReload reloads the collection if it's released for idle, and waits for it
+// to be loaded within the proxy.idleCollection.reloadTimeout.
+// If the timeout is 0, a retriable error is returned at once while the collection is reloading.
+func (lb *LBPolicyImpl) waitIdleCollectionReload(ctx context.Context, dbName string, collName string, collectionID int64, notLoadedErr error) (map[string][]nodeInfo, error) {
+	if lb.collectionAccess == nil {
+		return nil, notLoadedErr
+	}
+	log := log.Ctx(ctx).With(zap.String("collectionName", collName), zap.Int64("collectionID", collectionID))
+	reloading, err := lb.collectionAccess.Reload(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to reload idle released collection", zap.Error(err))
+		return nil, notLoadedErr
+	}
+	if !reloading {
+		return nil, notLoadedErr
+	}
+
+	reloadingErr := merr.WrapErrCollectionNotFullyLoaded(collName, "collection is reloading after released for idle")
+	timeout := paramtable.Get().ProxyCfg.IdleCollectionReloadTimeout.GetAsDuration(time.Second)
+	if timeout <= 0 {
+		return nil, reloadingErr
+	}
+
+	log.Info("wait for idle released collection to be reloaded", zap.Duration("timeout", timeout))
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var shardLeaders map[string][]nodeInfo
+	err = retry.Handle(ctx, func() (bool, error) {
+		var err error
+		shardLeaders, err = globalMetaCache.GetShards(ctx, false, dbName, collName, collectionID)
+		return err != nil, err
+	}, retry.Attempts(math.MaxInt32), retry.MaxSleepTime(time.Second))
+	if err != nil {
+		log.Warn("idle released collection is not reloaded in time", zap.Error(err))
+		return nil, reloadingErr
+	}
+	return shardLeaders, nil
+}
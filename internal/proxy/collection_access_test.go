@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestCollectionAccessReporter(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	mixCoord := mocks.NewMockMixCoordClient(t)
+	r := newCollectionAccessReporter()
+
+	// not started yet
+	reloading, err := r.Reload(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, reloading)
+
+	r.Start(ctx, mixCoord)
+	defer r.Close()
+
+	t.Run("report", func(t *testing.T) {
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		r.Record(1)
+		r.Record(2)
+		r.report(ctx)
+		// the failed accesses are reported next time
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *querypb.ReportCollectionAccessRequest, _ ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+				assert.ElementsMatch(t, []int64{1, 2, 3}, req.GetCollectionIDs())
+				return &querypb.ReportCollectionAccessResponse{Status: merr.Success()}, nil
+			}).Once()
+		r.Record(3)
+		r.report(ctx)
+		// nothing to report
+		r.report(ctx)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(&querypb.ReportCollectionAccessResponse{
+			Status:                 merr.Success(),
+			ReloadingCollectionIDs: []int64{1},
+		}, nil).Once()
+		reloading, err := r.Reload(ctx, 1)
+		assert.NoError(t, err)
+		assert.True(t, reloading)
+
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(&querypb.ReportCollectionAccessResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil).Once()
+		_, err = r.Reload(ctx, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestWaitIdleCollectionReload(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	notLoadedErr := merr.WrapErrCollectionNotLoaded("coll")
+
+	lb := NewLBPolicyImpl(NewMockShardClientManager(t))
+	_, err := lb.waitIdleCollectionReload(ctx, "db", "coll", 1, notLoadedErr)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotLoaded)
+
+	mixCoord := mocks.NewMockMixCoordClient(t)
+	lb.collectionAccess = newCollectionAccessReporter()
+	lb.collectionAccess.mixCoord = mixCoord
+
+	t.Run("not reloading", func(t *testing.T) {
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(&querypb.ReportCollectionAccessResponse{
+			Status: merr.Success(),
+		}, nil).Once()
+		_, err := lb.waitIdleCollectionReload(ctx, "db", "coll", 1, notLoadedErr)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotLoaded)
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().ProxyCfg.IdleCollectionReloadTimeout.Key, "0")
+		defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.IdleCollectionReloadTimeout.Key)
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(&querypb.ReportCollectionAccessResponse{
+			Status:                 merr.Success(),
+			ReloadingCollectionIDs: []int64{1},
+		}, nil).Once()
+		_, err := lb.waitIdleCollectionReload(ctx, "db", "coll", 1, notLoadedErr)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFullyLoaded)
+		assert.True(t, merr.IsRetryableErr(err))
+	})
+
+	t.Run("wait reloaded", func(t *testing.T) {
+		cache := NewMockCache(t)
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+		cache.EXPECT().GetShards(mock.Anything, false, "db", "coll", int64(1)).Return(nil, notLoadedErr).Once()
+		cache.EXPECT().GetShards(mock.Anything, false, "db", "coll", int64(1)).Return(map[string][]nodeInfo{
+			"channel": {{nodeID: 1}},
+		}, nil).Once()
+		mixCoord.EXPECT().ReportCollectionAccess(mock.Anything, mock.Anything).Return(&querypb.ReportCollectionAccessResponse{
+			Status:                 merr.Success(),
+			ReloadingCollectionIDs: []int64{1},
+		}, nil).Once()
+		shardLeaders, err := lb.waitIdleCollectionReload(ctx, "db", "coll", 1, notLoadedErr)
+		assert.NoError(t, err)
+		assert.Len(t, shardLeaders, 1)
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 )
 
@@ -97,6 +99,11 @@ func TestDatabaseInterceptor(t *testing.T) {
 			&milvuspb.SelectGrantRequest{Entity: &milvuspb.GrantEntity{}},
 			&milvuspb.ManualCompactionRequest{},
 			&milvuspb.RunAnalyzerRequest{},
+			&rootcoordpb.RenamePartitionRequest{},
+			&rootcoordpb.RenameFieldRequest{},
+			&rootcoordpb.AddCollectionFunctionRequest{},
+			&rootcoordpb.GetFunctionBackfillProgressRequest{},
+			&proxypb.FederatedSearchRequest{},
 		}
 
 		md := metadata.Pairs(util.HeaderDBName, "db")
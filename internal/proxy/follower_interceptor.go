@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// followerPermittedMethods are the full methods which can be served by a follower cluster.
+// Besides the read only methods, the follower owns its querynodes and resource groups,
+// so the load/release and resource group management are permitted too.
+// Any method not listed here is rejected, so a newly added method is read-only by default.
+var followerPermittedMethods = typeutil.NewSet(
+	// connection and health
+	milvuspb.MilvusService_Connect_FullMethodName,
+	milvuspb.MilvusService_GetVersion_FullMethodName,
+	milvuspb.MilvusService_CheckHealth_FullMethodName,
+	milvuspb.MilvusService_GetComponentStates_FullMethodName,
+	milvuspb.MilvusService_GetMetrics_FullMethodName,
+	milvuspb.MilvusService_AllocTimestamp_FullMethodName,
+	grpc_health_v1.Health_Check_FullMethodName,
+
+	// search and query
+	milvuspb.MilvusService_Search_FullMethodName,
+	milvuspb.MilvusService_HybridSearch_FullMethodName,
+	milvuspb.MilvusService_Query_FullMethodName,
+	milvuspb.MilvusService_CalcDistance_FullMethodName,
+	milvuspb.MilvusService_RunAnalyzer_FullMethodName,
+	rootcoordpb.MilvusExt_FederatedSearch_FullMethodName,
+	rootcoordpb.MilvusExt_GetFunctionBackfillProgress_FullMethodName,
+	rootcoordpb.MilvusExt_BeginReadSession_FullMethodName,
+	rootcoordpb.MilvusExt_EndReadSession_FullMethodName,
+
+	// describe the meta
+	milvuspb.MilvusService_ListDatabases_FullMethodName,
+	milvuspb.MilvusService_DescribeDatabase_FullMethodName,
+	milvuspb.MilvusService_HasCollection_FullMethodName,
+	milvuspb.MilvusService_DescribeCollection_FullMethodName,
+	milvuspb.MilvusService_ShowCollections_FullMethodName,
+	milvuspb.MilvusService_GetCollectionStatistics_FullMethodName,
+	milvuspb.MilvusService_HasPartition_FullMethodName,
+	milvuspb.MilvusService_ShowPartitions_FullMethodName,
+	milvuspb.MilvusService_GetPartitionStatistics_FullMethodName,
+	milvuspb.MilvusService_DescribeAlias_FullMethodName,
+	milvuspb.MilvusService_ListAliases_FullMethodName,
+	milvuspb.MilvusService_DescribeIndex_FullMethodName,
+	milvuspb.MilvusService_GetIndexStatistics_FullMethodName,
+	milvuspb.MilvusService_GetIndexState_FullMethodName,
+	milvuspb.MilvusService_GetIndexBuildProgress_FullMethodName,
+	milvuspb.MilvusService_ListIndexedSegment_FullMethodName,
+	milvuspb.MilvusService_DescribeSegmentIndexData_FullMethodName,
+	milvuspb.MilvusService_GetPersistentSegmentInfo_FullMethodName,
+	milvuspb.MilvusService_GetQuerySegmentInfo_FullMethodName,
+	milvuspb.MilvusService_GetReplicas_FullMethodName,
+	milvuspb.MilvusService_GetFlushState_FullMethodName,
+	milvuspb.MilvusService_GetFlushAllState_FullMethodName,
+	milvuspb.MilvusService_GetCompactionState_FullMethodName,
+	milvuspb.MilvusService_GetCompactionStateWithPlans_FullMethodName,
+	milvuspb.MilvusService_GetImportState_FullMethodName,
+	milvuspb.MilvusService_ListImportTasks_FullMethodName,
+
+	// load and release
+	milvuspb.MilvusService_LoadCollection_FullMethodName,
+	milvuspb.MilvusService_ReleaseCollection_FullMethodName,
+	milvuspb.MilvusService_LoadPartitions_FullMethodName,
+	milvuspb.MilvusService_ReleasePartitions_FullMethodName,
+	milvuspb.MilvusService_GetLoadingProgress_FullMethodName,
+	milvuspb.MilvusService_GetLoadState_FullMethodName,
+	milvuspb.MilvusService_LoadBalance_FullMethodName,
+
+	// resource group
+	milvuspb.MilvusService_CreateResourceGroup_FullMethodName,
+	milvuspb.MilvusService_DropResourceGroup_FullMethodName,
+	milvuspb.MilvusService_UpdateResourceGroups_FullMethodName,
+	milvuspb.MilvusService_TransferNode_FullMethodName,
+	milvuspb.MilvusService_TransferReplica_FullMethodName,
+	milvuspb.MilvusService_ListResourceGroups_FullMethodName,
+	milvuspb.MilvusService_DescribeResourceGroup_FullMethodName,
+
+	// rbac
+	milvuspb.MilvusService_ListCredUsers_FullMethodName,
+	milvuspb.MilvusService_SelectRole_FullMethodName,
+	milvuspb.MilvusService_SelectUser_FullMethodName,
+	milvuspb.MilvusService_SelectGrant_FullMethodName,
+	milvuspb.MilvusService_ListPrivilegeGroups_FullMethodName,
+	milvuspb.MilvusService_BackupRBAC_FullMethodName,
+)
+
+// FollowerInterceptor returns a new unary server interceptor that rejects the DML and DDL requests
+// when the cluster runs as a read-only follower of a primary cluster.
+func FollowerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := CheckFollowerPermitted(info.FullMethod); err != nil {
+			log.Ctx(ctx).RatedInfo(60, "request rejected by follower cluster",
+				zap.String("method", info.FullMethod), zap.Error(err))
+			if rsp := GetFailedResponse(req, err); rsp != nil {
+				return rsp, nil
+			}
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// CheckFollowerPermitted checks whether the method can be served when the cluster runs as a follower.
+func CheckFollowerPermitted(fullMethod string) error {
+	if !Params.CommonCfg.FollowerEnabled.GetAsBool() {
+		return nil
+	}
+	if !followerPermittedMethods.Contain(fullMethod) {
+		return merr.WrapErrServiceReadOnly(fullMethod, "the cluster is a follower")
+	}
+	return nil
+}
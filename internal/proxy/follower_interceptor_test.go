@@ -0,0 +1,90 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestFollowerInterceptor(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	interceptor := FollowerInterceptor()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &commonpb.Status{}, nil
+	}
+	insertInfo := &grpc.UnaryServerInfo{FullMethod: milvuspb.MilvusService_Insert_FullMethodName}
+
+	t.Run("not follower", func(t *testing.T) {
+		resp, err := interceptor(ctx, &milvuspb.InsertRequest{}, insertInfo, handler)
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.(*commonpb.Status)))
+	})
+
+	paramtable.Get().Save(paramtable.Get().CommonCfg.FollowerEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.FollowerEnabled.Key)
+
+	t.Run("permitted methods", func(t *testing.T) {
+		methods := []string{
+			milvuspb.MilvusService_Search_FullMethodName,
+			milvuspb.MilvusService_Query_FullMethodName,
+			milvuspb.MilvusService_DescribeCollection_FullMethodName,
+			milvuspb.MilvusService_ShowCollections_FullMethodName,
+			milvuspb.MilvusService_LoadCollection_FullMethodName,
+			milvuspb.MilvusService_ReleaseCollection_FullMethodName,
+			milvuspb.MilvusService_CreateResourceGroup_FullMethodName,
+			milvuspb.MilvusService_GetVersion_FullMethodName,
+			milvuspb.MilvusService_Connect_FullMethodName,
+			rootcoordpb.MilvusExt_GetFunctionBackfillProgress_FullMethodName,
+		}
+		for _, method := range methods {
+			_, err := interceptor(ctx, &milvuspb.SearchRequest{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			assert.NoError(t, err)
+			assert.NoError(t, CheckFollowerPermitted(method))
+		}
+	})
+
+	t.Run("rejected methods", func(t *testing.T) {
+		resp, err := interceptor(ctx, &milvuspb.InsertRequest{}, insertInfo, handler)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.(*milvuspb.MutationResult).GetStatus()), merr.ErrServiceReadOnly)
+
+		resp, err = interceptor(ctx, &milvuspb.CreateCollectionRequest{},
+			&grpc.UnaryServerInfo{FullMethod: milvuspb.MilvusService_CreateCollection_FullMethodName}, handler)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.(*commonpb.Status)), merr.ErrServiceReadOnly)
+
+		methods := []string{
+			milvuspb.MilvusService_Delete_FullMethodName,
+			milvuspb.MilvusService_Upsert_FullMethodName,
+			milvuspb.MilvusService_Import_FullMethodName,
+			milvuspb.MilvusService_DropCollection_FullMethodName,
+			milvuspb.MilvusService_RenameCollection_FullMethodName,
+			milvuspb.MilvusService_CreateIndex_FullMethodName,
+			milvuspb.MilvusService_CreatePartition_FullMethodName,
+			milvuspb.MilvusService_CreateAlias_FullMethodName,
+			milvuspb.MilvusService_CreateDatabase_FullMethodName,
+			milvuspb.MilvusService_Flush_FullMethodName,
+			milvuspb.MilvusService_CreateCredential_FullMethodName,
+			milvuspb.MilvusService_ReplicateMessage_FullMethodName,
+			rootcoordpb.MilvusExt_AddCollectionFunction_FullMethodName,
+			"/milvus.proto.milvus.MilvusService/TransferPartition",
+			"/milvus.proto.cdc.ChangeDataCapture/CreateReplicateStream",
+			// the unknown method is never permitted.
+			"/milvus.proto.milvus.MilvusService/Unknown",
+			"",
+		}
+		for _, method := range methods {
+			assert.ErrorIs(t, CheckFollowerPermitted(method), merr.ErrServiceReadOnly)
+		}
+	})
+}
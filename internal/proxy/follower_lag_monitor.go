@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// followerLagMonitor tails the time tick of the primary wal on every pchannel,
+// and reports how far the follower cluster falls behind the primary cluster.
+// The streamingcoord of a follower assigns every pchannel as read-only,
+// so the follower never appends any message into the shared wal,
+// and every time tick seen by the monitor is written by the primary cluster.
+type followerLagMonitor struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// pchannel -> the latest time tick of the primary seen by the follower.
+	timeTicks *typeutil.ConcurrentMap[string, uint64]
+}
+
+func newFollowerLagMonitor(ctx context.Context) *followerLagMonitor {
+	ctx, cancel := context.WithCancel(ctx)
+	return &followerLagMonitor{
+		ctx:       ctx,
+		cancel:    cancel,
+		timeTicks: typeutil.NewConcurrentMap[string, uint64](),
+	}
+}
+
+// Start starts to tail all the pchannels of the primary wal.
+func (m *followerLagMonitor) Start() {
+	for _, pchannel := range util.GetAllTopicsFromConfiguration().Collect() {
+		m.timeTicks.Insert(pchannel, 0)
+		m.wg.Add(1)
+		go m.tail(pchannel)
+	}
+}
+
+func (m *followerLagMonitor) tail(pchannel string) {
+	defer m.wg.Done()
+	log := log.Ctx(m.ctx).With(zap.String("pchannel", pchannel))
+
+	handler := make(adaptor.ChanMessageHandler, 64)
+	scanner := streaming.WAL().Read(m.ctx, streaming.ReadOption{
+		PChannel:       pchannel,
+		DeliverPolicy:  options.DeliverPolicyLatest(),
+		DeliverFilters: []options.DeliverFilter{options.DeliverFilterMessageType(message.MessageTypeTimeTick)},
+		MessageHandler: handler,
+	})
+	defer scanner.Close()
+
+	log.Info("follower start to tail the primary wal")
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("follower stop tailing the primary wal")
+			return
+		case msg, ok := <-handler:
+			if !ok {
+				log.Warn("follower stop tailing the primary wal for closed scanner", zap.Error(scanner.Error()))
+				return
+			}
+			m.observe(pchannel, msg.TimeTick())
+		}
+	}
+}
+
+// observe updates the latest time tick of the pchannel.
+func (m *followerLagMonitor) observe(pchannel string, timeTick uint64) {
+	m.timeTicks.Insert(pchannel, timeTick)
+	metrics.ProxyFollowerReplicationLag.
+		WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), pchannel).
+		Set(float64(tsoutil.SubByNow(timeTick)))
+}
+
+// Lag returns the max replication lag among all pchannels and the related pchannel.
+// The pchannel which has never received a time tick is considered as the most lagging one.
+func (m *followerLagMonitor) Lag() (time.Duration, string) {
+	var (
+		maxLag      time.Duration
+		maxPChannel string
+	)
+	m.timeTicks.Range(func(pchannel string, timeTick uint64) bool {
+		if timeTick == 0 {
+			maxLag, maxPChannel = time.Duration(math.MaxInt64), pchannel
+			return false
+		}
+		if lag := time.Since(tsoutil.PhysicalTime(timeTick)); lag > maxLag {
+			maxLag, maxPChannel = lag, pchannel
+		}
+		return true
+	})
+	return maxLag, maxPChannel
+}
+
+// Close stops tailing the primary wal.
+func (m *followerLagMonitor) Close() {
+	m.cancel()
+	m.wg.Wait()
+}
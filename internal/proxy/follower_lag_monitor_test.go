@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+func TestFollowerLagMonitor(t *testing.T) {
+	paramtable.Init()
+	m := newFollowerLagMonitor(context.Background())
+	defer m.Close()
+
+	lag, pchannel := m.Lag()
+	assert.Equal(t, time.Duration(0), lag)
+	assert.Empty(t, pchannel)
+
+	now := time.Now()
+	m.observe("pchannel_0", tsoutil.ComposeTSByTime(now, 0))
+	m.observe("pchannel_1", tsoutil.ComposeTSByTime(now.Add(-10*time.Second), 0))
+	lag, pchannel = m.Lag()
+	assert.Equal(t, "pchannel_1", pchannel)
+	assert.GreaterOrEqual(t, lag, 10*time.Second)
+
+	// the pchannel never seen is the most lagging one.
+	m.timeTicks.Insert("pchannel_2", 0)
+	lag, pchannel = m.Lag()
+	assert.Equal(t, "pchannel_2", pchannel)
+	assert.Greater(t, lag, time.Hour)
+}
@@ -28,12 +28,14 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/tidwall/gjson"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -51,10 +53,13 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
@@ -2726,7 +2731,10 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 
 	log.Debug("Detail of insert request in Proxy")
 
-	if err := it.WaitToFinish(); err != nil {
+	err := it.WaitToFinish()
+	// the written data may be visible even if the insert fails partially.
+	globalResultCache.Invalidate(it.insertMsg.GetCollectionID(), max(it.result.GetTimestamp(), it.EndTs()))
+	if err != nil {
 		log.Warn("Failed to execute insert task in task scheduler: " + err.Error())
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
@@ -2844,7 +2852,10 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	log.Debug("Run delete in Proxy")
 
-	if err := dr.Run(ctx); err != nil {
+	err := dr.Run(ctx)
+	// the deletion may be visible even if the delete fails partially.
+	globalResultCache.Invalidate(dr.collectionID, max(dr.result.GetTimestamp(), dr.ts))
+	if err != nil {
 		log.Error("Failed to run delete task: " + err.Error())
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
@@ -2964,7 +2975,10 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		zap.Uint64("BeginTS", it.BeginTs()),
 		zap.Uint64("EndTS", it.EndTs()))
 
-	if err := it.WaitToFinish(); err != nil {
+	err := it.WaitToFinish()
+	// the written data may be visible even if the upsert fails partially.
+	globalResultCache.Invalidate(it.collectionID, max(it.result.GetTimestamp(), it.EndTs()))
+	if err != nil {
 		log.Info("Failed to execute insert task in task scheduler",
 			zap.Error(err))
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -3211,6 +3225,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 				metrics.SearchLabel,
 			).Inc()
 		}
+		logSlowSearch(ctx, request, qt, span, sp)
 	}()
 
 	log.Debug(rpcReceived(method))
@@ -3310,6 +3325,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 			hookutil.RelatedCntKey:      qt.result.GetResults().GetAllSearchCount(),
 		})
 		SetReportValue(qt.result.GetStatus(), v)
+		SetResourceUsage(qt.result.GetStatus(), qt.resourceUsage)
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeSearch, dbName, username).Add(float64(v))
 		}
@@ -3447,6 +3463,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 				metrics.HybridSearchLabel,
 			).Inc()
 		}
+		logSlowHybridSearch(ctx, request, qt, span, sp)
 	}()
 
 	log.Debug(rpcReceived(method))
@@ -3545,6 +3562,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 			hookutil.RelatedCntKey:      qt.result.GetResults().GetAllSearchCount(),
 		})
 		SetReportValue(qt.result.GetStatus(), v)
+		SetResourceUsage(qt.result.GetStatus(), qt.resourceUsage)
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeHybridSearch, dbName, username).Add(float64(v))
 		}
@@ -3552,6 +3570,114 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 	return qt.result, qt.resultSizeInsufficient, qt.isTopkReduce, nil
 }
 
+// FederatedSearch searches the collections sharing compatible vector fields with the same request,
+// the sub-searches run in parallel as ordinary search tasks with the window of offset+limit,
+// then the results are merged by score and paginated as a whole.
+func (node *Proxy) FederatedSearch(ctx context.Context, request *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.FederatedSearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	method := "FederatedSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-FederatedSearch")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.Strings("collections", request.GetCollectionNames()),
+	)
+	log.Debug(rpcReceived(method))
+
+	limit, offset, err := validateFederatedSearchRequest(request)
+	if err != nil {
+		log.Warn("invalid federated search request", zap.Error(err))
+		return &proxypb.FederatedSearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	functionScore, err := newFederatedFunctionScore(ctx, request)
+	if err != nil {
+		log.Warn("invalid function score of federated search", zap.Error(err))
+		return &proxypb.FederatedSearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	window := limit + offset
+	if functionScore != nil {
+		window = rerankCandidateWindow(functionScore.FunctionScore, window)
+	}
+
+	tasks := make([]*searchTask, 0, len(request.GetCollectionNames()))
+	for _, collectionName := range request.GetCollectionNames() {
+		subRequest := proto.Clone(request.GetRequest()).(*milvuspb.SearchRequest)
+		subRequest.DbName = request.GetDbName()
+		subRequest.CollectionName = collectionName
+		// every collection searches the whole window, the offset is applied after the results are merged.
+		subRequest.SearchParams = federatedSubSearchParams(subRequest.GetSearchParams(), window)
+		if functionScore != nil {
+			// the function score is applied once over the merged candidates of all the collections.
+			subRequest.FunctionScore = nil
+			subRequest.OutputFields = append(subRequest.GetOutputFields(), functionScore.extraOutputFields...)
+		}
+
+		qt := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Search),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ReqID: paramtable.GetNodeID(),
+			},
+			request:             subRequest,
+			tr:                  timerecord.NewTimeRecorder("search"),
+			mixCoord:            node.mixCoord,
+			node:                node,
+			lb:                  node.lbPolicy,
+			mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+			requeryFunc:         requeryImpl,
+		}
+		if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+			log.Warn(rpcFailedToEnqueue(method), zap.String("collection", collectionName), zap.Error(err))
+			return &proxypb.FederatedSearchResults{
+				Status: merr.Status(err),
+			}, nil
+		}
+		tasks = append(tasks, qt)
+	}
+	tr.CtxRecord(ctx, "federated search requests enqueue")
+
+	for _, qt := range tasks {
+		if err := qt.WaitToFinish(); err != nil {
+			log.Warn(rpcFailedToWaitToFinish(method), zap.String("collection", qt.request.GetCollectionName()), zap.Error(err))
+			return &proxypb.FederatedSearchResults{
+				Status: merr.Status(errors.Wrapf(err, "failed to search collection %s", qt.request.GetCollectionName())),
+			}, nil
+		}
+	}
+	tr.CtxRecord(ctx, "wait federated search results")
+
+	results, collectionNames, err := reduceFederatedSearchResults(ctx, tasks, limit, offset, functionScore)
+	if err != nil {
+		log.Warn("failed to reduce federated search results", zap.Error(err))
+		return &proxypb.FederatedSearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Debug(rpcDone(method), zap.Duration("duration", tr.ElapseSpan()))
+	return &proxypb.FederatedSearchResults{
+		Status:          merr.Success(),
+		Results:         results,
+		CollectionNames: collectionNames,
+	}, nil
+}
+
 func (node *Proxy) getVectorPlaceholderGroupForSearchByPks(ctx context.Context, request *milvuspb.SearchRequest) ([]byte, error) {
 	placeholderGroup := &commonpb.PlaceholderGroup{}
 	err := proto.Unmarshal(request.PlaceholderGroup, placeholderGroup)
@@ -3742,6 +3868,7 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask, sp trace.Span) (*mi
 				metrics.QueryLabel,
 			).Inc()
 		}
+		logSlowQuery(ctx, request, qt, span, sp)
 	}()
 
 	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
@@ -3883,6 +4010,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		hookutil.RelatedCntKey:      qt.allQueryCnt,
 	})
 	SetReportValue(res.Status, v)
+	SetResourceUsage(res.Status, qt.resourceUsage)
 	metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeQuery, request.DbName, username).Add(float64(v))
 	return res, nil
 }
@@ -5938,6 +6066,15 @@ func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealt
 		return fn("mixcoord", resp, err)
 	})
 
+	if node.followerLagMonitor != nil {
+		maxLag := Params.CommonCfg.FollowerMaxReplicationLag.GetAsDuration(time.Second)
+		if lag, pchannel := node.followerLagMonitor.Lag(); maxLag >= 0 && lag > maxLag {
+			mu.Lock()
+			errReasons = append(errReasons, fmt.Sprintf("follower replication lag of %s exceeds %s", pchannel, maxLag))
+			mu.Unlock()
+		}
+	}
+
 	err := group.Wait()
 	if err != nil || len(errReasons) != 0 {
 		return &milvuspb.CheckHealthResponse{
@@ -5991,6 +6128,445 @@ func (node *Proxy) RenameCollection(ctx context.Context, req *milvuspb.RenameCol
 	return resp, nil
 }
 
+// RenamePartition renames a partition of the collection.
+func (node *Proxy) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RenamePartition")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("oldName", req.GetOldName()),
+		zap.String("newName", req.GetNewName()))
+
+	log.Info("received rename partition request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		log.Warn("validate collection name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := validatePartitionTag(req.GetNewName(), true); err != nil {
+		log.Warn("validate new partition name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.RenamePartition(ctx, req)
+	if err != nil {
+		log.Warn("failed to rename partition", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// RenameField renames a field of the collection, the expressions and output fields
+// must refer to the field by the new name once it's done.
+func (node *Proxy) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RenameField")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("oldName", req.GetOldName()),
+		zap.String("newName", req.GetNewName()))
+
+	log.Info("received rename field request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		log.Warn("validate collection name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := validateFieldName(req.GetNewName()); err != nil {
+		log.Warn("validate new field name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AlterCollectionField),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.RenameField(ctx, req)
+	if err != nil {
+		log.Warn("failed to rename field", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// AddCollectionFunction adds a function and its output fields to an existing collection,
+// the outputs of the existing rows are backfilled by datacoord.
+func (node *Proxy) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionFunction")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("function", req.GetFunction().GetName()))
+
+	log.Info("received add collection function request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		log.Warn("validate collection name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection schema", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := validateAddedFunction(schema.CollectionSchema, req.GetFunction(), req.GetOutputFields()); err != nil {
+		log.Warn("validate function fail", zap.Error(err))
+		return merr.Status(merr.WrapErrParameterInvalidMsg(err.Error())), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AddCollectionField),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.AddCollectionFunction(ctx, req)
+	if err != nil {
+		log.Warn("failed to add collection function", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// GetFunctionBackfillProgress returns the progress of backfilling the outputs of the functions
+// added to an existing collection.
+func (node *Proxy) GetFunctionBackfillProgress(ctx context.Context, req *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GetFunctionBackfillProgress")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("function", req.GetFunctionName()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &indexpb.GetFunctionBackfillProgressResponse{Status: merr.Status(err)}, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		return &indexpb.GetFunctionBackfillProgressResponse{Status: merr.Status(err)}, nil
+	}
+	resp, err := node.mixCoord.GetFunctionBackfillProgress(ctx, &indexpb.GetFunctionBackfillProgressRequest{
+		CollectionID: collectionID,
+		FunctionName: req.GetFunctionName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to get function backfill progress", zap.Error(err))
+		return &indexpb.GetFunctionBackfillProgressResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// TransferPartition moves or copies a partition into another collection which has the identical schema.
+func (node *Proxy) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-TransferPartition")
+	defer sp.End()
+
+	if req.GetTargetDbName() == "" {
+		req.TargetDbName = req.GetDbName()
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("partition", req.GetPartitionName()),
+		zap.String("targetDb", req.GetTargetDbName()),
+		zap.String("targetCollection", req.GetTargetCollectionName()),
+		zap.String("targetPartition", req.GetTargetPartitionName()),
+		zap.Bool("copy", req.GetCopy()))
+
+	log.Info("received transfer partition request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	for _, name := range []string{req.GetCollectionName(), req.GetTargetCollectionName()} {
+		if err := validateCollectionName(name); err != nil {
+			log.Warn("validate collection name fail", zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	if err := validatePartitionTag(req.GetTargetPartitionName(), true); err != nil {
+		log.Warn("validate target partition name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.TransferPartition(ctx, req)
+	if err != nil {
+		log.Warn("failed to transfer partition", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// CreateProjection creates a projection which keeps applying the inserts and deletes
+// of the source collection to the target collection.
+func (node *Proxy) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateProjection")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("projection", req.GetProjectionName()),
+		zap.String("source", req.GetSourceCollectionName()),
+		zap.String("target", req.GetTargetCollectionName()))
+
+	log.Info("received create projection request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := validateName(req.GetProjectionName(), "projection name"); err != nil {
+		log.Warn("validate projection name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	for _, name := range []string{req.GetSourceCollectionName(), req.GetTargetCollectionName()} {
+		if err := validateCollectionName(name); err != nil {
+			log.Warn("validate collection name fail", zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.CreateProjection(ctx, req)
+	if err != nil {
+		log.Warn("failed to create projection", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// DropProjection stops the projection and removes it, the data of the target collection is kept.
+func (node *Proxy) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropProjection")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("projection", req.GetProjectionName()))
+
+	log.Info("received drop projection request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := validateName(req.GetProjectionName(), "projection name"); err != nil {
+		log.Warn("validate projection name fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.DropProjection(ctx, req)
+	if err != nil {
+		log.Warn("failed to drop projection", zap.Error(err))
+		return merr.Status(err), err
+	}
+	return resp, nil
+}
+
+// ListProjections lists the projections of the database with their state, consistency and lag.
+func (node *Proxy) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListProjections")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &rootcoordpb.ListProjectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.ListProjections(ctx, req)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to list projections", zap.String("db", req.GetDbName()), zap.Error(err))
+		return &rootcoordpb.ListProjectionsResponse{Status: merr.Status(err)}, err
+	}
+	return resp, nil
+}
+
+// WatchChanges streams the row-level and ddl changes of the collection decoded from the wal.
+// The stream is resumable from the checkpoints of the responses, and ends after the collection is dropped.
+func (node *Proxy) WatchChanges(req *cdcpb.WatchChangesRequest, stream cdcpb.ChangeDataCapture_WatchChangesServer) error {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(stream.Context(), "Proxy-WatchChanges")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return err
+	}
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return merr.WrapErrServiceUnavailable("streaming service is not enabled", "watch changes requires the streaming service")
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return err
+	}
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	} else {
+		// the privilege is checked against the database of the request.
+		md, _ := metadata.FromIncomingContext(ctx)
+		md = md.Copy()
+		md.Set(util.HeaderDBName, req.GetDbName())
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	log := log.Ctx(ctx).With(zap.String("db", req.GetDbName()), zap.String("collection", req.GetCollectionName()))
+
+	// watching the changes exposes the data of the collection, which is the same as querying it.
+	ctx, err := PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{DbName: req.GetDbName(), CollectionName: req.GetCollectionName()})
+	if err != nil {
+		log.Warn("permission denied to watch changes", zap.Error(err))
+		return err
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	vchannels, err := node.chMgr.getVChannels(collectionID)
+	if err != nil {
+		return err
+	}
+	watcher, err := newChangeWatcher(collectionID, vchannels, req.GetStartCheckpoints())
+	if err != nil {
+		return err
+	}
+
+	log.Info("start to watch changes", zap.Int64("collectionID", collectionID), zap.Int("checkpoints", len(req.GetStartCheckpoints())))
+	if err := watcher.Watch(ctx, stream.Send); err != nil {
+		log.Warn("watch changes stopped", zap.Error(err))
+		return err
+	}
+	log.Info("watch changes finished since the collection is dropped")
+	return nil
+}
+
+// BeginReadSession pins the latest snapshot of a loaded collection,
+// the searches and queries carrying the returned session id read exactly the snapshot until the session expires.
+func (node *Proxy) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-BeginReadSession")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("ttl", req.GetTtlSeconds()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+
+	ttl, err := readSessionTTL(req.GetTtlSeconds())
+	if err != nil {
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+	// the snapshot is pinned at the latest timestamp, as the strong consistency.
+	ts, err := node.tsoAllocator.AllocOne(ctx)
+	if err != nil {
+		log.Warn("failed to allocate timestamp", zap.Error(err))
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+	// the session id is random, the requests carrying it are validated by the query coord.
+	sessionID := uuid.NewString()
+
+	resp, err := node.mixCoord.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		CollectionID:   collectionID,
+		TtlSeconds:     int64(ttl / time.Second),
+		SessionId:      sessionID,
+		Timestamp:      ts,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to begin read session", zap.Error(err))
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Info("read session begun", zap.String("sessionID", sessionID))
+	return &querypb.BeginReadSessionResponse{
+		Status:    merr.Success(),
+		SessionId: sessionID,
+		Timestamp: ts,
+		ExpireAt:  resp.GetExpireAt(),
+	}, nil
+}
+
+// EndReadSession ends the read session before it expires, so the snapshot of it is no longer kept.
+func (node *Proxy) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-EndReadSession")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetSessionId() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("session id is required to end read session")), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.mixCoord.EndReadSession(ctx, req)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("failed to end read session", zap.String("sessionID", req.GetSessionId()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
 func (node *Proxy) CreateResourceGroup(ctx context.Context, request *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -46,10 +46,12 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	mqcommon "github.com/milvus-io/milvus/pkg/v2/mq/common"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
@@ -235,6 +237,242 @@ func TestProxyRenameCollection(t *testing.T) {
 	})
 }
 
+func TestProxyRenamePartition(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.RenamePartition(context.Background(), &rootcoordpb.RenamePartitionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("illegal new partition name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.RenamePartition(context.Background(), &rootcoordpb.RenamePartitionRequest{
+			CollectionName: "coll", OldName: "p1", NewName: "$#^%#&#$*!)#@!",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("rename ok", func(t *testing.T) {
+		rc := mocks.NewMockMixCoordClient(t)
+		rc.EXPECT().RenamePartition(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+				return merr.Success(), nil
+			})
+		node := &Proxy{
+			session:  &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+			mixCoord: rc,
+		}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.RenamePartition(context.Background(), &rootcoordpb.RenamePartitionRequest{
+			CollectionName: "coll", OldName: "p1", NewName: "p2",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+}
+
+func TestProxyRenameField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.RenameField(context.Background(), &rootcoordpb.RenameFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("illegal new field name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.RenameField(context.Background(), &rootcoordpb.RenameFieldRequest{
+			CollectionName: "coll", OldName: "f1", NewName: "$#^%#&#$*!)#@!",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("rename fail", func(t *testing.T) {
+		rc := mocks.NewMockMixCoordClient(t)
+		rc.EXPECT().RenameField(mock.Anything, mock.Anything).Return(nil, errors.New("fail"))
+		node := &Proxy{
+			session:  &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+			mixCoord: rc,
+		}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.RenameField(context.Background(), &rootcoordpb.RenameFieldRequest{
+			CollectionName: "coll", OldName: "f1", NewName: "f2",
+		})
+		assert.Error(t, err)
+		assert.False(t, merr.Ok(resp))
+	})
+}
+
+func TestProxyAddCollectionFunction(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.AddCollectionFunction(context.Background(), &rootcoordpb.AddCollectionFunctionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("illegal collection name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.AddCollectionFunction(context.Background(), &rootcoordpb.AddCollectionFunctionRequest{
+			CollectionName: "$#^%#&#$*!)#@!",
+		})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp))
+	})
+}
+
+func TestProxyGetFunctionBackfillProgress(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.GetFunctionBackfillProgress(context.Background(), &rootcoordpb.GetFunctionBackfillProgressRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+}
+
+func TestProxyTransferPartition(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.TransferPartition(context.Background(), &rootcoordpb.TransferPartitionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("illegal target collection name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.TransferPartition(context.Background(), &rootcoordpb.TransferPartitionRequest{
+			CollectionName: "coll", PartitionName: "p1", TargetCollectionName: "$#^%#&#$*!)#@!", TargetPartitionName: "p1",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		rc := mocks.NewMockMixCoordClient(t)
+		rc.EXPECT().TransferPartition(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				assert.Equal(t, "db", req.GetTargetDbName())
+				assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+				return merr.Success(), nil
+			})
+		node := &Proxy{
+			session:  &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+			mixCoord: rc,
+		}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.TransferPartition(context.Background(), &rootcoordpb.TransferPartitionRequest{
+			DbName: "db", CollectionName: "coll", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p2",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+}
+
+func TestProxyProjection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.CreateProjection(context.Background(), &rootcoordpb.CreateProjectionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+		resp, err = node.DropProjection(context.Background(), &rootcoordpb.DropProjectionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+		listResp, err := node.ListProjections(context.Background(), &rootcoordpb.ListProjectionsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(listResp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("illegal name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.CreateProjection(context.Background(), &rootcoordpb.CreateProjectionRequest{
+			ProjectionName: "$#^%#&#$*!)#@!", SourceCollectionName: "coll", TargetCollectionName: "coll2",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+		resp, err = node.CreateProjection(context.Background(), &rootcoordpb.CreateProjectionRequest{
+			ProjectionName: "proj", SourceCollectionName: "coll", TargetCollectionName: "",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+		resp, err = node.DropProjection(context.Background(), &rootcoordpb.DropProjectionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		rc := mocks.NewMockMixCoordClient(t)
+		rc.EXPECT().CreateProjection(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+				return merr.Success(), nil
+			})
+		rc.EXPECT().DropProjection(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		rc.EXPECT().ListProjections(mock.Anything, mock.Anything).Return(&rootcoordpb.ListProjectionsResponse{
+			Status:      merr.Success(),
+			Projections: []*rootcoordpb.ProjectionStatus{{Name: "proj"}},
+		}, nil)
+		node := &Proxy{
+			session:  &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+			mixCoord: rc,
+		}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.CreateProjection(context.Background(), &rootcoordpb.CreateProjectionRequest{
+			DbName: "db", ProjectionName: "proj", SourceCollectionName: "coll", TargetCollectionName: "coll2",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		listResp, err := node.ListProjections(context.Background(), &rootcoordpb.ListProjectionsRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.Len(t, listResp.GetProjections(), 1)
+		resp, err = node.DropProjection(context.Background(), &rootcoordpb.DropProjectionRequest{DbName: "db", ProjectionName: "proj"})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+}
+
+func TestProxyWatchChanges(t *testing.T) {
+	stream := &changeTestStream{ctx: context.Background()}
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		err := node.WatchChanges(&cdcpb.WatchChangesRequest{CollectionName: "coll"}, stream)
+		assert.ErrorIs(t, err, merr.ErrServiceNotReady)
+	})
+
+	t.Run("streaming disabled", func(t *testing.T) {
+		streamingutil.UnsetStreamingServiceEnabled()
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		err := node.WatchChanges(&cdcpb.WatchChangesRequest{CollectionName: "coll"}, stream)
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+
+	t.Run("illegal name", func(t *testing.T) {
+		streamingutil.SetStreamingServiceEnabled()
+		defer streamingutil.UnsetStreamingServiceEnabled()
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		err := node.WatchChanges(&cdcpb.WatchChangesRequest{CollectionName: "$#^%#&#$*!)#@!"}, stream)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+	assert.Empty(t, stream.responses)
+}
+
 func TestProxy_ResourceGroup(t *testing.T) {
 	factory := dependency.NewDefaultFactory(true)
 	ctx := context.Background()
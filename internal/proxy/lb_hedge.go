@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	// hedgeLatencyWindow is the number of the recent shard request latencies kept for each collection.
+	hedgeLatencyWindow = 128
+	// hedgeMinSamples is the number of the latencies required before hedging the requests of a collection.
+	hedgeMinSamples = 32
+	// hedgeDelayPercentile is the percentile of the recent latencies used as the hedge delay.
+	hedgeDelayPercentile = 0.95
+	// hedgeBudgetCapacity bounds the hedged requests sent in a burst.
+	hedgeBudgetCapacity = 10
+)
+
+// errShardResultDropped is returned by the execution whose result is dropped, since the other execution
+// of the same hedged shard request has claimed the result.
+var errShardResultDropped = errors.New("shard result is dropped by hedged request")
+
+type hedgeGuardKey struct{}
+
+// claimShardResult returns whether the shard result of the execution should be kept.
+// Only the first execution of a hedged shard request claims the result,
+// the others must drop theirs and return errShardResultDropped.
+func claimShardResult(ctx context.Context) bool {
+	claimed, ok := ctx.Value(hedgeGuardKey{}).(*atomic.Bool)
+	if !ok {
+		return true
+	}
+	return claimed.CompareAndSwap(false, true)
+}
+
+// hedgeLatencyTracker tracks the recent shard request latencies of a collection to derive the hedge delay.
+type hedgeLatencyTracker struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	delay   time.Duration
+}
+
+func newHedgeLatencyTracker() *hedgeLatencyTracker {
+	return &hedgeLatencyTracker{
+		samples: make([]time.Duration, 0, hedgeLatencyWindow),
+	}
+}
+
+func (t *hedgeLatencyTracker) Record(latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.samples) < hedgeLatencyWindow {
+		t.samples = append(t.samples, latency)
+	} else {
+		t.samples[t.next] = latency
+	}
+	t.next = (t.next + 1) % hedgeLatencyWindow
+	if len(t.samples) < hedgeMinSamples {
+		return
+	}
+	sorted := slices.Clone(t.samples)
+	slices.Sort(sorted)
+	t.delay = sorted[int(float64(len(sorted)-1)*hedgeDelayPercentile)]
+}
+
+// Delay returns the delay before hedging a shard request, false if there are not enough latencies yet.
+func (t *hedgeLatencyTracker) Delay() (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.samples) < hedgeMinSamples {
+		return 0, false
+	}
+	return max(t.delay, paramtable.Get().ProxyCfg.HedgedRequestMinDelay.GetAsDuration(time.Millisecond)), true
+}
+
+// hedgeBudget limits the hedged requests to a ratio of the shard requests,
+// so the hedged requests don't double the load of the query nodes once all the replicas slow down.
+type hedgeBudget struct {
+	mu     sync.Mutex
+	tokens float64
+}
+
+// Deposit is called for each hedgeable shard request.
+func (b *hedgeBudget) Deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.tokens+paramtable.Get().ProxyCfg.HedgedRequestBudgetRatio.GetAsFloat(), hedgeBudgetCapacity)
+}
+
+// Withdraw returns whether a hedged request could be sent.
+func (b *hedgeBudget) Withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+type hedgeResult struct {
+	nodeID int64
+	hedged bool
+	err    error
+}
+
+// executeHedged executes the workload on the target node, if it doesn't answer within the hedge delay,
+// a duplicate is sent to another replica and the first succeeded result is taken.
+// The nodes failed to execute are added into the excludeNodes.
+func (lb *LBPolicyImpl) executeHedged(ctx context.Context, balancer LBBalancer, workload *ChannelWorkload,
+	targetNode nodeInfo, client types.QueryNodeClient, excludeNodes typeutil.UniqueSet,
+) error {
+	if !workload.hedge || len(workload.shardLeaders) < 2 {
+		return workload.exec(ctx, targetNode.nodeID, client, workload.channel)
+	}
+	tracker, ok := lb.hedgeTrackers.Get(workload.collectionID)
+	if !ok {
+		tracker, _ = lb.hedgeTrackers.GetOrInsert(workload.collectionID, newHedgeLatencyTracker())
+	}
+	start := time.Now()
+	delay, ok := tracker.Delay()
+	if !ok {
+		err := workload.exec(ctx, targetNode.nodeID, client, workload.channel)
+		if err == nil {
+			tracker.Record(time.Since(start))
+		}
+		return err
+	}
+	lb.hedgeBudget.Deposit()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = context.WithValue(ctx, hedgeGuardKey{}, atomic.NewBool(false))
+	resultCh := make(chan hedgeResult, 2)
+	go func() {
+		err := workload.exec(ctx, targetNode.nodeID, client, workload.channel)
+		resultCh <- hedgeResult{nodeID: targetNode.nodeID, err: err}
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	pending := 1
+	var lastErr error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			hedgeNode, hedgeClient, ok := lb.selectHedgeNode(ctx, balancer, workload, targetNode.nodeID, excludeNodes)
+			if !ok {
+				continue
+			}
+			pending++
+			metrics.ProxyHedgedRequestCount.WithLabelValues(paramtable.GetStringNodeID(), metrics.HedgeSentLabel).Inc()
+			go func() {
+				defer balancer.CancelWorkload(hedgeNode.nodeID, workload.nq)
+				err := workload.exec(ctx, hedgeNode.nodeID, hedgeClient, workload.channel)
+				resultCh <- hedgeResult{nodeID: hedgeNode.nodeID, hedged: true, err: err}
+			}()
+		case result := <-resultCh:
+			pending--
+			if errors.Is(result.err, errShardResultDropped) {
+				// wait for the execution claimed the result
+				continue
+			}
+			if result.err == nil {
+				tracker.Record(time.Since(start))
+				if result.hedged {
+					metrics.ProxyHedgedRequestCount.WithLabelValues(paramtable.GetStringNodeID(), metrics.HedgeWinLabel).Inc()
+				}
+				return nil
+			}
+			excludeNodes.Insert(result.nodeID)
+			lastErr = result.err
+		}
+	}
+	return lastErr
+}
+
+// selectHedgeNode selects another serviceable replica for the hedged request within the budget.
+func (lb *LBPolicyImpl) selectHedgeNode(ctx context.Context, balancer LBBalancer, workload *ChannelWorkload,
+	targetNodeID int64, excludeNodes typeutil.UniqueSet,
+) (nodeInfo, types.QueryNodeClient, bool) {
+	candidates := make(map[int64]nodeInfo)
+	for _, node := range workload.shardLeaders {
+		if node.nodeID != targetNodeID && node.serviceable && !excludeNodes.Contain(node.nodeID) {
+			candidates[node.nodeID] = node
+		}
+	}
+	if len(candidates) == 0 {
+		return nodeInfo{}, nil, false
+	}
+	if !lb.hedgeBudget.Withdraw() {
+		metrics.ProxyHedgedRequestCount.WithLabelValues(paramtable.GetStringNodeID(), metrics.HedgeSkipLabel).Inc()
+		return nodeInfo{}, nil, false
+	}
+
+	nodeID, err := balancer.SelectNode(ctx, lo.Keys(candidates), workload.nq)
+	if err != nil {
+		return nodeInfo{}, nil, false
+	}
+	node, ok := candidates[nodeID]
+	if !ok {
+		balancer.CancelWorkload(nodeID, workload.nq)
+		return nodeInfo{}, nil, false
+	}
+	client, err := lb.clientMgr.GetClient(ctx, node)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get delegator for hedged request",
+			zap.Int64("collectionID", workload.collectionID),
+			zap.String("channelName", workload.channel),
+			zap.Int64("nodeID", nodeID),
+			zap.Error(err))
+		balancer.CancelWorkload(nodeID, workload.nq)
+		return nodeInfo{}, nil, false
+	}
+	return node, client, true
+}
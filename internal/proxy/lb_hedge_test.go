@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestHedgeLatencyTracker(t *testing.T) {
+	paramtable.Init()
+	tracker := newHedgeLatencyTracker()
+	for i := 1; i < hedgeMinSamples; i++ {
+		tracker.Record(time.Duration(i) * time.Second)
+	}
+	_, ok := tracker.Delay()
+	assert.False(t, ok)
+
+	for i := hedgeMinSamples; i <= hedgeLatencyWindow*2; i++ {
+		tracker.Record(time.Duration(i) * time.Second)
+	}
+	delay, ok := tracker.Delay()
+	assert.True(t, ok)
+	// only the latest latencies are kept
+	assert.Len(t, tracker.samples, hedgeLatencyWindow)
+	assert.Equal(t, 249*time.Second, delay)
+
+	tracker = newHedgeLatencyTracker()
+	for i := 0; i < hedgeMinSamples; i++ {
+		tracker.Record(time.Microsecond)
+	}
+	delay, _ = tracker.Delay()
+	assert.Equal(t, paramtable.Get().ProxyCfg.HedgedRequestMinDelay.GetAsDuration(time.Millisecond), delay)
+}
+
+func TestHedgeBudget(t *testing.T) {
+	paramtable.Init()
+	b := &hedgeBudget{}
+	assert.False(t, b.Withdraw())
+	for i := 0; i < 11; i++ {
+		b.Deposit()
+	}
+	assert.True(t, b.Withdraw())
+	assert.False(t, b.Withdraw())
+
+	for i := 0; i < 1000; i++ {
+		b.Deposit()
+	}
+	for i := 0; i < hedgeBudgetCapacity; i++ {
+		assert.True(t, b.Withdraw())
+	}
+	assert.False(t, b.Withdraw())
+}
+
+func TestClaimShardResult(t *testing.T) {
+	assert.True(t, claimShardResult(context.Background()))
+	ctx := context.WithValue(context.Background(), hedgeGuardKey{}, atomic.NewBool(false))
+	assert.True(t, claimShardResult(ctx))
+	assert.False(t, claimShardResult(ctx))
+}
+
+func TestExecuteHedged(t *testing.T) {
+	paramtable.Init()
+	nodes := []nodeInfo{{nodeID: 1, serviceable: true}, {nodeID: 2, serviceable: true}}
+	newPolicy := func(t *testing.T) (*LBPolicyImpl, *MockLBBalancer) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mgr := NewMockShardClientManager(t)
+		mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(qn, nil).Maybe()
+		balancer := NewMockLBBalancer(t)
+		balancer.EXPECT().SelectNode(mock.Anything, []int64{2}, mock.Anything).Return(2, nil).Maybe()
+		balancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything).Maybe()
+		lb := NewLBPolicyImpl(mgr)
+		tracker := newHedgeLatencyTracker()
+		for i := 0; i < hedgeMinSamples; i++ {
+			tracker.Record(time.Millisecond)
+		}
+		lb.hedgeTrackers.Insert(1, tracker)
+		return lb, balancer
+	}
+
+	t.Run("hedge win", func(t *testing.T) {
+		lb, balancer := newPolicy(t)
+		lb.hedgeBudget.tokens = hedgeBudgetCapacity
+		results := typeutil.NewConcurrentSet[int64]()
+		workload := &ChannelWorkload{
+			collectionID: 1,
+			channel:      "channel",
+			shardLeaders: nodes,
+			hedge:        true,
+			exec: func(ctx context.Context, nodeID int64, _ types.QueryNodeClient, _ string) error {
+				if nodeID == 1 {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+				if !claimShardResult(ctx) {
+					return errShardResultDropped
+				}
+				results.Insert(nodeID)
+				return nil
+			},
+		}
+		excludeNodes := typeutil.NewUniqueSet()
+		err := lb.executeHedged(context.Background(), balancer, workload, nodes[0], nil, excludeNodes)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2}, results.Collect())
+		assert.Zero(t, excludeNodes.Len())
+	})
+
+	t.Run("dropped result", func(t *testing.T) {
+		lb, balancer := newPolicy(t)
+		lb.hedgeBudget.tokens = hedgeBudgetCapacity
+		results := typeutil.NewConcurrentSet[int64]()
+		hedged := make(chan struct{})
+		workload := &ChannelWorkload{
+			collectionID: 1,
+			channel:      "channel",
+			shardLeaders: nodes,
+			hedge:        true,
+			exec: func(ctx context.Context, nodeID int64, _ types.QueryNodeClient, _ string) error {
+				if nodeID == 1 {
+					<-hedged
+					// the first request claims the result, but it is slower to insert the result.
+					assert.True(t, claimShardResult(ctx))
+					hedged <- struct{}{}
+					time.Sleep(10 * time.Millisecond)
+					results.Insert(nodeID)
+					return nil
+				}
+				hedged <- struct{}{}
+				<-hedged
+				if !claimShardResult(ctx) {
+					return errShardResultDropped
+				}
+				results.Insert(nodeID)
+				return nil
+			},
+		}
+		err := lb.executeHedged(context.Background(), balancer, workload, nodes[0], nil, typeutil.NewUniqueSet())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1}, results.Collect())
+	})
+
+	t.Run("out of budget", func(t *testing.T) {
+		lb, balancer := newPolicy(t)
+		mockErr := errors.New("mock")
+		workload := &ChannelWorkload{
+			collectionID: 1,
+			channel:      "channel",
+			shardLeaders: nodes,
+			hedge:        true,
+			exec: func(ctx context.Context, nodeID int64, _ types.QueryNodeClient, _ string) error {
+				assert.Equal(t, int64(1), nodeID)
+				time.Sleep(50 * time.Millisecond)
+				return mockErr
+			},
+		}
+		excludeNodes := typeutil.NewUniqueSet()
+		err := lb.executeHedged(context.Background(), balancer, workload, nodes[0], nil, excludeNodes)
+		assert.ErrorIs(t, err, mockErr)
+		assert.True(t, excludeNodes.Contain(1))
+	})
+}
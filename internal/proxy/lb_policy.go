@@ -45,6 +45,7 @@ type ChannelWorkload struct {
 	nq             int64
 	exec           executeFunc
 	retryTimes     uint
+	hedge          bool
 }
 
 type CollectionWorkLoad struct {
@@ -53,6 +54,7 @@ type CollectionWorkLoad struct {
 	collectionID   int64
 	nq             int64
 	exec           executeFunc
+	hedge          bool
 }
 
 type LBPolicy interface {
@@ -74,6 +76,12 @@ type LBPolicyImpl struct {
 	clientMgr      shardClientMgr
 	balancerMap    map[string]LBBalancer
 	retryOnReplica int
+
+	hedgeTrackers *typeutil.ConcurrentMap[int64, *hedgeLatencyTracker]
+	hedgeBudget   hedgeBudget
+
+	// collectionAccess reports the accessed collections, so the collections released for idle are reloaded.
+	collectionAccess *collectionAccessReporter
 }
 
 func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
@@ -96,6 +104,7 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 		clientMgr:      clientMgr,
 		balancerMap:    balancerMap,
 		retryOnReplica: retryOnReplica,
+		hedgeTrackers:  typeutil.NewConcurrentMap[int64, *hedgeLatencyTracker](),
 	}
 }
 
@@ -107,6 +116,9 @@ func (lb *LBPolicyImpl) Start(ctx context.Context) {
 
 // GetShardLeaders should always retry until ctx done, except the collection is not loaded.
 func (lb *LBPolicyImpl) GetShardLeaders(ctx context.Context, dbName string, collName string, collectionID int64, withCache bool) (map[string][]nodeInfo, error) {
+	if lb.collectionAccess != nil {
+		lb.collectionAccess.Record(collectionID)
+	}
 	var shardLeaders map[string][]nodeInfo
 	// use retry to handle query coord service not ready
 	err := retry.Handle(ctx, func() (bool, error) {
@@ -117,6 +129,9 @@ func (lb *LBPolicyImpl) GetShardLeaders(ctx context.Context, dbName string, coll
 		}
 		return false, nil
 	})
+	if errors.Is(err, merr.ErrCollectionNotLoaded) {
+		return lb.waitIdleCollectionReload(ctx, dbName, collName, collectionID, err)
+	}
 
 	return shardLeaders, err
 }
@@ -248,7 +263,7 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 			return true, lastErr
 		}
 
-		err = workload.exec(ctx, targetNode.nodeID, client, workload.channel)
+		err = lb.executeHedged(ctx, balancer, &workload, targetNode, client, excludeNodes)
 		if err != nil {
 			log.Warn("search/query channel failed",
 				zap.Int64("collectionID", workload.collectionID),
@@ -307,6 +322,7 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 				nq:             workload.nq,
 				exec:           workload.exec,
 				retryTimes:     uint(channelRetryTimes),
+				hedge:          workload.hedge,
 			})
 		})
 	}
@@ -339,6 +355,7 @@ func (lb *LBPolicyImpl) ExecuteOneChannel(ctx context.Context, workload Collecti
 			nq:             workload.nq,
 			exec:           workload.exec,
 			retryTimes:     uint(channelRetryTimes),
+			hedge:          workload.hedge,
 		})
 	}
 	return fmt.Errorf("no acitvate sheard leader exist for collection: %s", workload.collectionName)
@@ -105,6 +105,7 @@ type collectionInfo struct {
 	replicateID           string
 	updateTimestamp       uint64
 	collectionTTL         uint64
+	hedgedRequest         bool
 }
 
 type databaseInfo struct {
@@ -476,6 +477,7 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 			partitionKeyIsolation: isolation,
 			updateTimestamp:       collection.UpdateTimestamp,
 			collectionTTL:         getCollectionTTL(schemaInfo.CollectionSchema.GetProperties()),
+			hedgedRequest:         common.IsHedgedRequestEnabled(collection.Properties...),
 		}, nil
 	}
 	_, dbOk := m.collInfo[database]
@@ -495,6 +497,7 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		replicateID:           replicateID,
 		updateTimestamp:       collection.UpdateTimestamp,
 		collectionTTL:         getCollectionTTL(schemaInfo.CollectionSchema.GetProperties()),
+		hedgedRequest:         common.IsHedgedRequestEnabled(collection.Properties...),
 	}
 
 	log.Ctx(ctx).Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName),
@@ -860,9 +863,15 @@ func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionNa
 	defer m.mu.Unlock()
 	_, dbOk := m.collInfo[database]
 	if dbOk {
+		if info, ok := m.collInfo[database][collectionName]; ok {
+			globalResultCache.RemoveCollection(info.collID)
+		}
 		delete(m.collInfo[database], collectionName)
 	}
 	if database == "" {
+		if info, ok := m.collInfo[defaultDB][collectionName]; ok {
+			globalResultCache.RemoveCollection(info.collID)
+		}
 		delete(m.collInfo[defaultDB], collectionName)
 	}
 	log.Ctx(ctx).Debug("remove collection", zap.String("db", database), zap.String("collection", collectionName), zap.Bool("dbok", dbOk))
@@ -872,6 +881,7 @@ func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID Uniq
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	globalResultCache.RemoveCollection(collectionID)
 	curVersion := m.collectionCacheVersion[collectionID]
 	var collNames []string
 	for database, db := range m.collInfo {
@@ -1227,6 +1237,9 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) (err error) {
 func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
 	log.Ctx(ctx).Debug("remove database", zap.String("name", database))
 	m.mu.Lock()
+	for _, info := range m.collInfo[database] {
+		globalResultCache.RemoveCollection(info.collID)
+	}
 	delete(m.collInfo, database)
 	delete(m.dbInfo, database)
 	m.mu.Unlock()
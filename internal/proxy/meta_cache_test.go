@@ -419,6 +419,34 @@ func (c *MockMixCoordClientInterface) RenameCollection(ctx context.Context, req
 	panic("implement me")
 }
 
+func (c *MockMixCoordClientInterface) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error) {
+	panic("implement me")
+}
+
 func (c *MockMixCoordClientInterface) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("implement me")
 }
@@ -608,6 +636,11 @@ func (c *MockMixCoordClientInterface) GetChannelRecoveryInfo(ctx context.Context
 	panic("implement me")
 }
 
+// TransferSegments copies the flushed segments of a partition into another partition.
+func (c *MockMixCoordClientInterface) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest, opts ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error) {
+	panic("implement me")
+}
+
 // GetFlushedSegments returns flushed segment list of requested collection/parition
 //
 // ctx is the context to control request deadline and cancellation
@@ -18,6 +18,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
@@ -119,6 +121,19 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	}
 	log := log.Ctx(ctx)
 	log.RatedDebug(60, "PrivilegeInterceptor", zap.String("type", reflect.TypeOf(req).String()))
+	if r, ok := req.(*proxypb.FederatedSearchRequest); ok {
+		// the federated search refers many collections, the search privilege is checked on each of them.
+		for _, collectionName := range r.GetCollectionNames() {
+			if _, err := PrivilegeInterceptor(ctx, &milvuspb.SearchRequest{DbName: r.GetDbName(), CollectionName: collectionName}); err != nil {
+				return ctx, err
+			}
+		}
+		return ctx, nil
+	}
+	if r, ok := req.(*querypb.BeginReadSessionRequest); ok {
+		// the read session pins a snapshot of the collection for the later reads, which requires the query privilege.
+		return PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{DbName: r.GetDbName(), CollectionName: r.GetCollectionName()})
+	}
 	privilegeExt, err := funcutil.GetPrivilegeExtObj(req)
 	if err != nil {
 		log.RatedInfo(60, "GetPrivilegeExtObj err", zap.Error(err))
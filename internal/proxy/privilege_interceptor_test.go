@@ -11,6 +11,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -321,6 +323,28 @@ func TestPrivilegeGroup(t *testing.T) {
 
 		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &milvuspb.ShowCollectionsRequest{})
 		assert.NoError(t, err)
+
+		// the federated search requires the search privilege on every collection.
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &proxypb.FederatedSearchRequest{
+			CollectionNames: []string{"coll1"},
+		})
+		assert.NoError(t, err)
+
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &proxypb.FederatedSearchRequest{
+			CollectionNames: []string{"coll1", "coll2"},
+		})
+		assert.Error(t, err)
+
+		// the read session requires the query privilege on the collection.
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &querypb.BeginReadSessionRequest{
+			CollectionName: "coll1",
+		})
+		assert.NoError(t, err)
+
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &querypb.BeginReadSessionRequest{
+			CollectionName: "coll2",
+		})
+		assert.Error(t, err)
 	})
 
 	t.Run("grant ReadOnly to all collection", func(t *testing.T) {
@@ -125,6 +125,12 @@ type Proxy struct {
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
 
+	// replication lag of the follower cluster
+	followerLagMonitor *followerLagMonitor
+
+	// reports the searched and queried collections for the idle release
+	collectionAccess *collectionAccessReporter
+
 	// materialized view
 	enableMaterializedView bool
 
@@ -140,7 +146,9 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	n := 1024 // better to be configurable
 	mgr := newShardClientMgr()
+	collectionAccess := newCollectionAccessReporter()
 	lbPolicy := NewLBPolicyImpl(mgr)
+	lbPolicy.collectionAccess = collectionAccess
 	lbPolicy.Start(ctx)
 	resourceManager := resource.NewManager(10*time.Second, 20*time.Second, make(map[string]time.Duration))
 	replicateStreamManager := NewReplicateStreamManager(ctx, factory, resourceManager)
@@ -152,6 +160,7 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		shardMgr:               mgr,
 		simpleLimiter:          NewSimpleLimiter(Params.QuotaConfig.AllocWaitInterval.GetAsDuration(time.Millisecond), Params.QuotaConfig.AllocRetryTimes.GetAsUint()),
 		lbPolicy:               lbPolicy,
+		collectionAccess:       collectionAccess,
 		resourceManager:        resourceManager,
 		replicateStreamManager: replicateStreamManager,
 		slowQueries:            expirable.NewLRU[Timestamp, *metricsinfo.SlowQuery](20, nil, time.Minute*15),
@@ -433,6 +442,17 @@ func (node *Proxy) Start() error {
 		node.sendChannelsTimeTickLoop()
 	}
 
+	if Params.CommonCfg.FollowerEnabled.GetAsBool() && streamingutil.IsStreamingServiceEnabled() {
+		node.followerLagMonitor = newFollowerLagMonitor(node.ctx)
+		node.followerLagMonitor.Start()
+		log.Info("start follower lag monitor done", zap.String("role", typeutil.ProxyRole))
+	}
+
+	if node.collectionAccess != nil {
+		node.collectionAccess.Start(node.ctx, node.mixCoord)
+		log.Debug("start collection access reporter done", zap.String("role", typeutil.ProxyRole))
+	}
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -480,6 +500,16 @@ func (node *Proxy) Stop() error {
 		}
 	}
 
+	if node.followerLagMonitor != nil {
+		node.followerLagMonitor.Close()
+		log.Info("close follower lag monitor", zap.String("role", typeutil.ProxyRole))
+	}
+
+	if node.collectionAccess != nil {
+		node.collectionAccess.Close()
+		log.Info("close collection access reporter", zap.String("role", typeutil.ProxyRole))
+	}
+
 	for _, cb := range node.closeCallbacks {
 		cb()
 	}
@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// readSessionTTL returns the ttl of the read session requested, the default ttl is used if it's not positive.
+func readSessionTTL(ttlSeconds int64) (time.Duration, error) {
+	if ttlSeconds <= 0 {
+		return paramtable.Get().ProxyCfg.ReadSessionDefaultTTL.GetAsDuration(time.Second), nil
+	}
+	ttl := time.Duration(ttlSeconds) * time.Second
+	if maxTTL := paramtable.Get().ProxyCfg.ReadSessionMaxTTL.GetAsDuration(time.Second); ttl > maxTTL {
+		return 0, merr.WrapErrParameterInvalidRange(int64(1), int64(maxTTL/time.Second), ttlSeconds, "read session ttl exceeds the limit")
+	}
+	return ttl, nil
+}
+
+// readSessionTimestamp returns the pinned timestamp of the read session carried by the params,
+// returns 0 if the request doesn't belong to any read session.
+// The session is resolved by the query coord, so the unknown, expired or ended sessions are rejected.
+func readSessionTimestamp(ctx context.Context, mixCoord types.MixCoordClient, params []*commonpb.KeyValuePair, collectionID int64) (uint64, error) {
+	id, err := funcutil.GetAttrByKeyFromRepeatedKV(ReadSessionKey, params)
+	if err != nil {
+		return 0, nil
+	}
+	resp, err := mixCoord.GetReadSession(ctx, &querypb.GetReadSessionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		SessionId: id,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return 0, err
+	}
+	if resp.GetCollectionID() != collectionID {
+		return 0, merr.WrapErrParameterInvalidMsg("read session %s doesn't belong to collection %d", id, collectionID)
+	}
+	return resp.GetTimestamp(), nil
+}
@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestReadSessionTTL(t *testing.T) {
+	paramtable.Init()
+	ttl, err := readSessionTTL(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 300*time.Second, ttl)
+
+	ttl, err = readSessionTTL(60)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+
+	_, err = readSessionTTL(3601)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestReadSessionTimestamp(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	mixCoord := mocks.NewMockMixCoordClient(t)
+	params := []*commonpb.KeyValuePair{{Key: ReadSessionKey, Value: "session"}}
+
+	ts, err := readSessionTimestamp(ctx, mixCoord, nil, 100)
+	assert.NoError(t, err)
+	assert.Zero(t, ts)
+
+	mixCoord.EXPECT().GetReadSession(mock.Anything, mock.Anything).Return(&querypb.GetReadSessionResponse{
+		Status:       merr.Success(),
+		CollectionID: 100,
+		Timestamp:    1000,
+	}, nil).Twice()
+	ts, err = readSessionTimestamp(ctx, mixCoord, params, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), ts)
+
+	// the session of another collection.
+	_, err = readSessionTimestamp(ctx, mixCoord, params, 101)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	// the unknown or expired session.
+	mixCoord.EXPECT().GetReadSession(mock.Anything, mock.Anything).Return(&querypb.GetReadSessionResponse{
+		Status: merr.Status(merr.WrapErrParameterInvalidMsg("read session session is unknown or expired")),
+	}, nil).Once()
+	_, err = readSessionTimestamp(ctx, mixCoord, params, 100)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	mixCoord.EXPECT().GetReadSession(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	_, err = readSessionTimestamp(ctx, mixCoord, params, 100)
+	assert.Error(t, err)
+}
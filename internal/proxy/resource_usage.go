@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// resourceUsage is the resource usage summary of a search or query request,
+// summed over the costs returned by all the shards.
+type resourceUsage struct {
+	segmentsScanned  int64
+	rowsScanned      int64
+	storageBytes     int64
+	queryNodeCPUTime time.Duration
+	reduceTime       time.Duration
+}
+
+func (u *resourceUsage) add(cost *internalpb.CostAggregation) {
+	u.segmentsScanned += cost.GetTotalSegmentsScanned()
+	u.rowsScanned += cost.GetTotalRowsScanned()
+	u.storageBytes += cost.GetTotalStorageBytes()
+	u.queryNodeCPUTime += time.Duration(cost.GetTotalQuerynodeCpuTimeUs()) * time.Microsecond
+}
+
+// merge adds the usage on the querynodes of the other request, such as the requery of search,
+// the reduce time is not merged since it's counted by the outer request already.
+func (u *resourceUsage) merge(other resourceUsage) {
+	u.segmentsScanned += other.segmentsScanned
+	u.rowsScanned += other.rowsScanned
+	u.storageBytes += other.storageBytes
+	u.queryNodeCPUTime += other.queryNodeCPUTime
+}
+
+// SetResourceUsage sets the resource usage summary into the status extra info if proxy.returnResourceUsage is enabled.
+func SetResourceUsage(status *commonpb.Status, usage resourceUsage) {
+	if !paramtable.Get().ProxyCfg.ReturnResourceUsage.GetAsBool() {
+		return
+	}
+	if !merr.Ok(status) {
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[common.ResourceUsageSegmentsScannedKey] = strconv.FormatInt(usage.segmentsScanned, 10)
+	status.ExtraInfo[common.ResourceUsageRowsScannedKey] = strconv.FormatInt(usage.rowsScanned, 10)
+	status.ExtraInfo[common.ResourceUsageStorageBytesKey] = strconv.FormatInt(usage.storageBytes, 10)
+	status.ExtraInfo[common.ResourceUsageQueryNodeCPUTimeKey] = strconv.FormatInt(usage.queryNodeCPUTime.Microseconds(), 10)
+	status.ExtraInfo[common.ResourceUsageReduceTimeKey] = strconv.FormatInt(usage.reduceTime.Microseconds(), 10)
+}
@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestResourceUsage(t *testing.T) {
+	paramtable.Init()
+
+	usage := resourceUsage{}
+	usage.add(&internalpb.CostAggregation{TotalSegmentsScanned: 2, TotalRowsScanned: 100, TotalStorageBytes: 1024, TotalQuerynodeCpuTimeUs: 300})
+	usage.add(&internalpb.CostAggregation{TotalSegmentsScanned: 1, TotalRowsScanned: 50, TotalQuerynodeCpuTimeUs: 200})
+	usage.add(nil)
+	usage.reduceTime = 20 * time.Microsecond
+
+	requery := resourceUsage{segmentsScanned: 1, rowsScanned: 10, queryNodeCPUTime: time.Millisecond, reduceTime: time.Second}
+	usage.merge(requery)
+	assert.Equal(t, resourceUsage{
+		segmentsScanned:  4,
+		rowsScanned:      160,
+		storageBytes:     1024,
+		queryNodeCPUTime: 1500 * time.Microsecond,
+		reduceTime:       20 * time.Microsecond,
+	}, usage)
+
+	// disabled by default.
+	status := merr.Success()
+	SetResourceUsage(status, usage)
+	assert.Empty(t, status.GetExtraInfo())
+
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.ReturnResourceUsage.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.ReturnResourceUsage.Key)
+	SetResourceUsage(status, usage)
+	assert.Equal(t, map[string]string{
+		common.ResourceUsageSegmentsScannedKey:  "4",
+		common.ResourceUsageRowsScannedKey:      "160",
+		common.ResourceUsageStorageBytesKey:     "1024",
+		common.ResourceUsageQueryNodeCPUTimeKey: "1500",
+		common.ResourceUsageReduceTimeKey:       "20",
+	}, status.GetExtraInfo())
+
+	// not set for the failed request.
+	status = merr.Status(merr.ErrServiceNotReady)
+	SetResourceUsage(status, usage)
+	assert.NotContains(t, status.GetExtraInfo(), common.ResourceUsageSegmentsScannedKey)
+}
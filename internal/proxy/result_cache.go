@@ -0,0 +1,236 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	searchResultCacheName = "SearchResultCache"
+	queryResultCacheName  = "QueryResultCache"
+)
+
+// globalResultCache caches the search and query results of the proxy.
+var globalResultCache = newResultCache()
+
+// resultCacheKey identifies a normalized read request of a collection.
+type resultCacheKey struct {
+	collectionID int64
+	digest       [sha256.Size]byte
+}
+
+// newResultCacheKey digests the requests, which should have the per-request fields such as
+// base, timestamps and username cleared, so the same reads share the same key.
+func newResultCacheKey(collectionID int64, requests ...proto.Message) (resultCacheKey, error) {
+	h := sha256.New()
+	for _, req := range requests {
+		bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+		if err != nil {
+			return resultCacheKey{}, err
+		}
+		// the length prefix keeps the boundaries of the requests.
+		if err := binary.Write(h, binary.LittleEndian, int64(len(bytes))); err != nil {
+			return resultCacheKey{}, err
+		}
+		h.Write(bytes)
+	}
+	key := resultCacheKey{collectionID: collectionID}
+	copy(key.digest[:], h.Sum(nil))
+	return key, nil
+}
+
+// isResultCacheable returns whether the reads of the consistency level can be served from the cache.
+// The Strong and Session reads always require the latest writes, so they are never cached.
+func isResultCacheable(level commonpb.ConsistencyLevel) bool {
+	if !paramtable.Get().ProxyCfg.ResultCacheEnabled.GetAsBool() {
+		return false
+	}
+	return level == commonpb.ConsistencyLevel_Bounded || level == commonpb.ConsistencyLevel_Eventually
+}
+
+type resultCacheEntry struct {
+	key      resultCacheKey
+	ts       uint64
+	cachedAt time.Time
+	size     int64
+	result   proto.Message
+}
+
+// resultCache is a memory bounded lru cache of the read results.
+// Each result is cached with the timestamp of the data it sees, it serves the reads whose guarantee timestamp
+// is not greater than it. The writes of a collection raise the watermark of the collection,
+// the results older than the watermark are never served, so the reads see their own writes through this proxy.
+// The writes through the other proxies are unknown, the channels time tick raises the watermark of all collections,
+// so the results are never older than the graceful time of the bounded consistency.
+type resultCache struct {
+	mu         sync.Mutex
+	entries    map[resultCacheKey]*list.Element
+	lru        *list.List
+	watermarks map[int64]uint64
+	tick       uint64
+	size       int64
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{
+		entries:    make(map[resultCacheKey]*list.Element),
+		lru:        list.New(),
+		watermarks: make(map[int64]uint64),
+	}
+}
+
+// Get returns a copy of the cached result which is fresh enough for the guarantee timestamp.
+func (c *resultCache) Get(name string, key resultCacheKey, guaranteeTs uint64) (proto.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), name, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	entry := elem.Value.(*resultCacheEntry)
+	ttl := paramtable.Get().ProxyCfg.ResultCacheTTL.GetAsDuration(time.Second)
+	if time.Since(entry.cachedAt) > ttl || entry.ts < c.watermark(key.collectionID) {
+		c.remove(elem)
+		metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), name, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	if entry.ts < guaranteeTs {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), name, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), name, metrics.CacheHitLabel).Inc()
+	return proto.Clone(entry.result), true
+}
+
+// Put caches a copy of the result which sees the data until ts.
+func (c *resultCache) Put(key resultCacheKey, ts uint64, result proto.Message) {
+	size := int64(proto.Size(result))
+	limit := paramtable.Get().ProxyCfg.ResultCacheMemoryLimit.GetAsInt64()
+	if size > limit {
+		return
+	}
+	result = proto.Clone(result)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the result may be computed before a write finished.
+	if ts < c.watermark(key.collectionID) {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		if elem.Value.(*resultCacheEntry).ts > ts {
+			return
+		}
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&resultCacheEntry{
+		key:      key,
+		ts:       ts,
+		cachedAt: time.Now(),
+		size:     size,
+		result:   result,
+	})
+	c.size += size
+	for c.size > limit {
+		c.remove(c.lru.Back())
+	}
+	metrics.ProxyResultCacheMemorySize.WithLabelValues(paramtable.GetStringNodeID()).Set(float64(c.size))
+}
+
+// Invalidate raises the watermark of the collection to the timestamp of a write,
+// the results older than the watermark are removed lazily.
+func (c *resultCache) Invalidate(collectionID int64, ts uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts > c.watermarks[collectionID] {
+		c.watermarks[collectionID] = ts
+	}
+}
+
+// Tick raises the watermark of all collections with the time tick of the channels,
+// the results older than the graceful time before the tick are removed lazily.
+func (c *resultCache) Tick(ts uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts > c.tick {
+		c.tick = ts
+	}
+}
+
+// watermark returns the min timestamp of the data seen by the results of the collection which can be served.
+func (c *resultCache) watermark(collectionID int64) uint64 {
+	watermark := c.watermarks[collectionID]
+	if c.tick == 0 {
+		return watermark
+	}
+	gracefulTime := paramtable.Get().CommonCfg.GracefulTime.GetAsDuration(time.Millisecond)
+	return max(watermark, tsoutil.AddPhysicalDurationOnTs(c.tick, -gracefulTime))
+}
+
+// RemoveCollection removes all the results of the collection, it's called once the meta of the collection changes.
+func (c *resultCache) RemoveCollection(collectionID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeIf(func(entry *resultCacheEntry) bool {
+		return entry.key.collectionID == collectionID
+	})
+}
+
+func (c *resultCache) removeIf(predicate func(entry *resultCacheEntry) bool) {
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if predicate(elem.Value.(*resultCacheEntry)) {
+			c.remove(elem)
+		}
+		elem = next
+	}
+	metrics.ProxyResultCacheMemorySize.WithLabelValues(paramtable.GetStringNodeID()).Set(float64(c.size))
+}
+
+func (c *resultCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*resultCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+// getResultTs returns the timestamp of the data seen by the result from the mvcc timestamps of the channels.
+func getResultTs(channelsMvcc map[string]Timestamp, guaranteeTs Timestamp) Timestamp {
+	if len(channelsMvcc) == 0 {
+		return guaranteeTs
+	}
+	ts := typeutil.MaxTimestamp
+	for _, mvcc := range channelsMvcc {
+		ts = min(ts, mvcc)
+	}
+	return max(ts, guaranteeTs)
+}
@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+func newResultCacheTestKey(t *testing.T, collectionID int64, plan string) resultCacheKey {
+	key, err := newResultCacheKey(collectionID, &internalpb.SearchRequest{SerializedExprPlan: []byte(plan)})
+	assert.NoError(t, err)
+	return key
+}
+
+func TestResultCacheKey(t *testing.T) {
+	key1 := newResultCacheTestKey(t, 1, "plan")
+	assert.Equal(t, key1, newResultCacheTestKey(t, 1, "plan"))
+	assert.NotEqual(t, key1, newResultCacheTestKey(t, 2, "plan"))
+	assert.NotEqual(t, key1, newResultCacheTestKey(t, 1, "plan2"))
+}
+
+func TestIsResultCacheable(t *testing.T) {
+	assert.False(t, isResultCacheable(commonpb.ConsistencyLevel_Bounded))
+
+	paramtable.Get().Save(Params.ProxyCfg.ResultCacheEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ResultCacheEnabled.Key)
+	assert.True(t, isResultCacheable(commonpb.ConsistencyLevel_Bounded))
+	assert.True(t, isResultCacheable(commonpb.ConsistencyLevel_Eventually))
+	assert.False(t, isResultCacheable(commonpb.ConsistencyLevel_Strong))
+	assert.False(t, isResultCacheable(commonpb.ConsistencyLevel_Session))
+}
+
+func TestResultCache(t *testing.T) {
+	result := &milvuspb.QueryResults{CollectionName: "coll", OutputFields: []string{"pk"}}
+
+	t.Run("guarantee timestamp", func(t *testing.T) {
+		c := newResultCache()
+		key := newResultCacheTestKey(t, 1, "plan")
+		_, ok := c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+
+		c.Put(key, 100, result)
+		cached, ok := c.Get(queryResultCacheName, key, 100)
+		assert.True(t, ok)
+		assert.True(t, proto.Equal(result, cached))
+		// the cached result doesn't see the data until the guarantee timestamp.
+		_, ok = c.Get(queryResultCacheName, key, 101)
+		assert.False(t, ok)
+
+		// the older result doesn't replace the newer one.
+		c.Put(key, 50, result)
+		_, ok = c.Get(queryResultCacheName, key, 100)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c := newResultCache()
+		key := newResultCacheTestKey(t, 1, "plan")
+		otherKey := newResultCacheTestKey(t, 2, "plan")
+		c.Put(key, 100, result)
+		c.Put(otherKey, 100, result)
+
+		c.Invalidate(1, 200)
+		_, ok := c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+		_, ok = c.Get(queryResultCacheName, otherKey, 0)
+		assert.True(t, ok)
+
+		// the result computed before the write is not cached.
+		c.Put(key, 150, result)
+		_, ok = c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+		c.Put(key, 200, result)
+		_, ok = c.Get(queryResultCacheName, key, 0)
+		assert.True(t, ok)
+
+		c.RemoveCollection(1)
+		_, ok = c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+		assert.Equal(t, int64(proto.Size(result)), c.size)
+	})
+
+	t.Run("time tick", func(t *testing.T) {
+		c := newResultCache()
+		key := newResultCacheTestKey(t, 1, "plan")
+		now := tsoutil.ComposeTSByTime(time.Now(), 0)
+		gracefulTime := Params.CommonCfg.GracefulTime.GetAsDuration(time.Millisecond)
+		c.Put(key, tsoutil.AddPhysicalDurationOnTs(now, -gracefulTime/2), result)
+
+		// the result within the graceful time before the tick is served.
+		c.Tick(now)
+		_, ok := c.Get(queryResultCacheName, key, 0)
+		assert.True(t, ok)
+
+		// the result older than the graceful time before the tick may miss the writes through other proxies.
+		c.Tick(tsoutil.AddPhysicalDurationOnTs(now, gracefulTime))
+		_, ok = c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+		c.Put(key, now, result)
+		_, ok = c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.ResultCacheTTL.Key, "0")
+		defer paramtable.Get().Reset(Params.ProxyCfg.ResultCacheTTL.Key)
+		c := newResultCache()
+		key := newResultCacheTestKey(t, 1, "plan")
+		c.Put(key, 100, result)
+		_, ok := c.Get(queryResultCacheName, key, 0)
+		assert.False(t, ok)
+		assert.Zero(t, c.size)
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		size := proto.Size(result)
+		paramtable.Get().Save(Params.ProxyCfg.ResultCacheMemoryLimit.Key, "2")
+		defer paramtable.Get().Reset(Params.ProxyCfg.ResultCacheMemoryLimit.Key)
+		c := newResultCache()
+		// the result larger than the limit is never cached.
+		c.Put(newResultCacheTestKey(t, 1, "plan"), 100, result)
+		assert.Zero(t, c.size)
+
+		paramtable.Get().Save(Params.ProxyCfg.ResultCacheMemoryLimit.Key, paramtable.Get().ProxyCfg.ResultCacheMemoryLimit.DefaultValue)
+		for i := 0; i < 3; i++ {
+			c.Put(newResultCacheTestKey(t, 1, string(rune('a'+i))), 100, result)
+		}
+		assert.Equal(t, int64(3*size), c.size)
+		paramtable.Get().Save(Params.ProxyCfg.ResultCacheMemoryLimit.Key, "0")
+		c.Put(newResultCacheTestKey(t, 1, "d"), 100, &milvuspb.QueryResults{})
+		// the least recently used results are evicted.
+		assert.Zero(t, c.size)
+		assert.Equal(t, 1, c.lru.Len())
+	})
+}
+
+func TestGetResultTs(t *testing.T) {
+	assert.Equal(t, uint64(100), getResultTs(nil, 100))
+	assert.Equal(t, uint64(150), getResultTs(map[string]Timestamp{"ch1": 200, "ch2": 150}, 100))
+	assert.Equal(t, uint64(100), getResultTs(map[string]Timestamp{"ch1": 50}, 100))
+}
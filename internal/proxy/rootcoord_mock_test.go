@@ -1191,6 +1191,34 @@ func (coord *MixCoordMock) RenameCollection(ctx context.Context, req *milvuspb.R
 	return &commonpb.Status{}, nil
 }
 
+func (coord *MixCoordMock) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *MixCoordMock) ListProjections(ctx context.Context, in *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error) {
+	return &rootcoordpb.ListProjectionsResponse{}, nil
+}
+
 func (coord *MixCoordMock) DescribeDatabase(ctx context.Context, in *rootcoordpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	return &rootcoordpb.DescribeDatabaseResponse{}, nil
 }
@@ -1203,6 +1231,10 @@ func (coord *MixCoordMock) GetChannelRecoveryInfo(ctx context.Context, in *datap
 	return &datapb.GetChannelRecoveryInfoResponse{}, nil
 }
 
+func (coord *MixCoordMock) TransferSegments(ctx context.Context, in *datapb.TransferSegmentsRequest, opts ...grpc.CallOption) (*datapb.TransferSegmentsResponse, error) {
+	return &datapb.TransferSegmentsResponse{}, nil
+}
+
 func (coord *MixCoordMock) BackupRBAC(ctx context.Context, in *milvuspb.BackupRBACMetaRequest, opts ...grpc.CallOption) (*milvuspb.BackupRBACMetaResponse, error) {
 	return &milvuspb.BackupRBACMetaResponse{}, nil
 }
@@ -1434,6 +1466,12 @@ func (coord *MixCoordMock) GetIndexBuildProgress(ctx context.Context, req *index
 	}, nil
 }
 
+func (coord *MixCoordMock) GetFunctionBackfillProgress(ctx context.Context, req *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return &indexpb.GetFunctionBackfillProgressResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
 func (coord *MixCoordMock) ShowLoadCollections(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error) {
 	if coord.ShowLoadCollectionsFunc != nil {
 		return coord.ShowLoadCollectionsFunc(ctx, in)
@@ -1608,6 +1646,22 @@ func (coord *MixCoordMock) UpdateLoadConfig(ctx context.Context, in *querypb.Upd
 	return merr.Success(), nil
 }
 
+func (coord *MixCoordMock) ReportCollectionAccess(ctx context.Context, in *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+	return &querypb.ReportCollectionAccessResponse{Status: merr.Success()}, nil
+}
+
+func (coord *MixCoordMock) BeginReadSession(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	return &querypb.BeginReadSessionResponse{Status: merr.Success()}, nil
+}
+
+func (coord *MixCoordMock) GetReadSession(ctx context.Context, in *querypb.GetReadSessionRequest, opts ...grpc.CallOption) (*querypb.GetReadSessionResponse, error) {
+	return &querypb.GetReadSessionResponse{Status: merr.Success()}, nil
+}
+
+func (coord *MixCoordMock) EndReadSession(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (coord *MixCoordMock) GetRecoveryInfoV2(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error) {
 	return &datapb.GetRecoveryInfoResponseV2{}, nil
 }
@@ -3,12 +3,16 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/rerank"
 	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -469,3 +473,279 @@ func fillInEmptyResult(numQueries int64) *milvuspb.SearchResults {
 		},
 	}
 }
+
+// reduceFederatedSearchResults merges the results of the sub-searches of a federated search. The hits are ordered by
+// score, the ties are broken by the smaller pk and then the order of the collections, so the pagination is stable.
+// It returns the merged result and the collection of each hit. If there is a function score, all the candidates are merged
+// and reranked by the function score at once, then paginated.
+func reduceFederatedSearchResults(ctx context.Context, tasks []*searchTask, limit int64, offset int64, functionScore *federatedFunctionScore) (*schemapb.SearchResultData, []string, error) {
+	nq := tasks[0].SearchRequest.GetNq()
+	log := log.Ctx(ctx).With(zap.Int64("nq", nq), zap.Int64("limit", limit), zap.Int64("offset", offset))
+
+	var (
+		metricType        string
+		positivelyRelated bool
+		pkType            schemapb.DataType
+		reference         *schemapb.SearchResultData
+		allSearchCount    int64
+		subSearchData     = make([]*schemapb.SearchResultData, 0, len(tasks))
+		sortableData      = make([]*schemapb.SearchResultData, 0, len(tasks))
+		collectionNames   = make([]string, 0, len(tasks))
+		candidates        int64
+	)
+	for i, t := range tasks {
+		collectionName := t.request.GetCollectionName()
+		data := t.result.GetResults()
+		if int64(len(data.GetTopks())) != nq {
+			return nil, nil, merr.WrapErrServiceInternal(fmt.Sprintf("the result of collection %s has %d queries, expected %d",
+				collectionName, len(data.GetTopks()), nq))
+		}
+		allSearchCount += data.GetAllSearchCount()
+
+		subMetricType := ""
+		if len(t.queryInfos) > 0 {
+			subMetricType = t.queryInfos[0].GetMetricType()
+		}
+		subPositivelyRelated := t.functionScore != nil || metric.PositivelyRelated(subMetricType)
+		if i == 0 {
+			metricType, positivelyRelated = subMetricType, subPositivelyRelated
+		} else if !strings.EqualFold(metricType, subMetricType) || positivelyRelated != subPositivelyRelated {
+			return nil, nil, merr.WrapErrParameterInvalidMsg("collection %s is searched with metric type %s, but collection %s with %s",
+				tasks[0].request.GetCollectionName(), metricType, collectionName, subMetricType)
+		}
+
+		if typeutil.GetSizeOfIDs(data.GetIds()) == 0 {
+			continue
+		}
+		subPkType := schemapb.DataType_Int64
+		if data.GetIds().GetStrId() != nil {
+			subPkType = schemapb.DataType_VarChar
+		}
+		if reference == nil {
+			reference, pkType = data, subPkType
+		} else {
+			if pkType != subPkType {
+				return nil, nil, merr.WrapErrParameterInvalidMsg("the primary key of collection %s is %s, but %s of collection %s",
+					collectionName, subPkType.String(), pkType.String(), tasks[0].request.GetCollectionName())
+			}
+			fieldsData, err := alignFederatedFieldsData(reference.GetFieldsData(), data.GetFieldsData())
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "collection %s is incompatible", collectionName)
+			}
+			data.FieldsData = fieldsData
+		}
+
+		// the scores are compared as the greater the better while merging.
+		scores := data.GetScores()
+		if !positivelyRelated {
+			scores = make([]float32, len(data.GetScores()))
+			for k, score := range data.GetScores() {
+				scores[k] = -score
+			}
+		}
+		candidates += lo.Max(data.GetTopks())
+		subSearchData = append(subSearchData, data)
+		sortableData = append(sortableData, &schemapb.SearchResultData{
+			Ids:    data.GetIds(),
+			Topks:  data.GetTopks(),
+			Scores: scores,
+		})
+		collectionNames = append(collectionNames, collectionName)
+	}
+	if reference == nil {
+		ret := fillInEmptyResult(nq).GetResults()
+		ret.AllSearchCount = allSearchCount
+		return ret, nil, nil
+	}
+
+	mergeLimit, mergeOffset := limit, offset
+	if functionScore != nil {
+		mergeLimit, mergeOffset = candidates, 0
+	}
+	ret := &milvuspb.SearchResults{
+		Results: &schemapb.SearchResultData{
+			NumQueries:       nq,
+			FieldsData:       typeutil.PrepareResultFieldData(reference.GetFieldsData(), mergeLimit),
+			Scores:           make([]float32, 0, mergeLimit*nq),
+			Ids:              &schemapb.IDs{},
+			Topks:            make([]int64, 0, nq),
+			OutputFields:     reference.GetOutputFields(),
+			PrimaryFieldName: reference.GetPrimaryFieldName(),
+			AllSearchCount:   allSearchCount,
+		},
+	}
+	if err := setupIdListForSearchResult(ret, pkType, mergeLimit*nq); err != nil {
+		return nil, nil, err
+	}
+
+	subSearchNum := len(subSearchData)
+	subSearchNqOffset := make([][]int64, subSearchNum)
+	for i := 0; i < subSearchNum; i++ {
+		subSearchNqOffset[i] = make([]int64, nq)
+		for j := int64(1); j < nq; j++ {
+			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchData[i].Topks[j-1]
+		}
+	}
+
+	hitCollections := make([]string, 0, mergeLimit*nq)
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	var retSize int64
+	for i := int64(0); i < nq; i++ {
+		cursors := make([]int64, subSearchNum)
+		// skip offset results
+		for k := int64(0); k < mergeOffset; k++ {
+			subSearchIdx, _ := selectHighestScoreIndex(ctx, sortableData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+		}
+
+		var j int64
+		for j = 0; j < mergeLimit; j++ {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(ctx, sortableData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchData[subSearchIdx].GetFieldsData(), resultDataIdx)
+			typeutil.CopyPk(ret.Results.Ids, subSearchData[subSearchIdx].GetIds(), int(resultDataIdx))
+			ret.Results.Scores = append(ret.Results.Scores, subSearchData[subSearchIdx].Scores[resultDataIdx])
+			hitCollections = append(hitCollections, collectionNames[subSearchIdx])
+			cursors[subSearchIdx]++
+		}
+		ret.Results.Topks = append(ret.Results.Topks, j)
+		ret.Results.TopK = j
+
+		// limit search result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	log.Debug("federated search results reduced", zap.Int("collections", subSearchNum), zap.Int("hits", len(hitCollections)))
+	if functionScore == nil {
+		return ret.Results, hitCollections, nil
+	}
+
+	roundDecimal := int64(-1)
+	if len(tasks[0].queryInfos) > 0 {
+		roundDecimal = tasks[0].queryInfos[0].GetRoundDecimal()
+	}
+	params := rerank.NewSearchParams(nq, limit, offset, roundDecimal, -1, 1, false, "", []string{metricType})
+	return rerankFederatedSearchResults(ctx, functionScore, ret.Results, hitCollections, params)
+}
+
+// rerankFederatedSearchResults applies the function score over the merged candidates of the collections,
+// the candidates are identified by their positions in the merged result, as the pks of the collections may collide.
+func rerankFederatedSearchResults(ctx context.Context, functionScore *federatedFunctionScore, merged *schemapb.SearchResultData,
+	hitCollections []string, params *rerank.SearchParams,
+) (*schemapb.SearchResultData, []string, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(functionScore.schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	positions := &schemapb.IDs{}
+	if pkField.GetDataType() == schemapb.DataType_Int64 {
+		ids := make([]int64, len(hitCollections))
+		for i := range ids {
+			ids[i] = int64(i)
+		}
+		positions.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+	} else {
+		ids := make([]string, len(hitCollections))
+		for i := range ids {
+			ids[i] = strconv.Itoa(i)
+		}
+		positions.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids}}
+	}
+	// the output fields are aligned by name, the input fields of the function score are identified by the ids
+	// of the collection it's created with.
+	fieldIDs := lo.SliceToMap(functionScore.schema.GetFields(), func(field *schemapb.FieldSchema) (string, int64) {
+		return field.GetName(), field.GetFieldID()
+	})
+	candidates := &schemapb.SearchResultData{
+		NumQueries: merged.GetNumQueries(),
+		TopK:       merged.GetTopK(),
+		Topks:      merged.GetTopks(),
+		Scores:     merged.GetScores(),
+		Ids:        positions,
+		FieldsData: lo.Map(merged.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type:      fieldData.GetType(),
+				FieldName: fieldData.GetFieldName(),
+				FieldId:   fieldIDs[fieldData.GetFieldName()],
+				Field:     fieldData.GetField(),
+				ValidData: fieldData.GetValidData(),
+				IsDynamic: fieldData.GetIsDynamic(),
+			}
+		}),
+	}
+	ranked, err := functionScore.Process(ctx, params, []*milvuspb.SearchResults{{Results: candidates}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extraOutputFields := typeutil.NewSet(functionScore.extraOutputFields...)
+	fieldsData := lo.Filter(merged.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+		return !extraOutputFields.Contain(fieldData.GetFieldName())
+	})
+	rankedIDs := ranked.GetResults().GetIds()
+	size := typeutil.GetSizeOfIDs(rankedIDs)
+	ret := &milvuspb.SearchResults{
+		Results: &schemapb.SearchResultData{
+			NumQueries: merged.GetNumQueries(),
+			TopK:       ranked.GetResults().GetTopK(),
+			Topks:      ranked.GetResults().GetTopks(),
+			Scores:     ranked.GetResults().GetScores(),
+			Ids:        &schemapb.IDs{},
+			FieldsData: typeutil.PrepareResultFieldData(fieldsData, int64(size)),
+			OutputFields: lo.Filter(merged.GetOutputFields(), func(name string, _ int) bool {
+				return !extraOutputFields.Contain(name)
+			}),
+			PrimaryFieldName: merged.GetPrimaryFieldName(),
+			AllSearchCount:   merged.GetAllSearchCount(),
+		},
+	}
+	pkType := schemapb.DataType_Int64
+	if merged.GetIds().GetStrId() != nil {
+		pkType = schemapb.DataType_VarChar
+	}
+	if err := setupIdListForSearchResult(ret, pkType, int64(size)); err != nil {
+		return nil, nil, err
+	}
+	collections := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var pos int64
+		switch id := typeutil.GetPK(rankedIDs, int64(i)).(type) {
+		case int64:
+			pos = id
+		case string:
+			pos, _ = strconv.ParseInt(id, 10, 64)
+		}
+		typeutil.AppendFieldData(ret.Results.FieldsData, fieldsData, pos)
+		typeutil.CopyPk(ret.Results.Ids, merged.GetIds(), int(pos))
+		collections = append(collections, hitCollections[pos])
+	}
+	return ret.Results, collections, nil
+}
+
+// alignFederatedFieldsData reorders the output fields of a collection by the output fields of the reference collection,
+// the fields must exist in both collections with the same data type.
+func alignFederatedFieldsData(reference []*schemapb.FieldData, fieldsData []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	fields := make(map[string]*schemapb.FieldData, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		fields[fieldData.GetFieldName()] = fieldData
+	}
+	aligned := make([]*schemapb.FieldData, 0, len(reference))
+	for _, ref := range reference {
+		fieldData, ok := fields[ref.GetFieldName()]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("output field %s not found", ref.GetFieldName())
+		}
+		if fieldData.GetType() != ref.GetType() {
+			return nil, merr.WrapErrParameterInvalidMsg("the data type of output field %s is %s, expected %s",
+				ref.GetFieldName(), fieldData.GetType().String(), ref.GetType().String())
+		}
+		aligned = append(aligned, fieldData)
+	}
+	return aligned, nil
+}
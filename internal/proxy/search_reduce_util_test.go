@@ -6,7 +6,13 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/rerank"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
 type SearchReduceUtilTestSuite struct {
@@ -61,6 +67,118 @@ func (struts *SearchReduceUtilTestSuite) TestReduceSearchResult() {
 	}
 }
 
+func (struts *SearchReduceUtilTestSuite) TestReduceFederatedSearchResults() {
+	genTask := func(collectionName string, metricType string, ids []int64, scores []float32, fieldsData ...*schemapb.FieldData) *searchTask {
+		return &searchTask{
+			SearchRequest: &internalpb.SearchRequest{Nq: 1},
+			request:       &milvuspb.SearchRequest{CollectionName: collectionName},
+			queryInfos:    []*planpb.QueryInfo{{MetricType: metricType}},
+			result: &milvuspb.SearchResults{
+				Results: &schemapb.SearchResultData{
+					NumQueries: 1,
+					TopK:       int64(len(ids)),
+					Topks:      []int64{int64(len(ids))},
+					Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+					Scores:     scores,
+					FieldsData: fieldsData,
+				},
+			},
+		}
+	}
+
+	struts.Run("merge with offset", func() {
+		tasks := []*searchTask{
+			genTask("a", "L2", []int64{1, 2, 3}, []float32{0.1, 0.3, 0.5},
+				getFieldData("f", 101, schemapb.DataType_Int64, []int64{10, 20, 30}, 1),
+				getFieldData("g", 102, schemapb.DataType_Int32, []int32{11, 21, 31}, 1)),
+			// the output fields are in different order.
+			genTask("b", "L2", []int64{1, 4}, []float32{0.1, 0.2},
+				getFieldData("g", 202, schemapb.DataType_Int32, []int32{41, 51}, 1),
+				getFieldData("f", 201, schemapb.DataType_Int64, []int64{40, 50}, 1)),
+			genEmptySearchTask("c", "L2"),
+		}
+		results, collectionNames, err := reduceFederatedSearchResults(context.Background(), tasks, 3, 1, nil)
+		struts.NoError(err)
+		// (a, 1) and (b, 1) tie, the former collection goes first.
+		struts.Equal([]int64{1, 4, 2}, results.GetIds().GetIntId().GetData())
+		struts.Equal([]float32{0.1, 0.2, 0.3}, results.GetScores())
+		struts.Equal([]string{"b", "b", "a"}, collectionNames)
+		struts.Equal([]int64{3}, results.GetTopks())
+		struts.Equal([]int64{40, 50, 20}, results.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		struts.Equal([]int32{41, 51, 21}, results.GetFieldsData()[1].GetScalars().GetIntData().GetData())
+	})
+
+	struts.Run("function score over merged candidates", func() {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "f", DataType: schemapb.DataType_Int64},
+			},
+		}
+		functionScore, err := rerank.NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{{
+				Name:            "expr",
+				Type:            schemapb.FunctionType_Rerank,
+				InputFieldNames: []string{"f"},
+				Params: []*commonpb.KeyValuePair{
+					{Key: "reranker", Value: "expr"},
+					{Key: "expression", Value: "score * f"},
+				},
+			}},
+		})
+		struts.NoError(err)
+		tasks := []*searchTask{
+			genTask("a", "IP", []int64{1, 2}, []float32{0.9, 0.8}, getFieldData("f", 101, schemapb.DataType_Int64, []int64{1, 10}, 1)),
+			// the pk 1 collides with the one of collection a, and the field id of f differs.
+			genTask("b", "IP", []int64{1, 3}, []float32{0.85, 0.1}, getFieldData("f", 201, schemapb.DataType_Int64, []int64{2, 100}, 1)),
+		}
+		results, collectionNames, err := reduceFederatedSearchResults(context.Background(), tasks, 2, 1,
+			&federatedFunctionScore{FunctionScore: functionScore, schema: schema, extraOutputFields: []string{"f"}})
+		struts.NoError(err)
+		// the reranked scores: (b, 3) 10, (a, 2) 8, (b, 1) 1.7, (a, 1) 0.9
+		struts.Equal([]int64{2, 1}, results.GetIds().GetIntId().GetData())
+		struts.InDeltaSlice([]float32{8, 1.7}, results.GetScores(), 0.001)
+		struts.Equal([]string{"a", "b"}, collectionNames)
+		struts.Equal([]int64{2}, results.GetTopks())
+		// the input field searched for the function score only is removed.
+		struts.Empty(results.GetFieldsData())
+	})
+
+	struts.Run("all empty", func() {
+		results, collectionNames, err := reduceFederatedSearchResults(context.Background(), []*searchTask{genEmptySearchTask("a", "IP")}, 3, 0, nil)
+		struts.NoError(err)
+		struts.Equal([]int64{0}, results.GetTopks())
+		struts.Empty(collectionNames)
+	})
+
+	struts.Run("different metric types", func() {
+		tasks := []*searchTask{
+			genTask("a", "L2", []int64{1}, []float32{0.1}),
+			genTask("b", "IP", []int64{1}, []float32{0.1}),
+		}
+		_, _, err := reduceFederatedSearchResults(context.Background(), tasks, 3, 0, nil)
+		struts.ErrorIs(err, merr.ErrParameterInvalid)
+	})
+
+	struts.Run("incompatible output fields", func() {
+		tasks := []*searchTask{
+			genTask("a", "IP", []int64{1}, []float32{0.1}, getFieldData("f", 101, schemapb.DataType_Int64, []int64{10}, 1)),
+			genTask("b", "IP", []int64{2}, []float32{0.2}, getFieldData("f", 201, schemapb.DataType_Int32, []int32{20}, 1)),
+		}
+		_, _, err := reduceFederatedSearchResults(context.Background(), tasks, 3, 0, nil)
+		struts.ErrorIs(err, merr.ErrParameterInvalid)
+	})
+}
+
+func genEmptySearchTask(collectionName string, metricType string) *searchTask {
+	return &searchTask{
+		SearchRequest: &internalpb.SearchRequest{Nq: 1},
+		request:       &milvuspb.SearchRequest{CollectionName: collectionName},
+		queryInfos:    []*planpb.QueryInfo{{MetricType: metricType}},
+		result:        fillInEmptyResult(1),
+	}
+}
+
 func TestSearchReduceUtilTestSuite(t *testing.T) {
 	suite.Run(t, new(SearchReduceUtilTestSuite))
 }
@@ -9,13 +9,16 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/rerank"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -476,6 +479,12 @@ func parseGroupByInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemap
 	return ret, nil
 }
 
+// rerankCandidateWindow returns the topk to search for the reranker producing the topk results,
+// the candidate window is capped by the topk limit.
+func rerankCandidateWindow(functionScore *rerank.FunctionScore, topk int64) int64 {
+	return max(topk, min(functionScore.CandidateWindow(topk), Params.QuotaConfig.TopKLimit.GetAsInt64()))
+}
+
 // parseRankParams get limit and offset from rankParams, both are optional.
 func parseRankParams(rankParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*rankParams, error) {
 	var (
@@ -577,3 +586,100 @@ func convertHybridSearchToSearch(req *milvuspb.HybridSearchRequest) *milvuspb.Se
 	}
 	return ret
 }
+
+// validateFederatedSearchRequest checks the federated search request, returns the limit and offset of the merged result.
+func validateFederatedSearchRequest(request *proxypb.FederatedSearchRequest) (int64, int64, error) {
+	collectionNames := request.GetCollectionNames()
+	if len(collectionNames) == 0 {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("at least one collection is required in federated search")
+	}
+	if typeutil.NewSet(collectionNames...).Len() != len(collectionNames) {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("duplicated collections in federated search: %v", collectionNames)
+	}
+	searchRequest := request.GetRequest()
+	if searchRequest == nil {
+		return 0, 0, merr.WrapErrParameterMissing("request", "the search request of federated search is required")
+	}
+	if searchRequest.GetSearchByPrimaryKeys() {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("search by primary keys is not supported in federated search")
+	}
+
+	searchParams := searchRequest.GetSearchParams()
+	if isIterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(IteratorField, searchParams); strings.EqualFold(isIterator, "true") {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("search iterator is not supported in federated search")
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParams); err == nil {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("grouping search is not supported in federated search")
+	}
+
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, searchParams)
+	if err != nil {
+		return 0, 0, merr.WrapErrParameterMissing(TopKKey)
+	}
+	limit, err := strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", TopKKey, topKStr)
+	}
+	var offset int64
+	if offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, searchParams); err == nil {
+		if offset, err = strconv.ParseInt(offsetStr, 0, 64); err != nil || offset < 0 {
+			return 0, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", OffsetKey, offsetStr)
+		}
+	}
+	if err := validateLimit(limit + offset); err != nil {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("%s+%s [%d] is invalid, %s", OffsetKey, TopKKey, limit+offset, err.Error())
+	}
+	return limit, offset, nil
+}
+
+// federatedFunctionScore is the function score of a federated search, which is applied once over the merged
+// candidates of all the collections instead of the results of each collection.
+type federatedFunctionScore struct {
+	*rerank.FunctionScore
+	// schema is the schema of the first collection, which the function score is created with.
+	schema *schemapb.CollectionSchema
+	// extraOutputFields are the input fields of the function score not in the output fields,
+	// they're searched for the function score only and removed from the result.
+	extraOutputFields []string
+}
+
+// newFederatedFunctionScore creates the function score of the federated search, nil if there's no function score.
+func newFederatedFunctionScore(ctx context.Context, request *proxypb.FederatedSearchRequest) (*federatedFunctionScore, error) {
+	searchRequest := request.GetRequest()
+	if searchRequest.GetFunctionScore() == nil {
+		return nil, nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionNames()[0])
+	if err != nil {
+		return nil, err
+	}
+	functionScore, err := rerank.NewFunctionScore(schema.CollectionSchema, searchRequest.GetFunctionScore())
+	if err != nil {
+		return nil, err
+	}
+	var extraOutputFields []string
+	outputFields := typeutil.NewSet(searchRequest.GetOutputFields()...)
+	if !outputFields.Contain("*") {
+		extraOutputFields = lo.Filter(functionScore.GetAllInputFieldNames(), func(name string, _ int) bool {
+			return !outputFields.Contain(name)
+		})
+	}
+	return &federatedFunctionScore{
+		FunctionScore:     functionScore,
+		schema:            schema.CollectionSchema,
+		extraOutputFields: extraOutputFields,
+	}, nil
+}
+
+// federatedSubSearchParams returns the search params of the sub-search of a collection, which searches the top k
+// results without offset, as the offset is only meaningful for the merged result.
+func federatedSubSearchParams(searchParams []*commonpb.KeyValuePair, topK int64) []*commonpb.KeyValuePair {
+	params := make([]*commonpb.KeyValuePair, 0, len(searchParams)+1)
+	for _, kv := range searchParams {
+		if kv.GetKey() == TopKKey || kv.GetKey() == OffsetKey {
+			continue
+		}
+		params = append(params, &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()})
+	}
+	return append(params, &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(topK, 10)})
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+)
+
+func durationInMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// newSlowQueryEntry creates the slow query log entry with the common fields of the request.
+func newSlowQueryEntry(ctx context.Context, typ string, request proto.Message, duration time.Duration, sp trace.Span, phases *taskPhaseLatency) *slowlog.Entry {
+	user, _ := GetCurUserFromContext(ctx)
+	traceID := ""
+	if sp != nil {
+		traceID = sp.SpanContext().TraceID().String()
+	}
+	entry := &slowlog.Entry{
+		Time:     time.Now(),
+		Type:     typ,
+		User:     user,
+		TraceID:  traceID,
+		Duration: durationInMs(duration),
+		Phases: &slowlog.Phases{
+			Queue:       durationInMs(phases.queue),
+			PreExecute:  durationInMs(phases.preExecute),
+			Execute:     durationInMs(phases.execute),
+			PostExecute: durationInMs(phases.postExecute),
+		},
+	}
+	bytes, err := protojson.Marshal(request)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to marshal slow query request", zap.String("type", typ), zap.Error(err))
+	} else {
+		entry.Request = bytes
+	}
+	return entry
+}
+
+// logSlowSearch writes the search into the slow query log if it's slower than the threshold.
+func logSlowSearch(ctx context.Context, request *milvuspb.SearchRequest, qt *searchTask, duration time.Duration, sp trace.Span) {
+	if !slowlog.ShouldLog(slowlog.TypeSearch, duration) {
+		return
+	}
+	entry := newSlowQueryEntry(ctx, slowlog.TypeSearch, request, duration, sp, qt.phaseLatency())
+	entry.Database = request.GetDbName()
+	entry.Collection = request.GetCollectionName()
+	entry.Partitions = request.GetPartitionNames()
+	entry.ConsistencyLevel = request.GetConsistencyLevel().String()
+	entry.GuaranteeTs = qt.GetGuaranteeTimestamp()
+	entry.Expr = request.GetDsl()
+	entry.OutputFields = request.GetOutputFields()
+	entry.NQ = request.GetNq()
+	entry.TopK = qt.SearchRequest.GetTopk()
+	entry.SearchParams = funcutil.KeyValuePair2Map(request.GetSearchParams())
+	slowlog.Write(entry)
+}
+
+// logSlowHybridSearch writes the hybrid search into the slow query log if it's slower than the threshold.
+func logSlowHybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest, qt *searchTask, duration time.Duration, sp trace.Span) {
+	if !slowlog.ShouldLog(slowlog.TypeHybridSearch, duration) {
+		return
+	}
+	entry := newSlowQueryEntry(ctx, slowlog.TypeHybridSearch, request, duration, sp, qt.phaseLatency())
+	entry.Database = request.GetDbName()
+	entry.Collection = request.GetCollectionName()
+	entry.Partitions = request.GetPartitionNames()
+	entry.ConsistencyLevel = request.GetConsistencyLevel().String()
+	entry.GuaranteeTs = qt.GetGuaranteeTimestamp()
+	entry.OutputFields = request.GetOutputFields()
+	entry.TopK = qt.SearchRequest.GetTopk()
+	entry.SearchParams = funcutil.KeyValuePair2Map(request.GetRankParams())
+	for _, subReq := range request.GetRequests() {
+		entry.NQ += subReq.GetNq()
+		entry.SubRequests = append(entry.SubRequests, slowlog.SubRequest{
+			Expr:         subReq.GetDsl(),
+			NQ:           subReq.GetNq(),
+			SearchParams: funcutil.KeyValuePair2Map(subReq.GetSearchParams()),
+		})
+	}
+	slowlog.Write(entry)
+}
+
+// logSlowQuery writes the query into the slow query log if it's slower than the threshold.
+func logSlowQuery(ctx context.Context, request *milvuspb.QueryRequest, qt *queryTask, duration time.Duration, sp trace.Span) {
+	if !slowlog.ShouldLog(slowlog.TypeQuery, duration) {
+		return
+	}
+	entry := newSlowQueryEntry(ctx, slowlog.TypeQuery, request, duration, sp, qt.phaseLatency())
+	entry.Database = request.GetDbName()
+	entry.Collection = request.GetCollectionName()
+	entry.Partitions = request.GetPartitionNames()
+	entry.ConsistencyLevel = request.GetConsistencyLevel().String()
+	entry.GuaranteeTs = qt.GetGuaranteeTimestamp()
+	entry.Expr = request.GetExpr()
+	entry.OutputFields = request.GetOutputFields()
+	entry.TopK = qt.RetrieveRequest.GetLimit()
+	entry.SearchParams = funcutil.KeyValuePair2Map(request.GetQueryParams())
+	slowlog.Write(entry)
+}
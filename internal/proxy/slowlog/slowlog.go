@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slowlog persists the slow search and query requests of proxy into a rotating local file,
+// each line of the file is a JSON encoded Entry, which keeps the original request for replay.
+package slowlog
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	TypeSearch       = "Search"
+	TypeHybridSearch = "HybridSearch"
+	TypeQuery        = "Query"
+)
+
+var (
+	_globalL *SlowQueryLogger
+	once     sync.Once
+)
+
+// Phases is the latency breakdown of a request on proxy, in milliseconds.
+type Phases struct {
+	Queue       float64 `json:"queue"`
+	PreExecute  float64 `json:"pre_execute"`
+	Execute     float64 `json:"execute"`
+	PostExecute float64 `json:"post_execute"`
+}
+
+// SubRequest is a sub search request of a hybrid search.
+type SubRequest struct {
+	Expr         string            `json:"expr,omitempty"`
+	NQ           int64             `json:"nq"`
+	SearchParams map[string]string `json:"search_params,omitempty"`
+}
+
+// Entry is a slow query log entry.
+type Entry struct {
+	Time             time.Time         `json:"time"`
+	Type             string            `json:"type"`
+	Database         string            `json:"db"`
+	Collection       string            `json:"collection"`
+	Partitions       []string          `json:"partitions,omitempty"`
+	User             string            `json:"user,omitempty"`
+	TraceID          string            `json:"trace_id,omitempty"`
+	ConsistencyLevel string            `json:"consistency_level"`
+	GuaranteeTs      uint64            `json:"guarantee_ts,omitempty"`
+	Expr             string            `json:"expr,omitempty"`
+	OutputFields     []string          `json:"output_fields,omitempty"`
+	NQ               int64             `json:"nq,omitempty"`
+	TopK             int64             `json:"topk,omitempty"`
+	SearchParams     map[string]string `json:"search_params,omitempty"`
+	SubRequests      []SubRequest      `json:"sub_requests,omitempty"`
+	Duration         float64           `json:"duration_ms"`
+	Phases           *Phases           `json:"phases,omitempty"`
+	// Request is the protojson encoded original request, which is used to replay the request.
+	Request json.RawMessage `json:"request"`
+}
+
+type SlowQueryLogger struct {
+	writer *accesslog.RotateWriter
+}
+
+func (l *SlowQueryLogger) init(params *paramtable.ComponentParam) error {
+	cfg := &params.ProxyCfg.SlowQueryLog
+	writer, err := accesslog.NewRotateWriterWithConfig(&accesslog.RotateWriterConfig{
+		LocalPath:     cfg.LocalPath.GetValue(),
+		FileName:      cfg.Filename.GetValue(),
+		RotatedTime:   cfg.RotatedTime.GetAsInt64(),
+		MaxSize:       cfg.MaxSize.GetAsInt(),
+		MaxBackups:    cfg.MaxBackups.GetAsInt(),
+		MinioEnable:   cfg.MinioEnable.GetAsBool(),
+		RemotePath:    cfg.RemotePath.GetValue(),
+		RemoteMaxTime: cfg.RemoteMaxTime.GetAsInt(),
+	}, &params.MinioCfg)
+	if err != nil {
+		return err
+	}
+	l.writer = writer
+	return nil
+}
+
+func (l *SlowQueryLogger) Write(entry *Entry) error {
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = l.writer.Write(append(bytes, '\n'))
+	return err
+}
+
+func (l *SlowQueryLogger) Close() error {
+	return l.writer.Close()
+}
+
+// InitSlowQueryLogger initializes the global slow query logger if proxy.slowQueryLog.enable is set.
+func InitSlowQueryLogger(params *paramtable.ComponentParam) {
+	once.Do(func() {
+		if !params.ProxyCfg.SlowQueryLog.Enable.GetAsBool() {
+			return
+		}
+		logger := &SlowQueryLogger{}
+		if err := logger.init(params); err != nil {
+			log.Warn("Init slow query logger failed", zap.Error(err))
+			return
+		}
+		_globalL = logger
+		log.Info("Init slow query logger success")
+	})
+}
+
+// Threshold returns the latency threshold of the request type, a request slower than it is logged.
+func Threshold(typ string) time.Duration {
+	cfg := &paramtable.Get().ProxyCfg.SlowQueryLog
+	switch typ {
+	case TypeSearch:
+		return cfg.SearchThreshold.GetAsDuration(time.Second)
+	case TypeHybridSearch:
+		return cfg.HybridSearchThreshold.GetAsDuration(time.Second)
+	case TypeQuery:
+		return cfg.QueryThreshold.GetAsDuration(time.Second)
+	default:
+		return 0
+	}
+}
+
+// ShouldLog returns whether the request of the type with the duration shall be logged.
+func ShouldLog(typ string, duration time.Duration) bool {
+	if _globalL == nil {
+		return false
+	}
+	threshold := Threshold(typ)
+	return threshold > 0 && duration > threshold
+}
+
+// Write writes the entry into the global slow query logger.
+func Write(entry *Entry) {
+	if _globalL == nil {
+		return
+	}
+	if err := _globalL.Write(entry); err != nil {
+		log.Warn("write slow query log failed", zap.String("type", entry.Type), zap.Error(err))
+	}
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestThreshold(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SlowQueryLog.SearchThreshold.Key, "1")
+	defer params.Reset(params.ProxyCfg.SlowQueryLog.SearchThreshold.Key)
+	params.Save(params.ProxyCfg.SlowQueryLog.QueryThreshold.Key, "0")
+	defer params.Reset(params.ProxyCfg.SlowQueryLog.QueryThreshold.Key)
+
+	assert.Equal(t, time.Second, Threshold(TypeSearch))
+	assert.Equal(t, 5*time.Second, Threshold(TypeHybridSearch))
+	assert.Equal(t, time.Duration(0), Threshold(TypeQuery))
+	assert.Equal(t, time.Duration(0), Threshold("Unknown"))
+
+	// nothing is logged without the logger.
+	assert.False(t, ShouldLog(TypeSearch, 2*time.Second))
+
+	_globalL = &SlowQueryLogger{}
+	defer func() { _globalL = nil }()
+	assert.True(t, ShouldLog(TypeSearch, 2*time.Second))
+	assert.False(t, ShouldLog(TypeSearch, 500*time.Millisecond))
+	assert.False(t, ShouldLog(TypeHybridSearch, 2*time.Second))
+	// the threshold 0 disables the slow query log of the type.
+	assert.False(t, ShouldLog(TypeQuery, time.Hour))
+}
+
+func TestWrite(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	dir := t.TempDir()
+	params.Save(params.ProxyCfg.SlowQueryLog.LocalPath.Key, dir)
+	defer params.Reset(params.ProxyCfg.SlowQueryLog.LocalPath.Key)
+
+	logger := &SlowQueryLogger{}
+	require.NoError(t, logger.init(params))
+	_globalL = logger
+	defer func() { _globalL = nil }()
+
+	entries := []*Entry{
+		{
+			Type:         TypeSearch,
+			Database:     "default",
+			Collection:   "test",
+			Expr:         "id > 0",
+			NQ:           10,
+			TopK:         100,
+			SearchParams: map[string]string{"nprobe": "16"},
+			Duration:     6000,
+			Phases:       &Phases{Queue: 1, PreExecute: 2, Execute: 5990, PostExecute: 7},
+			Request:      json.RawMessage(`{"collectionName":"test"}`),
+		},
+		{
+			Type:       TypeQuery,
+			Collection: "test",
+			Expr:       "id in [1, 2]",
+			Duration:   7000,
+			Request:    json.RawMessage(`{"collectionName":"test","expr":"id in [1, 2]"}`),
+		},
+	}
+	for _, entry := range entries {
+		Write(entry)
+	}
+	require.NoError(t, logger.Close())
+
+	file, err := os.Open(path.Join(dir, params.ProxyCfg.SlowQueryLog.Filename.GetValue()))
+	require.NoError(t, err)
+	defer file.Close()
+
+	var read []*Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := &Entry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+		read = append(read, entry)
+	}
+	require.Len(t, read, 2)
+	assert.Equal(t, TypeSearch, read[0].Type)
+	assert.Equal(t, int64(100), read[0].TopK)
+	assert.Equal(t, "16", read[0].SearchParams["nprobe"])
+	assert.Equal(t, 5990.0, read[0].Phases.Execute)
+	assert.JSONEq(t, `{"collectionName":"test"}`, string(read[0].Request))
+	assert.Equal(t, TypeQuery, read[1].Type)
+	assert.Equal(t, "id in [1, 2]", read[1].Expr)
+	assert.Nil(t, read[1].Phases)
+}
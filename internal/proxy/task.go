@@ -55,6 +55,7 @@ const (
 	ReduceStopForBestKey = "reduce_stop_for_best"
 	IteratorField        = "iterator"
 	CollectionID         = "collection_id"
+	ReadSessionKey       = "read_session_id"
 	GroupByFieldKey      = "group_by_field"
 	GroupSizeKey         = "group_size"
 	StrictGroupSize      = "strict_group_size"
@@ -151,6 +152,21 @@ type task interface {
 type baseTask struct {
 	onEnqueueTime time.Time
 	executingTime time.Time
+	phases        taskPhaseLatency
+}
+
+// taskPhaseLatency is the latency of each phase of a task processed by the scheduler.
+type taskPhaseLatency struct {
+	queue       time.Duration
+	preExecute  time.Duration
+	execute     time.Duration
+	postExecute time.Duration
+}
+
+// phaseLatencyRecorder is implemented by the tasks embedding baseTask,
+// the scheduler records the phase latency into it before notifying the task done.
+type phaseLatencyRecorder interface {
+	phaseLatency() *taskPhaseLatency
 }
 
 func (bt *baseTask) CanSkipAllocTimestamp() bool {
@@ -177,6 +193,10 @@ func (bt *baseTask) GetDurationInExecuting() time.Duration {
 	return time.Since(bt.executingTime)
 }
 
+func (bt *baseTask) phaseLatency() *taskPhaseLatency {
+	return &bt.phases
+}
+
 type dmlTask interface {
 	task
 	setChannels() error
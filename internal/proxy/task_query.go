@@ -70,11 +70,17 @@ type queryTask struct {
 	lb               LBPolicy
 	channelsMvcc     map[string]Timestamp
 	fastSkip         bool
+	hedgedRequest    bool
 
 	reQuery              bool
 	allQueryCnt          int64
 	totalRelatedDataSize int64
+	resourceUsage        resourceUsage
 	mustUsePartitionKey  bool
+
+	// resultCacheKey is nil if the query cannot be served from the result cache.
+	resultCacheKey *resultCacheKey
+	resultCacheHit bool
 }
 
 type queryParams struct {
@@ -490,8 +496,19 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
 	}
+	// the query of a read session reads the pinned snapshot of the session.
+	sessionTs, err := readSessionTimestamp(ctx, t.mixCoord, t.request.GetQueryParams(), t.CollectionID)
+	if err != nil {
+		log.Warn("invalid read session", zap.Error(err))
+		return err
+	}
+	if sessionTs > 0 {
+		t.MvccTimestamp = sessionTs
+		t.GuaranteeTimestamp = sessionTs
+	}
 	t.RetrieveRequest.IsIterator = queryParams.isIterator
 
+	t.hedgedRequest = collectionInfo.hedgedRequest
 	if collectionInfo.collectionTTL != 0 {
 		physicalTime, _ := tsoutil.ParseTS(guaranteeTs)
 		expireTime := physicalTime.Add(-time.Duration(collectionInfo.collectionTTL))
@@ -503,6 +520,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.DbID = 0 // TODO
+	t.initResultCacheKey(consistencyLevel)
 	log.Debug("Query PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Uint64("mvcc_ts", t.GetMvccTimestamp()),
@@ -511,6 +529,35 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// initResultCacheKey builds the key of the result cache from the normalized query request,
+// the iterators, the queries of read sessions and the requeries of search are never cached.
+func (t *queryTask) initResultCacheKey(consistencyLevel commonpb.ConsistencyLevel) {
+	if t.reQuery || t.queryParams.isIterator || t.GetMvccTimestamp() > 0 || !isResultCacheable(consistencyLevel) {
+		return
+	}
+	retrieveReq := typeutil.Clone(t.RetrieveRequest)
+	retrieveReq.Base = nil
+	retrieveReq.ReqID = 0
+	retrieveReq.MvccTimestamp = 0
+	retrieveReq.GuaranteeTimestamp = 0
+	retrieveReq.TimeoutTimestamp = 0
+	retrieveReq.CollectionTtlTimestamps = 0
+	retrieveReq.ConsistencyLevel = 0
+	retrieveReq.Username = ""
+	// the fields only used by the proxy to reduce the results.
+	request := &milvuspb.QueryRequest{
+		CollectionName: t.request.GetCollectionName(),
+		OutputFields:   t.request.GetOutputFields(),
+		QueryParams:    t.request.GetQueryParams(),
+	}
+	key, err := newResultCacheKey(t.GetCollectionID(), retrieveReq, request)
+	if err != nil {
+		log.Ctx(t.ctx).Warn("failed to build result cache key", zap.Error(err))
+		return
+	}
+	t.resultCacheKey = &key
+}
+
 func (t *queryTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute query %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
@@ -518,6 +565,15 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
 		zap.String("requestType", "query"))
 
+	if t.resultCacheKey != nil {
+		if result, ok := globalResultCache.Get(queryResultCacheName, *t.resultCacheKey, t.GetGuaranteeTimestamp()); ok {
+			t.result = result.(*milvuspb.QueryResults)
+			t.resultCacheHit = true
+			log.Debug("query served from result cache")
+			return nil
+		}
+	}
+
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
@@ -525,6 +581,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		collectionName: t.collectionName,
 		nq:             1,
 		exec:           t.queryShard,
+		hedge:          t.hedgedRequest,
 	})
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
@@ -544,12 +601,16 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	log := log.Ctx(ctx).With(zap.Int64("collection", t.GetCollectionID()),
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
 		zap.String("requestType", "query"))
+	if t.resultCacheHit {
+		return nil
+	}
 
 	var err error
 
 	toReduceResults := make([]*internalpb.RetrieveResults, 0)
 	t.allQueryCnt = 0
 	t.totalRelatedDataSize = 0
+	t.resourceUsage = resourceUsage{}
 	select {
 	case <-t.TraceCtx().Done():
 		log.Warn("proxy", zap.Int64("Query: wait to finish failed, timeout!, msgID:", t.ID()))
@@ -560,6 +621,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			toReduceResults = append(toReduceResults, res)
 			t.allQueryCnt += res.GetAllRetrieveCount()
 			t.totalRelatedDataSize += res.GetCostAggregation().GetTotalRelatedDataSize()
+			t.resourceUsage.add(res.GetCostAggregation())
 			log.Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()))
 			return true
 		})
@@ -567,6 +629,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
+	reduceStart := time.Now()
 
 	reducer := createMilvusReducer(ctx, t.queryParams, t.RetrieveRequest, t.schema.CollectionSchema, t.plan, t.collectionName)
 
@@ -583,11 +646,15 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	}
 	t.result.PrimaryFieldName = primaryFieldSchema.GetName()
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	t.resourceUsage.reduceTime = time.Since(reduceStart)
 
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() == 0 {
 		// first page for iteration, need to set up sessionTs for iterator
 		t.result.SessionTs = getMaxMvccTsFromChannels(t.channelsMvcc, t.BeginTs())
 	}
+	if t.resultCacheKey != nil {
+		globalResultCache.Put(*t.resultCacheKey, getResultTs(t.channelsMvcc, t.GetGuaranteeTimestamp()), t.result)
+	}
 	log.Debug("Query PostExecute done")
 	return nil
 }
@@ -628,7 +695,10 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	result, err := qn.Query(ctx, req)
 	if err != nil {
 		log.Warn("QueryNode query return error", zap.Error(err))
-		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		// the request may be canceled since the hedged request has returned, the shard leaders are still valid.
+		if ctx.Err() == nil {
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		}
 		return err
 	}
 	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
@@ -642,8 +712,11 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	}
 
 	log.Debug("get query result")
-	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
+	if !claimShardResult(ctx) {
+		return errShardResultDropped
+	}
+	t.resultBuf.Insert(result)
 	return nil
 }
 
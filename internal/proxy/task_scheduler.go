@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -501,7 +502,15 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), t.Type().String()).
 		Observe(float64(waitDuration.Milliseconds()))
 
+	phases := &taskPhaseLatency{}
+	if recorder, ok := t.(phaseLatencyRecorder); ok {
+		phases = recorder.phaseLatency()
+	}
+	phases.queue = waitDuration
+	tr := timerecord.NewTimeRecorder("processTask")
+
 	err := t.PreExecute(ctx)
+	phases.preExecute = tr.RecordSpan()
 
 	defer func() {
 		t.Notify(err)
@@ -514,6 +523,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.AddEvent("scheduler process Execute")
 	err = t.Execute(ctx)
+	phases.execute = tr.RecordSpan()
 	if err != nil {
 		span.RecordError(err)
 		log.Ctx(ctx).Warn("Failed to execute task: ", zap.Error(err))
@@ -522,6 +532,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.AddEvent("scheduler process PostExecute")
 	err = t.PostExecute(ctx)
+	phases.postExecute = tr.RecordSpan()
 	if err != nil {
 		span.RecordError(err)
 		log.Ctx(ctx).Warn("Failed to post-execute task: ", zap.Error(err))
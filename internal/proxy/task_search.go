@@ -72,6 +72,7 @@ type searchTask struct {
 	resultSizeInsufficient bool
 	isTopkReduce           bool
 	isRecallEvaluation     bool
+	hedgedRequest          bool
 
 	translatedOutputFields []string
 	userOutputFields       []string
@@ -87,10 +88,14 @@ type searchTask struct {
 	queryChannelsTs map[string]Timestamp
 	queryInfos      []*planpb.QueryInfo
 	relatedDataSize int64
+	resourceUsage   resourceUsage
 
 	// New reranker functions
 	functionScore *rerank.FunctionScore
 	rankParams    *rankParams
+	// rerankLimit is the limit of the search with function score, the topk of the search
+	// covers the candidate window of the reranker, and the offset is applied by the reranker.
+	rerankLimit int64
 
 	isIterator bool
 	// we always remove pk field from output fields, as search result already contains pk field.
@@ -99,6 +104,10 @@ type searchTask struct {
 
 	// To facilitate writing unit tests
 	requeryFunc func(t *searchTask, span trace.Span, ids *schemapb.IDs, outputFields []string) (*milvuspb.QueryResults, error)
+
+	// resultCacheKey is nil if the search cannot be served from the result cache.
+	resultCacheKey *resultCacheKey
+	resultCacheHit bool
 }
 
 func (t *searchTask) CanSkipAllocTimestamp() bool {
@@ -255,6 +264,16 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
 	}
+	// the search of a read session reads the pinned snapshot of the session.
+	sessionTs, err := readSessionTimestamp(ctx, t.mixCoord, t.request.GetSearchParams(), t.CollectionID)
+	if err != nil {
+		log.Warn("invalid read session", zap.Error(err))
+		return err
+	}
+	if sessionTs > 0 {
+		t.MvccTimestamp = sessionTs
+		t.GuaranteeTimestamp = sessionTs
+	}
 	t.SearchRequest.IsIterator = t.isIterator
 
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
@@ -266,6 +285,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		t.SearchRequest.Username = username
 	}
 
+	t.hedgedRequest = collectionInfo.hedgedRequest
 	if collectionInfo.collectionTTL != 0 {
 		physicalTime, _ := tsoutil.ParseTS(guaranteeTs)
 		expireTime := physicalTime.Add(-time.Duration(collectionInfo.collectionTTL))
@@ -277,6 +297,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if err = ValidateTask(t); err != nil {
 		return err
 	}
+	t.initResultCacheKey()
 
 	log.Debug("search PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
@@ -287,6 +308,36 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// initResultCacheKey builds the key of the result cache from the normalized search request,
+// the iterators, the searches of read sessions and recall evaluations are never cached.
+func (t *searchTask) initResultCacheKey() {
+	if t.isIterator || t.GetMvccTimestamp() > 0 || t.GetIsRecallEvaluation() || !isResultCacheable(t.SearchRequest.GetConsistencyLevel()) {
+		return
+	}
+	searchReq := typeutil.Clone(t.SearchRequest)
+	searchReq.Base = nil
+	searchReq.ReqID = 0
+	searchReq.MvccTimestamp = 0
+	searchReq.GuaranteeTimestamp = 0
+	searchReq.TimeoutTimestamp = 0
+	searchReq.CollectionTtlTimestamps = 0
+	searchReq.ConsistencyLevel = 0
+	searchReq.Username = ""
+	// the fields only used by the proxy to post process the results.
+	request := &milvuspb.SearchRequest{
+		CollectionName: t.request.GetCollectionName(),
+		OutputFields:   t.request.GetOutputFields(),
+		SearchParams:   t.request.GetSearchParams(),
+		FunctionScore:  t.request.GetFunctionScore(),
+	}
+	key, err := newResultCacheKey(t.GetCollectionID(), searchReq, request)
+	if err != nil {
+		log.Ctx(t.ctx).Warn("failed to build result cache key", zap.Error(err))
+		return
+	}
+	t.resultCacheKey = &key
+}
+
 func (t *searchTask) checkNq(ctx context.Context) (int64, error) {
 	var nq int64
 	if t.SearchRequest.GetIsAdvanced() {
@@ -390,6 +441,8 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 	if !t.functionScore.IsSupportGroup() && t.rankParams.GetGroupByFieldId() >= 0 {
 		return merr.WrapErrParameterInvalidMsg("Current rerank does not support grouping search")
 	}
+	rankTopk := t.rankParams.limit + t.rankParams.offset
+	candidateWindow := rerankCandidateWindow(t.functionScore, rankTopk)
 
 	t.SearchRequest.SubReqs = make([]*internalpb.SubSearchRequest, len(t.request.GetSubReqs()))
 	t.queryInfos = make([]*planpb.QueryInfo, len(t.request.GetSubReqs()))
@@ -399,6 +452,10 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		// the sub search gets more candidates if the reranker needs a candidate window larger than the results
+		if candidateWindow > rankTopk && queryInfo.GetTopk() < candidateWindow {
+			queryInfo.Topk = candidateWindow
+		}
 
 		ignoreGrowing := t.SearchRequest.IgnoreGrowing
 		if !ignoreGrowing {
@@ -683,6 +740,10 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 		if !t.functionScore.IsSupportGroup() && queryInfo.GetGroupByFieldId() > 0 {
 			return merr.WrapErrParameterInvalidMsg("Current rerank does not support grouping search")
 		}
+
+		// the offset is applied by the reranker, so the reranker gets all the candidates of the window
+		t.rerankLimit = queryInfo.GetTopk() - offset
+		queryInfo.Topk = rerankCandidateWindow(t.functionScore, queryInfo.GetTopk())
 	}
 
 	t.isIterator = isIterator
@@ -767,17 +828,21 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 
 func (t *searchTask) searchPostProcess(ctx context.Context, span trace.Span, toReduceResults []*internalpb.SearchResults) error {
 	metricType := getMetricType(toReduceResults)
-	result, err := t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.GetNq(), t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(), metricType, t.queryInfos[0], false)
+	reduceOffset := t.SearchRequest.GetOffset()
+	if t.functionScore != nil {
+		reduceOffset = 0
+	}
+	result, err := t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.GetNq(), t.SearchRequest.GetTopk(), reduceOffset, metricType, t.queryInfos[0], false)
 	if err != nil {
 		return err
 	}
 
-	if t.functionScore != nil && len(result.Results.FieldsData) != 0 {
+	if t.functionScore != nil && (len(result.Results.FieldsData) != 0 || len(t.functionScore.GetAllInputFieldIDs()) == 0) {
 		{
 			ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-call-rerank-function-udf")
 			defer sp.End()
 			groupScorerStr := getGroupScorerStr(t.request.GetSearchParams())
-			params := rerank.NewSearchParams(t.Nq, t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(),
+			params := rerank.NewSearchParams(t.Nq, t.rerankLimit, t.SearchRequest.GetOffset(),
 				t.queryInfos[0].RoundDecimal, t.queryInfos[0].GroupByFieldId, t.queryInfos[0].GroupSize, t.queryInfos[0].StrictGroupSize, groupScorerStr, []string{metricType})
 			// rank only returns id and score
 			if t.result, err = t.functionScore.Process(ctx, params, []*milvuspb.SearchResults{result}); err != nil {
@@ -893,12 +958,22 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
 
+	if t.resultCacheKey != nil {
+		if result, ok := globalResultCache.Get(searchResultCacheName, *t.resultCacheKey, t.GetGuaranteeTimestamp()); ok {
+			t.result = result.(*milvuspb.SearchResults)
+			t.resultCacheHit = true
+			log.Debug("search served from result cache")
+			return nil
+		}
+	}
+
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.SearchRequest.CollectionID,
 		collectionName: t.collectionName,
 		nq:             t.Nq,
 		exec:           t.searchShard,
+		hedge:          t.hedgedRequest,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
@@ -982,6 +1057,9 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		tr.CtxElapse(ctx, "done")
 	}()
 	log := log.Ctx(ctx).With(zap.Int64("nq", t.SearchRequest.GetNq()))
+	if t.resultCacheHit {
+		return nil
+	}
 
 	toReduceResults, err := t.collectSearchResults(ctx)
 	if err != nil {
@@ -989,8 +1067,10 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
+	reduceStart := time.Now()
 	t.queryChannelsTs = make(map[string]uint64)
 	t.relatedDataSize = 0
+	t.resourceUsage = resourceUsage{}
 	isTopkReduce := false
 	isRecallEvaluation := false
 	for _, r := range toReduceResults {
@@ -1001,6 +1081,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			isRecallEvaluation = true
 		}
 		t.relatedDataSize += r.GetCostAggregation().GetTotalRelatedDataSize()
+		t.resourceUsage.add(r.GetCostAggregation())
 		for ch, ts := range r.GetChannelsMvcc() {
 			t.queryChannelsTs[ch] = ts
 		}
@@ -1064,6 +1145,12 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	t.resourceUsage.reduceTime = time.Since(reduceStart)
+
+	// the insufficient result is searched again without topk reduce.
+	if t.resultCacheKey != nil && !t.resultSizeInsufficient && merr.Ok(t.result.GetStatus()) {
+		globalResultCache.Put(*t.resultCacheKey, getResultTs(t.queryChannelsTs, t.GetGuaranteeTimestamp()), t.result)
+	}
 
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -1092,7 +1179,10 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 	result, err = qn.Search(ctx, req)
 	if err != nil {
 		log.Warn("QueryNode search return error", zap.Error(err))
-		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		// the request may be canceled since the hedged request has returned, the shard leaders are still valid.
+		if ctx.Err() == nil {
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		}
 		return err
 	}
 	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
@@ -1105,10 +1195,13 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 			zap.String("reason", result.GetStatus().GetReason()))
 		return errors.Wrapf(merr.Error(result.GetStatus()), "fail to search on QueryNode %d", nodeID)
 	}
+	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
+	if !claimShardResult(ctx) {
+		return errShardResultDropped
+	}
 	if t.resultBuf != nil {
 		t.resultBuf.Insert(result)
 	}
-	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
 
 	return nil
 }
@@ -1185,6 +1278,7 @@ func requeryImpl(t *searchTask, span trace.Span, ids *schemapb.IDs, outputFields
 	if err != nil {
 		return nil, err
 	}
+	t.resourceUsage.merge(qt.resourceUsage)
 	if queryResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		return nil, merr.Error(queryResult.GetStatus())
 	}
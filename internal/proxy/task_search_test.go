@@ -17,8 +17,12 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"strconv"
 	"strings"
@@ -292,6 +296,96 @@ func TestSearchTask_PostExecute(t *testing.T) {
 		assert.Equal(t, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, qt.result.Results.Ids.GetIntId().Data)
 	})
 
+	t.Run("Test search decay rerank with offset", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		collName := "test_collection_decay_rerank_with_offset" + funcutil.GenRandomStr()
+		_, fieldNameId := createCollWithFields(t, collName, qc)
+		qt := getSearchTaskWithRerank(t, collName, testFloatField)
+		qt.request.SearchParams = append(qt.request.SearchParams, &commonpb.KeyValuePair{Key: OffsetKey, Value: "2"})
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), qt.SearchRequest.GetTopk())
+		assert.Equal(t, int64(10), qt.rerankLimit)
+
+		qt.resultBuf.Insert(genTestSearchResultData(1, 12, schemapb.DataType_Float, testFloatField, fieldNameId[testFloatField], false))
+		err := qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		// all the 12 candidates are reranked as [9, 10, 8, 11, 7, ..., 0], then the offset is applied once
+		assert.Equal(t, []int64{10}, qt.result.Results.Topks)
+		assert.Equal(t, []int64{8, 11, 7, 6, 5, 4, 3, 2, 1, 0}, qt.result.Results.Ids.GetIntId().Data)
+	})
+
+	t.Run("Test search model rerank with offset", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// the score of each document is its position in the request
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Texts []string `json:"texts"`
+			}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &req)
+			results := make([]map[string]any, 0, len(req.Texts))
+			for i := range req.Texts {
+				results = append(results, map[string]any{"index": i, "score": float32(i)})
+			}
+			data, _ := json.Marshal(results)
+			w.WriteHeader(http.StatusOK)
+			w.Write(data)
+		}))
+		defer ts.Close()
+
+		collName := "test_collection_model_rerank_with_offset" + funcutil.GenRandomStr()
+		_, fieldNameId := createCollWithFieldTypes(t, collName, qc, map[string]schemapb.DataType{
+			testInt64Field:    schemapb.DataType_Int64,
+			testFloatVecField: schemapb.DataType_FloatVector,
+			testVarCharField:  schemapb.DataType_VarChar,
+		})
+		qt := getSearchTaskWithRerank(t, collName, testVarCharField)
+		qt.request.FunctionScore.Functions[0].Params = []*commonpb.KeyValuePair{
+			{Key: "reranker", Value: "model"},
+			{Key: "provider", Value: "tei"},
+			{Key: "endpoint", Value: ts.URL},
+			{Key: "queries", Value: `["q"]`},
+		}
+		qt.request.SearchParams = append(qt.request.SearchParams, &commonpb.KeyValuePair{Key: OffsetKey, Value: "2"})
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+
+		qt.resultBuf.Insert(genTestSearchResultData(1, 12, schemapb.DataType_VarChar, testVarCharField, fieldNameId[testVarCharField], false))
+		err := qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		// all the 12 candidates are reranked as [11, 10, ..., 0], then the offset is applied once
+		assert.Equal(t, []int64{10}, qt.result.Results.Topks)
+		assert.Equal(t, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, qt.result.Results.Ids.GetIntId().Data)
+	})
+
+	t.Run("Test search rerank with candidate window", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		collName := "test_collection_rerank_with_candidate_window" + funcutil.GenRandomStr()
+		_, fieldNameId := createCollWithFields(t, collName, qc)
+		qt := getSearchTaskWithRerank(t, collName, testInt32Field)
+		qt.request.FunctionScore.Functions[0].Params = []*commonpb.KeyValuePair{
+			{Key: "reranker", Value: "mmr"},
+			{Key: "lambda", Value: "1"},
+			{Key: "window_size", Value: "20"},
+		}
+		qt.request.SearchParams = append(qt.request.SearchParams, &commonpb.KeyValuePair{Key: OffsetKey, Value: "2"})
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), qt.SearchRequest.GetTopk())
+		assert.Equal(t, int64(10), qt.rerankLimit)
+
+		qt.resultBuf.Insert(genTestSearchResultData(1, 20, schemapb.DataType_Int32, testInt32Field, fieldNameId[testInt32Field], false))
+		err := qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10}, qt.result.Results.Topks)
+		// the offset is applied once after the rerank
+		assert.Equal(t, []int64{17, 16, 15, 14, 13, 12, 11, 10, 9, 8}, qt.result.Results.Ids.GetIntId().Data)
+	})
+
 	getHybridSearchTaskWithRerank := func(t *testing.T, collName string, funcInput string, data [][]string) *searchTask {
 		subReqs := []*milvuspb.SubSearchRequest{}
 		for _, item := range data {
@@ -560,6 +654,10 @@ func createCollWithFields(t *testing.T, collName string, rc types.MixCoordClient
 		testInt32Field:    schemapb.DataType_Int32,
 		testBoolField:     schemapb.DataType_Bool,
 	}
+	return createCollWithFieldTypes(t, collName, rc, fieldName2Types)
+}
+
+func createCollWithFieldTypes(t *testing.T, collName string, rc types.MixCoordClient, fieldName2Types map[string]schemapb.DataType) (*schemapb.CollectionSchema, map[string]int64) {
 	schema := constructCollectionSchemaByDataType(collName, fieldName2Types, testInt64Field, true)
 	marshaledSchema, err := proto.Marshal(schema)
 	assert.NoError(t, err)
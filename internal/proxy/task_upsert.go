@@ -165,7 +165,8 @@ func (it *upsertTask) insertPreExecute(ctx context.Context) error {
 			return err
 		}
 		sp.AddEvent("Create-function-udf")
-		if err := exec.ProcessInsert(ctx, it.upsertMsg.InsertMsg); err != nil {
+		// only the stale function outputs are recomputed, the supplied outputs are kept if their inputs are not supplied
+		if err := exec.ProcessUpsert(ctx, it.upsertMsg.InsertMsg); err != nil {
 			return err
 		}
 		sp.AddEvent("Call-function-udf")
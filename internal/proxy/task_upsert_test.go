@@ -22,6 +22,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -382,39 +383,57 @@ func TestUpsertTask_Function(t *testing.T) {
 		}
 	}
 
-	data := []*schemapb.FieldData{}
-	f1 := schemapb.FieldData{
-		Type:      schemapb.DataType_Int64,
-		FieldId:   100,
-		FieldName: "id",
-		IsDynamic: false,
-		Field: &schemapb.FieldData_Scalars{
-			Scalars: &schemapb.ScalarField{
-				Data: &schemapb.ScalarField_LongData{
-					LongData: &schemapb.LongArray{
-						Data: []int64{0, 1},
+	genText := func(texts []string, validData []bool) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldId:   101,
+			FieldName: "text",
+			IsDynamic: false,
+			ValidData: validData,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: texts,
+						},
 					},
 				},
 			},
-		},
+		}
 	}
-	data = append(data, &f1)
-	f2 := schemapb.FieldData{
-		Type:      schemapb.DataType_VarChar,
-		FieldId:   101,
-		FieldName: "text",
-		IsDynamic: false,
-		Field: &schemapb.FieldData_Scalars{
-			Scalars: &schemapb.ScalarField{
-				Data: &schemapb.ScalarField_StringData{
-					StringData: &schemapb.StringArray{
-						Data: []string{"sentence", "sentence"},
+	genVector := func() *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldId:   102,
+			FieldName: "vector",
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 4,
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{Data: []float32{9, 9, 9, 9, 9, 9, 9, 9}},
 					},
 				},
 			},
-		},
+		}
+	}
+	genData := func(fields ...*schemapb.FieldData) []*schemapb.FieldData {
+		pk := &schemapb.FieldData{
+			Type:      schemapb.DataType_Int64,
+			FieldId:   100,
+			FieldName: "id",
+			IsDynamic: false,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: []int64{0, 1},
+						},
+					},
+				},
+			},
+		}
+		return append([]*schemapb.FieldData{pk}, fields...)
 	}
-	data = append(data, &f2)
 	collectionName := "TestUpsertTask_function"
 	schema := &schemapb.CollectionSchema{
 		Name:        collectionName,
@@ -454,7 +473,6 @@ func TestUpsertTask_Function(t *testing.T) {
 		},
 	}
 
-	info := newSchemaInfo(schema)
 	collectionID := UniqueID(0)
 	cache := NewMockCache(t)
 	globalMetaCache = cache
@@ -471,40 +489,113 @@ func TestUpsertTask_Function(t *testing.T) {
 	idAllocator.Start()
 	defer idAllocator.Close()
 	assert.NoError(t, err)
-	task := upsertTask{
-		ctx: context.Background(),
-		req: &milvuspb.UpsertRequest{
-			CollectionName: collectionName,
-		},
-		upsertMsg: &msgstream.UpsertMsg{
-			InsertMsg: &msgstream.InsertMsg{
-				InsertRequest: &msgpb.InsertRequest{
-					Base: commonpbutil.NewMsgBase(
-						commonpbutil.WithMsgType(commonpb.MsgType_Insert),
-					),
-					CollectionName: collectionName,
-					DbName:         "hooooooo",
-					Version:        msgpb.InsertDataVersion_ColumnBased,
-					FieldsData:     data,
-					NumRows:        2,
-					PartitionName:  Params.CommonCfg.DefaultPartitionName.GetValue(),
+	newTask := func(schema *schemapb.CollectionSchema, data []*schemapb.FieldData) *upsertTask {
+		return &upsertTask{
+			ctx: context.Background(),
+			req: &milvuspb.UpsertRequest{
+				CollectionName: collectionName,
+			},
+			upsertMsg: &msgstream.UpsertMsg{
+				InsertMsg: &msgstream.InsertMsg{
+					InsertRequest: &msgpb.InsertRequest{
+						Base: commonpbutil.NewMsgBase(
+							commonpbutil.WithMsgType(commonpb.MsgType_Insert),
+						),
+						CollectionName: collectionName,
+						DbName:         "hooooooo",
+						Version:        msgpb.InsertDataVersion_ColumnBased,
+						FieldsData:     data,
+						NumRows:        2,
+						PartitionName:  Params.CommonCfg.DefaultPartitionName.GetValue(),
+					},
 				},
 			},
-		},
-		idAllocator: idAllocator,
-		schema:      info,
-		result:      &milvuspb.MutationResult{},
+			idAllocator: idAllocator,
+			schema:      newSchemaInfo(schema),
+			result:      &milvuspb.MutationResult{},
+		}
+	}
+	getVector := func(task *upsertTask) []float32 {
+		for _, field := range task.upsertMsg.InsertMsg.GetFieldsData() {
+			if field.GetFieldName() == "vector" {
+				return field.GetVectors().GetFloatVector().GetData()
+			}
+		}
+		return nil
+	}
+	withText := func(nullable bool, defaultValue string) *schemapb.CollectionSchema {
+		s := proto.Clone(schema).(*schemapb.CollectionSchema)
+		s.Fields[1].Nullable = nullable
+		if defaultValue != "" {
+			s.Fields[1].DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: defaultValue}}
+		}
+		return s
+	}
+
+	// the output is computed from the supplied input
+	{
+		task := newTask(schema, genData(genText([]string{"sentence", "sentence"}, nil)))
+		err = task.insertPreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, getVector(task), 8)
+		assert.NotEqual(t, genVector().GetVectors().GetFloatVector().GetData(), getVector(task))
 	}
-	err = task.insertPreExecute(ctx)
-	assert.NoError(t, err)
 
 	// process failed
 	{
-		oldRows := task.upsertMsg.InsertMsg.InsertRequest.NumRows
+		task := newTask(schema, genData(genText([]string{"sentence", "sentence"}, nil)))
 		task.upsertMsg.InsertMsg.InsertRequest.NumRows = 10000
 		err = task.insertPreExecute(ctx)
 		assert.Error(t, err)
-		task.upsertMsg.InsertMsg.InsertRequest.NumRows = oldRows
+	}
+
+	// the supplied output is recomputed since the input is supplied too
+	{
+		task := newTask(schema, genData(genText([]string{"sentence", "sentence"}, nil), genVector()))
+		err = task.insertPreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, getVector(task), 8)
+		assert.NotEqual(t, genVector().GetVectors().GetFloatVector().GetData(), getVector(task))
+	}
+
+	// the input is not supplied, the output can't be computed
+	{
+		task := newTask(withText(true, ""), genData())
+		err = task.insertPreExecute(ctx)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.ErrorContains(t, err, "the function output field [vector] must be supplied")
+	}
+
+	// the nullable input isn't supplied with the supplied output
+	{
+		task := newTask(withText(true, ""), genData(genVector()))
+		err = task.insertPreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, genVector().GetVectors().GetFloatVector().GetData(), getVector(task))
+	}
+
+	// the nullable input has null value
+	{
+		task := newTask(withText(true, ""), genData(genText([]string{"sentence"}, []bool{true, false})))
+		err = task.insertPreExecute(ctx)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.ErrorContains(t, err, "has null value")
+	}
+
+	// the null value is computed as the default value
+	{
+		task := newTask(withText(true, "default"), genData(genText([]string{"sentence"}, []bool{true, false})))
+		err = task.insertPreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, getVector(task), 8)
+	}
+
+	// the input isn't supplied, the output is computed from the default value
+	{
+		task := newTask(withText(false, "default"), genData())
+		err = task.insertPreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, getVector(task), 8)
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -48,7 +49,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
@@ -881,6 +884,35 @@ func validateFunction(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validateAddedFunction validates the function added to an existing collection and its output fields,
+// the functions already in the collection are not checked again.
+func validateAddedFunction(coll *schemapb.CollectionSchema, fSchema *schemapb.FunctionSchema, outputFields []*schemapb.FieldSchema) error {
+	if len(coll.GetFields())+len(outputFields) > Params.ProxyCfg.MaxFieldNum.GetAsInt() {
+		return fmt.Errorf("the number of fields exceeds the maximum value %d", Params.ProxyCfg.MaxFieldNum.GetAsInt())
+	}
+	for _, function := range coll.GetFunctions() {
+		if function.GetName() == fSchema.GetName() {
+			return fmt.Errorf("duplicate function name: %s", fSchema.GetName())
+		}
+	}
+	fieldNames := typeutil.NewSet(lo.Map(coll.GetFields(), func(field *schemapb.FieldSchema, _ int) string {
+		return field.GetName()
+	})...)
+	for _, field := range outputFields {
+		if fieldNames.Contain(field.GetName()) {
+			return fmt.Errorf("duplicate field name: %s", field.GetName())
+		}
+		if err := ValidateField(field, coll); err != nil {
+			return err
+		}
+	}
+	return validateFunction(&schemapb.CollectionSchema{
+		Name:      coll.GetName(),
+		Fields:    append(slices.Clone(coll.GetFields()), outputFields...),
+		Functions: []*schemapb.FunctionSchema{fSchema},
+	})
+}
+
 func checkFunctionOutputField(fSchema *schemapb.FunctionSchema, fields []*schemapb.FieldSchema) error {
 	switch fSchema.GetType() {
 	case schemapb.FunctionType_BM25:
@@ -895,6 +927,14 @@ func checkFunctionOutputField(fSchema *schemapb.FunctionSchema, fields []*schema
 		if err := function.TextEmbeddingOutputsCheck(fields); err != nil {
 			return err
 		}
+	case typeutil.FunctionTypeMultimodalEmbedding:
+		if err := function.MultimodalEmbeddingOutputsCheck(fields); err != nil {
+			return err
+		}
+	case typeutil.FunctionTypeSparseEmbedding:
+		if err := function.SparseEmbeddingOutputsCheck(fields); err != nil {
+			return err
+		}
 	default:
 		return errors.New("check output field for unknown function type")
 	}
@@ -916,6 +956,14 @@ func checkFunctionInputField(function *schemapb.FunctionSchema, fields []*schema
 		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
 			return errors.New("TextEmbedding function input field must be a VARCHAR/TEXT field")
 		}
+	case typeutil.FunctionTypeMultimodalEmbedding:
+		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text && fields[0].DataType != schemapb.DataType_BinaryVector) {
+			return errors.New("MultimodalEmbedding function input field must be a VARCHAR/TEXT or BinaryVector field")
+		}
+	case typeutil.FunctionTypeSparseEmbedding:
+		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
+			return errors.New("SparseEmbedding function input field must be a VARCHAR/TEXT field")
+		}
 	default:
 		return errors.New("check input field with unknown function type")
 	}
@@ -961,6 +1009,14 @@ func checkFunctionBasicParams(function *schemapb.FunctionSchema) error {
 		if len(function.GetParams()) == 0 {
 			return errors.New("TextEmbedding function accepts no params")
 		}
+	case typeutil.FunctionTypeMultimodalEmbedding:
+		if len(function.GetParams()) == 0 {
+			return errors.New("MultimodalEmbedding function requires the params of the provider")
+		}
+	case typeutil.FunctionTypeSparseEmbedding:
+		if len(function.GetParams()) == 0 {
+			return errors.New("SparseEmbedding function requires the params of the provider")
+		}
 	default:
 		return errors.New("check function params with unknown function type")
 	}
@@ -2380,6 +2436,20 @@ func GetRequestInfo(ctx context.Context, req proto.Message) (int64, map[int64][]
 	case *milvuspb.QueryRequest:
 		dbID, collToPartIDs, err := getCollectionAndPartitionIDs(ctx, req.(reqPartNames))
 		return dbID, collToPartIDs, internalpb.RateType_DQLQuery, 1, err // think of the query request's nq as 1
+	case *proxypb.FederatedSearchRequest:
+		db, err := globalMetaCache.GetDatabaseInfo(ctx, r.GetDbName())
+		if err != nil {
+			return util.InvalidDBID, map[int64][]int64{}, 0, 0, err
+		}
+		collToPartIDs := make(map[int64][]int64, len(r.GetCollectionNames()))
+		for _, collectionName := range r.GetCollectionNames() {
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, r.GetDbName(), collectionName)
+			if err != nil {
+				return util.InvalidDBID, map[int64][]int64{}, 0, 0, err
+			}
+			collToPartIDs[collectionID] = []int64{}
+		}
+		return db.dbID, collToPartIDs, internalpb.RateType_DQLSearch, int(r.GetRequest().GetNq()), nil
 	case *milvuspb.CreateCollectionRequest:
 		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
 		return dbID, collToPartIDs, internalpb.RateType_DDLCollection, 1, nil
@@ -2392,9 +2462,18 @@ func GetRequestInfo(ctx context.Context, req proto.Message) (int64, map[int64][]
 	case *milvuspb.ReleaseCollectionRequest:
 		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
 		return dbID, collToPartIDs, internalpb.RateType_DDLCollection, 1, nil
+	case *rootcoordpb.RenameFieldRequest:
+		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
+		return dbID, collToPartIDs, internalpb.RateType_DDLCollection, 1, nil
+	case *rootcoordpb.AddCollectionFunctionRequest:
+		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
+		return dbID, collToPartIDs, internalpb.RateType_DDLCollection, 1, nil
 	case *milvuspb.CreatePartitionRequest:
 		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
 		return dbID, collToPartIDs, internalpb.RateType_DDLPartition, 1, nil
+	case *rootcoordpb.RenamePartitionRequest:
+		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
+		return dbID, collToPartIDs, internalpb.RateType_DDLPartition, 1, nil
 	case *milvuspb.DropPartitionRequest:
 		dbID, collToPartIDs := getCollectionID(req.(reqCollName))
 		return dbID, collToPartIDs, internalpb.RateType_DDLPartition, 1, nil
@@ -2468,6 +2547,10 @@ func GetFailedResponse(req any, err error) any {
 		return &milvuspb.QueryResults{
 			Status: merr.Status(err),
 		}
+	case *proxypb.FederatedSearchRequest:
+		return &proxypb.FederatedSearchResults{
+			Status: merr.Status(err),
+		}
 	case *milvuspb.CreateCollectionRequest, *milvuspb.DropCollectionRequest,
 		*milvuspb.LoadCollectionRequest, *milvuspb.ReleaseCollectionRequest,
 		*milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest,
@@ -3046,6 +3046,24 @@ func TestValidateFunctionInputField(t *testing.T) {
 		err := checkFunctionInputField(function, fields)
 		assert.Error(t, err)
 	})
+
+	t.Run("MultimodalEmbedding function input", func(t *testing.T) {
+		function := &schemapb.FunctionSchema{
+			Type: typeutil.FunctionTypeMultimodalEmbedding,
+		}
+		for _, dataType := range []schemapb.DataType{schemapb.DataType_VarChar, schemapb.DataType_Text, schemapb.DataType_BinaryVector} {
+			assert.NoError(t, checkFunctionInputField(function, []*schemapb.FieldSchema{{DataType: dataType}}))
+		}
+		assert.Error(t, checkFunctionInputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_FloatVector}}))
+	})
+
+	t.Run("SparseEmbedding function input", func(t *testing.T) {
+		function := &schemapb.FunctionSchema{
+			Type: typeutil.FunctionTypeSparseEmbedding,
+		}
+		assert.NoError(t, checkFunctionInputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Text}}))
+		assert.Error(t, checkFunctionInputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_BinaryVector}}))
+	})
 }
 
 func TestValidateFunctionOutputField(t *testing.T) {
@@ -3125,6 +3143,22 @@ func TestValidateFunctionOutputField(t *testing.T) {
 		err := checkFunctionOutputField(function, fields)
 		assert.Error(t, err)
 	})
+
+	t.Run("MultimodalEmbedding function output", func(t *testing.T) {
+		function := &schemapb.FunctionSchema{
+			Type: typeutil.FunctionTypeMultimodalEmbedding,
+		}
+		assert.NoError(t, checkFunctionOutputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_FloatVector}}))
+		assert.Error(t, checkFunctionOutputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Int8Vector}}))
+	})
+
+	t.Run("SparseEmbedding function output", func(t *testing.T) {
+		function := &schemapb.FunctionSchema{
+			Type: typeutil.FunctionTypeSparseEmbedding,
+		}
+		assert.NoError(t, checkFunctionOutputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_SparseFloatVector}}))
+		assert.Error(t, checkFunctionOutputField(function, []*schemapb.FieldSchema{{DataType: schemapb.DataType_FloatVector}}))
+	})
 }
 
 func TestValidateFunctionBasicParams(t *testing.T) {
@@ -3524,3 +3558,67 @@ func BenchmarkCheckVarcharFormat(b *testing.B) {
 		checkInputUtf8Compatiable(schema, data)
 	}
 }
+
+func TestValidateAnalyzerWithGoTokenizer(t *testing.T) {
+	genField := func(enableMatch string) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			FieldID:  101,
+			Name:     "text",
+			DataType: schemapb.DataType_VarChar,
+			TypeParams: []*commonpb.KeyValuePair{
+				{Key: "enable_analyzer", Value: "true"},
+				{Key: "enable_match", Value: enableMatch},
+				{Key: "analyzer_params", Value: `{"type": "go", "filter": ["lowercase"]}`},
+			},
+		}
+	}
+	schema := &schemapb.CollectionSchema{}
+
+	err := validateAnalyzer(schema, genField("true"))
+	assert.NoError(t, err)
+
+	schema.Functions = []*schemapb.FunctionSchema{{
+		Name:             "bm25",
+		Type:             schemapb.FunctionType_BM25,
+		InputFieldNames:  []string{"text"},
+		InputFieldIds:    []int64{101},
+		OutputFieldNames: []string{"sparse"},
+	}}
+	err = validateAnalyzer(schema, genField("false"))
+	assert.NoError(t, err)
+}
+
+func TestValidateAddedFunction(t *testing.T) {
+	paramtable.Init()
+	coll := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "128"}},
+			},
+		},
+		Functions: []*schemapb.FunctionSchema{{Name: "existed"}},
+	}
+	genFunction := func(name string, input string) *schemapb.FunctionSchema {
+		return &schemapb.FunctionSchema{
+			Name:             name,
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{input},
+			OutputFieldNames: []string{"vec"},
+			Params:           []*commonpb.KeyValuePair{{Key: "provider", Value: "openai"}},
+		}
+	}
+	genOutput := func(name string) []*schemapb.FieldSchema {
+		return []*schemapb.FieldSchema{{
+			Name: name, DataType: schemapb.DataType_FloatVector,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+		}}
+	}
+
+	assert.ErrorContains(t, validateAddedFunction(coll, genFunction("existed", "text"), genOutput("vec")), "duplicate function name")
+	assert.ErrorContains(t, validateAddedFunction(coll, genFunction("embedding", "text"), genOutput("text")), "duplicate field name")
+	assert.ErrorContains(t, validateAddedFunction(coll, genFunction("embedding", "pk"), genOutput("vec")), "VARCHAR/TEXT")
+	assert.ErrorContains(t, validateAddedFunction(coll, genFunction("embedding", "missing"), genOutput("vec")), "input field not found")
+}
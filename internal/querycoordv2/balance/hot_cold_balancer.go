@@ -0,0 +1,418 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type segmentTemperature int
+
+const (
+	segmentWarm segmentTemperature = iota
+	segmentHot
+	segmentCold
+)
+
+// HotColdBalancer places the segments by the access counts reported by the query nodes.
+// The hot segments are spread across the nodes by the access counts, the cold segments are pinned to
+// the nodes with mmap or lazyload enabled, and the rest are balanced by the score as ScoreBasedBalancer.
+type HotColdBalancer struct {
+	*ScoreBasedBalancer
+
+	// moved is the segments moved by the balancer recently, the node a segment moves to counts
+	// the access from zero, so the access count before the move is kept until the cooldown ends.
+	moved *typeutil.ConcurrentMap[int64, movedSegment]
+}
+
+type movedSegment struct {
+	accessCount int64
+	movedAt     time.Time
+}
+
+func NewHotColdBalancer(scheduler task.Scheduler,
+	nodeManager *session.NodeManager,
+	dist *meta.DistributionManager,
+	meta *meta.Meta,
+	targetMgr meta.TargetManagerInterface,
+) *HotColdBalancer {
+	return &HotColdBalancer{
+		ScoreBasedBalancer: NewScoreBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
+		moved:              typeutil.NewConcurrentMap[int64, movedSegment](),
+	}
+}
+
+// accessCount returns the access count of the segment reported by the node it's on,
+// or kept since the segment is moved recently. The segment not loaded yet has no access count.
+func (b *HotColdBalancer) accessCount(s *meta.Segment) (int64, bool) {
+	count, ok := int64(0), false
+	if node := b.nodeManager.Get(s.Node); node != nil {
+		count, ok = node.SegmentAccessCount(s.GetID()), true
+	}
+	if moved, movedOk := b.getMoved(s.GetID()); movedOk {
+		return max(count, moved.accessCount), true
+	}
+	return count, ok
+}
+
+// getMoved returns the segment moved within the cooldown.
+func (b *HotColdBalancer) getMoved(segmentID int64) (movedSegment, bool) {
+	moved, ok := b.moved.Get(segmentID)
+	if !ok {
+		return movedSegment{}, false
+	}
+	cooldown := params.Params.QueryCoordCfg.MovedSegmentCooldown.GetAsDuration(time.Second)
+	if time.Since(moved.movedAt) >= cooldown {
+		b.moved.Remove(segmentID)
+		return movedSegment{}, false
+	}
+	return moved, true
+}
+
+// recordMoved keeps the access counts of the segments to move, so they're not moved back
+// for the access counted from zero on the new nodes.
+func (b *HotColdBalancer) recordMoved(plans []SegmentAssignPlan) {
+	now := time.Now()
+	for _, plan := range plans {
+		count, _ := b.accessCount(plan.Segment)
+		b.moved.Insert(plan.Segment.GetID(), movedSegment{accessCount: count, movedAt: now})
+	}
+}
+
+// recentlyMoved returns whether the segment is moved by the balancer within the cooldown,
+// such segments are skipped unless their nodes are stopping.
+func (b *HotColdBalancer) recentlyMoved(s *meta.Segment) bool {
+	_, ok := b.getMoved(s.GetID())
+	return ok
+}
+
+func (b *HotColdBalancer) temperature(s *meta.Segment) segmentTemperature {
+	count, ok := b.accessCount(s)
+	if !ok {
+		return segmentWarm
+	}
+	if count >= params.Params.QueryCoordCfg.HotSegmentAccessCount.GetAsInt64() {
+		return segmentHot
+	}
+	if count < params.Params.QueryCoordCfg.ColdSegmentAccessCount.GetAsInt64() {
+		return segmentCold
+	}
+	return segmentWarm
+}
+
+// nodeAccessCount returns the total access count of the segments on the node.
+func (b *HotColdBalancer) nodeAccessCount(nodeID int64) int64 {
+	if b.nodeManager.Get(nodeID) == nil {
+		return 0
+	}
+	total := int64(0)
+	for _, s := range b.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID)) {
+		count, _ := b.accessCount(s)
+		total += count
+	}
+	return total
+}
+
+func (b *HotColdBalancer) coldStorageNodes(nodes []int64) []int64 {
+	return lo.Filter(nodes, func(node int64, _ int) bool {
+		info := b.nodeManager.Get(node)
+		return info != nil && info.ColdStorageEnabled()
+	})
+}
+
+// AssignSegment assigns the hot segments to the nodes with the least access count,
+// the cold segments to the nodes with mmap or lazyload enabled, and the others by the score.
+func (b *HotColdBalancer) AssignSegment(ctx context.Context, collectionID int64, segments []*meta.Segment, nodes []int64, forceAssign bool) []SegmentAssignPlan {
+	br := NewBalanceReport()
+	return b.assignSegment(br, collectionID, segments, nodes, forceAssign)
+}
+
+func (b *HotColdBalancer) assignSegment(br *balanceReport, collectionID int64, segments []*meta.Segment, nodes []int64, forceAssign bool) []SegmentAssignPlan {
+	var hot, cold, warm []*meta.Segment
+	for _, s := range segments {
+		switch b.temperature(s) {
+		case segmentHot:
+			hot = append(hot, s)
+		case segmentCold:
+			cold = append(cold, s)
+		default:
+			warm = append(warm, s)
+		}
+	}
+
+	plans := b.assignHotSegment(br, hot, nodes, forceAssign)
+	if coldNodes := b.coldStorageNodes(nodes); len(coldNodes) > 0 {
+		plans = append(plans, b.ScoreBasedBalancer.assignSegment(br, collectionID, cold, coldNodes, forceAssign)...)
+	} else {
+		warm = append(warm, cold...)
+	}
+	if len(warm) > 0 {
+		plans = append(plans, b.ScoreBasedBalancer.assignSegment(br, collectionID, warm, nodes, forceAssign)...)
+	}
+	return plans
+}
+
+// assignHotSegment assigns each hot segment to the node with the least access count,
+// so the searches and queries on the hot segments are spread across the nodes.
+func (b *HotColdBalancer) assignHotSegment(br *balanceReport, segments []*meta.Segment, nodes []int64, forceAssign bool) []SegmentAssignPlan {
+	if len(segments) == 0 {
+		return nil
+	}
+	balanceBatchSize := math.MaxInt64
+	if !forceAssign {
+		nodes = lo.Filter(nodes, func(node int64, _ int) bool {
+			info := b.nodeManager.Get(node)
+			normalNode := info != nil && info.GetState() == session.NodeStateNormal
+			if !normalNode {
+				br.AddRecord(StrRecord(fmt.Sprintf("non-manual balance, skip abnormal node: %d", node)))
+			}
+			return normalNode
+		})
+		balanceBatchSize = paramtable.Get().QueryCoordCfg.BalanceSegmentBatchSize.GetAsInt()
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	nodeAccess := make(map[int64]int64, len(nodes))
+	for _, node := range nodes {
+		nodeAccess[node] = b.nodeAccessCount(node)
+	}
+	segmentAccess := make(map[int64]int64, len(segments))
+	for _, s := range segments {
+		segmentAccess[s.GetID()], _ = b.accessCount(s)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segmentAccess[segments[i].GetID()] > segmentAccess[segments[j].GetID()]
+	})
+
+	plans := make([]SegmentAssignPlan, 0, len(segments))
+	for _, s := range segments {
+		targetNode := lo.MinBy(nodes, func(a, b int64) bool {
+			return nodeAccess[a] < nodeAccess[b] || (nodeAccess[a] == nodeAccess[b] && a < b)
+		})
+		count := segmentAccess[s.GetID()]
+
+		from := int64(-1)
+		fromScore := int64(0)
+		if sourceAccess, ok := nodeAccess[s.Node]; ok {
+			// the segment comes from balancer, move it only if the access counts get closer.
+			if !forceAssign && sourceAccess-nodeAccess[targetNode] <= count {
+				br.AddRecord(StrRecordf("skip generate balance plan for hot segment %d since no enough benefit", s.ID))
+				continue
+			}
+			from = s.Node
+			fromScore = sourceAccess
+			nodeAccess[s.Node] -= count
+		}
+
+		plan := SegmentAssignPlan{
+			From:         from,
+			To:           targetNode,
+			Segment:      s,
+			FromScore:    fromScore,
+			ToScore:      nodeAccess[targetNode],
+			SegmentScore: count,
+		}
+		br.AddRecord(StrRecordf("add hot segment plan %s", plan))
+		plans = append(plans, plan)
+		nodeAccess[targetNode] += count
+
+		if len(plans) > balanceBatchSize {
+			break
+		}
+	}
+	return plans
+}
+
+func (b *HotColdBalancer) BalanceReplica(ctx context.Context, replica *meta.Replica) (segmentPlans []SegmentAssignPlan, channelPlans []ChannelAssignPlan) {
+	log := log.With(
+		zap.Int64("collection", replica.GetCollectionID()),
+		zap.Int64("replica id", replica.GetID()),
+		zap.String("replica group", replica.GetResourceGroup()),
+	)
+	br := NewBalanceReport()
+	defer func() {
+		if len(segmentPlans) == 0 && len(channelPlans) == 0 {
+			log.WithRateGroup(fmt.Sprintf("hotcoldbalance-noplan-%d", replica.GetID()), 1, 60).
+				RatedDebug(60, "no plan generated, balance report", zap.Stringers("nodesInfo", br.NodesInfo()), zap.Stringers("records", br.detailRecords))
+		} else {
+			log.Info("balance plan generated", zap.Stringers("nodesInfo", br.NodesInfo()), zap.Stringers("report details", br.records))
+		}
+	}()
+
+	if replica.NodesCount() == 0 {
+		br.AddRecord(StrRecord("replica has no querynode"))
+		return nil, nil
+	}
+
+	stoppingBalance := paramtable.Get().QueryCoordCfg.EnableStoppingBalance.GetAsBool()
+
+	channelPlans = b.balanceChannels(ctx, br, replica, stoppingBalance)
+	if len(channelPlans) == 0 {
+		segmentPlans = b.balanceSegments(ctx, br, replica, stoppingBalance)
+		b.recordMoved(segmentPlans)
+	}
+	return
+}
+
+func (b *HotColdBalancer) balanceSegments(ctx context.Context, br *balanceReport, replica *meta.Replica, stoppingBalance bool) []SegmentAssignPlan {
+	rwNodes := replica.GetRWNodes()
+	roNodes := replica.GetRONodes()
+
+	if len(rwNodes) == 0 {
+		br.AddRecord(StrRecord("no rwNodes to balance"))
+		return nil
+	}
+	if len(roNodes) != 0 {
+		if !stoppingBalance {
+			log.RatedInfo(10, "stopping balance is disabled!", zap.Int64s("stoppingNode", roNodes))
+			return nil
+		}
+		log.Info("Handle stopping nodes",
+			zap.Any("stopping nodes", roNodes),
+			zap.Any("available nodes", rwNodes),
+		)
+		return b.genStoppingSegmentPlan(ctx, replica, rwNodes, roNodes)
+	}
+
+	if plans := b.genHotSegmentPlan(ctx, br, replica, rwNodes); len(plans) > 0 {
+		return plans
+	}
+	if plans := b.genColdSegmentPlan(ctx, br, replica, rwNodes); len(plans) > 0 {
+		return plans
+	}
+
+	// balance the others by the score, the hot segments, the pinned cold segments
+	// and the segments moved recently stay where they are.
+	coldNodes := b.coldStorageNodes(rwNodes)
+	return lo.Filter(b.ScoreBasedBalancer.genSegmentPlan(ctx, br, replica, rwNodes), func(plan SegmentAssignPlan, _ int) bool {
+		if b.recentlyMoved(plan.Segment) {
+			return false
+		}
+		switch b.temperature(plan.Segment) {
+		case segmentHot:
+			return false
+		case segmentCold:
+			return len(coldNodes) == 0 || lo.Contains(coldNodes, plan.To)
+		default:
+			return true
+		}
+	})
+}
+
+func (b *HotColdBalancer) genStoppingSegmentPlan(ctx context.Context, replica *meta.Replica, onlineNodes []int64, offlineNodes []int64) []SegmentAssignPlan {
+	segmentPlans := make([]SegmentAssignPlan, 0)
+	for _, nodeID := range offlineNodes {
+		plans := b.AssignSegment(ctx, replica.GetCollectionID(), b.movableSegments(ctx, replica, nodeID), onlineNodes, false)
+		for i := range plans {
+			plans[i].From = nodeID
+			plans[i].Replica = replica
+		}
+		segmentPlans = append(segmentPlans, plans...)
+	}
+	return segmentPlans
+}
+
+// movableSegments returns the segments of the replica on the node which could be balanced.
+func (b *HotColdBalancer) movableSegments(ctx context.Context, replica *meta.Replica, nodeID int64) []*meta.Segment {
+	dist := b.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(replica.GetCollectionID()), meta.WithNodeID(nodeID))
+	return lo.Filter(dist, func(segment *meta.Segment, _ int) bool {
+		return b.targetMgr.CanSegmentBeMoved(ctx, segment.GetCollectionID(), segment.GetID()) &&
+			len(b.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica), meta.WithSegmentID(segment.GetID()))) == 1
+	})
+}
+
+// genHotSegmentPlan moves the hot segments out of the nodes with the access count above the average.
+func (b *HotColdBalancer) genHotSegmentPlan(ctx context.Context, br *balanceReport, replica *meta.Replica, onlineNodes []int64) []SegmentAssignPlan {
+	nodeAccess := make(map[int64]int64, len(onlineNodes))
+	total := int64(0)
+	for _, node := range onlineNodes {
+		nodeAccess[node] = b.nodeAccessCount(node)
+		total += nodeAccess[node]
+	}
+	if total == 0 {
+		return nil
+	}
+	average := float64(total) / float64(len(onlineNodes))
+	toleration := average * params.Params.QueryCoordCfg.ScoreUnbalanceTolerationFactor.GetAsFloat()
+
+	segmentsToMove := make([]*meta.Segment, 0)
+	for _, node := range onlineNodes {
+		if float64(nodeAccess[node]) <= average+toleration {
+			continue
+		}
+		hot := lo.Filter(b.movableSegments(ctx, replica, node), func(s *meta.Segment, _ int) bool {
+			return !b.recentlyMoved(s) && b.temperature(s) == segmentHot
+		})
+		br.AddRecord(StrRecordf("pick %d hot segments from node %d with access count %d above average %f", len(hot), node, nodeAccess[node], average))
+		segmentsToMove = append(segmentsToMove, hot...)
+	}
+
+	plans := b.assignHotSegment(br, segmentsToMove, onlineNodes, false)
+	for i := range plans {
+		plans[i].Replica = replica
+	}
+	return plans
+}
+
+// genColdSegmentPlan moves the cold segments to the nodes with mmap or lazyload enabled.
+func (b *HotColdBalancer) genColdSegmentPlan(ctx context.Context, br *balanceReport, replica *meta.Replica, onlineNodes []int64) []SegmentAssignPlan {
+	coldNodes := b.coldStorageNodes(onlineNodes)
+	if len(coldNodes) == 0 {
+		return nil
+	}
+
+	segmentsToMove := make([]*meta.Segment, 0)
+	for _, node := range onlineNodes {
+		if lo.Contains(coldNodes, node) {
+			continue
+		}
+		cold := lo.Filter(b.movableSegments(ctx, replica, node), func(s *meta.Segment, _ int) bool {
+			return !b.recentlyMoved(s) && b.temperature(s) == segmentCold
+		})
+		if len(cold) > 0 {
+			br.AddRecord(StrRecordf("pick %d cold segments from node %d without cold storage", len(cold), node))
+		}
+		segmentsToMove = append(segmentsToMove, cold...)
+	}
+	if len(segmentsToMove) == 0 {
+		return nil
+	}
+
+	plans := b.ScoreBasedBalancer.assignSegment(br, replica.GetCollectionID(), segmentsToMove, coldNodes, false)
+	for i := range plans {
+		plans[i].From = plans[i].Segment.Node
+		plans[i].Replica = replica
+	}
+	return plans
+}
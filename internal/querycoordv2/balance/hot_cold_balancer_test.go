@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type HotColdBalancerTestSuite struct {
+	suite.Suite
+	balancer *HotColdBalancer
+}
+
+func (suite *HotColdBalancerTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *HotColdBalancerTestSuite) SetupTest() {
+	nodeManager := session.NewNodeManager()
+	testMeta := meta.NewMeta(RandomIncrementIDAllocator(), mocks.NewQueryCoordCatalog(suite.T()), nodeManager)
+	targetMgr := meta.NewMockTargetManager(suite.T())
+	targetMgr.EXPECT().CanSegmentBeMoved(mock.Anything, mock.Anything, mock.Anything).Return(true).Maybe()
+	scheduler := task.NewMockScheduler(suite.T())
+	scheduler.EXPECT().GetSegmentTaskDelta(mock.Anything, mock.Anything).Return(0).Maybe()
+	scheduler.EXPECT().GetChannelTaskDelta(mock.Anything, mock.Anything).Return(0).Maybe()
+	suite.balancer = NewHotColdBalancer(scheduler, nodeManager, meta.NewDistributionManager(), testMeta, targetMgr)
+}
+
+func (suite *HotColdBalancerTestSuite) addNode(nodeID int64, state session.State, coldStorage bool, accessCounts map[int64]int64) {
+	nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID,
+		Address:  "127.0.0.1:0",
+		Hostname: "localhost",
+	})
+	nodeInfo.UpdateStats(
+		session.WithSegmentAccessCounts(accessCounts),
+		session.WithColdStorage(coldStorage, false),
+	)
+	nodeInfo.SetState(state)
+	suite.balancer.nodeManager.Add(nodeInfo)
+}
+
+func (suite *HotColdBalancerTestSuite) putSegments(nodeID int64, segmentIDs ...int64) {
+	segments := lo.Map(segmentIDs, func(id int64, _ int) *meta.Segment {
+		return &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: id, CollectionID: 1, NumOfRows: 10}, Node: nodeID}
+	})
+	suite.balancer.dist.SegmentDistManager.Update(nodeID, segments...)
+}
+
+func (suite *HotColdBalancerTestSuite) TestAssignSegment() {
+	suite.addNode(1, session.NodeStateNormal, true, nil)
+	suite.addNode(2, session.NodeStateNormal, false, map[int64]int64{20: 300})
+	suite.addNode(3, session.NodeStateNormal, false, nil)
+	suite.addNode(4, session.NodeStateStopping, false, map[int64]int64{10: 500})
+	suite.putSegments(2, 20)
+	suite.putSegments(4, 10, 11)
+
+	segments := append(suite.balancer.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(4)),
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 12, CollectionID: 1, NumOfRows: 10}})
+	plans := suite.balancer.AssignSegment(context.Background(), 1, segments, []int64{1, 2, 3}, false)
+	targets := lo.SliceToMap(plans, func(plan SegmentAssignPlan) (int64, int64) {
+		return plan.Segment.GetID(), plan.To
+	})
+	suite.Len(targets, 3)
+	// the hot segment goes to the node with the least access count.
+	suite.Equal(int64(1), targets[10])
+	// the cold segment is pinned to the node with cold storage.
+	suite.Equal(int64(1), targets[11])
+	// the segment not loaded yet is assigned by the score.
+	suite.Contains([]int64{1, 2, 3}, targets[12])
+}
+
+func (suite *HotColdBalancerTestSuite) TestBalanceHotSegment() {
+	suite.addNode(1, session.NodeStateNormal, false, map[int64]int64{1: 500, 2: 400})
+	suite.addNode(2, session.NodeStateNormal, false, nil)
+	suite.putSegments(1, 1, 2)
+	suite.putSegments(2, 3)
+	replica := meta.NewReplica(&querypb.Replica{ID: 1, CollectionID: 1, Nodes: []int64{1, 2}, ResourceGroup: meta.DefaultResourceGroupName})
+
+	segmentPlans, channelPlans := suite.balancer.BalanceReplica(context.Background(), replica)
+	suite.Empty(channelPlans)
+	suite.Len(segmentPlans, 1)
+	suite.Equal(int64(1), segmentPlans[0].Segment.GetID())
+	suite.Equal(int64(1), segmentPlans[0].From)
+	suite.Equal(int64(2), segmentPlans[0].To)
+}
+
+func (suite *HotColdBalancerTestSuite) TestBalanceColdSegment() {
+	suite.addNode(1, session.NodeStateNormal, true, nil)
+	suite.addNode(2, session.NodeStateNormal, false, map[int64]int64{6: 50})
+	suite.putSegments(2, 5, 6)
+	replica := meta.NewReplica(&querypb.Replica{ID: 1, CollectionID: 1, Nodes: []int64{1, 2}, ResourceGroup: meta.DefaultResourceGroupName})
+
+	segmentPlans, _ := suite.balancer.BalanceReplica(context.Background(), replica)
+	suite.Len(segmentPlans, 1)
+	suite.Equal(int64(5), segmentPlans[0].Segment.GetID())
+	suite.Equal(int64(2), segmentPlans[0].From)
+	suite.Equal(int64(1), segmentPlans[0].To)
+}
+
+func (suite *HotColdBalancerTestSuite) TestMovedSegmentKeepsAccess() {
+	suite.addNode(1, session.NodeStateNormal, true, map[int64]int64{1: 500, 2: 400})
+	suite.addNode(2, session.NodeStateNormal, false, nil)
+	suite.putSegments(1, 1, 2)
+	replica := meta.NewReplica(&querypb.Replica{ID: 1, CollectionID: 1, Nodes: []int64{1, 2}, ResourceGroup: meta.DefaultResourceGroupName})
+
+	segmentPlans, _ := suite.balancer.BalanceReplica(context.Background(), replica)
+	suite.Len(segmentPlans, 1)
+	suite.Equal(int64(1), segmentPlans[0].Segment.GetID())
+	suite.Equal(int64(2), segmentPlans[0].To)
+
+	// the segment moved counts the access from zero on the new node.
+	suite.balancer.dist.SegmentDistManager.Update(1, &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 1, NumOfRows: 10}, Node: 1})
+	suite.putSegments(2, 1)
+	suite.balancer.nodeManager.Get(1).UpdateStats(session.WithSegmentAccessCounts(map[int64]int64{2: 400}))
+	suite.Equal(int64(500), suite.balancer.nodeAccessCount(2))
+	suite.Equal(segmentHot, suite.balancer.temperature(suite.balancer.dist.SegmentDistManager.GetByFilter(meta.WithSegmentID(1))[0]))
+
+	// the moved segment stays on the new node, instead of moving to the cold storage node.
+	segmentPlans, _ = suite.balancer.BalanceReplica(context.Background(), replica)
+	suite.Empty(segmentPlans)
+
+	// the access count before the move is dropped after the cooldown.
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MovedSegmentCooldown.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.MovedSegmentCooldown.Key)
+	suite.Equal(int64(0), suite.balancer.nodeAccessCount(2))
+}
+
+func TestHotColdBalancerSuite(t *testing.T) {
+	suite.Run(t, new(HotColdBalancerTestSuite))
+}
@@ -129,6 +129,11 @@ func (dh *distHandler) handleDistResp(ctx context.Context, resp *querypb.GetData
 			zap.Time("lastHeartBeatTime", node.LastHeartbeat()), zap.Int64("nodeID", node.ID()))
 	}
 	node.SetLastHeartbeat(time.Now())
+	// the segment access counts change without distribution change.
+	node.UpdateStats(
+		session.WithSegmentAccessCounts(resp.GetSegmentAccessCounts()),
+		session.WithColdStorage(resp.GetMmapEnabled(), resp.GetLazyloadEnabled()),
+	)
 
 	// skip  update dist if no distribution change happens in query node
 	if resp.GetLastModifyTs() != 0 && resp.GetLastModifyTs() <= dh.lastUpdateTs {
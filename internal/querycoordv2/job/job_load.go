@@ -212,13 +212,14 @@ func (job *LoadCollectionJob) Execute() error {
 	ctx, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
 	collection := &meta.Collection{
 		CollectionLoadInfo: &querypb.CollectionLoadInfo{
-			CollectionID:  req.GetCollectionID(),
-			ReplicaNumber: req.GetReplicaNumber(),
-			Status:        querypb.LoadStatus_Loading,
-			FieldIndexID:  req.GetFieldIndexID(),
-			LoadType:      querypb.LoadType_LoadCollection,
-			LoadFields:    req.GetLoadFields(),
-			DbID:          job.collInfo.GetDbId(),
+			CollectionID:       req.GetCollectionID(),
+			ReplicaNumber:      req.GetReplicaNumber(),
+			Status:             querypb.LoadStatus_Loading,
+			FieldIndexID:       req.GetFieldIndexID(),
+			LoadType:           querypb.LoadType_LoadCollection,
+			LoadFields:         req.GetLoadFields(),
+			DbID:               job.collInfo.GetDbId(),
+			IdleReleaseSeconds: meta.IdleReleaseSeconds(job.collInfo.GetProperties()),
 		},
 		CreatedAt: time.Now(),
 		LoadSpan:  sp,
@@ -417,13 +418,14 @@ func (job *LoadPartitionJob) Execute() error {
 
 		collection := &meta.Collection{
 			CollectionLoadInfo: &querypb.CollectionLoadInfo{
-				CollectionID:  req.GetCollectionID(),
-				ReplicaNumber: req.GetReplicaNumber(),
-				Status:        querypb.LoadStatus_Loading,
-				FieldIndexID:  req.GetFieldIndexID(),
-				LoadType:      querypb.LoadType_LoadPartition,
-				LoadFields:    req.GetLoadFields(),
-				DbID:          job.collInfo.GetDbId(),
+				CollectionID:       req.GetCollectionID(),
+				ReplicaNumber:      req.GetReplicaNumber(),
+				Status:             querypb.LoadStatus_Loading,
+				FieldIndexID:       req.GetFieldIndexID(),
+				LoadType:           querypb.LoadType_LoadPartition,
+				LoadFields:         req.GetLoadFields(),
+				DbID:               job.collInfo.GetDbId(),
+				IdleReleaseSeconds: meta.IdleReleaseSeconds(job.collInfo.GetProperties()),
 			},
 			CreatedAt: time.Now(),
 			LoadSpan:  sp,
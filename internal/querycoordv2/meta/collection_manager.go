@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -90,6 +91,16 @@ func (collection *Collection) Clone() *Collection {
 	}
 }
 
+// IdleReleaseSeconds returns the idle release seconds configured by the collection properties,
+// 0 if the collection is never released for idle.
+func IdleReleaseSeconds(properties []*commonpb.KeyValuePair) int64 {
+	idle, ok := common.GetCollectionIdleReleaseDuration(properties...)
+	if !ok {
+		return 0
+	}
+	return int64(idle / time.Second)
+}
+
 type Partition struct {
 	*querypb.PartitionLoadInfo
 	LoadPercentage int32
@@ -640,3 +651,21 @@ func (m *CollectionManager) UpdateReplicaNumber(ctx context.Context, collectionI
 
 	return m.putCollection(ctx, true, newCollection, newPartitions...)
 }
+
+// UpdateIdleRelease refreshes the cached idle release seconds of the loaded collection with its altered properties.
+func (m *CollectionManager) UpdateIdleRelease(ctx context.Context, collectionID typeutil.UniqueID, properties []*commonpb.KeyValuePair) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	seconds := IdleReleaseSeconds(properties)
+	if collection.GetIdleReleaseSeconds() == seconds {
+		return nil
+	}
+	newCollection := collection.Clone()
+	newCollection.IdleReleaseSeconds = seconds
+	return m.putCollection(ctx, true, newCollection)
+}
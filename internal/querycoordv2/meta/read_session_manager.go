@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// ReadSession pins the sealed segments of the current target when the session begins,
+// so the segments serving the snapshot of the session are neither released from the query nodes
+// nor garbage collected after compaction until the session expires.
+type ReadSession struct {
+	ID           string
+	CollectionID int64
+	Timestamp    uint64
+	SegmentIDs   typeutil.UniqueSet
+	ExpireAt     time.Time
+}
+
+// ReadSessionManager manages the read sessions in memory,
+// the sessions are lost once the query coord restarts, which only loses the protection of the snapshots.
+type ReadSessionManager struct {
+	mu       sync.RWMutex
+	sessions map[string]*ReadSession
+}
+
+func NewReadSessionManager() *ReadSessionManager {
+	return &ReadSessionManager{
+		sessions: make(map[string]*ReadSession),
+	}
+}
+
+// BeginReadSession registers the read session pinning the segments, or renews it if it exists.
+func (m *ReadSessionManager) BeginReadSession(id string, collectionID int64, timestamp uint64, segmentIDs []int64, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[id] = &ReadSession{
+		ID:           id,
+		CollectionID: collectionID,
+		Timestamp:    timestamp,
+		SegmentIDs:   typeutil.NewUniqueSet(segmentIDs...),
+		ExpireAt:     time.Now().Add(ttl),
+	}
+}
+
+// GetReadSession returns the unexpired read session, returns false if the session is unknown or expired.
+func (m *ReadSessionManager) GetReadSession(id string) (*ReadSession, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	session, ok := m.sessions[id]
+	if !ok || time.Now().After(session.ExpireAt) {
+		return nil, false
+	}
+	return session, true
+}
+
+// EndReadSession removes the read session, returns false if the session doesn't exist.
+func (m *ReadSessionManager) EndReadSession(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.sessions[id]
+	delete(m.sessions, id)
+	return ok
+}
+
+// RemoveReadSessions removes all the read sessions of the collection, used once the collection is released.
+func (m *ReadSessionManager) RemoveReadSessions(collectionID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, session := range m.sessions {
+		if session.CollectionID == collectionID {
+			delete(m.sessions, id)
+		}
+	}
+}
+
+// HasReadSession returns whether the collection has any unexpired read session.
+func (m *ReadSessionManager) HasReadSession(collectionID int64) bool {
+	has := false
+	m.rangeReadSessions(func(session *ReadSession) {
+		has = has || session.CollectionID == collectionID
+	})
+	return has
+}
+
+// GetPinnedSegments returns the segments of the collection pinned by the unexpired read sessions.
+func (m *ReadSessionManager) GetPinnedSegments(collectionID int64) typeutil.UniqueSet {
+	segmentIDs := typeutil.NewUniqueSet()
+	m.rangeReadSessions(func(session *ReadSession) {
+		if session.CollectionID == collectionID {
+			segmentIDs.Insert(session.SegmentIDs.Collect()...)
+		}
+	})
+	return segmentIDs
+}
+
+// ListPinnedSegments returns the segments of all the collections pinned by the unexpired read sessions.
+func (m *ReadSessionManager) ListPinnedSegments() typeutil.UniqueSet {
+	segmentIDs := typeutil.NewUniqueSet()
+	m.rangeReadSessions(func(session *ReadSession) {
+		segmentIDs.Insert(session.SegmentIDs.Collect()...)
+	})
+	return segmentIDs
+}
+
+// rangeReadSessions calls the fn on each unexpired read session, the expired sessions are removed.
+func (m *ReadSessionManager) rangeReadSessions(fn func(session *ReadSession)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for id, session := range m.sessions {
+		if now.After(session.ExpireAt) {
+			delete(m.sessions, id)
+			continue
+		}
+		fn(session)
+	}
+}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSessionManager(t *testing.T) {
+	m := NewReadSessionManager()
+	assert.False(t, m.HasReadSession(100))
+
+	m.BeginReadSession("s1", 100, 1000, []int64{1, 2}, time.Minute)
+	m.BeginReadSession("s2", 100, 1001, []int64{2, 3}, time.Minute)
+	m.BeginReadSession("s3", 101, 1002, []int64{4}, time.Minute)
+	assert.True(t, m.HasReadSession(100))
+	assert.True(t, m.HasReadSession(101))
+	assert.False(t, m.HasReadSession(102))
+	assert.ElementsMatch(t, []int64{1, 2, 3}, m.GetPinnedSegments(100).Collect())
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, m.ListPinnedSegments().Collect())
+
+	session, ok := m.GetReadSession("s1")
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), session.CollectionID)
+	assert.Equal(t, uint64(1000), session.Timestamp)
+	_, ok = m.GetReadSession("unknown")
+	assert.False(t, ok)
+
+	assert.True(t, m.EndReadSession("s1"))
+	assert.False(t, m.EndReadSession("s1"))
+	_, ok = m.GetReadSession("s1")
+	assert.False(t, ok)
+	assert.True(t, m.HasReadSession(100))
+	assert.ElementsMatch(t, []int64{2, 3}, m.GetPinnedSegments(100).Collect())
+	assert.True(t, m.EndReadSession("s2"))
+	assert.False(t, m.HasReadSession(100))
+	assert.Zero(t, m.GetPinnedSegments(100).Len())
+
+	m.RemoveReadSessions(101)
+	assert.False(t, m.HasReadSession(101))
+	assert.Zero(t, m.ListPinnedSegments().Len())
+
+	// the expired session is removed.
+	m.BeginReadSession("s4", 100, 1000, []int64{1}, -time.Second)
+	_, ok = m.GetReadSession("s4")
+	assert.False(t, ok)
+	assert.False(t, m.HasReadSession(100))
+	assert.Zero(t, m.GetPinnedSegments(100).Len())
+	assert.False(t, m.EndReadSession("s4"))
+
+	// begin an existing session renews it.
+	m.BeginReadSession("s5", 100, 1000, nil, -time.Second)
+	m.BeginReadSession("s5", 100, 1000, nil, time.Minute)
+	assert.True(t, m.HasReadSession(100))
+}
@@ -178,6 +178,8 @@ func (mgr *TargetManager) UpdateCollectionNextTarget(ctx context.Context, collec
 		return nil
 	}
 
+	mgr.keepPinnedSegments(collectionID, segments, dmChannels)
+
 	allocatedTarget := NewCollectionTarget(segments, dmChannels, partitionIDs)
 
 	mgr.next.updateCollectionTarget(collectionID, allocatedTarget)
@@ -189,6 +191,59 @@ func (mgr *TargetManager) UpdateCollectionNextTarget(ctx context.Context, collec
 	return nil
 }
 
+// keepPinnedSegments keeps the segments pinned by the read sessions readable in the next target.
+// The pinned segments dropped from the current target, e.g. by compaction, are retained,
+// and the segments compacted from them are left out until the sessions end to avoid reading the rows twice,
+// while the other changes of the next target are applied as usual.
+func (mgr *TargetManager) keepPinnedSegments(collectionID int64, segments map[int64]*datapb.SegmentInfo, dmChannels map[string]*DmChannel) {
+	pinned := mgr.meta.GetPinnedSegments(collectionID)
+	current := mgr.current.getCollectionTarget(collectionID)
+	if pinned.Len() == 0 || current == nil {
+		return
+	}
+
+	currentSegments := current.GetAllSegments()
+	retained := typeutil.NewUniqueSet()
+	for segmentID := range pinned {
+		if _, ok := segments[segmentID]; ok {
+			continue
+		}
+		if _, ok := currentSegments[segmentID]; ok {
+			retained.Insert(segmentID)
+		}
+	}
+	if retained.Len() == 0 {
+		return
+	}
+
+	// the compaction results of the retained segments are replaced by all the segments they are compacted from.
+	for segmentID, segment := range segments {
+		compactedFromRetained := lo.ContainsBy(segment.GetCompactionFrom(), func(fromID int64) bool {
+			return retained.Contain(fromID)
+		})
+		if !compactedFromRetained {
+			continue
+		}
+		delete(segments, segmentID)
+		for _, fromID := range segment.GetCompactionFrom() {
+			if _, ok := currentSegments[fromID]; ok {
+				retained.Insert(fromID)
+			}
+		}
+	}
+	for segmentID := range retained {
+		segments[segmentID] = currentSegments[segmentID]
+	}
+	for _, channel := range dmChannels {
+		channel.DroppedSegmentIds = lo.Filter(channel.GetDroppedSegmentIds(), func(segmentID int64, _ int) bool {
+			return !retained.Contain(segmentID)
+		})
+	}
+	log.Info("keep the segments pinned by read sessions in next target",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", retained.Collect()))
+}
+
 func mergeDmChannelInfo(infos []*datapb.VchannelInfo) *DmChannel {
 	var dmChannel *DmChannel
 
@@ -245,6 +245,44 @@ func (suite *TargetManagerSuite) TestUpdateNextTarget() {
 	suite.NoError(err)
 }
 
+func (suite *TargetManagerSuite) TestKeepPinnedSegments() {
+	ctx := suite.ctx
+	collectionID := int64(1000)
+	suite.mgr.UpdateCollectionCurrentTarget(ctx, collectionID)
+	suite.meta.BeginReadSession("session", collectionID, 1000, []int64{1}, time.Minute)
+
+	// segment 1 and 2 are compacted into segment 20, segment 21 is newly flushed.
+	nextTargetChannels := []*datapb.VchannelInfo{
+		{
+			CollectionID:      collectionID,
+			ChannelName:       "1000-dmc0",
+			DroppedSegmentIds: []int64{1, 2},
+		},
+		{
+			CollectionID: collectionID,
+			ChannelName:  "1000-dmc1",
+		},
+	}
+	nextTargetSegments := []*datapb.SegmentInfo{
+		{ID: 20, PartitionID: 100, InsertChannel: "1000-dmc0", CompactionFrom: []int64{1, 2}},
+		{ID: 3, PartitionID: 101, InsertChannel: "1000-dmc0"},
+		{ID: 4, PartitionID: 101, InsertChannel: "1000-dmc0"},
+		{ID: 21, PartitionID: 101, InsertChannel: "1000-dmc1"},
+	}
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(nextTargetChannels, nextTargetSegments, nil)
+
+	// the pinned segment is kept together with the segment compacted along with it, the others are updated.
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(ctx, collectionID))
+	suite.True(suite.assertSegments([]int64{1, 2, 3, 4, 21}, suite.mgr.GetSealedSegmentsByCollection(ctx, collectionID, NextTarget)))
+	suite.Empty(suite.mgr.GetDroppedSegmentsByChannel(ctx, collectionID, "1000-dmc0", NextTarget))
+
+	// the compaction result is applied once the session ends.
+	suite.True(suite.meta.EndReadSession("session"))
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(ctx, collectionID))
+	suite.True(suite.assertSegments([]int64{20, 3, 4, 21}, suite.mgr.GetSealedSegmentsByCollection(ctx, collectionID, NextTarget)))
+}
+
 func (suite *TargetManagerSuite) TestRemovePartition() {
 	ctx := suite.ctx
 	collectionID := int64(1000)
@@ -53,6 +53,8 @@ type CollectionObserver struct {
 	partitionLoadedCount map[int64]int
 
 	loadTasks *typeutil.ConcurrentMap[string, LoadTask]
+	// lastAccess is the last time the collection is loaded, searched or queried, reported by the proxies.
+	lastAccess *typeutil.ConcurrentMap[int64, time.Time]
 
 	proxyManager proxyutil.ProxyClientManagerInterface
 
@@ -82,6 +84,7 @@ func NewCollectionObserver(
 		checkerController:    checherController,
 		partitionLoadedCount: make(map[int64]int),
 		loadTasks:            typeutil.NewConcurrentMap[string, LoadTask](),
+		lastAccess:           typeutil.NewConcurrentMap[int64, time.Time](),
 		proxyManager:         proxyManager,
 	}
 
@@ -140,6 +143,7 @@ func (ob *CollectionObserver) LoadCollection(ctx context.Context, collectionID i
 	}
 
 	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadCollection, CollectionID: collectionID})
+	ob.RecordAccess(collectionID)
 	ob.checkerController.Check()
 }
 
@@ -153,9 +157,23 @@ func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID i
 	}
 
 	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadPartition, CollectionID: collectionID, PartitionIDs: partitionIDs})
+	ob.RecordAccess(collectionID)
 	ob.checkerController.Check()
 }
 
+// RecordAccess records the collections are accessed now.
+func (ob *CollectionObserver) RecordAccess(collectionIDs ...int64) {
+	now := time.Now()
+	for _, collectionID := range collectionIDs {
+		ob.lastAccess.Insert(collectionID, now)
+	}
+}
+
+// LastAccess returns the last time the collection is loaded, searched or queried.
+func (ob *CollectionObserver) LastAccess(collectionID int64) (time.Time, bool) {
+	return ob.lastAccess.Get(collectionID)
+}
+
 func (ob *CollectionObserver) Observe(ctx context.Context) {
 	ob.observeTimeout(ctx)
 	ob.observeLoadStatus(ctx)
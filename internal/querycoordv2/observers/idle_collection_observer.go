@@ -0,0 +1,271 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type (
+	ReleaseCollectionFunc func(ctx context.Context, collectionID int64) error
+	LoadCollectionFunc    func(ctx context.Context, req *querypb.LoadCollectionRequest) error
+)
+
+// IdleCollectionObserver releases the loaded collections which are not searched or queried
+// for the minutes of the collection.idleRelease.minutes property, and reloads them with the same
+// replica number, resource groups and load fields once they're accessed again.
+type IdleCollectionObserver struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	meta               *meta.Meta
+	catalog            metastore.QueryCoordCatalog
+	collectionObserver *CollectionObserver
+	release            ReleaseCollectionFunc
+	load               LoadCollectionFunc
+
+	keyLock *lock.KeyLock[int64]
+	// released is the load requests of the collections released for idle.
+	released *typeutil.ConcurrentMap[int64, *querypb.LoadCollectionRequest]
+	// reloading is the collections reloaded on access, until they're fully loaded.
+	reloading *typeutil.ConcurrentSet[int64]
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewIdleCollectionObserver(
+	meta *meta.Meta,
+	catalog metastore.QueryCoordCatalog,
+	collectionObserver *CollectionObserver,
+	release ReleaseCollectionFunc,
+	load LoadCollectionFunc,
+) *IdleCollectionObserver {
+	return &IdleCollectionObserver{
+		meta:               meta,
+		catalog:            catalog,
+		collectionObserver: collectionObserver,
+		release:            release,
+		load:               load,
+		keyLock:            lock.NewKeyLock[int64](),
+		released:           typeutil.NewConcurrentMap[int64, *querypb.LoadCollectionRequest](),
+		reloading:          typeutil.NewConcurrentSet[int64](),
+	}
+}
+
+// Recover recovers the collections released for idle from the catalog.
+func (ob *IdleCollectionObserver) Recover(ctx context.Context) error {
+	reqs, err := ob.catalog.GetIdleReleasedCollections(ctx)
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		ob.released.Insert(req.GetCollectionID(), req)
+	}
+	log.Ctx(ctx).Info("recover idle released collections done", zap.Int("num", len(reqs)))
+	return nil
+}
+
+func (ob *IdleCollectionObserver) Start() {
+	ob.startOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		ob.cancel = cancel
+
+		interval := params.Params.QueryCoordCfg.IdleCollectionCheckInterval.GetAsDuration(time.Second)
+		ob.wg.Add(1)
+		go func() {
+			defer ob.wg.Done()
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					log.Info("IdleCollectionObserver stopped")
+					return
+
+				case <-ticker.C:
+					ob.checkIdle(ctx)
+				}
+			}
+		}()
+	})
+}
+
+func (ob *IdleCollectionObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+// checkIdle releases the collections idle for longer than their idle release minutes.
+func (ob *IdleCollectionObserver) checkIdle(ctx context.Context) {
+	// the access of the released collections are useless.
+	ob.collectionObserver.lastAccess.Range(func(collectionID int64, _ time.Time) bool {
+		if !ob.meta.CollectionManager.Exist(ctx, collectionID) {
+			ob.collectionObserver.lastAccess.Remove(collectionID)
+		}
+		return true
+	})
+
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections(ctx) {
+		// the partial loaded collections are never released for idle.
+		if collection.GetLoadType() != querypb.LoadType_LoadCollection ||
+			collection.GetStatus() != querypb.LoadStatus_Loaded {
+			continue
+		}
+		// the snapshots of the read sessions must be kept.
+		if ob.meta.HasReadSession(collection.GetCollectionID()) {
+			continue
+		}
+		lastAccess, ok := ob.collectionObserver.LastAccess(collection.GetCollectionID())
+		if !ok {
+			ob.collectionObserver.RecordAccess(collection.GetCollectionID())
+			continue
+		}
+		// the idle release ttl is cached in the load meta, and refreshed once the collection is altered.
+		idle := time.Duration(collection.GetIdleReleaseSeconds()) * time.Second
+		if idle <= 0 || time.Since(lastAccess) < idle {
+			continue
+		}
+		ob.releaseIdle(ctx, collection, lastAccess)
+	}
+}
+
+func (ob *IdleCollectionObserver) releaseIdle(ctx context.Context, collection *meta.Collection, lastAccess time.Time) {
+	collectionID := collection.GetCollectionID()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Time("lastAccess", lastAccess))
+
+	ob.keyLock.Lock(collectionID)
+	defer ob.keyLock.Unlock(collectionID)
+
+	// the load config is saved before releasing, so the collection is always reloaded if the release is done.
+	req := &querypb.LoadCollectionRequest{
+		DbID:           collection.GetDbID(),
+		CollectionID:   collectionID,
+		ReplicaNumber:  collection.GetReplicaNumber(),
+		FieldIndexID:   collection.GetFieldIndexID(),
+		ResourceGroups: ob.meta.ReplicaManager.GetResourceGroupByCollection(ctx, collectionID).Collect(),
+		LoadFields:     collection.GetLoadFields(),
+	}
+	if err := ob.catalog.SaveIdleReleasedCollection(ctx, req); err != nil {
+		log.Warn("failed to save idle released collection", zap.Error(err))
+		metrics.QueryCoordIdleReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return
+	}
+	if err := ob.release(ctx, collectionID); err != nil {
+		log.Warn("failed to release idle collection", zap.Error(err))
+		if err := ob.catalog.RemoveIdleReleasedCollection(ctx, collectionID); err != nil {
+			log.Warn("failed to remove idle released collection", zap.Error(err))
+		}
+		metrics.QueryCoordIdleReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return
+	}
+	ob.released.Insert(collectionID, req)
+	metrics.QueryCoordIdleReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	log.Info("collection released for idle",
+		zap.Int32("replicaNumber", req.GetReplicaNumber()),
+		zap.Strings("resourceGroups", req.GetResourceGroups()))
+}
+
+// Access records the access of the collections, and reloads the collections released for idle.
+// It returns the collections which are reloading.
+func (ob *IdleCollectionObserver) Access(ctx context.Context, collectionIDs []int64) []int64 {
+	ob.collectionObserver.RecordAccess(collectionIDs...)
+	return lo.Filter(collectionIDs, func(collectionID int64, _ int) bool {
+		return ob.reload(ctx, collectionID)
+	})
+}
+
+func (ob *IdleCollectionObserver) reload(ctx context.Context, collectionID int64) bool {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	ob.keyLock.Lock(collectionID)
+	defer ob.keyLock.Unlock(collectionID)
+
+	// the collection may be loaded explicitly after released for idle.
+	if ob.meta.CollectionManager.Exist(ctx, collectionID) {
+		ob.forget(ctx, collectionID)
+	}
+	if req, ok := ob.released.Get(collectionID); ok {
+		req = proto.Clone(req).(*querypb.LoadCollectionRequest)
+		// the resource groups may be dropped after released, load with the configured load info then.
+		if lo.ContainsBy(req.GetResourceGroups(), func(rg string) bool {
+			return !ob.meta.ResourceManager.ContainResourceGroup(ctx, rg)
+		}) {
+			log.Info("resource groups of idle released collection not found, reload with the configured load info",
+				zap.Strings("resourceGroups", req.GetResourceGroups()))
+			req.ReplicaNumber = 0
+			req.ResourceGroups = nil
+		}
+		if err := ob.load(ctx, req); err != nil {
+			log.Warn("failed to reload idle released collection", zap.Error(err))
+			metrics.QueryCoordIdleReloadCount.WithLabelValues(metrics.FailLabel).Inc()
+			return false
+		}
+		ob.forget(ctx, collectionID)
+		ob.reloading.Insert(collectionID)
+		metrics.QueryCoordIdleReloadCount.WithLabelValues(metrics.SuccessLabel).Inc()
+		log.Info("reload idle released collection on access")
+	}
+
+	if !ob.reloading.Contain(collectionID) {
+		return false
+	}
+	collection := ob.meta.CollectionManager.GetCollection(ctx, collectionID)
+	if collection == nil || collection.GetStatus() == querypb.LoadStatus_Loaded {
+		ob.reloading.Remove(collectionID)
+		return false
+	}
+	return true
+}
+
+// Forget forgets the collection released for idle, it's called once the collection is released explicitly.
+func (ob *IdleCollectionObserver) Forget(ctx context.Context, collectionID int64) {
+	ob.keyLock.Lock(collectionID)
+	defer ob.keyLock.Unlock(collectionID)
+	ob.forget(ctx, collectionID)
+	ob.reloading.Remove(collectionID)
+}
+
+func (ob *IdleCollectionObserver) forget(ctx context.Context, collectionID int64) {
+	if _, ok := ob.released.Get(collectionID); !ok {
+		return
+	}
+	if err := ob.catalog.RemoveIdleReleasedCollection(ctx, collectionID); err != nil {
+		log.Ctx(ctx).Warn("failed to remove idle released collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+	ob.released.Remove(collectionID)
+}
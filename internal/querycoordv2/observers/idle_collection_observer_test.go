@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type IdleCollectionObserverSuite struct {
+	suite.Suite
+
+	ctx     context.Context
+	store   *mocks.QueryCoordCatalog
+	meta    *meta.Meta
+	collOb  *CollectionObserver
+	ob      *IdleCollectionObserver
+	loaded  []*querypb.LoadCollectionRequest
+	release []int64
+}
+
+func (suite *IdleCollectionObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *IdleCollectionObserverSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.store = mocks.NewQueryCoordCatalog(suite.T())
+	suite.store.EXPECT().SaveCollection(mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.store.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.store.EXPECT().ReleaseCollection(mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.store.EXPECT().ReleaseReplicas(mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), suite.store, session.NewNodeManager())
+	suite.collOb = &CollectionObserver{
+		meta:       suite.meta,
+		lastAccess: typeutil.NewConcurrentMap[int64, time.Time](),
+	}
+	suite.loaded = nil
+	suite.release = nil
+
+	suite.ob = NewIdleCollectionObserver(suite.meta, suite.store, suite.collOb,
+		func(ctx context.Context, collectionID int64) error {
+			suite.release = append(suite.release, collectionID)
+			suite.meta.CollectionManager.RemoveCollection(ctx, collectionID)
+			suite.meta.ReplicaManager.RemoveCollection(ctx, collectionID)
+			return nil
+		},
+		func(ctx context.Context, req *querypb.LoadCollectionRequest) error {
+			suite.loaded = append(suite.loaded, req)
+			suite.putCollection(req.GetCollectionID(), querypb.LoadStatus_Loading)
+			return nil
+		},
+	)
+}
+
+func (suite *IdleCollectionObserverSuite) putCollection(collectionID int64, status querypb.LoadStatus) {
+	collection := utils.CreateTestCollection(collectionID, 1)
+	collection.LoadType = querypb.LoadType_LoadCollection
+	collection.Status = status
+	suite.NoError(suite.meta.CollectionManager.PutCollection(suite.ctx, collection))
+	suite.NoError(suite.meta.ReplicaManager.Put(suite.ctx, utils.CreateTestReplica(collectionID*10, collectionID, nil)))
+}
+
+func (suite *IdleCollectionObserverSuite) TestReleaseAndReload() {
+	suite.putCollection(1, querypb.LoadStatus_Loaded)
+	suite.putCollection(2, querypb.LoadStatus_Loaded)
+	suite.NoError(suite.meta.CollectionManager.UpdateIdleRelease(suite.ctx, 1, []*commonpb.KeyValuePair{
+		{Key: common.CollectionIdleReleaseMinutesKey, Value: "1"},
+	}))
+	suite.store.EXPECT().SaveIdleReleasedCollection(mock.Anything, mock.Anything).Return(nil).Once()
+
+	// the collections without access are regarded as accessed now.
+	suite.ob.checkIdle(suite.ctx)
+	suite.Empty(suite.release)
+
+	suite.collOb.lastAccess.Insert(1, time.Now().Add(-2*time.Minute))
+	suite.collOb.lastAccess.Insert(2, time.Now().Add(-2*time.Minute))
+	suite.ob.checkIdle(suite.ctx)
+	suite.Equal([]int64{1}, suite.release)
+	_, ok := suite.ob.released.Get(1)
+	suite.True(ok)
+
+	// reload with the same load config on access.
+	suite.store.EXPECT().RemoveIdleReleasedCollection(mock.Anything, int64(1)).Return(nil).Once()
+	suite.Equal([]int64{1}, suite.ob.Access(suite.ctx, []int64{1, 3}))
+	suite.Len(suite.loaded, 1)
+	suite.Equal(int32(1), suite.loaded[0].GetReplicaNumber())
+	suite.Equal([]string{meta.DefaultResourceGroupName}, suite.loaded[0].GetResourceGroups())
+	_, ok = suite.collOb.LastAccess(3)
+	suite.True(ok)
+
+	// the collection is reloading until it's fully loaded.
+	suite.Equal([]int64{1}, suite.ob.Access(suite.ctx, []int64{1}))
+	suite.Len(suite.loaded, 1)
+	suite.meta.CollectionManager.GetCollection(suite.ctx, 1).Status = querypb.LoadStatus_Loaded
+	suite.Empty(suite.ob.Access(suite.ctx, []int64{1}))
+
+	// the access of the released collections are cleared.
+	suite.ob.checkIdle(suite.ctx)
+	_, ok = suite.collOb.LastAccess(3)
+	suite.False(ok)
+}
+
+func (suite *IdleCollectionObserverSuite) TestIdleReleaseAltered() {
+	suite.putCollection(1, querypb.LoadStatus_Loaded)
+	suite.collOb.lastAccess.Insert(1, time.Now().Add(-2*time.Minute))
+
+	// never released for idle without the property.
+	suite.ob.checkIdle(suite.ctx)
+	suite.Empty(suite.release)
+
+	// the altered property takes effect without reloading.
+	suite.NoError(suite.meta.CollectionManager.UpdateIdleRelease(suite.ctx, 1, []*commonpb.KeyValuePair{
+		{Key: common.CollectionIdleReleaseMinutesKey, Value: "1"},
+	}))
+	suite.Equal(int64(60), suite.meta.CollectionManager.GetCollection(suite.ctx, 1).GetIdleReleaseSeconds())
+	suite.store.EXPECT().SaveIdleReleasedCollection(mock.Anything, mock.Anything).Return(nil).Once()
+	suite.ob.checkIdle(suite.ctx)
+	suite.Equal([]int64{1}, suite.release)
+}
+
+func (suite *IdleCollectionObserverSuite) TestReleasedExplicitly() {
+	suite.store.EXPECT().GetIdleReleasedCollections(mock.Anything).Return([]*querypb.LoadCollectionRequest{
+		{CollectionID: 1, ReplicaNumber: 2, ResourceGroups: []string{"rg_dropped"}},
+		{CollectionID: 2, ReplicaNumber: 1},
+	}, nil)
+	suite.NoError(suite.ob.Recover(suite.ctx))
+
+	// the resource group is dropped, reload with the configured load info.
+	suite.store.EXPECT().RemoveIdleReleasedCollection(mock.Anything, int64(1)).Return(nil).Once()
+	suite.Equal([]int64{1}, suite.ob.Access(suite.ctx, []int64{1}))
+	suite.Equal(int32(0), suite.loaded[0].GetReplicaNumber())
+	suite.Empty(suite.loaded[0].GetResourceGroups())
+
+	// the collection released explicitly is never reloaded.
+	suite.store.EXPECT().RemoveIdleReleasedCollection(mock.Anything, int64(2)).Return(nil).Once()
+	suite.ob.Forget(suite.ctx, 2)
+	suite.Empty(suite.ob.Access(suite.ctx, []int64{2}))
+	suite.Len(suite.loaded, 1)
+}
+
+func TestIdleCollectionObserver(t *testing.T) {
+	suite.Run(t, new(IdleCollectionObserverSuite))
+}
@@ -116,6 +116,8 @@ type Server struct {
 	resourceObserver    *observers.ResourceObserver
 	leaderCacheObserver *observers.LeaderCacheObserver
 
+	idleCollectionObserver *observers.IdleCollectionObserver
+
 	getBalancerFunc checkers.GetBalancerFunc
 	balancerMap     map[string]balance.Balance
 	balancerLock    sync.RWMutex
@@ -335,6 +337,8 @@ func (s *Server) initQueryCoord() error {
 			balancer = balance.NewMultiTargetBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
 		case meta.ChannelLevelScoreBalancerName:
 			balancer = balance.NewChannelLevelScoreBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+		case meta.HotColdBalancerName:
+			balancer = balance.NewHotColdBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
 		default:
 			log.Info(fmt.Sprintf("default to use %s", meta.ScoreBasedBalancerName))
 			balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
@@ -355,6 +359,10 @@ func (s *Server) initQueryCoord() error {
 
 	// Init observers
 	s.initObserver()
+	if err := s.idleCollectionObserver.Recover(s.ctx); err != nil {
+		log.Warn("failed to recover idle released collections", zap.Error(err))
+		return err
+	}
 
 	// Init heartbeat
 	log.Info("init dist controller")
@@ -455,6 +463,14 @@ func (s *Server) initObserver() {
 	s.leaderCacheObserver = observers.NewLeaderCacheObserver(
 		s.proxyClientManager,
 	)
+
+	s.idleCollectionObserver = observers.NewIdleCollectionObserver(
+		s.meta,
+		s.store,
+		s.collectionObserver,
+		s.releaseIdleCollection,
+		s.reloadIdleCollection,
+	)
 }
 
 func (s *Server) afterStart() {}
@@ -531,6 +547,7 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start()
 	s.replicaObserver.Start()
 	s.resourceObserver.Start()
+	s.idleCollectionObserver.Start()
 
 	log.Info("start task scheduler...")
 	s.taskScheduler.Start()
@@ -583,6 +600,9 @@ func (s *Server) Stop() error {
 	if s.resourceObserver != nil {
 		s.resourceObserver.Stop()
 	}
+	if s.idleCollectionObserver != nil {
+		s.idleCollectionObserver.Stop()
+	}
 	if s.leaderCacheObserver != nil {
 		s.leaderCacheObserver.Stop()
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -344,6 +345,9 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 	log.Info("collection released")
 	metrics.QueryCoordReleaseLatency.WithLabelValues().Observe(float64(tr.ElapseSpan().Milliseconds()))
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
+	// the collection released explicitly is never reloaded on access.
+	s.idleCollectionObserver.Forget(ctx, req.GetCollectionID())
+	s.meta.RemoveReadSessions(req.GetCollectionID())
 
 	return merr.Success(), nil
 }
@@ -1261,9 +1265,132 @@ func (s *Server) ListLoadedSegments(ctx context.Context, req *querypb.ListLoaded
 		segmentIDs.Insert(segment.ID)
 	}
 
+	// the segments pinned by the read sessions are still read after being compacted,
+	// so they are reported as loaded to prevent the garbage collection of data coord.
+	segmentIDs.Insert(s.meta.ListPinnedSegments().Collect()...)
+
 	resp := &querypb.ListLoadedSegmentsResponse{
 		Status:     merr.Success(),
 		SegmentIDs: segmentIDs.Collect(),
 	}
 	return resp, nil
 }
+
+// ReportCollectionAccess records the collections searched or queried by the proxy,
+// and reloads the collections released for idle among them.
+func (s *Server) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest) (*querypb.ReportCollectionAccessResponse, error) {
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return &querypb.ReportCollectionAccessResponse{
+			Status: merr.Status(errors.Wrap(err, "failed to report collection access")),
+		}, nil
+	}
+
+	return &querypb.ReportCollectionAccessResponse{
+		Status:                 merr.Success(),
+		ReloadingCollectionIDs: s.idleCollectionObserver.Access(ctx, req.GetCollectionIDs()),
+	}, nil
+}
+
+// BeginReadSession pins the sealed segments of the current target of the loaded collection
+// until the session expires or ends, so the snapshot read by the session is kept on the query nodes.
+func (s *Server) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("sessionID", req.GetSessionId()),
+		zap.Int64("ttl", req.GetTtlSeconds()),
+	)
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return &querypb.BeginReadSessionResponse{
+			Status: merr.Status(errors.Wrap(err, "failed to begin read session")),
+		}, nil
+	}
+
+	if req.GetSessionId() == "" || req.GetTtlSeconds() <= 0 {
+		err := merr.WrapErrParameterInvalidMsg("session id and positive ttl are required for read session")
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+	if s.meta.CollectionManager.CalculateLoadStatus(ctx, req.GetCollectionID()) != querypb.LoadStatus_Loaded {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		log.Warn("failed to begin read session", zap.Error(err))
+		return &querypb.BeginReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+
+	segmentIDs := lo.Keys(s.targetMgr.GetSealedSegmentsByCollection(ctx, req.GetCollectionID(), meta.CurrentTarget))
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	s.meta.BeginReadSession(req.GetSessionId(), req.GetCollectionID(), req.GetTimestamp(), segmentIDs, ttl)
+	log.Info("read session begun", zap.Int("pinnedSegmentNum", len(segmentIDs)))
+	return &querypb.BeginReadSessionResponse{
+		Status:    merr.Success(),
+		SessionId: req.GetSessionId(),
+		Timestamp: req.GetTimestamp(),
+		ExpireAt:  time.Now().Add(ttl).Unix(),
+	}, nil
+}
+
+// EndReadSession unpins the segments pinned by the read session.
+func (s *Server) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return merr.Status(errors.Wrap(err, "failed to end read session")), nil
+	}
+
+	if s.meta.EndReadSession(req.GetSessionId()) {
+		log.Ctx(ctx).Info("read session ended", zap.String("sessionID", req.GetSessionId()))
+	}
+	return merr.Success(), nil
+}
+
+// GetReadSession returns the read session, the requests carrying an unknown or expired session are rejected by the proxy.
+func (s *Server) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest) (*querypb.GetReadSessionResponse, error) {
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return &querypb.GetReadSessionResponse{
+			Status: merr.Status(errors.Wrap(err, "failed to get read session")),
+		}, nil
+	}
+
+	session, ok := s.meta.GetReadSession(req.GetSessionId())
+	if !ok {
+		err := merr.WrapErrParameterInvalidMsg("read session %s is unknown or expired", req.GetSessionId())
+		return &querypb.GetReadSessionResponse{Status: merr.Status(err)}, nil
+	}
+	return &querypb.GetReadSessionResponse{
+		Status:       merr.Success(),
+		CollectionID: session.CollectionID,
+		Timestamp:    session.Timestamp,
+		ExpireAt:     session.ExpireAt.Unix(),
+	}, nil
+}
+
+// releaseIdleCollection releases the collection for idle, unlike the ReleaseCollection request,
+// the collection is reloaded on the next access.
+func (s *Server) releaseIdleCollection(ctx context.Context, collectionID int64) error {
+	releaseJob := job.NewReleaseCollectionJob(ctx,
+		&querypb.ReleaseCollectionRequest{CollectionID: collectionID},
+		s.dist,
+		s.meta,
+		s.broker,
+		s.targetMgr,
+		s.targetObserver,
+		s.checkerController,
+		s.proxyClientManager,
+	)
+	s.jobScheduler.Add(releaseJob)
+	return releaseJob.Wait()
+}
+
+func (s *Server) reloadIdleCollection(ctx context.Context, req *querypb.LoadCollectionRequest) error {
+	return merr.CheckRPCCall(s.LoadCollection(ctx, req))
+}
+
+// RefreshCollectionProperties refreshes the load meta cached from the altered collection properties,
+// it's a no-op if the collection isn't loaded.
+func (s *Server) RefreshCollectionProperties(ctx context.Context, collectionID int64, properties []*commonpb.KeyValuePair) error {
+	if !s.meta.CollectionManager.Exist(ctx, collectionID) {
+		return nil
+	}
+	err := s.meta.CollectionManager.UpdateIdleRelease(ctx, collectionID, properties)
+	if errors.Is(err, merr.ErrCollectionNotFound) {
+		// released concurrently.
+		return nil
+	}
+	return err
+}
@@ -219,6 +219,13 @@ func (suite *ServiceSuite) SetupTest() {
 		metricsRequest:      metricsinfo.NewMetricsRequest(),
 		proxyClientManager:  suite.proxyManager,
 	}
+	suite.server.idleCollectionObserver = observers.NewIdleCollectionObserver(
+		suite.meta,
+		suite.store,
+		suite.collectionObserver,
+		suite.server.releaseIdleCollection,
+		suite.server.reloadIdleCollection,
+	)
 
 	suite.server.registerMetricsRequest()
 	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
@@ -994,6 +1001,85 @@ func (suite *ServiceSuite) TestReleaseCollection() {
 	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestReadSession() {
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+
+	// the collection not loaded
+	resp, err := server.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{
+		CollectionID: collection,
+		SessionId:    "session",
+		TtlSeconds:   60,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFullyLoaded)
+
+	suite.loadAll()
+	suite.updateCollectionStatus(ctx, collection, querypb.LoadStatus_Loaded)
+
+	// invalid ttl
+	resp, err = server.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{
+		CollectionID: collection,
+		SessionId:    "session",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	resp, err = server.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{
+		CollectionID: collection,
+		SessionId:    "session",
+		TtlSeconds:   60,
+		Timestamp:    1000,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.True(suite.meta.HasReadSession(collection))
+
+	// the sealed segments of the current target are pinned and reported as loaded.
+	pinned := suite.meta.GetPinnedSegments(collection)
+	suite.ElementsMatch(lo.Keys(suite.targetMgr.GetSealedSegmentsByCollection(ctx, collection, meta.CurrentTarget)), pinned.Collect())
+	loaded, err := server.ListLoadedSegments(ctx, &querypb.ListLoadedSegmentsRequest{})
+	suite.NoError(merr.CheckRPCCall(loaded, err))
+	suite.Subset(loaded.GetSegmentIDs(), pinned.Collect())
+
+	session, err := server.GetReadSession(ctx, &querypb.GetReadSessionRequest{SessionId: "session"})
+	suite.NoError(merr.CheckRPCCall(session, err))
+	suite.Equal(collection, session.GetCollectionID())
+	suite.Equal(uint64(1000), session.GetTimestamp())
+
+	status, err := server.EndReadSession(ctx, &querypb.EndReadSessionRequest{SessionId: "session"})
+	suite.NoError(merr.CheckRPCCall(status, err))
+	suite.False(suite.meta.HasReadSession(collection))
+
+	// the unknown session is rejected.
+	session, err = server.GetReadSession(ctx, &querypb.GetReadSessionRequest{SessionId: "session"})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(session.GetStatus()), merr.ErrParameterInvalid)
+
+	// the sessions are removed with the released collection
+	resp, err = server.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{
+		CollectionID: collection,
+		SessionId:    "session",
+		TtlSeconds:   60,
+	})
+	suite.NoError(merr.CheckRPCCall(resp, err))
+	status, err = server.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{CollectionID: collection})
+	suite.NoError(merr.CheckRPCCall(status, err))
+	suite.False(suite.meta.HasReadSession(collection))
+
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.BeginReadSession(ctx, &querypb.BeginReadSessionRequest{CollectionID: collection})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+	status, err = server.EndReadSession(ctx, &querypb.EndReadSessionRequest{SessionId: "session"})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), status.GetCode())
+	session, err = server.GetReadSession(ctx, &querypb.GetReadSessionRequest{SessionId: "session"})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), session.GetStatus().GetCode())
+}
+
 func (suite *ServiceSuite) TestReleasePartition() {
 	ctx := context.Background()
 	suite.loadAll()
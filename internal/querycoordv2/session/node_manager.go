@@ -182,6 +182,21 @@ func (n *NodeInfo) CPUNum() int64 {
 	return n.stats.getCPUNum()
 }
 
+// SegmentAccessCount returns the decayed access count of the segment on the node.
+func (n *NodeInfo) SegmentAccessCount(segmentID int64) int64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getSegmentAccessCount(segmentID)
+}
+
+// ColdStorageEnabled returns whether the node enables mmap or lazyload,
+// which is suitable to serve the cold segments.
+func (n *NodeInfo) ColdStorageEnabled() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.isColdStorageEnabled()
+}
+
 func (n *NodeInfo) SetLastHeartbeat(time time.Time) {
 	n.lastHeartbeat.Store(time.UnixNano())
 }
@@ -253,3 +268,15 @@ func WithCPUNum(num int64) StatsOption {
 		n.setCPUNum(num)
 	}
 }
+
+func WithSegmentAccessCounts(counts map[int64]int64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setSegmentAccessCounts(counts)
+	}
+}
+
+func WithColdStorage(mmapEnabled, lazyloadEnabled bool) StatsOption {
+	return func(n *NodeInfo) {
+		n.setColdStorage(mmapEnabled, lazyloadEnabled)
+	}
+}
@@ -86,6 +86,12 @@ func (s *NodeManagerSuite) TestNodeInfo() {
 
 	node.SetLastHeartbeat(time.Now())
 	s.NotNil(node.LastHeartbeat())
+
+	s.False(node.ColdStorageEnabled())
+	node.UpdateStats(WithSegmentAccessCounts(map[int64]int64{1: 10}), WithColdStorage(false, true))
+	s.Equal(int64(10), node.SegmentAccessCount(1))
+	s.Equal(int64(0), node.SegmentAccessCount(2))
+	s.True(node.ColdStorageEnabled())
 }
 
 // TestCPUNumFunctionality tests the newly added CPU core number functionality
@@ -21,6 +21,10 @@ type stats struct {
 	channelCnt      int
 	memCapacityInMB float64
 	CPUNum          int64
+
+	segmentAccessCounts map[int64]int64
+	mmapEnabled         bool
+	lazyloadEnabled     bool
 }
 
 func (s *stats) setSegmentCnt(cnt int) {
@@ -55,6 +59,23 @@ func (s *stats) getCPUNum() int64 {
 	return s.CPUNum
 }
 
+func (s *stats) setSegmentAccessCounts(counts map[int64]int64) {
+	s.segmentAccessCounts = counts
+}
+
+func (s *stats) getSegmentAccessCount(segmentID int64) int64 {
+	return s.segmentAccessCounts[segmentID]
+}
+
+func (s *stats) setColdStorage(mmapEnabled, lazyloadEnabled bool) {
+	s.mmapEnabled = mmapEnabled
+	s.lazyloadEnabled = lazyloadEnabled
+}
+
+func (s *stats) isColdStorageEnabled() bool {
+	return s.mmapEnabled || s.lazyloadEnabled
+}
+
 func newStats() stats {
 	return stats{}
 }
@@ -3,6 +3,8 @@ package segments
 import (
 	"context"
 
+	"github.com/samber/lo"
+
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/segcore"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -29,6 +31,9 @@ func (r *cntReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveR
 	res.CostAggregation = &internalpb.CostAggregation{
 		TotalRelatedDataSize: relatedDataSize,
 	}
+	SumResourceUsage(res.CostAggregation, lo.Map(results, func(result *internalpb.RetrieveResults, _ int) *internalpb.CostAggregation {
+		return result.GetCostAggregation()
+	})...)
 	return res, nil
 }
 
@@ -0,0 +1,92 @@
+package metricsutil
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// segmentAccessHalfLife is the half life of the segment access counts,
+// so the counts reflect the recent access frequency of the segments.
+const segmentAccessHalfLife = 5 * time.Minute
+
+var globalAccessCounter = newSegmentAccessCounter(segmentAccessHalfLife)
+
+// RecordSegmentAccess records a search or query on the segment.
+func RecordSegmentAccess(segmentID int64) {
+	globalAccessCounter.Record(segmentID, time.Now())
+}
+
+// GetSegmentAccessCounts returns the decayed access counts of the segments accessed recently,
+// which are reported to the query coord to balance the hot and cold segments.
+func GetSegmentAccessCounts() map[int64]int64 {
+	return globalAccessCounter.Counts(time.Now())
+}
+
+// segmentAccessCounter counts the accesses of each segment with exponential decay.
+type segmentAccessCounter struct {
+	halfLife time.Duration
+	counters *typeutil.ConcurrentMap[int64, *decayedCounter]
+}
+
+func newSegmentAccessCounter(halfLife time.Duration) *segmentAccessCounter {
+	return &segmentAccessCounter{
+		halfLife: halfLife,
+		counters: typeutil.NewConcurrentMap[int64, *decayedCounter](),
+	}
+}
+
+// Record records an access of the segment.
+func (c *segmentAccessCounter) Record(segmentID int64, now time.Time) {
+	counter, ok := c.counters.Get(segmentID)
+	if !ok {
+		counter, _ = c.counters.GetOrInsert(segmentID, &decayedCounter{updatedAt: now})
+	}
+	counter.Add(now, c.halfLife)
+}
+
+// Counts returns the access counts of the segments, the segments decayed to zero are removed,
+// so the removed segments never leak.
+func (c *segmentAccessCounter) Counts(now time.Time) map[int64]int64 {
+	counts := make(map[int64]int64)
+	c.counters.Range(func(segmentID int64, counter *decayedCounter) bool {
+		count := int64(math.Round(counter.Get(now, c.halfLife)))
+		if count == 0 {
+			c.counters.Remove(segmentID)
+			return true
+		}
+		counts[segmentID] = count
+		return true
+	})
+	return counts
+}
+
+// decayedCounter is a counter halved every half life.
+type decayedCounter struct {
+	mu        sync.Mutex
+	value     float64
+	updatedAt time.Time
+}
+
+func (c *decayedCounter) decay(now time.Time, halfLife time.Duration) {
+	if elapsed := now.Sub(c.updatedAt); elapsed > 0 {
+		c.value *= math.Exp2(-elapsed.Seconds() / halfLife.Seconds())
+		c.updatedAt = now
+	}
+}
+
+func (c *decayedCounter) Add(now time.Time, halfLife time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.decay(now, halfLife)
+	c.value++
+}
+
+func (c *decayedCounter) Get(now time.Time, halfLife time.Duration) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.decay(now, halfLife)
+	return c.value
+}
@@ -0,0 +1,30 @@
+package metricsutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentAccessCounter(t *testing.T) {
+	c := newSegmentAccessCounter(time.Minute)
+	now := time.Now()
+	for i := 0; i < 8; i++ {
+		c.Record(1, now)
+	}
+	c.Record(2, now)
+	assert.Equal(t, map[int64]int64{1: 8, 2: 1}, c.Counts(now))
+
+	// the counts are halved every half life.
+	now = now.Add(time.Minute)
+	c.Record(1, now)
+	assert.Equal(t, map[int64]int64{1: 5, 2: 1}, c.Counts(now))
+
+	now = now.Add(10 * time.Minute)
+	assert.Empty(t, c.Counts(now))
+	assert.Equal(t, 0, c.counters.Len())
+
+	RecordSegmentAccess(100)
+	assert.Equal(t, int64(1), GetSegmentAccessCounts()[100])
+}
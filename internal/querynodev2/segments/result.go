@@ -123,6 +123,7 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 		return acc + result.GetCostAggregation().GetTotalRelatedDataSize()
 	}, 0)
 	searchResults.CostAggregation.TotalRelatedDataSize = relatedDataSize
+	SumResourceUsage(searchResults.CostAggregation, requestCosts...)
 	searchResults.ChannelsMvcc = channelsMvcc
 	searchResults.IsTopkReduce = isTopkReduce
 	searchResults.IsRecallEvaluation = isRecallEvaluation
@@ -174,6 +175,7 @@ func ReduceAdvancedSearchResults(ctx context.Context, results []*internalpb.Sear
 		searchResults.CostAggregation = &internalpb.CostAggregation{}
 	}
 	searchResults.CostAggregation.TotalRelatedDataSize = relatedDataSize
+	SumResourceUsage(searchResults.CostAggregation, requestCosts...)
 	searchResults.IsTopkReduce = isTopkReduce
 	return searchResults, nil
 }
@@ -356,6 +358,7 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		ret.CostAggregation = &internalpb.CostAggregation{}
 	}
 	ret.CostAggregation.TotalRelatedDataSize = relatedDataSize
+	SumResourceUsage(ret.CostAggregation, requestCosts...)
 	return ret, nil
 }
 
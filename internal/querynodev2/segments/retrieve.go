@@ -71,6 +71,7 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 			result,
 			s,
 		}
+		recordSegmentUsage(ctx, s)
 		metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
 			metrics.QueryLabel, label).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		return nil
@@ -105,8 +106,10 @@ func retrieveOnSegmentsWithStream(ctx context.Context, mgr *Manager, segments []
 		go func(segment Segment, i int) {
 			defer wg.Done()
 			tr := timerecord.NewTimeRecorder("retrieveOnSegmentsWithStream")
+			// the results of each segment are sent with the usage of its own.
+			segmentCtx, usageRecorder := WithUsageRecorder(ctx)
 			var result *segcorepb.RetrieveResults
-			err := doOnSegment(ctx, mgr, segment, func(ctx context.Context, segment Segment) error {
+			err := doOnSegment(segmentCtx, mgr, segment, func(ctx context.Context, segment Segment) error {
 				var err error
 				result, err = segment.Retrieve(ctx, plan)
 				return err
@@ -117,13 +120,14 @@ func retrieveOnSegmentsWithStream(ctx context.Context, mgr *Manager, segments []
 			}
 
 			if len(result.GetOffset()) != 0 {
+				recordSegmentUsage(segmentCtx, segment)
+				cost := &internalpb.CostAggregation{TotalRelatedDataSize: GetSegmentRelatedDataSize(segment)}
+				usageRecorder.Fill(cost)
 				if err = svr.Send(&internalpb.RetrieveResults{
-					Status:     merr.Success(),
-					Ids:        result.GetIds(),
-					FieldsData: result.GetFieldsData(),
-					CostAggregation: &internalpb.CostAggregation{
-						TotalRelatedDataSize: GetSegmentRelatedDataSize(segment),
-					},
+					Status:                    merr.Success(),
+					Ids:                       result.GetIds(),
+					FieldsData:                result.GetFieldsData(),
+					CostAggregation:           cost,
 					SealedSegmentIDsRetrieved: []int64{segment.ID()},
 					AllRetrieveCount:          result.GetAllRetrieveCount(),
 				}); err != nil {
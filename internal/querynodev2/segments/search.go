@@ -50,6 +50,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 			return err
 		}
 		resultCh <- searchResult
+		recordSegmentUsage(ctx, s)
 		// update metrics
 		elapsed := tr.ElapseSpan().Milliseconds()
 		metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
@@ -74,6 +75,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 
 			var err error
 			accessRecord := metricsutil.NewSearchSegmentAccessRecord(getSegmentMetricLabel(seg))
+			metricsutil.RecordSegmentAccess(seg.ID())
 			defer func() {
 				accessRecord.Finish(err)
 			}()
@@ -135,6 +137,7 @@ func searchSegmentsStreamly(ctx context.Context,
 		if searchErr != nil {
 			return searchErr
 		}
+		recordSegmentUsage(ctx, seg)
 		reduceMutex.Lock()
 		searchResultsToClear = append(searchResultsToClear, searchResult)
 		reducedErr := streamReduce(searchResult)
@@ -164,6 +167,7 @@ func searchSegmentsStreamly(ctx context.Context,
 
 			var err error
 			accessRecord := metricsutil.NewSearchSegmentAccessRecord(getSegmentMetricLabel(seg))
+			metricsutil.RecordSegmentAccess(seg.ID())
 			defer func() {
 				accessRecord.Finish(err)
 			}()
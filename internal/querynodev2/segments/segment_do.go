@@ -17,6 +17,7 @@ func doOnSegment(ctx context.Context, mgr *Manager, seg Segment, do doOnSegmentF
 	// record search time and cache miss
 	var err error
 	accessRecord := metricsutil.NewQuerySegmentAccessRecord(getSegmentMetricLabel(seg))
+	metricsutil.RecordSegmentAccess(seg.ID())
 	defer func() {
 		accessRecord.Finish(err)
 	}()
@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+type usageRecorderKey struct{}
+
+// UsageRecorder records the resource usage of a request on the segments, concurrent safe.
+type UsageRecorder struct {
+	segmentsScanned  atomic.Int64
+	rowsScanned      atomic.Int64
+	storageBytes     atomic.Int64
+	queryNodeCPUTime atomic.Duration
+}
+
+// WithUsageRecorder returns a context carrying a new UsageRecorder,
+// the segments searched or retrieved with the context record their usage into it.
+func WithUsageRecorder(ctx context.Context) (context.Context, *UsageRecorder) {
+	recorder := &UsageRecorder{}
+	return context.WithValue(ctx, usageRecorderKey{}, recorder), recorder
+}
+
+// recordSegmentUsage records the usage of a scan on the segment into the recorder carried by ctx, if any.
+func recordSegmentUsage(ctx context.Context, segment Segment) {
+	recorder, ok := ctx.Value(usageRecorderKey{}).(*UsageRecorder)
+	if !ok {
+		return
+	}
+	recorder.segmentsScanned.Inc()
+	recorder.rowsScanned.Add(segment.RowNum())
+	// The disk size is the part of the segment served by the mmap files or the disk cache.
+	recorder.storageBytes.Add(int64(segment.ResourceUsageEstimate().DiskSize))
+}
+
+// recordCPUTime records the cpu time of the segcore thread executing a scan into the recorder carried by ctx, if any.
+func recordCPUTime(ctx context.Context, cpuTime time.Duration) {
+	recorder, ok := ctx.Value(usageRecorderKey{}).(*UsageRecorder)
+	if !ok {
+		return
+	}
+	recorder.queryNodeCPUTime.Add(cpuTime)
+}
+
+// Fill fills the recorded usage into the cost.
+func (r *UsageRecorder) Fill(cost *internalpb.CostAggregation) {
+	cost.TotalSegmentsScanned = r.segmentsScanned.Load()
+	cost.TotalRowsScanned = r.rowsScanned.Load()
+	cost.TotalStorageBytes = r.storageBytes.Load()
+	cost.TotalQuerynodeCpuTimeUs = r.queryNodeCPUTime.Load().Microseconds()
+}
+
+// FillShares fills the recorded usage of the merged requests into their costs by the nq shares,
+// so the usage summed over the merged requests equals the recorded one instead of counting it repeatedly.
+func (r *UsageRecorder) FillShares(costs []*internalpb.CostAggregation, nqs []int64) {
+	totalNQ := lo.Reduce(nqs, func(acc int64, nq int64, _ int) int64 { return acc + nq }, 0)
+	share := func(total int64, set func(cost *internalpb.CostAggregation, value int64)) {
+		remain := total
+		for i, cost := range costs {
+			value := remain
+			if i < len(costs)-1 {
+				value = 0
+				if totalNQ > 0 {
+					value = total * nqs[i] / totalNQ
+				}
+			}
+			set(cost, value)
+			remain -= value
+		}
+	}
+	share(r.segmentsScanned.Load(), func(cost *internalpb.CostAggregation, value int64) {
+		cost.TotalSegmentsScanned = value
+	})
+	share(r.rowsScanned.Load(), func(cost *internalpb.CostAggregation, value int64) {
+		cost.TotalRowsScanned = value
+	})
+	share(r.storageBytes.Load(), func(cost *internalpb.CostAggregation, value int64) {
+		cost.TotalStorageBytes = value
+	})
+	share(r.queryNodeCPUTime.Load().Microseconds(), func(cost *internalpb.CostAggregation, value int64) {
+		cost.TotalQuerynodeCpuTimeUs = value
+	})
+}
+
+// SumResourceUsage sums the resource usage of the costs into the target cost.
+func SumResourceUsage(target *internalpb.CostAggregation, costs ...*internalpb.CostAggregation) {
+	var segmentsScanned, rowsScanned, storageBytes, queryNodeCPUTimeUs int64
+	for _, cost := range costs {
+		segmentsScanned += cost.GetTotalSegmentsScanned()
+		rowsScanned += cost.GetTotalRowsScanned()
+		storageBytes += cost.GetTotalStorageBytes()
+		queryNodeCPUTimeUs += cost.GetTotalQuerynodeCpuTimeUs()
+	}
+	target.TotalSegmentsScanned = segmentsScanned
+	target.TotalRowsScanned = rowsScanned
+	target.TotalStorageBytes = storageBytes
+	target.TotalQuerynodeCpuTimeUs = queryNodeCPUTimeUs
+}
@@ -0,0 +1,58 @@
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+func TestUsageRecorder(t *testing.T) {
+	segment := NewMockSegment(t)
+	segment.EXPECT().RowNum().Return(int64(100))
+	segment.EXPECT().ResourceUsageEstimate().Return(ResourceUsage{DiskSize: 1024})
+
+	// no recorder in the context.
+	recordSegmentUsage(context.Background(), segment)
+	recordCPUTime(context.Background(), time.Millisecond)
+
+	ctx, recorder := WithUsageRecorder(context.Background())
+	recordSegmentUsage(ctx, segment)
+	recordCPUTime(ctx, time.Millisecond)
+	recordSegmentUsage(ctx, segment)
+	recordCPUTime(ctx, 2*time.Millisecond)
+	cost := &internalpb.CostAggregation{TotalRelatedDataSize: 10}
+	recorder.Fill(cost)
+	assert.Equal(t, &internalpb.CostAggregation{
+		TotalRelatedDataSize:    10,
+		TotalSegmentsScanned:    2,
+		TotalRowsScanned:        200,
+		TotalStorageBytes:       2048,
+		TotalQuerynodeCpuTimeUs: 3000,
+	}, cost)
+
+	// the target may be one of the costs.
+	other := &internalpb.CostAggregation{TotalSegmentsScanned: 1, TotalRowsScanned: 50, TotalQuerynodeCpuTimeUs: 100}
+	SumResourceUsage(cost, cost, other, nil)
+	assert.EqualValues(t, 3, cost.GetTotalSegmentsScanned())
+	assert.EqualValues(t, 250, cost.GetTotalRowsScanned())
+	assert.EqualValues(t, 2048, cost.GetTotalStorageBytes())
+	assert.EqualValues(t, 3100, cost.GetTotalQuerynodeCpuTimeUs())
+
+	// the usage of the merged requests is split by the nq shares.
+	costs := []*internalpb.CostAggregation{{}, {}, {}}
+	recorder.FillShares(costs, []int64{1, 1, 2})
+	assert.Equal(t, []int64{0, 0, 2}, lo.Map(costs, func(cost *internalpb.CostAggregation, _ int) int64 {
+		return cost.GetTotalSegmentsScanned()
+	}))
+	assert.Equal(t, []int64{50, 50, 100}, lo.Map(costs, func(cost *internalpb.CostAggregation, _ int) int64 {
+		return cost.GetTotalRowsScanned()
+	}))
+	assert.Equal(t, []int64{750, 750, 1500}, lo.Map(costs, func(cost *internalpb.CostAggregation, _ int) int64 {
+		return cost.GetTotalQuerynodeCpuTimeUs()
+	}))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments/metricsutil"
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
@@ -1312,14 +1313,17 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 	})
 
 	return &querypb.GetDataDistributionResponse{
-		Status:          merr.Success(),
-		NodeID:          node.GetNodeID(),
-		Segments:        segmentVersionInfos,
-		Channels:        channelVersionInfos,
-		LeaderViews:     leaderViews,
-		LastModifyTs:    lastModifyTs,
-		MemCapacityInMB: float64(hardware.GetMemoryCount() / 1024 / 1024),
-		CpuNum:          int64(hardware.GetCPUNum()),
+		Status:              merr.Success(),
+		NodeID:              node.GetNodeID(),
+		Segments:            segmentVersionInfos,
+		Channels:            channelVersionInfos,
+		LeaderViews:         leaderViews,
+		LastModifyTs:        lastModifyTs,
+		MemCapacityInMB:     float64(hardware.GetMemoryCount() / 1024 / 1024),
+		CpuNum:              int64(hardware.GetCPUNum()),
+		SegmentAccessCounts: metricsutil.GetSegmentAccessCounts(),
+		MmapEnabled:         paramtable.Get().QueryNodeCfg.MmapVectorField.GetAsBool() || paramtable.Get().QueryNodeCfg.MmapScalarField.GetAsBool(),
+		LazyloadEnabled:     paramtable.Get().QueryNodeCfg.LazyLoadEnabled.GetAsBool(),
 	}, nil
 }
 
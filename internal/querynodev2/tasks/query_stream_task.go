@@ -50,6 +50,14 @@ func (t *QueryStreamTask) Username() string {
 	return t.req.Req.GetUsername()
 }
 
+func (t *QueryStreamTask) DatabaseName() string {
+	return t.collection.GetDBName()
+}
+
+func (t *QueryStreamTask) ResourceGroup() string {
+	return t.collection.GetResourceGroup()
+}
+
 func (t *QueryStreamTask) IsGpuIndex() bool {
 	return false
 }
@@ -60,6 +60,14 @@ func (t *QueryTask) Username() string {
 	return t.req.Req.GetUsername()
 }
 
+func (t *QueryTask) DatabaseName() string {
+	return t.collection.GetDBName()
+}
+
+func (t *QueryTask) ResourceGroup() string {
+	return t.collection.GetResourceGroup()
+}
+
 func (t *QueryTask) IsGpuIndex() bool {
 	return false
 }
@@ -113,7 +121,8 @@ func (t *QueryTask) Execute() error {
 		return err
 	}
 	defer retrievePlan.Delete()
-	results, pinnedSegments, err := segments.Retrieve(t.ctx, t.segmentManager, retrievePlan, t.req)
+	ctx, usageRecorder := segments.WithUsageRecorder(t.ctx)
+	results, pinnedSegments, err := segments.Retrieve(ctx, t.segmentManager, retrievePlan, t.req)
 	defer t.segmentManager.Segment.Unpin(pinnedSegments)
 	if err != nil {
 		return err
@@ -161,6 +170,7 @@ func (t *QueryTask) Execute() error {
 		AllRetrieveCount: reducedResult.GetAllRetrieveCount(),
 		HasMoreResult:    reducedResult.HasMoreResult,
 	}
+	usageRecorder.Fill(t.result.CostAggregation)
 	return nil
 }
 
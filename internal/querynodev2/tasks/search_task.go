@@ -32,8 +32,9 @@ import (
 )
 
 var (
-	_ scheduler.Task      = &SearchTask{}
-	_ scheduler.MergeTask = &SearchTask{}
+	_ scheduler.Task                = &SearchTask{}
+	_ scheduler.MergeTask           = &SearchTask{}
+	_ scheduler.SplittableMergeTask = &SearchTask{}
 )
 
 type SearchTask struct {
@@ -89,6 +90,14 @@ func (t *SearchTask) Username() string {
 	return t.req.Req.GetUsername()
 }
 
+func (t *SearchTask) DatabaseName() string {
+	return t.collection.GetDBName()
+}
+
+func (t *SearchTask) ResourceGroup() string {
+	return t.collection.GetResourceGroup()
+}
+
 func (t *SearchTask) GetNodeID() int64 {
 	return t.serverID
 }
@@ -131,7 +140,8 @@ func (t *SearchTask) PreExecute() error {
 }
 
 func (t *SearchTask) Execute() error {
-	log := log.Ctx(t.ctx).With(
+	execCtx := t.executionContext()
+	log := log.Ctx(execCtx).With(
 		zap.Int64("collectionID", t.collection.ID()),
 		zap.String("shard", t.req.GetDmlChannels()[0]),
 	)
@@ -139,7 +149,8 @@ func (t *SearchTask) Execute() error {
 	if t.scheduleSpan != nil {
 		t.scheduleSpan.End()
 	}
-	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
+	tr := timerecord.NewTimeRecorderWithTrace(execCtx, "SearchTask")
+	ctx, usageRecorder := segments.WithUsageRecorder(execCtx)
 
 	req := t.req
 	err := t.combinePlaceHolderGroups()
@@ -158,7 +169,7 @@ func (t *SearchTask) Execute() error {
 	)
 	if req.GetScope() == querypb.DataScope_Historical {
 		results, searchedSegments, err = segments.SearchHistorical(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -167,7 +178,7 @@ func (t *SearchTask) Execute() error {
 		)
 	} else if req.GetScope() == querypb.DataScope_Streaming {
 		results, searchedSegments, err = segments.SearchStreaming(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -185,6 +196,7 @@ func (t *SearchTask) Execute() error {
 	metricType := searchReq.Plan().GetMetricType()
 
 	if len(results) == 0 {
+		costs := make([]*internalpb.CostAggregation, 0, len(t.originNqs))
 		for i := range t.originNqs {
 			var task *SearchTask
 			if i == 0 {
@@ -207,7 +219,9 @@ func (t *SearchTask) Execute() error {
 					ServiceTime: tr.ElapseSpan().Milliseconds(),
 				},
 			}
+			costs = append(costs, task.result.CostAggregation)
 		}
+		usageRecorder.FillShares(costs, t.originNqs)
 		return nil
 	}
 
@@ -235,8 +249,9 @@ func (t *SearchTask) Execute() error {
 		metrics.ReduceSegments,
 		metrics.BatchReduce).
 		Observe(float64(tr.RecordSpan().Milliseconds()))
+	costs := make([]*internalpb.CostAggregation, 0, len(t.originNqs))
 	for i := range t.originNqs {
-		blob, err := segcore.GetSearchResultDataBlob(t.ctx, blobs, i)
+		blob, err := segcore.GetSearchResultDataBlob(execCtx, blobs, i)
 		if err != nil {
 			return err
 		}
@@ -268,7 +283,9 @@ func (t *SearchTask) Execute() error {
 				TotalRelatedDataSize: relatedDataSize,
 			},
 		}
+		costs = append(costs, task.result.CostAggregation)
 	}
+	usageRecorder.FillShares(costs, t.originNqs)
 
 	return nil
 }
@@ -325,8 +342,65 @@ func (t *SearchTask) Done(err error) {
 	}
 }
 
+// Canceled returns the error only if all the tasks merged are canceled,
+// the merged task keeps running for the tasks alive even if it's canceled itself.
 func (t *SearchTask) Canceled() error {
-	return t.ctx.Err()
+	err := t.ctx.Err()
+	if err == nil {
+		return nil
+	}
+	for _, other := range t.others {
+		if other.ctx.Err() == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// SplitCanceled removes the canceled tasks merged into the task and returns them,
+// the nq and topk of the task are shrunk to the tasks left.
+func (t *SearchTask) SplitCanceled() []scheduler.Task {
+	var canceled []scheduler.Task
+	others := make([]*SearchTask, 0, len(t.others))
+	for _, other := range t.others {
+		if other.ctx.Err() != nil {
+			canceled = append(canceled, other)
+			continue
+		}
+		others = append(others, other)
+	}
+	if len(canceled) == 0 {
+		return nil
+	}
+
+	t.others = others
+	t.groupSize = 1
+	t.nq = t.originNqs[0]
+	t.topk = t.originTopks[0]
+	t.originNqs = []int64{t.originNqs[0]}
+	t.originTopks = []int64{t.originTopks[0]}
+	for _, other := range others {
+		t.groupSize += other.groupSize
+		t.nq += other.nq
+		t.topk = funcutil.Max(t.topk, other.topk)
+		t.originNqs = append(t.originNqs, other.originNqs...)
+		t.originTopks = append(t.originTopks, other.originTopks...)
+	}
+	return canceled
+}
+
+// executionContext returns the context to execute the task with,
+// the canceled task runs with the context of the alive task merged into it.
+func (t *SearchTask) executionContext() context.Context {
+	if t.ctx.Err() == nil {
+		return t.ctx
+	}
+	for _, other := range t.others {
+		if other.ctx.Err() == nil {
+			return other.ctx
+		}
+	}
+	return t.ctx
 }
 
 func (t *SearchTask) Wait() error {
@@ -432,6 +506,7 @@ func (t *StreamingSearchTask) Execute() error {
 		t.scheduleSpan.End()
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
+	ctx, usageRecorder := segments.WithUsageRecorder(t.ctx)
 	req := t.req
 	t.combinePlaceHolderGroups()
 	searchReq, err := segcore.NewSearchRequest(t.collection.GetCCollection(), req, t.placeholderGroup)
@@ -449,7 +524,7 @@ func (t *StreamingSearchTask) Execute() error {
 			return reduceErr
 		}
 		pinnedSegments, err := segments.SearchHistoricalStreamly(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -473,7 +548,7 @@ func (t *StreamingSearchTask) Execute() error {
 		}, 0)
 	} else if req.GetScope() == querypb.DataScope_Streaming {
 		results, pinnedSegments, err := segments.SearchStreaming(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -514,6 +589,7 @@ func (t *StreamingSearchTask) Execute() error {
 	}
 
 	// 2. reorganize blobs to original search request
+	costs := make([]*internalpb.CostAggregation, 0, len(t.originNqs))
 	for i := range t.originNqs {
 		blob, err := segcore.GetSearchResultDataBlob(t.ctx, t.resultBlobs, i)
 		if err != nil {
@@ -547,7 +623,9 @@ func (t *StreamingSearchTask) Execute() error {
 				TotalRelatedDataSize: relatedDataSize,
 			},
 		}
+		costs = append(costs, task.result.CostAggregation)
 	}
+	usageRecorder.FillShares(costs, t.originNqs)
 
 	return nil
 }
@@ -18,6 +18,7 @@ package tasks
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"math/rand"
 	"testing"
@@ -27,6 +28,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 )
 
@@ -142,6 +144,52 @@ func (s *SearchTaskSuite) TestCombinePlaceHolderGroups() {
 	})
 }
 
+func (s *SearchTaskSuite) TestSplitCanceled() {
+	newTask := func(ctx context.Context, nq, topk int64) *SearchTask {
+		return &SearchTask{
+			ctx:         ctx,
+			groupSize:   1,
+			nq:          nq,
+			topk:        topk,
+			originNqs:   []int64{nq},
+			originTopks: []int64{topk},
+			notifier:    make(chan error, 1),
+		}
+	}
+	headCtx, cancelHead := context.WithCancel(context.Background())
+	otherCtx, cancelOther := context.WithCancel(context.Background())
+	head := newTask(headCtx, 1, 10)
+	canceled := newTask(otherCtx, 2, 100)
+	alive := newTask(context.Background(), 3, 20)
+	head.others = []*SearchTask{canceled, alive}
+	head.groupSize, head.nq, head.topk = 3, 6, 100
+	head.originNqs = []int64{1, 2, 3}
+	head.originTopks = []int64{10, 100, 20}
+
+	s.Nil(head.SplitCanceled())
+
+	// the canceled head keeps running for the alive task merged into it.
+	cancelHead()
+	cancelOther()
+	s.NoError(head.Canceled())
+	s.Equal(alive.ctx, head.executionContext())
+	s.Equal([]scheduler.Task{canceled}, head.SplitCanceled())
+	s.Equal([]*SearchTask{alive}, head.others)
+	s.Equal(int64(2), head.groupSize)
+	s.Equal(int64(4), head.NQ())
+	s.Equal(int64(20), head.topk)
+	s.Equal([]int64{1, 3}, head.originNqs)
+	s.Equal([]int64{10, 20}, head.originTopks)
+
+	// the split task is done alone.
+	canceled.Done(canceled.Canceled())
+	s.ErrorIs(canceled.Wait(), context.Canceled)
+
+	head.others = nil
+	s.ErrorIs(head.Canceled(), context.Canceled)
+	s.Equal(headCtx, head.executionContext())
+}
+
 func TestSearchTask(t *testing.T) {
 	suite.Run(t, new(SearchTaskSuite))
 }
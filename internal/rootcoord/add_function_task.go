@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type addCollectionFunctionTask struct {
+	baseTask
+	Req *rootcoordpb.AddCollectionFunctionRequest
+}
+
+func (t *addCollectionFunctionTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AddCollectionField); err != nil {
+		return err
+	}
+	function := t.Req.GetFunction()
+	if function.GetName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the function name must not be empty")
+	}
+	// the outputs of the existing rows are backfilled by the stats tasks, rerank function has no outputs.
+	if function.GetType() == schemapb.FunctionType_Rerank {
+		return merr.WrapErrParameterInvalidMsg("rerank function can't be added to an existing collection")
+	}
+	outputNames := lo.Map(t.Req.GetOutputFields(), func(field *schemapb.FieldSchema, _ int) string {
+		return field.GetName()
+	})
+	if len(outputNames) == 0 || len(outputNames) != len(function.GetOutputFieldNames()) || !lo.Every(outputNames, function.GetOutputFieldNames()) {
+		return merr.WrapErrParameterInvalidMsg("the output fields %v don't match the outputs %v of function %s",
+			outputNames, function.GetOutputFieldNames(), function.GetName())
+	}
+	return checkFieldSchema(t.Req.GetOutputFields())
+}
+
+func (t *addCollectionFunctionTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	if err := addFunction(newColl, t.Req.GetFunction(), t.Req.GetOutputFields()); err != nil {
+		return err
+	}
+	return executeAddCollectionFunctionTaskSteps(ctx, t.core, oldColl, newColl, t.Req, t.GetTs())
+}
+
+func (t *addCollectionFunctionTask) GetLockerKey() LockerKey {
+	collection := t.core.getCollectionIDStr(t.ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), 0)
+	return NewLockerKeyChain(
+		NewClusterLockerKey(false),
+		NewDatabaseLockerKey(t.Req.GetDbName(), false),
+		NewCollectionLockerKey(collection, true),
+	)
+}
+
+// addFunction appends the function and its output fields to the collection in place,
+// the ids of the output fields and the function are assigned after the existing ones.
+func addFunction(coll *model.Collection, functionSchema *schemapb.FunctionSchema, outputFields []*schemapb.FieldSchema) error {
+	name2id := make(map[string]int64, len(coll.Fields))
+	maxFieldID := int64(StartOfUserFieldID)
+	for _, field := range coll.Fields {
+		name2id[field.Name] = field.FieldID
+		maxFieldID = max(maxFieldID, field.FieldID)
+	}
+	maxFunctionID := int64(StartOfUserFunctionID - 1)
+	for _, function := range coll.Functions {
+		if function.Name == functionSchema.GetName() {
+			return merr.WrapErrParameterInvalidMsg("duplicated function name %s", functionSchema.GetName())
+		}
+		maxFunctionID = max(maxFunctionID, function.ID)
+	}
+
+	inputIDs := make([]int64, 0, len(functionSchema.GetInputFieldNames()))
+	for _, name := range functionSchema.GetInputFieldNames() {
+		fieldID, ok := name2id[name]
+		if !ok {
+			return merr.WrapErrFieldNotFound(name, fmt.Sprintf("input field of function %s not found", functionSchema.GetName()))
+		}
+		inputIDs = append(inputIDs, fieldID)
+	}
+
+	for _, fieldSchema := range outputFields {
+		if _, ok := name2id[fieldSchema.GetName()]; ok {
+			return merr.WrapErrParameterInvalidMsg("duplicated output field name %s with other field", fieldSchema.GetName())
+		}
+		field := model.UnmarshalFieldModel(fieldSchema)
+		maxFieldID++
+		field.FieldID = maxFieldID
+		field.IsFunctionOutput = true
+		name2id[field.Name] = field.FieldID
+		coll.Fields = append(coll.Fields, field)
+	}
+
+	function := model.UnmarshalFunctionModel(functionSchema)
+	function.ID = maxFunctionID + 1
+	function.InputFieldIDs = inputIDs
+	function.OutputFieldIDs = lo.Map(function.OutputFieldNames, func(name string, _ int) int64 {
+		return name2id[name]
+	})
+	coll.Functions = append(coll.Functions, function)
+	return nil
+}
+
+func executeAddCollectionFunctionTaskSteps(ctx context.Context,
+	core *Core,
+	oldColl *model.Collection,
+	newColl *model.Collection,
+	req *rootcoordpb.AddCollectionFunctionRequest,
+	ts Timestamp,
+) error {
+	redoTask := newBaseRedoTask(core.stepExecutor)
+
+	// the segments flushed before the schema change don't have the output fields,
+	// they're backfilled by the stats tasks of datacoord.
+	redoTask.AddSyncStep(&WriteSchemaChangeWALStep{
+		baseStep:   baseStep{core: core},
+		collection: newColl,
+		ts:         ts,
+	})
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: core},
+		req: &milvuspb.AlterCollectionRequest{
+			Base:           req.GetBase(),
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetCollectionName(),
+			CollectionID:   oldColl.CollectionID,
+		},
+		core: core,
+	})
+
+	aliases := core.meta.ListAliasesByID(ctx, oldColl.CollectionID)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          req.GetDbName(),
+		collectionNames: append(aliases, oldColl.Name),
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AddCollectionField)},
+	})
+
+	return redoTask.Execute(ctx)
+}
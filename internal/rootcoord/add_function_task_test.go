@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_addCollectionFunctionTask_Prepare(t *testing.T) {
+	newReq := func() *rootcoordpb.AddCollectionFunctionRequest {
+		return &rootcoordpb.AddCollectionFunctionRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_AddCollectionField},
+			Function: &schemapb.FunctionSchema{
+				Name:             "embed",
+				Type:             schemapb.FunctionType_TextEmbedding,
+				InputFieldNames:  []string{"text"},
+				OutputFieldNames: []string{"vector"},
+			},
+			OutputFields: []*schemapb.FieldSchema{
+				{Name: "vector", DataType: schemapb.DataType_FloatVector},
+			},
+		}
+	}
+
+	t.Run("invalid msg type", func(t *testing.T) {
+		req := newReq()
+		req.Base.MsgType = commonpb.MsgType_Undefined
+		task := &addCollectionFunctionTask{Req: req}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("rerank function", func(t *testing.T) {
+		req := newReq()
+		req.Function.Type = schemapb.FunctionType_Rerank
+		task := &addCollectionFunctionTask{Req: req}
+		assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("bm25 function", func(t *testing.T) {
+		req := newReq()
+		req.Function.Type = schemapb.FunctionType_BM25
+		task := &addCollectionFunctionTask{Req: req}
+		assert.NoError(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("output fields mismatch", func(t *testing.T) {
+		req := newReq()
+		req.OutputFields[0].Name = "foo"
+		task := &addCollectionFunctionTask{Req: req}
+		assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		task := &addCollectionFunctionTask{Req: newReq()}
+		assert.NoError(t, task.Prepare(context.Background()))
+	})
+}
+
+func Test_addFunction(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			Fields: []*model.Field{
+				{FieldID: 0, Name: "RowID"},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true},
+				{FieldID: 101, Name: "text"},
+				{FieldID: 102, Name: "sparse", IsFunctionOutput: true},
+			},
+			Functions: []*model.Function{
+				{ID: 100, Name: "bm25", InputFieldNames: []string{"text"}, OutputFieldNames: []string{"sparse"}},
+			},
+		}
+	}
+	newFunction := func() *schemapb.FunctionSchema {
+		return &schemapb.FunctionSchema{
+			Name:             "embed",
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{"text"},
+			OutputFieldNames: []string{"vector"},
+		}
+	}
+	outputs := []*schemapb.FieldSchema{{Name: "vector", DataType: schemapb.DataType_FloatVector}}
+
+	t.Run("duplicated function name", func(t *testing.T) {
+		function := newFunction()
+		function.Name = "bm25"
+		err := addFunction(newColl(), function, outputs)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("input field not found", func(t *testing.T) {
+		function := newFunction()
+		function.InputFieldNames = []string{"foo"}
+		err := addFunction(newColl(), function, outputs)
+		assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+	})
+
+	t.Run("duplicated output field", func(t *testing.T) {
+		err := addFunction(newColl(), newFunction(), []*schemapb.FieldSchema{{Name: "text", DataType: schemapb.DataType_FloatVector}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		coll := newColl()
+		err := addFunction(coll, newFunction(), outputs)
+		assert.NoError(t, err)
+		assert.Len(t, coll.Fields, 5)
+		assert.Equal(t, int64(103), coll.Fields[4].FieldID)
+		assert.True(t, coll.Fields[4].IsFunctionOutput)
+		assert.Len(t, coll.Functions, 2)
+		assert.Equal(t, int64(101), coll.Functions[1].ID)
+		assert.Equal(t, []int64{101}, coll.Functions[1].InputFieldIDs)
+		assert.Equal(t, []int64{103}, coll.Functions[1].OutputFieldIDs)
+	})
+}
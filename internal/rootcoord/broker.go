@@ -25,6 +25,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -57,6 +58,9 @@ type Broker interface {
 	UnwatchChannels(ctx context.Context, info *watchInfo) error
 	GetSegmentStates(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
 	GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool
+	TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)
+	// GetChannelSeekPosition returns the position of the vchannel which the unflushed data starts from.
+	GetChannelSeekPosition(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error)
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	// notify observer to clean their meta cache
@@ -296,3 +300,28 @@ func (b *ServerBroker) GcConfirm(ctx context.Context, collectionID, partitionID
 	log.Info("received gc_confirm response", zap.Bool("finished", resp.GetGcFinished()))
 	return resp.GetGcFinished()
 }
+
+func (b *ServerBroker) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collection", req.GetCollectionID()), zap.Int64("partition", req.GetPartitionID()),
+		zap.Int64("targetCollection", req.GetTargetCollectionID()), zap.Int64("targetPartition", req.GetTargetPartitionID()))
+
+	log.Info("transferring segments")
+	resp, err := b.s.mixCoord.TransferSegments(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to transfer segments", zap.Error(err))
+		return nil, err
+	}
+	log.Info("done to transfer segments", zap.Int("num", len(resp.GetSegmentIDs())))
+	return resp, nil
+}
+
+func (b *ServerBroker) GetChannelSeekPosition(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error) {
+	resp, err := b.s.mixCoord.GetChannelRecoveryInfo(ctx, &datapb.GetChannelRecoveryInfoRequest{
+		Vchannel: vchannel,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("failed to get channel recovery info", zap.String("vchannel", vchannel), zap.Error(err))
+		return nil, err
+	}
+	return resp.GetInfo().GetSeekPosition(), nil
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
@@ -290,6 +291,55 @@ func TestServerBroker_GcConfirm(t *testing.T) {
 	})
 }
 
+func TestServerBroker_TransferSegments(t *testing.T) {
+	t.Run("failed", func(t *testing.T) {
+		mixc := mocks.NewMixCoord(t)
+		mixc.EXPECT().TransferSegments(mock.Anything, mock.Anything).Return(
+			&datapb.TransferSegmentsResponse{Status: merr.Status(errors.New("mock"))}, nil)
+		c := newTestCore(withMixCoord(mixc))
+		broker := newServerBroker(c)
+		_, err := broker.TransferSegments(context.Background(), &datapb.TransferSegmentsRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mixc := mocks.NewMixCoord(t)
+		mixc.EXPECT().TransferSegments(mock.Anything, mock.Anything).Return(
+			&datapb.TransferSegmentsResponse{Status: merr.Success(), SegmentIDs: []int64{1}}, nil)
+		c := newTestCore(withMixCoord(mixc))
+		broker := newServerBroker(c)
+		resp, err := broker.TransferSegments(context.Background(), &datapb.TransferSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1}, resp.GetSegmentIDs())
+	})
+}
+
+func TestServerBroker_GetChannelSeekPosition(t *testing.T) {
+	t.Run("failed", func(t *testing.T) {
+		mixc := mocks.NewMixCoord(t)
+		mixc.EXPECT().GetChannelRecoveryInfo(mock.Anything, mock.Anything).Return(
+			&datapb.GetChannelRecoveryInfoResponse{Status: merr.Status(errors.New("mock"))}, nil)
+		c := newTestCore(withMixCoord(mixc))
+		broker := newServerBroker(c)
+		_, err := broker.GetChannelSeekPosition(context.Background(), "ch1")
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mixc := mocks.NewMixCoord(t)
+		mixc.EXPECT().GetChannelRecoveryInfo(mock.Anything, mock.Anything).Return(
+			&datapb.GetChannelRecoveryInfoResponse{
+				Status: merr.Success(),
+				Info:   &datapb.VchannelInfo{SeekPosition: &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 100}},
+			}, nil)
+		c := newTestCore(withMixCoord(mixc))
+		broker := newServerBroker(c)
+		position, err := broker.GetChannelSeekPosition(context.Background(), "ch1")
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), position.GetTimestamp())
+	})
+}
+
 func mockGetDatabase(meta *mockrootcoord.IMetaTable) {
 	db := model.NewDatabase(1, "default", pb.DatabaseState_DatabaseCreated, nil)
 	meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).
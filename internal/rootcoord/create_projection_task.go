@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// createProjectionTask creates a projection which maintains the target collection from the wal of the source collection.
+// The projection applies the changes after it's created, the existing data of the source collection is not projected.
+type createProjectionTask struct {
+	baseTask
+	Req *rootcoordpb.CreateProjectionRequest
+}
+
+func (t *createProjectionTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if t.Req.GetProjectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the projection name must not be empty")
+	}
+	if t.Req.GetSourceCollectionName() == "" || t.Req.GetTargetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the source and target collection name must not be empty")
+	}
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return merr.WrapErrServiceUnavailable("projection requires the streaming service")
+	}
+	return nil
+}
+
+func (t *createProjectionTask) Execute(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	source, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetSourceCollectionName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+	target, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetTargetCollectionName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+	if err := checkProjectionSchema(source, target); err != nil {
+		return err
+	}
+	if err := t.core.projectionManager.CheckCollections(source.CollectionID, target.CollectionID); err != nil {
+		return err
+	}
+
+	// start from the seek position of each vchannel, the messages before the task ts are skipped.
+	checkpoints := make([]*pb.ProjectionCheckpoint, 0, len(source.VirtualChannelNames))
+	for _, vchannel := range source.VirtualChannelNames {
+		position, err := t.core.broker.GetChannelSeekPosition(ctx, vchannel)
+		if err != nil {
+			return err
+		}
+		messageID := adaptor.MustGetMessageIDFromMQWrapperIDBytes(streaming.WAL().WALName(), position.GetMsgID())
+		checkpoints = append(checkpoints, &pb.ProjectionCheckpoint{
+			Vchannel:  vchannel,
+			MessageId: messageID.Marshal(),
+			TimeTick:  t.GetTs(),
+		})
+	}
+	return t.core.projectionManager.Add(ctx, &pb.ProjectionInfo{
+		Name:               t.Req.GetProjectionName(),
+		DbID:               db.ID,
+		SourceCollectionID: source.CollectionID,
+		TargetCollectionID: target.CollectionID,
+		CreateTime:         t.GetTs(),
+		State:              pb.ProjectionState_ProjectionRunning,
+		Checkpoints:        checkpoints,
+	})
+}
+
+func (t *createProjectionTask) GetLockerKey() LockerKey {
+	// the projections of the database are created and dropped one by one.
+	return NewLockerKeyChain(
+		NewClusterLockerKey(false),
+		NewDatabaseLockerKey(t.Req.GetDbName(), true),
+	)
+}
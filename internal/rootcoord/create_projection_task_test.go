@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/mq/mqimpl/rocksmq/server"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_createProjectionTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &createProjectionTask{
+			Req: &rootcoordpb.CreateProjectionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		task := &createProjectionTask{
+			Req: &rootcoordpb.CreateProjectionRequest{
+				Base:                 &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("streaming disabled", func(t *testing.T) {
+		task := &createProjectionTask{
+			Req: &rootcoordpb.CreateProjectionRequest{
+				Base:                 &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				ProjectionName:       "proj",
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		streamingutil.SetStreamingServiceEnabled()
+		defer streamingutil.UnsetStreamingServiceEnabled()
+		task := &createProjectionTask{
+			Req: &rootcoordpb.CreateProjectionRequest{
+				Base:                 &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				ProjectionName:       "proj",
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_createProjectionTask_Execute(t *testing.T) {
+	newTask := func(core *Core) *createProjectionTask {
+		task := &createProjectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.CreateProjectionRequest{
+				DbName:               "db",
+				ProjectionName:       "proj",
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+			},
+		}
+		task.SetTs(100)
+		return task
+	}
+	newMeta := func(target *model.Collection) *mockrootcoord.IMetaTable {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(&model.Database{ID: 1, Name: "db"}, nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, "db", "source", mock.Anything).Return(newProjectionTestSource(), nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, "db", "target", mock.Anything).Return(target, nil)
+		return mockMeta
+	}
+
+	t.Run("schema mismatch", func(t *testing.T) {
+		target := newProjectionTestTarget()
+		target.Fields[1].DataType = schemapb.DataType_Int64
+		core := newTestCore(withMeta(newMeta(target)))
+		core.projectionManager = newProjectionManager(core)
+		err := newTask(core).Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("get seek position failed", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GetChannelSeekPositionFunc = func(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error) {
+			return nil, errors.New("mock")
+		}
+		core := newTestCore(withMeta(newMeta(newProjectionTestTarget())), withBroker(broker))
+		core.projectionManager = newProjectionManager(core)
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		wal := mock_streaming.NewMockWALAccesser(t)
+		wal.EXPECT().WALName().Return("rocksmq")
+		// the started projection waits for the changes of the source collection.
+		wal.EXPECT().Read(mock.Anything, mock.Anything).Return(&projectionTestScanner{done: make(chan struct{})}).Maybe()
+		streaming.SetWALForTest(wal)
+
+		broker := newMockBroker()
+		broker.GetChannelSeekPositionFunc = func(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error) {
+			assert.Equal(t, "source_v0", vchannel)
+			return &msgpb.MsgPosition{ChannelName: vchannel, MsgID: server.SerializeRmqID(10), Timestamp: 90}, nil
+		}
+		mockMeta := newMeta(newProjectionTestTarget())
+		mockMeta.EXPECT().SaveProjection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, info *pb.ProjectionInfo) error {
+			assert.Equal(t, int64(1), info.GetDbID())
+			assert.Equal(t, int64(111), info.GetSourceCollectionID())
+			assert.Equal(t, int64(222), info.GetTargetCollectionID())
+			assert.Len(t, info.GetCheckpoints(), 1)
+			assert.Equal(t, uint64(100), info.GetCheckpoints()[0].GetTimeTick())
+			return nil
+		})
+		core := newTestCore(withMeta(mockMeta), withBroker(broker))
+		core.projectionManager = newProjectionManager(core)
+		// the collection maintained by other projection can't be the target.
+		core.projectionManager.projections[projectionKey(1, "other")] = newProjection(core, &pb.ProjectionInfo{
+			Name: "other", DbID: 1, SourceCollectionID: 333, TargetCollectionID: 222,
+		})
+		err := newTask(core).Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		delete(core.projectionManager.projections, projectionKey(1, "other"))
+		err = newTask(core).Execute(context.Background())
+		assert.NoError(t, err)
+		defer core.projectionManager.Stop()
+		p, ok := core.projectionManager.Get(1, "proj")
+		assert.True(t, ok)
+		assert.Equal(t, pb.ProjectionState_ProjectionRunning, p.Info().GetState())
+	})
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_dropProjectionTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &dropProjectionTask{
+			Req: &rootcoordpb.DropProjectionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		task := &dropProjectionTask{
+			Req: &rootcoordpb.DropProjectionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func Test_dropProjectionTask_Execute(t *testing.T) {
+	t.Run("database not found", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(nil, merr.WrapErrDatabaseNotFound("db"))
+		core := newTestCore(withMeta(mockMeta))
+		task := &dropProjectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.DropProjectionRequest{DbName: "db", ProjectionName: "proj"},
+		}
+		err := task.Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(&model.Database{ID: 1, Name: "db"}, nil)
+		mockMeta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(&model.Database{ID: 1, Name: "db"}, nil)
+		mockMeta.EXPECT().DropProjection(mock.Anything, int64(1), "proj").Return(nil)
+		core := newTestCore(withMeta(mockMeta))
+		core.projectionManager = newProjectionManager(core)
+		core.projectionManager.projections[projectionKey(1, "proj")] = newProjection(core, &pb.ProjectionInfo{
+			Name: "proj", DbID: 1, State: pb.ProjectionState_ProjectionFailed,
+		})
+		task := &dropProjectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.DropProjectionRequest{DbName: "db", ProjectionName: "proj"},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		_, ok := core.projectionManager.Get(1, "proj")
+		assert.False(t, ok)
+	})
+}
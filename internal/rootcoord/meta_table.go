@@ -80,6 +80,7 @@ type IMetaTable interface {
 	ListAliases(ctx context.Context, dbName string, collectionName string, ts Timestamp) ([]string, error)
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RenameCollection(ctx context.Context, dbName string, oldName string, newDBName string, newName string, ts Timestamp) error
+	RenamePartition(ctx context.Context, collectionID UniqueID, oldName string, newName string, ts Timestamp) error
 	GetGeneralCount(ctx context.Context) int
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
@@ -110,6 +111,10 @@ type IMetaTable interface {
 	ListPrivilegeGroups(ctx context.Context) ([]*milvuspb.PrivilegeGroupInfo, error)
 	OperatePrivilegeGroup(ctx context.Context, groupName string, privileges []*milvuspb.PrivilegeEntity, operateType milvuspb.OperatePrivilegeGroupType) error
 	GetPrivilegeGroupRoles(ctx context.Context, groupName string) ([]*milvuspb.RoleEntity, error)
+
+	SaveProjection(ctx context.Context, projection *pb.ProjectionInfo) error
+	DropProjection(ctx context.Context, dbID int64, projectionName string) error
+	ListProjections(ctx context.Context) ([]*pb.ProjectionInfo, error)
 }
 
 // MetaTable is a persistent meta set of all databases, collections and partitions.
@@ -150,6 +155,7 @@ func (mt *MetaTable) reload() error {
 	defer mt.ddLock.Unlock()
 
 	record := timerecord.NewTimeRecorder("rootcoord")
+	mt.generalCnt = 0
 	mt.dbName2Meta = make(map[string]*model.Database)
 	mt.collID2Meta = make(map[UniqueID]*model.Collection)
 	mt.names = newNameDb()
@@ -992,6 +998,10 @@ func (mt *MetaTable) ChangePartitionState(ctx context.Context, collectionID Uniq
 	}
 	for idx, part := range coll.Partitions {
 		if part.PartitionID == partitionID {
+			if part.State == state {
+				// the source partition of the moving is marked dropping before it's dropped.
+				return nil
+			}
 			clone := part.Clone()
 			clone.State = state
 			ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
@@ -1020,6 +1030,49 @@ func (mt *MetaTable) ChangePartitionState(ctx context.Context, collectionID Uniq
 	return fmt.Errorf("partition not exist, collection: %d, partition: %d", collectionID, partitionID)
 }
 
+func (mt *MetaTable) RenamePartition(ctx context.Context, collectionID UniqueID, oldName string, newName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", collectionID),
+		zap.String("oldName", oldName),
+		zap.String("newName", newName),
+	)
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Available() {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+
+	idx := -1
+	for i, part := range coll.Partitions {
+		// the dropping partition still owns its name until it's removed.
+		if part.PartitionName == newName {
+			log.Warn("duplicated new partition name")
+			return merr.WrapErrParameterInvalidMsg("duplicated new partition name %s with other partition", newName)
+		}
+		if part.PartitionName == oldName && part.Available() {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return merr.WrapErrPartitionNotFound(oldName)
+	}
+
+	part := coll.Partitions[idx]
+	clone := part.Clone()
+	clone.PartitionName = newName
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterPartition(ctx1, coll.DBID, part, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID].Partitions[idx] = clone
+
+	log.Info("rename partition finished", zap.Int64("partitionID", part.PartitionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 func (mt *MetaTable) RemovePartition(ctx context.Context, dbID int64, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -1742,3 +1795,17 @@ func (mt *MetaTable) GetPrivilegeGroupRoles(ctx context.Context, groupName strin
 	}
 	return lo.Keys(rolesMap), nil
 }
+
+// SaveProjection persists the projection, the projection manager keeps the projections in memory,
+// so the meta table doesn't cache them.
+func (mt *MetaTable) SaveProjection(ctx context.Context, projection *pb.ProjectionInfo) error {
+	return mt.catalog.SaveProjection(ctx, projection)
+}
+
+func (mt *MetaTable) DropProjection(ctx context.Context, dbID int64, projectionName string) error {
+	return mt.catalog.DropProjection(ctx, dbID, projectionName)
+}
+
+func (mt *MetaTable) ListProjections(ctx context.Context) ([]*pb.ProjectionInfo, error) {
+	return mt.catalog.ListProjections(ctx)
+}
@@ -1760,6 +1760,77 @@ func TestMetaTable_ChangePartitionState(t *testing.T) {
 		err = meta.ChangePartitionState(context.TODO(), 100, 500, pb.PartitionState_PartitionDropping, 1000)
 		assert.NoError(t, err)
 	})
+
+	t.Run("state unchanged", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		meta := &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {
+					Name: "test", CollectionID: 100,
+					Partitions: []*model.Partition{
+						{CollectionID: 100, PartitionID: 500, State: pb.PartitionState_PartitionDropping},
+					},
+				},
+			},
+		}
+		err := meta.ChangePartitionState(context.TODO(), 100, 500, pb.PartitionState_PartitionDropping, 1000)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMetaTable_RenamePartition(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		return &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {
+					Name: "test", CollectionID: 100, State: pb.CollectionState_CollectionCreated,
+					Partitions: []*model.Partition{
+						{CollectionID: 100, PartitionID: 500, PartitionName: "p1", State: pb.PartitionState_PartitionCreated},
+						{CollectionID: 100, PartitionID: 501, PartitionName: "p2", State: pb.PartitionState_PartitionDropping},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenamePartition(context.TODO(), 101, "p1", "p3", 1000)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	})
+
+	t.Run("partition not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenamePartition(context.TODO(), 100, "p0", "p3", 1000)
+		assert.ErrorIs(t, err, merr.ErrPartitionNotFound)
+	})
+
+	t.Run("duplicated with dropping partition", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenamePartition(context.TODO(), 100, "p1", "p2", 1000)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("failed to alter partition", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterPartition(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("error mock AlterPartition"))
+		meta := newMeta(catalog)
+		err := meta.RenamePartition(context.TODO(), 100, "p1", "p3", 1000)
+		assert.Error(t, err)
+		assert.Equal(t, "p1", meta.collID2Meta[100].Partitions[0].PartitionName)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterPartition(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		meta := newMeta(catalog)
+		err := meta.RenamePartition(context.TODO(), 100, "p1", "p3", 1000)
+		assert.NoError(t, err)
+		assert.Equal(t, "p3", meta.collID2Meta[100].Partitions[0].PartitionName)
+	})
 }
 
 func TestMetaTable_CreateDatabase(t *testing.T) {
@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// metaSnapshot is the databases, collections and aliases of the meta table at some moment.
+type metaSnapshot struct {
+	dbs         map[string]*model.Database
+	collections map[UniqueID]*model.Collection
+	aliases     map[UniqueID][]string
+}
+
+func (mt *MetaTable) snapshot() *metaSnapshot {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	aliases := make(map[UniqueID][]string)
+	mt.aliases.iterate(func(db string, alias string, collectionID UniqueID) bool {
+		aliases[collectionID] = append(aliases[collectionID], alias)
+		return true
+	})
+	return &metaSnapshot{
+		dbs:         lo.Assign(mt.dbName2Meta),
+		collections: lo.Assign(mt.collID2Meta),
+		aliases:     aliases,
+	}
+}
+
+// ReloadMirroredMeta reloads the meta table after the meta of the primary cluster is mirrored into the follower cluster,
+// and expires the proxy caches of the databases and collections changed by the mirrored meta.
+func (c *Core) ReloadMirroredMeta(ctx context.Context) error {
+	mt, ok := c.meta.(*MetaTable)
+	if !ok {
+		return merr.WrapErrServiceInternal("the meta table doesn't support reload")
+	}
+	before := mt.snapshot()
+	if err := mt.reload(); err != nil {
+		return err
+	}
+	after := mt.snapshot()
+
+	for dbName, db := range before.dbs {
+		if newDB, ok := after.dbs[dbName]; ok && newDB.Equal(*db) {
+			continue
+		}
+		if err := c.ExpireMetaCache(ctx, dbName, []string{""}, 0, "", 0,
+			proxyutil.SetMsgType(commonpb.MsgType_AlterDatabase)); err != nil {
+			return err
+		}
+	}
+	for collectionID, coll := range before.collections {
+		newColl, ok := after.collections[collectionID]
+		removedAliases, addedAliases := lo.Difference(before.aliases[collectionID], after.aliases[collectionID])
+		if ok && newColl.Equal(*coll) &&
+			newColl.State == coll.State &&
+			newColl.UpdateTimestamp == coll.UpdateTimestamp &&
+			len(newColl.Functions) == len(coll.Functions) &&
+			len(removedAliases) == 0 && len(addedAliases) == 0 {
+			continue
+		}
+		// the collection is expired by id, so the aliases and the new name are expired as well.
+		if err := c.ExpireMetaCache(ctx, coll.DBName, []string{coll.Name}, collectionID, "", 0,
+			proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)); err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info("expire the meta cache of the collection changed by the mirrored meta",
+			zap.String("dbName", coll.DBName), zap.String("collectionName", coll.Name), zap.Int64("collectionID", collectionID))
+	}
+	return nil
+}
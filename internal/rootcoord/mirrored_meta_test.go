@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func TestCore_ReloadMirroredMeta(t *testing.T) {
+	t.Run("not reloadable", func(t *testing.T) {
+		c := newTestCore(withInvalidMeta())
+		assert.Error(t, c.ReloadMirroredMeta(context.Background()))
+	})
+
+	t.Run("expire the changed collections", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{model.NewDefaultDatabase(nil)}, nil)
+		catalog.EXPECT().ListAliases(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		catalog.EXPECT().ListCollections(mock.Anything, mock.Anything, mock.Anything).Return([]*model.Collection{
+			{CollectionID: 100, Name: "renamed", State: etcdpb.CollectionState_CollectionCreated},
+			{CollectionID: 101, Name: "unchanged", State: etcdpb.CollectionState_CollectionCreated},
+		}, nil)
+		mt := &MetaTable{
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			dbName2Meta: map[string]*model.Database{
+				model.NewDefaultDatabase(nil).Name: model.NewDefaultDatabase(nil),
+			},
+			collID2Meta: map[UniqueID]*model.Collection{
+				100: {CollectionID: 100, Name: "origin", State: etcdpb.CollectionState_CollectionCreated},
+				101: {CollectionID: 101, Name: "unchanged", State: etcdpb.CollectionState_CollectionCreated},
+				102: {CollectionID: 102, Name: "dropped", State: etcdpb.CollectionState_CollectionCreated},
+			},
+		}
+
+		c := newTestCore(withMeta(mt), withValidProxyManager())
+		expired := make([]*proxypb.InvalidateCollMetaCacheRequest, 0)
+		p, _ := c.proxyClientManager.GetProxyClients().Get(TestProxyID)
+		p.(*mockProxy).InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+			expired = append(expired, request)
+			return merr.Success(), nil
+		}
+
+		channel.ResetStaticPChannelStatsManager()
+		err := c.ReloadMirroredMeta(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, mt.collID2Meta, 2)
+		assert.Len(t, expired, 2)
+		for _, request := range expired {
+			assert.Equal(t, commonpb.MsgType_AlterCollection, request.GetBase().GetMsgType())
+			assert.Contains(t, []int64{100, 102}, request.GetCollectionID())
+		}
+	})
+}
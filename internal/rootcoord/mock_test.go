@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
@@ -897,6 +898,10 @@ type mockBroker struct {
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 
 	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
+
+	TransferSegmentsFunc func(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error)
+
+	GetChannelSeekPositionFunc func(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error)
 }
 
 func newMockBroker() *mockBroker {
@@ -939,6 +944,14 @@ func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID Uni
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) TransferSegments(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+	return b.TransferSegmentsFunc(ctx, req)
+}
+
+func (b mockBroker) GetChannelSeekPosition(ctx context.Context, vchannel string) (*msgpb.MsgPosition, error) {
+	return b.GetChannelSeekPositionFunc(ctx, vchannel)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
@@ -1015,6 +1015,54 @@ func (_c *IMetaTable_DropPrivilegeGroup_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// DropProjection provides a mock function with given fields: ctx, dbID, projectionName
+func (_m *IMetaTable) DropProjection(ctx context.Context, dbID int64, projectionName string) error {
+	ret := _m.Called(ctx, dbID, projectionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropProjection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, dbID, projectionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropProjection'
+type IMetaTable_DropProjection_Call struct {
+	*mock.Call
+}
+
+// DropProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - projectionName string
+func (_e *IMetaTable_Expecter) DropProjection(ctx interface{}, dbID interface{}, projectionName interface{}) *IMetaTable_DropProjection_Call {
+	return &IMetaTable_DropProjection_Call{Call: _e.mock.On("DropProjection", ctx, dbID, projectionName)}
+}
+
+func (_c *IMetaTable_DropProjection_Call) Run(run func(ctx context.Context, dbID int64, projectionName string)) *IMetaTable_DropProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropProjection_Call) Return(_a0 error) *IMetaTable_DropProjection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropProjection_Call) RunAndReturn(run func(context.Context, int64, string) error) *IMetaTable_DropProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropRole provides a mock function with given fields: ctx, tenant, roleName
 func (_m *IMetaTable) DropRole(ctx context.Context, tenant string, roleName string) error {
 	ret := _m.Called(ctx, tenant, roleName)
@@ -2281,6 +2329,64 @@ func (_c *IMetaTable_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListProjections provides a mock function with given fields: ctx
+func (_m *IMetaTable) ListProjections(ctx context.Context) ([]*etcdpb.ProjectionInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjections")
+	}
+
+	var r0 []*etcdpb.ProjectionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*etcdpb.ProjectionInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*etcdpb.ProjectionInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etcdpb.ProjectionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjections'
+type IMetaTable_ListProjections_Call struct {
+	*mock.Call
+}
+
+// ListProjections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IMetaTable_Expecter) ListProjections(ctx interface{}) *IMetaTable_ListProjections_Call {
+	return &IMetaTable_ListProjections_Call{Call: _e.mock.On("ListProjections", ctx)}
+}
+
+func (_c *IMetaTable_ListProjections_Call) Run(run func(ctx context.Context)) *IMetaTable_ListProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListProjections_Call) Return(_a0 []*etcdpb.ProjectionInfo, _a1 error) *IMetaTable_ListProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListProjections_Call) RunAndReturn(run func(context.Context) ([]*etcdpb.ProjectionInfo, error)) *IMetaTable_ListProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: ctx, tenant
 func (_m *IMetaTable) ListUserRole(ctx context.Context, tenant string) ([]string, error) {
 	ret := _m.Called(ctx, tenant)
@@ -2637,6 +2743,56 @@ func (_c *IMetaTable_RenameCollection_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RenamePartition provides a mock function with given fields: ctx, collectionID, oldName, newName, ts
+func (_m *IMetaTable) RenamePartition(ctx context.Context, collectionID int64, oldName string, newName string, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, oldName, newName, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenamePartition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, uint64) error); ok {
+		r0 = rf(ctx, collectionID, oldName, newName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_RenamePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenamePartition'
+type IMetaTable_RenamePartition_Call struct {
+	*mock.Call
+}
+
+// RenamePartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - oldName string
+//   - newName string
+//   - ts uint64
+func (_e *IMetaTable_Expecter) RenamePartition(ctx interface{}, collectionID interface{}, oldName interface{}, newName interface{}, ts interface{}) *IMetaTable_RenamePartition_Call {
+	return &IMetaTable_RenamePartition_Call{Call: _e.mock.On("RenamePartition", ctx, collectionID, oldName, newName, ts)}
+}
+
+func (_c *IMetaTable_RenamePartition_Call) Run(run func(ctx context.Context, collectionID int64, oldName string, newName string, ts uint64)) *IMetaTable_RenamePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_RenamePartition_Call) Return(_a0 error) *IMetaTable_RenamePartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_RenamePartition_Call) RunAndReturn(run func(context.Context, int64, string, string, uint64) error) *IMetaTable_RenamePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreRBAC provides a mock function with given fields: ctx, tenant, meta
 func (_m *IMetaTable) RestoreRBAC(ctx context.Context, tenant string, meta *milvuspb.RBACMeta) error {
 	ret := _m.Called(ctx, tenant, meta)
@@ -2685,6 +2841,53 @@ func (_c *IMetaTable_RestoreRBAC_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// SaveProjection provides a mock function with given fields: ctx, projection
+func (_m *IMetaTable) SaveProjection(ctx context.Context, projection *etcdpb.ProjectionInfo) error {
+	ret := _m.Called(ctx, projection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProjection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etcdpb.ProjectionInfo) error); ok {
+		r0 = rf(ctx, projection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_SaveProjection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProjection'
+type IMetaTable_SaveProjection_Call struct {
+	*mock.Call
+}
+
+// SaveProjection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projection *etcdpb.ProjectionInfo
+func (_e *IMetaTable_Expecter) SaveProjection(ctx interface{}, projection interface{}) *IMetaTable_SaveProjection_Call {
+	return &IMetaTable_SaveProjection_Call{Call: _e.mock.On("SaveProjection", ctx, projection)}
+}
+
+func (_c *IMetaTable_SaveProjection_Call) Run(run func(ctx context.Context, projection *etcdpb.ProjectionInfo)) *IMetaTable_SaveProjection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*etcdpb.ProjectionInfo))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_SaveProjection_Call) Return(_a0 error) *IMetaTable_SaveProjection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_SaveProjection_Call) RunAndReturn(run func(context.Context, *etcdpb.ProjectionInfo) error) *IMetaTable_SaveProjection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, tenant, entity
 func (_m *IMetaTable) SelectGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(ctx, tenant, entity)
@@ -0,0 +1,814 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func projectionKey(dbID int64, name string) string {
+	return fmt.Sprintf("%d/%s", dbID, name)
+}
+
+// projectionManager maintains the projections of the cluster.
+// A projection subscribes the vchannels of the source collection,
+// and applies the inserts and deletes of the source collection to the target collection.
+type projectionManager struct {
+	core *Core
+
+	mu          sync.RWMutex
+	projections map[string]*projection
+}
+
+func newProjectionManager(core *Core) *projectionManager {
+	return &projectionManager{
+		core:        core,
+		projections: make(map[string]*projection),
+	}
+}
+
+// Start recovers the projections from the meta and starts them.
+func (m *projectionManager) Start(ctx context.Context) error {
+	infos, err := m.core.meta.ListProjections(ctx)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, info := range infos {
+		p := newProjection(m.core, info)
+		m.projections[projectionKey(info.GetDbID(), info.GetName())] = p
+		p.Start()
+	}
+	log.Ctx(ctx).Info("projection manager started", zap.Int("projections", len(infos)))
+	return nil
+}
+
+func (m *projectionManager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, p := range m.projections {
+		p.Stop()
+	}
+}
+
+// Add persists the projection and starts to apply the changes of the source collection.
+func (m *projectionManager) Add(ctx context.Context, info *pb.ProjectionInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := projectionKey(info.GetDbID(), info.GetName())
+	if _, ok := m.projections[key]; ok {
+		return merr.WrapErrParameterInvalidMsg("projection %s already exists", info.GetName())
+	}
+	if err := m.core.meta.SaveProjection(ctx, info); err != nil {
+		return err
+	}
+	p := newProjection(m.core, info)
+	m.projections[key] = p
+	p.Start()
+	return nil
+}
+
+// Remove stops the projection and removes it from the meta, the data applied to the target collection is kept.
+func (m *projectionManager) Remove(ctx context.Context, dbID int64, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := projectionKey(dbID, name)
+	p, ok := m.projections[key]
+	if !ok {
+		return nil
+	}
+	p.Stop()
+	if err := m.core.meta.DropProjection(ctx, dbID, name); err != nil {
+		// the stopped projection is restarted after the rootcoord restarts.
+		p.Start()
+		return err
+	}
+	delete(m.projections, key)
+	metrics.CleanupRootCoordProjectionMetrics(p.dbName(), name)
+	return nil
+}
+
+func (m *projectionManager) Get(dbID int64, name string) (*projection, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.projections[projectionKey(dbID, name)]
+	return p, ok
+}
+
+// List returns the projections of the database.
+func (m *projectionManager) List(dbID int64) []*projection {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	projections := make([]*projection, 0)
+	for _, p := range m.projections {
+		if p.DbID() == dbID {
+			projections = append(projections, p)
+		}
+	}
+	return projections
+}
+
+// CheckCollections checks whether the target collection can be maintained by a new projection.
+// A collection is maintained by one projection at most, and a maintained collection cannot be the source of others,
+// so the projections never form a cycle.
+func (m *projectionManager) CheckCollections(sourceCollectionID, targetCollectionID int64) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, p := range m.projections {
+		info := p.Info()
+		if targetCollectionID == info.GetTargetCollectionID() || targetCollectionID == info.GetSourceCollectionID() {
+			return merr.WrapErrParameterInvalidMsg("the target collection is already used by projection %s", info.GetName())
+		}
+		if sourceCollectionID == info.GetTargetCollectionID() {
+			return merr.WrapErrParameterInvalidMsg("the source collection is maintained by projection %s", info.GetName())
+		}
+	}
+	return nil
+}
+
+// projection applies the changes of the source collection to the target collection.
+// Each vchannel of the source collection is consumed by one goroutine from its checkpoint,
+// the rows of one primary key are always in the same source vchannel, so the changes of a row are applied in order.
+type projection struct {
+	core *Core
+
+	mu    sync.RWMutex
+	info  *pb.ProjectionInfo
+	dirty bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newProjection(core *Core, info *pb.ProjectionInfo) *projection {
+	return &projection{
+		core: core,
+		info: info,
+	}
+}
+
+func (p *projection) DbID() int64 {
+	return p.info.GetDbID()
+}
+
+func (p *projection) dbName() string {
+	db, err := p.core.meta.GetDatabaseByID(context.Background(), p.info.GetDbID(), typeutil.MaxTimestamp)
+	if err != nil {
+		return ""
+	}
+	return db.Name
+}
+
+// Info returns a snapshot of the projection info.
+func (p *projection) Info() *pb.ProjectionInfo {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return proto.Clone(p.info).(*pb.ProjectionInfo)
+}
+
+// ConsistentTs returns the timestamp before which all the changes of the source collection are applied.
+func (p *projection) ConsistentTs() uint64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	ts := typeutil.MaxTimestamp
+	for _, checkpoint := range p.info.GetCheckpoints() {
+		if checkpoint.GetTimeTick() < ts {
+			ts = checkpoint.GetTimeTick()
+		}
+	}
+	return ts
+}
+
+// Lag returns the lag of the projection applying the changes of the source collection.
+func (p *projection) Lag() time.Duration {
+	return time.Since(tsoutil.PhysicalTime(p.ConsistentTs()))
+}
+
+func (p *projection) Start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.info.GetState() != pb.ProjectionState_ProjectionRunning {
+		return
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	for _, checkpoint := range p.info.GetCheckpoints() {
+		p.wg.Add(1)
+		go p.consumeLoop(checkpoint.GetVchannel())
+	}
+	p.wg.Add(1)
+	go p.checkpointLoop()
+}
+
+func (p *projection) Stop() {
+	p.mu.RLock()
+	cancel := p.cancel
+	p.mu.RUnlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	p.wg.Wait()
+	p.saveCheckpoints(context.Background())
+}
+
+func (p *projection) logger() *log.MLogger {
+	return log.With(zap.String("projection", p.info.GetName()),
+		zap.Int64("sourceCollectionID", p.info.GetSourceCollectionID()),
+		zap.Int64("targetCollectionID", p.info.GetTargetCollectionID()))
+}
+
+// checkpointLoop persists the checkpoints and updates the lag metric periodically.
+func (p *projection) checkpointLoop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(paramtable.Get().RootCoordCfg.ProjectionCheckpointInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.saveCheckpoints(p.ctx)
+			metrics.RootCoordProjectionLag.WithLabelValues(p.dbName(), p.info.GetName()).Set(float64(p.Lag().Milliseconds()))
+		}
+	}
+}
+
+func (p *projection) saveCheckpoints(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.dirty {
+		return
+	}
+	if err := p.core.meta.SaveProjection(ctx, p.info); err != nil {
+		p.logger().Warn("failed to save projection checkpoints", zap.Error(err))
+		return
+	}
+	p.dirty = false
+}
+
+func (p *projection) getCheckpoint(vchannel string) *pb.ProjectionCheckpoint {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, checkpoint := range p.info.GetCheckpoints() {
+		if checkpoint.GetVchannel() == vchannel {
+			return proto.Clone(checkpoint).(*pb.ProjectionCheckpoint)
+		}
+	}
+	return nil
+}
+
+func (p *projection) updateCheckpoint(vchannel string, msg message.ImmutableMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, checkpoint := range p.info.GetCheckpoints() {
+		if checkpoint.GetVchannel() == vchannel {
+			// the messages after the last confirmed message id with greater time tick are not applied yet.
+			checkpoint.MessageId = msg.LastConfirmedMessageID().Marshal()
+			checkpoint.TimeTick = msg.TimeTick()
+			p.dirty = true
+			return
+		}
+	}
+}
+
+// fail stops the projection, the failed projection is kept until it's dropped.
+func (p *projection) fail(err error) {
+	p.mu.Lock()
+	p.info.State = pb.ProjectionState_ProjectionFailed
+	p.info.Reason = err.Error()
+	p.dirty = true
+	p.cancel()
+	p.mu.Unlock()
+
+	p.logger().Warn("projection failed", zap.Error(err))
+	p.saveCheckpoints(context.Background())
+}
+
+func (p *projection) consumeLoop(vchannel string) {
+	defer p.wg.Done()
+	err := retry.Do(p.ctx, func() error {
+		return p.consume(vchannel)
+	}, retry.AttemptAlways(), retry.MaxSleepTime(10*time.Second))
+	if p.ctx.Err() != nil {
+		return
+	}
+	p.fail(err)
+}
+
+// consume reads the source vchannel from the checkpoint and applies the changes to the target collection.
+// It returns an unrecoverable error if the projection cannot move on anymore.
+func (p *projection) consume(vchannel string) error {
+	checkpoint := p.getCheckpoint(vchannel)
+	if checkpoint == nil {
+		return retry.Unrecoverable(merr.WrapErrChannelNotFound(vchannel))
+	}
+	startFrom, err := message.UnmarshalMessageID(streaming.WAL().WALName(), checkpoint.GetMessageId())
+	if err != nil {
+		return retry.Unrecoverable(err)
+	}
+	handler := make(adaptor.ChanMessageHandler, 64)
+	scanner := streaming.WAL().Read(p.ctx, streaming.ReadOption{
+		VChannel:      vchannel,
+		DeliverPolicy: options.DeliverPolicyStartFrom(startFrom),
+		DeliverFilters: []options.DeliverFilter{
+			options.DeliverFilterTimeTickGT(checkpoint.GetTimeTick()),
+			options.DeliverFilterMessageType(message.MessageTypeInsert, message.MessageTypeDelete,
+				message.MessageTypeTxn, message.MessageTypeTimeTick, message.MessageTypeDropCollection),
+		},
+		MessageHandler: handler,
+	})
+	defer scanner.Close()
+
+	for {
+		var msg message.ImmutableMessage
+		var ok bool
+		select {
+		case <-p.ctx.Done():
+			return p.ctx.Err()
+		case msg, ok = <-handler:
+			if !ok {
+				if err := scanner.Error(); err != nil {
+					return err
+				}
+				return merr.WrapErrServiceInternal("wal scanner is closed unexpectedly")
+			}
+		}
+		if msg.VChannel() != "" && msg.VChannel() != vchannel {
+			continue
+		}
+		if msg.MessageType() == message.MessageTypeDropCollection {
+			return retry.Unrecoverable(merr.WrapErrCollectionNotFound(p.info.GetSourceCollectionID(), "the source collection is dropped"))
+		}
+		msgs, err := p.project(msg)
+		if err != nil {
+			return err
+		}
+		if err := p.appendProjected(msgs); err != nil {
+			return err
+		}
+		p.updateCheckpoint(vchannel, msg)
+	}
+}
+
+// appendProjected appends the messages projected from one source message.
+// The deletes and inserts of a target vchannel are appended in order in one txn as upsert does,
+// so the insert is never deleted by the delete generated for it and no reader sees the delete alone.
+func (p *projection) appendProjected(msgs []message.MutableMessage) error {
+	dispatched := make(map[string][]message.MutableMessage)
+	order := make([]string, 0)
+	for _, msg := range msgs {
+		if _, ok := dispatched[msg.VChannel()]; !ok {
+			order = append(order, msg.VChannel())
+		}
+		dispatched[msg.VChannel()] = append(dispatched[msg.VChannel()], msg)
+	}
+	for _, vchannel := range order {
+		if err := p.appendInTxn(vchannel, dispatched[vchannel]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendInTxn appends the messages to the vchannel one by one in a txn.
+// A failed txn is retried from the checkpoint by the consume loop, which is safe since the projection is idempotent.
+func (p *projection) appendInTxn(vchannel string, msgs []message.MutableMessage) error {
+	if len(msgs) == 1 {
+		return streaming.WAL().AppendMessages(p.ctx, msgs[0]).UnwrapFirstError()
+	}
+	txn, err := streaming.WAL().Txn(p.ctx, streaming.TxnOption{VChannel: vchannel})
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if err := txn.Append(p.ctx, msg); err != nil {
+			_ = txn.Rollback(p.ctx) // rollback failure can be ignored.
+			return err
+		}
+	}
+	_, err = txn.Commit(p.ctx)
+	return err
+}
+
+// project rewrites the message of the source collection into the messages of the target collection.
+func (p *projection) project(msg message.ImmutableMessage) ([]message.MutableMessage, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeInsert, message.MessageTypeDelete, message.MessageTypeTxn:
+	default:
+		return nil, nil
+	}
+	source, err := p.core.meta.GetCollectionByIDWithMaxTs(p.ctx, p.info.GetSourceCollectionID())
+	if err != nil {
+		return nil, retry.Unrecoverable(err)
+	}
+	target, err := p.core.meta.GetCollectionByIDWithMaxTs(p.ctx, p.info.GetTargetCollectionID())
+	if err != nil {
+		return nil, retry.Unrecoverable(err)
+	}
+	return p.rewrite(source, target, msg)
+}
+
+func (p *projection) rewrite(source, target *model.Collection, msg message.ImmutableMessage) ([]message.MutableMessage, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeTxn:
+		msgs := make([]message.MutableMessage, 0)
+		err := message.AsImmutableTxnMessage(msg).RangeOver(func(m message.ImmutableMessage) error {
+			rewritten, err := p.rewrite(source, target, m)
+			msgs = append(msgs, rewritten...)
+			return err
+		})
+		return msgs, err
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := insertMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return p.projectInsert(source, target, body, msg.TimeTick())
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := deleteMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		return buildProjectionDeletes(target, body.GetPrimaryKeys(), msg.TimeTick())
+	default:
+		return nil, nil
+	}
+}
+
+// projectInsert splits the rows by the shards and partitions of the target collection.
+// The rows are deleted by primary key before being inserted, so the insert is applied as an upsert,
+// which keeps the projection idempotent when the messages after the persisted checkpoint are applied again.
+func (p *projection) projectInsert(source, target *model.Collection, body *msgpb.InsertRequest, timeTick uint64) ([]message.MutableMessage, error) {
+	numRows := int(body.GetNumRows())
+	if numRows == 0 {
+		return nil, nil
+	}
+	columns, err := projectColumns(source, target, body.GetFieldsData())
+	if err != nil {
+		return nil, err
+	}
+	pkColumn, err := getProjectedColumn(target, columns, func(field *model.Field) bool { return field.IsPrimaryKey })
+	if err != nil {
+		return nil, err
+	}
+	pks, err := primaryFieldData2IDs(pkColumn)
+	if err != nil {
+		return nil, retry.Unrecoverable(err)
+	}
+	msgs, err := buildProjectionDeletes(target, pks, timeTick)
+	if err != nil {
+		return nil, err
+	}
+
+	channelHashes := typeutil.HashPK2Channels(pks, target.VirtualChannelNames)
+	partitions, partitionHashes, err := assignProjectionPartitions(target, body.GetPartitionName(), columns, numRows)
+	if err != nil {
+		return nil, err
+	}
+	rowID, _, err := p.core.idAllocator.Alloc(uint32(numRows))
+	if err != nil {
+		return nil, err
+	}
+
+	type shardPartition struct {
+		channel   uint32
+		partition uint32
+	}
+	groups := make(map[shardPartition][]int)
+	order := make([]shardPartition, 0)
+	for row := 0; row < numRows; row++ {
+		key := shardPartition{channel: channelHashes[row], partition: partitionHashes[row]}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], row)
+	}
+	for _, key := range order {
+		rows := groups[key]
+		vchannel := target.VirtualChannelNames[key.channel]
+		partition := partitions[key.partition]
+		req := &msgpb.InsertRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Insert),
+				commonpbutil.WithTimeStamp(timeTick),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ShardName:      vchannel,
+			CollectionName: target.Name,
+			PartitionName:  partition.PartitionName,
+			DbID:           target.DBID,
+			CollectionID:   target.CollectionID,
+			PartitionID:    partition.PartitionID,
+			Version:        msgpb.InsertDataVersion_ColumnBased,
+			FieldsData:     make([]*schemapb.FieldData, len(columns)),
+			NumRows:        uint64(len(rows)),
+		}
+		for _, row := range rows {
+			typeutil.AppendFieldData(req.FieldsData, columns, int64(row))
+			req.RowIDs = append(req.RowIDs, rowID+int64(row))
+			req.Timestamps = append(req.Timestamps, timeTick)
+		}
+		msg, err := message.NewInsertMessageBuilderV1().
+			WithVChannel(vchannel).
+			WithHeader(&message.InsertMessageHeader{
+				CollectionId: target.CollectionID,
+				Partitions: []*message.PartitionSegmentAssignment{
+					{
+						PartitionId: partition.PartitionID,
+						Rows:        req.GetNumRows(),
+					},
+				},
+			}).
+			WithBody(req).
+			BuildMutable()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// buildProjectionDeletes splits the primary keys by the shards of the target collection,
+// the deletes are applied to all the partitions since the partition of the row in the target collection is unknown.
+func buildProjectionDeletes(target *model.Collection, pks *schemapb.IDs, timeTick uint64) ([]message.MutableMessage, error) {
+	numRows := typeutil.GetSizeOfIDs(pks)
+	if numRows == 0 {
+		return nil, nil
+	}
+	channelHashes := typeutil.HashPK2Channels(pks, target.VirtualChannelNames)
+	requests := make(map[uint32]*msgpb.DeleteRequest)
+	order := make([]uint32, 0)
+	for row := 0; row < numRows; row++ {
+		channel := channelHashes[row]
+		req, ok := requests[channel]
+		if !ok {
+			req = &msgpb.DeleteRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+					commonpbutil.WithTimeStamp(timeTick),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ShardName:      target.VirtualChannelNames[channel],
+				CollectionName: target.Name,
+				DbID:           target.DBID,
+				CollectionID:   target.CollectionID,
+				PartitionID:    common.AllPartitionsID,
+				PrimaryKeys:    &schemapb.IDs{},
+			}
+			requests[channel] = req
+			order = append(order, channel)
+		}
+		typeutil.AppendIDs(req.PrimaryKeys, pks, row)
+		req.Timestamps = append(req.Timestamps, timeTick)
+		req.NumRows++
+	}
+	msgs := make([]message.MutableMessage, 0, len(order))
+	for _, channel := range order {
+		req := requests[channel]
+		msg, err := message.NewDeleteMessageBuilderV1().
+			WithVChannel(req.GetShardName()).
+			WithHeader(&message.DeleteMessageHeader{
+				CollectionId: target.CollectionID,
+				Rows:         uint64(req.GetNumRows()),
+			}).
+			WithBody(req).
+			BuildMutable()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// projectColumns picks the columns of the target fields from the source columns by the field name.
+func projectColumns(source, target *model.Collection, sourceColumns []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	sourceFieldNames := make(map[int64]string, len(source.Fields))
+	var sourceDynamicFieldID int64 = -1
+	for _, field := range source.Fields {
+		sourceFieldNames[field.FieldID] = field.Name
+		if field.IsDynamic {
+			sourceDynamicFieldID = field.FieldID
+		}
+	}
+	columnsByName := make(map[string]*schemapb.FieldData, len(sourceColumns))
+	var dynamicColumn *schemapb.FieldData
+	for _, column := range sourceColumns {
+		if column.GetFieldId() == sourceDynamicFieldID || column.GetIsDynamic() {
+			dynamicColumn = column
+			continue
+		}
+		name, ok := sourceFieldNames[column.GetFieldId()]
+		if !ok {
+			name = column.GetFieldName()
+		}
+		columnsByName[name] = column
+	}
+
+	columns := make([]*schemapb.FieldData, 0, len(target.Fields))
+	for _, field := range target.Fields {
+		if field.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		column := columnsByName[field.Name]
+		if field.IsDynamic {
+			column = dynamicColumn
+		}
+		if column == nil {
+			if isComputedByStorage(target, field) {
+				continue
+			}
+			return nil, retry.Unrecoverable(merr.WrapErrParameterInvalidMsg(
+				"field %s of the target collection is not found in the changes of the source collection", field.Name))
+		}
+		column = proto.Clone(column).(*schemapb.FieldData)
+		column.FieldId = field.FieldID
+		column.FieldName = field.Name
+		column.IsDynamic = field.IsDynamic
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func getProjectedColumn(target *model.Collection, columns []*schemapb.FieldData, filter func(field *model.Field) bool) (*schemapb.FieldData, error) {
+	for _, field := range target.Fields {
+		if !filter(field) {
+			continue
+		}
+		for _, column := range columns {
+			if column.GetFieldId() == field.FieldID {
+				return column, nil
+			}
+		}
+		return nil, retry.Unrecoverable(merr.WrapErrFieldNotFound(field.Name))
+	}
+	return nil, nil
+}
+
+// assignProjectionPartitions returns the partition of each row in the target collection.
+// The rows are hashed by the partition key if the target collection has one,
+// otherwise the rows are written into the partition with the same name as the source partition if it exists,
+// or the default partition of the target collection.
+func assignProjectionPartitions(target *model.Collection, sourcePartition string, columns []*schemapb.FieldData, numRows int) ([]*model.Partition, []uint32, error) {
+	available := make(map[string]*model.Partition)
+	for _, partition := range target.Partitions {
+		if partition.Available() {
+			available[partition.PartitionName] = partition
+		}
+	}
+
+	keyColumn, err := getProjectedColumn(target, columns, func(field *model.Field) bool { return field.IsPartitionKey })
+	if err != nil {
+		return nil, nil, err
+	}
+	if keyColumn != nil {
+		partitionIDs := make(map[string]int64, len(available))
+		for name, partition := range available {
+			partitionIDs[name] = partition.PartitionID
+		}
+		names, _, err := typeutil.RearrangePartitionsForPartitionKey(partitionIDs)
+		if err != nil {
+			return nil, nil, retry.Unrecoverable(err)
+		}
+		hashes, err := typeutil.HashKey2Partitions(keyColumn, names)
+		if err != nil {
+			return nil, nil, retry.Unrecoverable(err)
+		}
+		partitions := make([]*model.Partition, 0, len(names))
+		for _, name := range names {
+			partitions = append(partitions, available[name])
+		}
+		return partitions, hashes, nil
+	}
+
+	partition, ok := available[sourcePartition]
+	if !ok {
+		partition, ok = available[Params.CommonCfg.DefaultPartitionName.GetValue()]
+	}
+	if !ok {
+		return nil, nil, retry.Unrecoverable(merr.WrapErrPartitionNotFound(Params.CommonCfg.DefaultPartitionName.GetValue()))
+	}
+	return []*model.Partition{partition}, make([]uint32, numRows), nil
+}
+
+// isComputedByStorage returns whether the field is the output of a function computed when the data is persisted,
+// such as the sparse vector of bm25, the column doesn't exist in the insert messages.
+func isComputedByStorage(coll *model.Collection, field *model.Field) bool {
+	if !field.IsFunctionOutput {
+		return false
+	}
+	for _, function := range coll.Functions {
+		if function.Type == schemapb.FunctionType_BM25 && funcutil.SliceContain(function.OutputFieldIDs, field.FieldID) {
+			return true
+		}
+	}
+	return false
+}
+
+func primaryFieldData2IDs(fieldData *schemapb.FieldData) (*schemapb.IDs, error) {
+	switch fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_LongData:
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: fieldData.GetScalars().GetLongData()}}, nil
+	case *schemapb.ScalarField_StringData:
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: fieldData.GetScalars().GetStringData()}}, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("only Int64 or VarChar primary key is supported")
+	}
+}
+
+// checkProjectionSchema checks whether the target collection can be maintained from the changes of the source collection.
+// The target collection must share the primary key with the source collection,
+// and each field of the target collection must be a field of the source collection with the same type.
+func checkProjectionSchema(source, target *model.Collection) error {
+	if source.CollectionID == target.CollectionID {
+		return merr.WrapErrParameterInvalidMsg("the source and target collection of a projection must be different")
+	}
+	sourceFields := make(map[string]*model.Field, len(source.Fields))
+	for _, field := range source.Fields {
+		sourceFields[field.Name] = field
+	}
+	for _, field := range target.Fields {
+		if field.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		if field.IsDynamic {
+			if !source.EnableDynamicField {
+				return merr.WrapErrParameterInvalidMsg("the dynamic field of the target collection requires the source collection enabling dynamic field")
+			}
+			continue
+		}
+		sourceField, ok := sourceFields[field.Name]
+		if !ok {
+			if isComputedByStorage(target, field) {
+				continue
+			}
+			return merr.WrapErrParameterInvalidMsg("field %s of the target collection is not found in the source collection", field.Name)
+		}
+		if field.IsPrimaryKey != sourceField.IsPrimaryKey {
+			return merr.WrapErrParameterInvalidMsg("the primary key of the target collection must be the primary key %s of the source collection", field.Name)
+		}
+		if field.DataType != sourceField.DataType || field.ElementType != sourceField.ElementType {
+			return merr.WrapErrParameterInvalidMsg("the type of field %s mismatch, %s vs %s", field.Name, sourceField.DataType, field.DataType)
+		}
+		targetParams := funcutil.KeyValuePair2Map(field.TypeParams)
+		sourceParams := funcutil.KeyValuePair2Map(sourceField.TypeParams)
+		if targetParams[common.DimKey] != sourceParams[common.DimKey] {
+			return merr.WrapErrParameterInvalidMsg("the dim of field %s mismatch", field.Name)
+		}
+		if sourceField.Nullable && !field.Nullable {
+			return merr.WrapErrParameterInvalidMsg("field %s of the target collection must be nullable", field.Name)
+		}
+	}
+	return nil
+}
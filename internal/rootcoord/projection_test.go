@@ -0,0 +1,384 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+)
+
+func newProjectionTestSource() *model.Collection {
+	return &model.Collection{
+		CollectionID: 111,
+		Name:         "source",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+			{FieldID: 103, Name: "title", DataType: schemapb.DataType_VarChar},
+		},
+		VirtualChannelNames: []string{"source_v0"},
+		Partitions: []*model.Partition{
+			{PartitionID: 1, PartitionName: "_default_0", State: pb.PartitionState_PartitionCreated},
+		},
+	}
+}
+
+func newProjectionTestTarget() *model.Collection {
+	return &model.Collection{
+		CollectionID: 222,
+		DBID:         1,
+		Name:         "target",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar, IsPartitionKey: true},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+		},
+		VirtualChannelNames: []string{"target_v0", "target_v1"},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default_0", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 11, PartitionName: "_default_1", State: pb.PartitionState_PartitionCreated},
+		},
+	}
+}
+
+func newProjectionTestInsert(timeTick uint64) message.ImmutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel("source_v0").
+		WithHeader(&message.InsertMessageHeader{CollectionId: 111}).
+		WithBody(&msgpb.InsertRequest{
+			CollectionID:  111,
+			PartitionName: "_default_0",
+			NumRows:       2,
+			FieldsData: []*schemapb.FieldData{
+				{FieldId: 100, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				}}},
+				{FieldId: 101, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{7, 8}}},
+				}}},
+				{FieldId: 102, Type: schemapb.DataType_FloatVector, Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim:  2,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+				}}},
+				{FieldId: 103, Type: schemapb.DataType_VarChar, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+				}}},
+			},
+		}).
+		MustBuildMutable().
+		WithTimeTick(timeTick).
+		WithLastConfirmed(walimplstest.NewTestMessageID(1)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(2))
+}
+
+func Test_checkProjectionSchema(t *testing.T) {
+	assert.NoError(t, checkProjectionSchema(newProjectionTestSource(), newProjectionTestTarget()))
+
+	target := newProjectionTestTarget()
+	target.CollectionID = 111
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	target = newProjectionTestTarget()
+	target.Fields = append(target.Fields, &model.Field{FieldID: 103, Name: "unknown", DataType: schemapb.DataType_Int64})
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	target = newProjectionTestTarget()
+	target.Fields[1].DataType = schemapb.DataType_Int64
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	target = newProjectionTestTarget()
+	target.Fields[2].TypeParams = []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	target = newProjectionTestTarget()
+	target.Fields[0].IsPrimaryKey = false
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	source := newProjectionTestSource()
+	source.Fields[3].Nullable = true
+	assert.ErrorIs(t, checkProjectionSchema(source, newProjectionTestTarget()), merr.ErrParameterInvalid)
+
+	target = newProjectionTestTarget()
+	target.Fields = append(target.Fields, &model.Field{FieldID: 103, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true})
+	assert.ErrorIs(t, checkProjectionSchema(newProjectionTestSource(), target), merr.ErrParameterInvalid)
+
+	// the bm25 output is computed by the target collection itself.
+	target = newProjectionTestTarget()
+	target.Fields = append(target.Fields, &model.Field{FieldID: 103, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector, IsFunctionOutput: true})
+	target.Functions = []*model.Function{{Name: "bm25", Type: schemapb.FunctionType_BM25, OutputFieldIDs: []int64{103}}}
+	assert.NoError(t, checkProjectionSchema(newProjectionTestSource(), target))
+}
+
+func Test_projection_rewrite(t *testing.T) {
+	core := newTestCore(withValidIDAllocator())
+	p := newProjection(core, &pb.ProjectionInfo{Name: "proj", SourceCollectionID: 111, TargetCollectionID: 222})
+	source, target := newProjectionTestSource(), newProjectionTestTarget()
+
+	t.Run("insert", func(t *testing.T) {
+		msgs, err := p.rewrite(source, target, newProjectionTestInsert(100))
+		assert.NoError(t, err)
+		deletes, inserts := 0, 0
+		for _, msg := range msgs {
+			switch msg.MessageType() {
+			case message.MessageTypeDelete:
+				deletes++
+				body := message.MustAsMutableDeleteMessageV1(msg).MustBody()
+				assert.Equal(t, int64(222), body.GetCollectionID())
+				assert.Equal(t, common.AllPartitionsID, body.GetPartitionID())
+			case message.MessageTypeInsert:
+				inserts++
+				body := message.MustAsMutableInsertMessageV1(msg).MustBody()
+				assert.Equal(t, int64(222), body.GetCollectionID())
+				assert.Contains(t, []int64{10, 11}, body.GetPartitionID())
+				assert.Equal(t, msg.VChannel(), body.GetShardName())
+				assert.Len(t, body.GetFieldsData(), 3)
+				// the columns are picked by name from the source.
+				assert.Equal(t, "title", body.GetFieldsData()[1].GetFieldName())
+				assert.Equal(t, int64(101), body.GetFieldsData()[1].GetFieldId())
+				assert.Equal(t, schemapb.DataType_VarChar, body.GetFieldsData()[1].GetType())
+			}
+			assert.Contains(t, target.VirtualChannelNames, msg.VChannel())
+		}
+		assert.NotZero(t, deletes)
+		assert.NotZero(t, inserts)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleteMsg := message.NewDeleteMessageBuilderV1().
+			WithVChannel("source_v0").
+			WithHeader(&message.DeleteMessageHeader{CollectionId: 111, Rows: 1}).
+			WithBody(&msgpb.DeleteRequest{
+				CollectionID: 111,
+				PartitionID:  common.AllPartitionsID,
+				NumRows:      1,
+				PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+			}).
+			MustBuildMutable().
+			WithTimeTick(100).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(3))
+		msgs, err := p.rewrite(source, target, deleteMsg)
+		assert.NoError(t, err)
+		assert.Len(t, msgs, 1)
+		body := message.MustAsMutableDeleteMessageV1(msgs[0]).MustBody()
+		assert.Equal(t, int64(222), body.GetCollectionID())
+		assert.Equal(t, []int64{1}, body.GetPrimaryKeys().GetIntId().GetData())
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		target := newProjectionTestTarget()
+		target.Fields = append(target.Fields, &model.Field{FieldID: 103, Name: "unknown", DataType: schemapb.DataType_Int64})
+		_, err := p.rewrite(source, target, newProjectionTestInsert(100))
+		assert.False(t, retry.IsRecoverable(err))
+	})
+
+	t.Run("default partition", func(t *testing.T) {
+		target := newProjectionTestTarget()
+		target.Fields[1].IsPartitionKey = false
+		target.Partitions = []*model.Partition{{PartitionID: 10, PartitionName: Params.CommonCfg.DefaultPartitionName.GetValue(), State: pb.PartitionState_PartitionCreated}}
+		msgs, err := p.rewrite(source, target, newProjectionTestInsert(100))
+		assert.NoError(t, err)
+		for _, msg := range msgs {
+			if msg.MessageType() == message.MessageTypeInsert {
+				assert.Equal(t, int64(10), message.MustAsMutableInsertMessageV1(msg).MustBody().GetPartitionID())
+			}
+		}
+	})
+}
+
+type projectionTestScanner struct {
+	done chan struct{}
+}
+
+func (s *projectionTestScanner) Done() <-chan struct{} { return s.done }
+
+func (s *projectionTestScanner) Error() error { return nil }
+
+func (s *projectionTestScanner) Close() {}
+
+type projectionTestTxn struct {
+	vchannel  string
+	msgs      []message.MutableMessage
+	committed bool
+}
+
+func (txn *projectionTestTxn) Append(ctx context.Context, msg message.MutableMessage, opts ...streaming.AppendOption) error {
+	txn.msgs = append(txn.msgs, msg)
+	return nil
+}
+
+func (txn *projectionTestTxn) Commit(ctx context.Context) (*types.AppendResult, error) {
+	txn.committed = true
+	return &types.AppendResult{}, nil
+}
+
+func (txn *projectionTestTxn) Rollback(ctx context.Context) error { return nil }
+
+func Test_projection_consume(t *testing.T) {
+	source, target := newProjectionTestSource(), newProjectionTestTarget()
+	dropMsg := message.NewDropCollectionMessageBuilderV1().
+		WithVChannel("source_v0").
+		WithHeader(&message.DropCollectionMessageHeader{CollectionId: 111}).
+		WithBody(&msgpb.DropCollectionRequest{CollectionID: 111}).
+		MustBuildMutable().
+		WithTimeTick(200).
+		WithLastConfirmed(walimplstest.NewTestMessageID(3)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(4))
+
+	wal := mock_streaming.NewMockWALAccesser(t)
+	wal.EXPECT().WALName().Return(walimplstest.WALName)
+	wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, option streaming.ReadOption) streaming.Scanner {
+		handler := option.MessageHandler.(adaptor.ChanMessageHandler)
+		go func() {
+			handler <- newProjectionTestInsert(100)
+			handler <- dropMsg
+		}()
+		return &projectionTestScanner{done: make(chan struct{})}
+	})
+	appended := 0
+	appendMessages := func(ctx context.Context, msgs ...message.MutableMessage) streaming.AppendResponses {
+		appended += len(msgs)
+		return streaming.AppendResponses{}
+	}
+	wal.EXPECT().AppendMessages(mock.Anything, mock.Anything).RunAndReturn(appendMessages).Maybe()
+	txns := make([]*projectionTestTxn, 0)
+	wal.EXPECT().Txn(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+		txn := &projectionTestTxn{vchannel: opts.VChannel}
+		txns = append(txns, txn)
+		return txn, nil
+	}).Maybe()
+	streaming.SetWALForTest(wal)
+
+	mockMeta := mockrootcoord.NewIMetaTable(t)
+	mockMeta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, int64(111)).Return(source, nil)
+	mockMeta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, int64(222)).Return(target, nil)
+	core := newTestCore(withMeta(mockMeta), withValidIDAllocator())
+	p := newProjection(core, &pb.ProjectionInfo{
+		Name:               "proj",
+		SourceCollectionID: 111,
+		TargetCollectionID: 222,
+		Checkpoints: []*pb.ProjectionCheckpoint{
+			{Vchannel: "source_v0", MessageId: walimplstest.NewTestMessageID(1).Marshal(), TimeTick: 50},
+		},
+	})
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	defer p.cancel()
+
+	err := p.consume("source_v0")
+	assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	assert.False(t, retry.IsRecoverable(err))
+	// the delete and insert of a shard are appended in order in one txn.
+	assert.Zero(t, appended)
+	assert.NotEmpty(t, txns)
+	for _, txn := range txns {
+		assert.True(t, txn.committed)
+		assert.GreaterOrEqual(t, len(txn.msgs), 2)
+		assert.Equal(t, message.MessageTypeDelete, txn.msgs[0].MessageType())
+		for _, msg := range txn.msgs[1:] {
+			assert.Equal(t, message.MessageTypeInsert, msg.MessageType())
+		}
+		for _, msg := range txn.msgs {
+			assert.Equal(t, txn.vchannel, msg.VChannel())
+		}
+	}
+	// the checkpoint moves to the last applied message.
+	checkpoint := p.getCheckpoint("source_v0")
+	assert.Equal(t, uint64(100), checkpoint.GetTimeTick())
+	assert.Equal(t, walimplstest.NewTestMessageID(1).Marshal(), checkpoint.GetMessageId())
+	assert.Equal(t, uint64(100), p.ConsistentTs())
+	assert.True(t, p.dirty)
+}
+
+func Test_projectionManager(t *testing.T) {
+	ctx := context.Background()
+	mockMeta := mockrootcoord.NewIMetaTable(t)
+	core := newTestCore(withMeta(mockMeta))
+	m := newProjectionManager(core)
+	// the failed projection is not started.
+	info := &pb.ProjectionInfo{
+		Name:               "proj",
+		DbID:               1,
+		SourceCollectionID: 111,
+		TargetCollectionID: 222,
+		State:              pb.ProjectionState_ProjectionFailed,
+	}
+
+	t.Run("add", func(t *testing.T) {
+		mockMeta.EXPECT().SaveProjection(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+		assert.Error(t, m.Add(ctx, info))
+
+		mockMeta.EXPECT().SaveProjection(mock.Anything, mock.Anything).Return(nil).Once()
+		assert.NoError(t, m.Add(ctx, info))
+		assert.ErrorIs(t, m.Add(ctx, info), merr.ErrParameterInvalid)
+
+		_, ok := m.Get(1, "proj")
+		assert.True(t, ok)
+		assert.Len(t, m.List(1), 1)
+		assert.Empty(t, m.List(2))
+	})
+
+	t.Run("check collections", func(t *testing.T) {
+		assert.NoError(t, m.CheckCollections(111, 333))
+		assert.ErrorIs(t, m.CheckCollections(333, 222), merr.ErrParameterInvalid)
+		assert.ErrorIs(t, m.CheckCollections(333, 111), merr.ErrParameterInvalid)
+		assert.ErrorIs(t, m.CheckCollections(222, 333), merr.ErrParameterInvalid)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		mockMeta.EXPECT().DropProjection(mock.Anything, int64(1), "proj").Return(errors.New("mock")).Once()
+		assert.Error(t, m.Remove(ctx, 1, "proj"))
+		_, ok := m.Get(1, "proj")
+		assert.True(t, ok)
+
+		mockMeta.EXPECT().DropProjection(mock.Anything, int64(1), "proj").Return(nil).Once()
+		mockMeta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(&model.Database{ID: 1, Name: "db"}, nil).Once()
+		assert.NoError(t, m.Remove(ctx, 1, "proj"))
+		_, ok = m.Get(1, "proj")
+		assert.False(t, ok)
+
+		// removing a nonexistent projection is a no-op.
+		assert.NoError(t, m.Remove(ctx, 1, "proj"))
+	})
+
+	t.Run("start", func(t *testing.T) {
+		mockMeta.EXPECT().ListProjections(mock.Anything).Return([]*pb.ProjectionInfo{info}, nil).Once()
+		m := newProjectionManager(core)
+		assert.NoError(t, m.Start(ctx))
+		assert.Len(t, m.List(1), 1)
+		m.Stop()
+	})
+}
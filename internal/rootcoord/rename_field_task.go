@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type renameFieldTask struct {
+	baseTask
+	Req *rootcoordpb.RenameFieldRequest
+}
+
+func (t *renameFieldTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollectionField); err != nil {
+		return err
+	}
+	if t.Req.GetOldName() == "" || t.Req.GetNewName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the old and new field name must not be empty")
+	}
+	if t.Req.GetOldName() == t.Req.GetNewName() {
+		return merr.WrapErrParameterInvalidMsg("the new field name is the same as the old one: %s", t.Req.GetNewName())
+	}
+	return nil
+}
+
+func (t *renameFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	if err := renameField(newColl, t.Req.GetOldName(), t.Req.GetNewName()); err != nil {
+		return err
+	}
+	return executeRenameFieldTaskSteps(ctx, t.core, oldColl, newColl, t.Req, t.GetTs())
+}
+
+func (t *renameFieldTask) GetLockerKey() LockerKey {
+	collection := t.core.getCollectionIDStr(t.ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), 0)
+	return NewLockerKeyChain(
+		NewClusterLockerKey(false),
+		NewDatabaseLockerKey(t.Req.GetDbName(), false),
+		NewCollectionLockerKey(collection, true),
+	)
+}
+
+// renameField renames the field of the collection in place,
+// the functions which take the field as input or output are renamed too.
+func renameField(coll *model.Collection, oldName string, newName string) error {
+	var target *model.Field
+	for _, field := range coll.Fields {
+		if field.Name == newName {
+			return merr.WrapErrParameterInvalidMsg("duplicated new field name %s with other field", newName)
+		}
+		if field.Name == oldName {
+			target = field
+		}
+	}
+	if target == nil {
+		return merr.WrapErrFieldNotFound(oldName)
+	}
+	if target.FieldID < common.StartOfUserFieldID || target.IsDynamic {
+		return merr.WrapErrParameterInvalidMsg("system field or dynamic field cannot be renamed: %s", oldName)
+	}
+
+	target.Name = newName
+	rename := func(name string, _ int) string {
+		if name == oldName {
+			return newName
+		}
+		return name
+	}
+	for _, function := range coll.Functions {
+		function.InputFieldNames = lo.Map(function.InputFieldNames, rename)
+		function.OutputFieldNames = lo.Map(function.OutputFieldNames, rename)
+	}
+	return nil
+}
+
+func executeRenameFieldTaskSteps(ctx context.Context,
+	core *Core,
+	oldColl *model.Collection,
+	newColl *model.Collection,
+	req *rootcoordpb.RenameFieldRequest,
+	ts Timestamp,
+) error {
+	redoTask := newBaseRedoTask(core.stepExecutor)
+
+	// the update timestamp of the new collection is assigned by the schema change message,
+	// so the stale schema cached by the client can be detected.
+	redoTask.AddSyncStep(&WriteSchemaChangeWALStep{
+		baseStep:   baseStep{core: core},
+		collection: newColl,
+		ts:         ts,
+	})
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: core},
+		req: &milvuspb.AlterCollectionRequest{
+			Base:           req.GetBase(),
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetCollectionName(),
+			CollectionID:   oldColl.CollectionID,
+		},
+		core: core,
+	})
+
+	aliases := core.meta.ListAliasesByID(ctx, oldColl.CollectionID)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          req.GetDbName(),
+		collectionNames: append(aliases, oldColl.Name),
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollectionField)},
+	})
+
+	return redoTask.Execute(ctx)
+}
@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_renameFieldTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &renameFieldTask{
+			Req: &rootcoordpb.RenameFieldRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		task := &renameFieldTask{
+			Req: &rootcoordpb.RenameFieldRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollectionField},
+				OldName: "f1",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		task := &renameFieldTask{
+			Req: &rootcoordpb.RenameFieldRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollectionField},
+				OldName: "f1",
+				NewName: "f2",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_renameField(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			Fields: []*model.Field{
+				{FieldID: 0, Name: "RowID"},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true},
+				{FieldID: 101, Name: "text"},
+				{FieldID: 102, Name: "sparse", IsFunctionOutput: true},
+				{FieldID: 103, Name: "$meta", IsDynamic: true},
+			},
+			Functions: []*model.Function{
+				{ID: 1, Name: "bm25", InputFieldNames: []string{"text"}, OutputFieldNames: []string{"sparse"}},
+			},
+		}
+	}
+
+	t.Run("field not found", func(t *testing.T) {
+		err := renameField(newColl(), "foo", "bar")
+		assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		err := renameField(newColl(), "text", "pk")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("system field", func(t *testing.T) {
+		err := renameField(newColl(), "RowID", "foo")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("dynamic field", func(t *testing.T) {
+		err := renameField(newColl(), "$meta", "foo")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("rename function input", func(t *testing.T) {
+		coll := newColl()
+		err := renameField(coll, "text", "content")
+		assert.NoError(t, err)
+		assert.Equal(t, "content", coll.Fields[2].Name)
+		assert.Equal(t, []string{"content"}, coll.Functions[0].InputFieldNames)
+		assert.Equal(t, []string{"sparse"}, coll.Functions[0].OutputFieldNames)
+	})
+
+	t.Run("rename function output", func(t *testing.T) {
+		coll := newColl()
+		err := renameField(coll, "sparse", "embedding")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"text"}, coll.Functions[0].InputFieldNames)
+		assert.Equal(t, []string{"embedding"}, coll.Functions[0].OutputFieldNames)
+	})
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type renamePartitionTask struct {
+	baseTask
+	Req *rootcoordpb.RenamePartitionRequest
+}
+
+func (t *renamePartitionTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if t.Req.GetOldName() == "" || t.Req.GetNewName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the old and new partition name must not be empty")
+	}
+	if t.Req.GetOldName() == t.Req.GetNewName() {
+		return merr.WrapErrParameterInvalidMsg("the new partition name is the same as the old one: %s", t.Req.GetNewName())
+	}
+	if t.Req.GetOldName() == Params.CommonCfg.DefaultPartitionName.GetValue() {
+		return merr.WrapErrParameterInvalidMsg("default partition cannot be renamed")
+	}
+	return nil
+}
+
+func (t *renamePartitionTask) Execute(ctx context.Context) error {
+	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+	for _, field := range coll.Fields {
+		if field.IsPartitionKey {
+			return merr.WrapErrParameterInvalidMsg("partitions of the collection with partition key cannot be renamed")
+		}
+	}
+
+	ts := t.GetTs()
+	if err := t.core.meta.RenamePartition(ctx, coll.CollectionID, t.Req.GetOldName(), t.Req.GetNewName(), ts); err != nil {
+		return err
+	}
+
+	aliases := t.core.meta.ListAliasesByID(ctx, coll.CollectionID)
+	return t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), append(aliases, coll.Name), coll.CollectionID, "", ts,
+		proxyutil.SetMsgType(commonpb.MsgType_AlterCollection))
+}
+
+func (t *renamePartitionTask) GetLockerKey() LockerKey {
+	collection := t.core.getCollectionIDStr(t.ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), 0)
+	return NewLockerKeyChain(
+		NewClusterLockerKey(false),
+		NewDatabaseLockerKey(t.Req.GetDbName(), false),
+		NewCollectionLockerKey(collection, true),
+	)
+}
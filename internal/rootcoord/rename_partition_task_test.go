@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_renamePartitionTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &renamePartitionTask{
+			Req: &rootcoordpb.RenamePartitionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		task := &renamePartitionTask{
+			Req: &rootcoordpb.RenamePartitionRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				OldName: "p1",
+				NewName: "p1",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("default partition", func(t *testing.T) {
+		task := &renamePartitionTask{
+			Req: &rootcoordpb.RenamePartitionRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				OldName: Params.CommonCfg.DefaultPartitionName.GetValue(),
+				NewName: "p1",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		task := &renamePartitionTask{
+			Req: &rootcoordpb.RenamePartitionRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				OldName: "p1",
+				NewName: "p2",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_renamePartitionTask_Execute(t *testing.T) {
+	t.Run("partition key", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{
+			CollectionID: 111,
+			Fields:       []*model.Field{{FieldID: 100, Name: "pk", IsPartitionKey: true}},
+		}, nil)
+		core := newTestCore(withValidProxyManager(), withMeta(mockMeta))
+		task := &renamePartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.RenamePartitionRequest{OldName: "p1", NewName: "p2"},
+		}
+		err := task.Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("failed to rename partition", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{CollectionID: 111}, nil)
+		mockMeta.EXPECT().RenamePartition(mock.Anything, int64(111), "p1", "p2", mock.Anything).Return(errors.New("mock"))
+		core := newTestCore(withValidProxyManager(), withMeta(mockMeta))
+		task := &renamePartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.RenamePartitionRequest{OldName: "p1", NewName: "p2"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{CollectionID: 111, Name: "coll"}, nil)
+		mockMeta.EXPECT().RenamePartition(mock.Anything, int64(111), "p1", "p2", mock.Anything).Return(nil)
+		mockMeta.EXPECT().ListAliasesByID(mock.Anything, int64(111)).Return([]string{"alias"})
+		core := newTestCore(withValidProxyManager(), withMeta(mockMeta))
+		task := &renamePartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.RenamePartitionRequest{CollectionName: "coll", OldName: "p1", NewName: "p2"},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
@@ -121,6 +121,8 @@ type Core struct {
 	streamingCoord *streamingcoord.Server
 	quotaCenter    *QuotaCenter
 
+	projectionManager *projectionManager
+
 	stateCode atomic.Int32
 	initOnce  sync.Once
 	startOnce sync.Once
@@ -458,6 +460,8 @@ func (c *Core) initInternal() error {
 	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.mixCoord, c.tsoAllocator, c.meta)
 	log.Debug("RootCoord init QuotaCenter done")
 
+	c.projectionManager = newProjectionManager(c)
+
 	if err := c.initCredentials(initCtx); err != nil {
 		return err
 	}
@@ -675,6 +679,11 @@ func (c *Core) startInternal() error {
 
 	c.scheduler.Start()
 	c.stepExecutor.Start()
+	if streamingutil.IsStreamingServiceEnabled() {
+		if err := c.projectionManager.Start(c.ctx); err != nil {
+			panic(err)
+		}
+	}
 	go func() {
 		// refresh rbac cache
 		if err := retry.Do(c.ctx, func() error {
@@ -763,6 +772,9 @@ func (c *Core) Stop() error {
 	if c.quotaCenter != nil {
 		c.quotaCenter.stop()
 	}
+	if c.projectionManager != nil {
+		c.projectionManager.Stop()
+	}
 
 	c.revokeSession()
 	c.cancelIfNotNil()
@@ -2980,6 +2992,257 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	return merr.Success(), nil
 }
 
+func (c *Core) RenamePartition(ctx context.Context, req *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()),
+		zap.String("oldPartitionName", req.GetOldName()), zap.String("newPartitionName", req.GetNewName()))
+	log.Info("received request to rename partition")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenamePartition", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RenamePartition")
+	t := &renamePartitionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to rename partition", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenamePartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to rename partition", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenamePartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenamePartition", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RenamePartition").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to rename partition", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) RenameField(ctx context.Context, req *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()),
+		zap.String("oldFieldName", req.GetOldName()), zap.String("newFieldName", req.GetNewName()))
+	log.Info("received request to rename field")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RenameField")
+	t := &renameFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to rename field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to rename field", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RenameField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to rename field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// AddCollectionFunction adds a function along with its output fields to an existing collection.
+func (c *Core) AddCollectionFunction(ctx context.Context, req *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()),
+		zap.String("functionName", req.GetFunction().GetName()))
+	log.Info("received request to add collection function")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionFunction", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionFunction")
+	t := &addCollectionFunctionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection function", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionFunction", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection function", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionFunction", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionFunction", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionFunction").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to add collection function", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) TransferPartition(ctx context.Context, req *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()),
+		zap.String("partitionName", req.GetPartitionName()), zap.String("targetDbName", req.GetTargetDbName()),
+		zap.String("targetCollectionName", req.GetTargetCollectionName()), zap.String("targetPartitionName", req.GetTargetPartitionName()),
+		zap.Bool("copy", req.GetCopy()))
+	log.Info("received request to transfer partition")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TransferPartition", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("TransferPartition")
+	t := &transferPartitionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to transfer partition", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TransferPartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to transfer partition", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TransferPartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TransferPartition", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("TransferPartition").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to transfer partition", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) CreateProjection(ctx context.Context, req *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("projectionName", req.GetProjectionName()),
+		zap.String("sourceCollectionName", req.GetSourceCollectionName()), zap.String("targetCollectionName", req.GetTargetCollectionName()))
+	log.Info("received request to create projection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateProjection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CreateProjection")
+	t := &createProjectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to create projection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateProjection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to create projection", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateProjection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateProjection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateProjection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to create projection", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) DropProjection(ctx context.Context, req *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("projectionName", req.GetProjectionName()))
+	log.Info("received request to drop projection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropProjection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropProjection")
+	t := &dropProjectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop projection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropProjection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop projection", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropProjection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropProjection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropProjection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to drop projection", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// ListProjections returns the projections of the database with their consistent ts and lag.
+func (c *Core) ListProjections(ctx context.Context, req *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.ListProjectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	db, err := c.meta.GetDatabaseByName(ctx, req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return &rootcoordpb.ListProjectionsResponse{Status: merr.Status(err)}, nil
+	}
+	collectionName := func(collectionID int64) string {
+		coll, err := c.meta.GetCollectionByIDWithMaxTs(ctx, collectionID)
+		if err != nil {
+			return ""
+		}
+		return coll.Name
+	}
+
+	projections := c.projectionManager.List(db.ID)
+	resp := &rootcoordpb.ListProjectionsResponse{
+		Status:      merr.Success(),
+		Projections: make([]*rootcoordpb.ProjectionStatus, 0, len(projections)),
+	}
+	for _, p := range projections {
+		info := p.Info()
+		resp.Projections = append(resp.Projections, &rootcoordpb.ProjectionStatus{
+			Name:                 info.GetName(),
+			SourceCollectionName: collectionName(info.GetSourceCollectionID()),
+			TargetCollectionName: collectionName(info.GetTargetCollectionID()),
+			State:                info.GetState(),
+			Reason:               info.GetReason(),
+			ConsistentTs:         p.ConsistentTs(),
+			LagMs:                p.Lag().Milliseconds(),
+			Checkpoints:          info.GetCheckpoints(),
+		})
+	}
+	return resp, nil
+}
+
 func (c *Core) DescribeDatabase(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return &rootcoordpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -169,7 +171,23 @@ func (s *scheduler) enqueue(task task) {
 	s.taskChan <- task
 }
 
+// isReadOnlyTask returns whether the task never mutates the meta or writes the wal,
+// only these tasks can be executed when the cluster runs as a follower.
+func isReadOnlyTask(t task) bool {
+	switch t.(type) {
+	case *describeCollectionTask, *describeDBTask, *hasCollectionTask, *hasPartitionTask,
+		*listDatabaseTask, *showCollectionTask, *showPartitionTask:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *scheduler) AddTask(task task) error {
+	if Params.CommonCfg.FollowerEnabled.GetAsBool() && !isReadOnlyTask(task) {
+		return merr.WrapErrServiceReadOnly(fmt.Sprintf("%T", task), "the cluster is a follower")
+	}
+
 	if Params.RootCoordCfg.UseLockScheduler.GetAsBool() {
 		lockKey := task.GetLockerKey()
 		if lockKey != nil {
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	mocktso "github.com/milvus-io/milvus/internal/tso/mocks"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -168,6 +169,29 @@ func Test_scheduler_enqueue_normal_case(t *testing.T) {
 	assert.Equal(t, Timestamp(101), task.GetTs())
 }
 
+func Test_scheduler_follower(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.FollowerEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.FollowerEnabled.Key)
+
+	idAlloc := newMockIDAllocator()
+	tsoAlloc := newMockTsoAllocator()
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return 100, nil
+	}
+	tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+		return 101, nil
+	}
+	s := newScheduler(context.Background(), idAlloc, tsoAlloc)
+	s.Start()
+	defer s.Stop()
+
+	err := s.AddTask(newMockNormalTask())
+	assert.ErrorIs(t, err, merr.ErrServiceReadOnly)
+
+	assert.True(t, isReadOnlyTask(&hasCollectionTask{}))
+	assert.False(t, isReadOnlyTask(&dropCollectionTask{}))
+}
+
 func Test_scheduler_bg(t *testing.T) {
 	idAlloc := newMockIDAllocator()
 	tsoAlloc := newMockTsoAllocator()
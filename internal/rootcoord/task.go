@@ -192,7 +192,7 @@ func NewLockerKeyChain(lockerKeys ...LockerKey) LockerKey {
 	}
 
 	for i := 0; i < len(lockerKeys)-1; i++ {
-		if lockerKeys[i] == nil || lockerKeys[i].Level() >= lockerKeys[i+1].Level() {
+		if lockerKeys[i] == nil || !isLockerKeyOrdered(lockerKeys[i], lockerKeys[i+1]) {
 			log.Warn("Invalid locker key chain", zap.Stack("stack"))
 			return nil
 		}
@@ -201,6 +201,18 @@ func NewLockerKeyChain(lockerKeys ...LockerKey) LockerKey {
 	return lockerKeys[0]
 }
 
+// isLockerKeyOrdered checks the order of the locker keys in chain,
+// the keys of the same level are allowed in ascending order, which avoids the dead lock.
+func isLockerKeyOrdered(current, next LockerKey) bool {
+	if next == nil {
+		return false
+	}
+	if current.Level() != next.Level() {
+		return current.Level() < next.Level()
+	}
+	return current.LockKey() < next.LockKey()
+}
+
 func GetLockerKeyString(k LockerKey) string {
 	if k == nil {
 		return "nil"
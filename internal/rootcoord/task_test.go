@@ -69,6 +69,17 @@ func TestLockerKey(t *testing.T) {
 		assert.Equal(t, lockerChain.Next(), dbLock)
 		assert.Equal(t, lockerChain.Next().Next(), collectionLock)
 	}
+
+	{
+		lockerChain := NewLockerKeyChain(NewClusterLockerKey(false), NewCollectionLockerKey("b", true), NewCollectionLockerKey("a", true))
+		assert.Nil(t, lockerChain)
+	}
+
+	{
+		lockerChain := NewLockerKeyChain(NewClusterLockerKey(false), NewCollectionLockerKey("a", true), NewCollectionLockerKey("b", true))
+		assert.NotNil(t, lockerChain)
+		assert.Equal(t, "$-0-false|a-2-true|b-2-true", GetLockerKeyString(lockerChain))
+	}
 }
 
 func TestGetLockerKey(t *testing.T) {
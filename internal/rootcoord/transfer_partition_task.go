@@ -0,0 +1,514 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// transferPartitionTask moves or copies a partition into another collection which has the identical schema.
+// The flushed segments are copied by datacoord, and the growing data is replayed from the wal of the source collection.
+type transferPartitionTask struct {
+	baseTask
+	Req *rootcoordpb.TransferPartitionRequest
+}
+
+func (t *transferPartitionTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if t.Req.GetPartitionName() == "" || t.Req.GetTargetPartitionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the source and target partition name must not be empty")
+	}
+	if t.Req.GetTargetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the target collection name must not be empty")
+	}
+	if !t.Req.GetCopy() && t.Req.GetPartitionName() == Params.CommonCfg.DefaultPartitionName.GetValue() {
+		return merr.WrapErrParameterInvalidMsg("default partition cannot be moved, copy it instead")
+	}
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return merr.WrapErrServiceUnavailable("transfer partition requires the streaming service")
+	}
+	return nil
+}
+
+func (t *transferPartitionTask) Execute(ctx context.Context) error {
+	ts := t.GetTs()
+	source, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), ts)
+	if err != nil {
+		return err
+	}
+	target, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetTargetDbName(), t.Req.GetTargetCollectionName(), ts)
+	if err != nil {
+		return err
+	}
+	if source.CollectionID == target.CollectionID {
+		return merr.WrapErrParameterInvalidMsg("cannot transfer partition within the same collection, rename the partition instead")
+	}
+	copyBinlogs, err := checkTransferSchema(source, target)
+	if err != nil {
+		return err
+	}
+
+	sourcePartition := getPartitionByName(source, t.Req.GetPartitionName())
+	if sourcePartition == nil {
+		return merr.WrapErrPartitionNotFound(t.Req.GetPartitionName())
+	}
+	targetPartition := getPartitionByName(target, t.Req.GetTargetPartitionName())
+	if targetPartition == nil {
+		if targetPartition, err = t.createTargetPartition(ctx, target); err != nil {
+			return err
+		}
+	}
+
+	channelMapping := make(map[string]string, len(source.VirtualChannelNames))
+	for i, vchannel := range source.VirtualChannelNames {
+		channelMapping[vchannel] = target.VirtualChannelNames[i]
+	}
+	transferReq := &datapb.TransferSegmentsRequest{
+		CollectionID:       source.CollectionID,
+		PartitionID:        sourcePartition.PartitionID,
+		TargetCollectionID: target.CollectionID,
+		TargetPartitionID:  targetPartition.PartitionID,
+		ChannelMapping:     channelMapping,
+		CopyBinlogs:        copyBinlogs,
+	}
+	if copyBinlogs {
+		transferReq.TargetSchema = &schemapb.CollectionSchema{
+			Name:               target.Name,
+			DbName:             t.Req.GetTargetDbName(),
+			Description:        target.Description,
+			AutoID:             target.AutoID,
+			Fields:             model.MarshalFieldModels(target.Fields),
+			Functions:          model.MarshalFunctionModels(target.Functions),
+			EnableDynamicField: target.EnableDynamicField,
+		}
+	}
+
+	// the data written into the source partition after the end timestamp is not replayed,
+	// so the writes are blocked before the cut if the source partition is dropped after moving.
+	endTs := ts
+	if !t.Req.GetCopy() {
+		if err := t.blockSourcePartition(ctx, source, sourcePartition); err != nil {
+			return err
+		}
+		if endTs, err = t.core.tsoAllocator.GenerateTSO(1); err != nil {
+			t.unblockSourcePartition(ctx, source, sourcePartition)
+			return err
+		}
+	}
+	resp, err := t.core.broker.TransferSegments(ctx, transferReq)
+	if err != nil {
+		t.unblockSourcePartition(ctx, source, sourcePartition)
+		return err
+	}
+
+	replayer := &partitionDataReplayer{
+		sourcePartitionID: sourcePartition.PartitionID,
+		target:            target,
+		targetPartition:   targetPartition,
+		channelMapping:    channelMapping,
+		transferred:       typeutil.NewUniqueSet(resp.GetSourceSegmentIDs()...),
+		endTs:             endTs,
+	}
+	for _, position := range resp.GetReplayPositions() {
+		if err := replayer.Replay(ctx, position); err != nil {
+			t.rollbackTransferSegments(ctx, resp)
+			t.unblockSourcePartition(ctx, source, sourcePartition)
+			return err
+		}
+	}
+
+	if !t.Req.GetCopy() {
+		if err := executeDropPartitionTaskSteps(ctx, t.core, sourcePartition.PartitionName, sourcePartition.PartitionID,
+			source, t.Req.GetDbName(), false, ts); err != nil {
+			t.rollbackTransferSegments(ctx, resp)
+			return err
+		}
+	}
+
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), append(t.core.meta.ListAliasesByID(ctx, source.CollectionID), source.Name),
+		source.CollectionID, "", ts, proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)); err != nil {
+		return err
+	}
+	return t.core.ExpireMetaCache(ctx, t.Req.GetTargetDbName(), append(t.core.meta.ListAliasesByID(ctx, target.CollectionID), target.Name),
+		target.CollectionID, "", ts, proxyutil.SetMsgType(commonpb.MsgType_AlterCollection))
+}
+
+// rollbackTransferSegments drops the target segments and aborts the import job of the failed transferring,
+// the growing data replayed into the target partition is not rolled back.
+func (t *transferPartitionTask) rollbackTransferSegments(ctx context.Context, resp *datapb.TransferSegmentsResponse) {
+	if len(resp.GetSegmentIDs()) == 0 && resp.GetImportJobID() == 0 {
+		return
+	}
+	_, err := t.core.broker.TransferSegments(ctx, &datapb.TransferSegmentsRequest{
+		RollbackSegmentIDs:  resp.GetSegmentIDs(),
+		RollbackImportJobID: resp.GetImportJobID(),
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to rollback the transferred segments",
+			zap.Int64s("segmentIDs", resp.GetSegmentIDs()), zap.Int64("importJobID", resp.GetImportJobID()), zap.Error(err))
+	}
+}
+
+// blockSourcePartition marks the source partition dropping and expires the proxy caches,
+// the proxies reject the writes into the partition afterwards.
+func (t *transferPartitionTask) blockSourcePartition(ctx context.Context, source *model.Collection, partition *model.Partition) error {
+	if err := t.core.meta.ChangePartitionState(ctx, source.CollectionID, partition.PartitionID,
+		pb.PartitionState_PartitionDropping, t.GetTs()); err != nil {
+		return err
+	}
+	return t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{source.Name}, source.CollectionID,
+		partition.PartitionName, t.GetTs(), proxyutil.SetMsgType(commonpb.MsgType_DropPartition))
+}
+
+// unblockSourcePartition restores the source partition blocked by a failed moving, it's a no-op when copying.
+func (t *transferPartitionTask) unblockSourcePartition(ctx context.Context, source *model.Collection, partition *model.Partition) {
+	if t.Req.GetCopy() {
+		return
+	}
+	err := t.core.meta.ChangePartitionState(ctx, source.CollectionID, partition.PartitionID, pb.PartitionState_PartitionCreated, t.GetTs())
+	if err == nil {
+		err = t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{source.Name}, source.CollectionID,
+			partition.PartitionName, t.GetTs(), proxyutil.SetMsgType(commonpb.MsgType_CreatePartition))
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to restore the source partition",
+			zap.Int64("collectionID", source.CollectionID), zap.Int64("partitionID", partition.PartitionID), zap.Error(err))
+	}
+}
+
+func (t *transferPartitionTask) createTargetPartition(ctx context.Context, target *model.Collection) (*model.Partition, error) {
+	cfgMaxPartitionNum := Params.RootCoordCfg.MaxPartitionNum.GetAsInt()
+	if len(target.Partitions) >= cfgMaxPartitionNum {
+		return nil, fmt.Errorf("partition number (%d) exceeds max configuration (%d), collection: %s",
+			len(target.Partitions), cfgMaxPartitionNum, target.Name)
+	}
+	partID, err := t.core.idAllocator.AllocOne()
+	if err != nil {
+		return nil, err
+	}
+	partition := &model.Partition{
+		PartitionID:               partID,
+		PartitionName:             t.Req.GetTargetPartitionName(),
+		PartitionCreatedTimestamp: t.GetTs(),
+		CollectionID:              target.CollectionID,
+		State:                     pb.PartitionState_PartitionCreating,
+	}
+	if err := executeCreatePartitionTaskSteps(ctx, t.core, partition, target, t.Req.GetTargetDbName(), t.GetTs()); err != nil {
+		return nil, err
+	}
+	return partition, nil
+}
+
+func (t *transferPartitionTask) GetLockerKey() LockerKey {
+	// the task touches two collections which may be in different databases,
+	// the keys of the same level are locked in order to avoid dead lock.
+	dbNames := typeutil.NewSet(t.Req.GetDbName(), t.Req.GetTargetDbName()).Collect()
+	collections := typeutil.NewSet(
+		t.core.getCollectionIDStr(t.ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), 0),
+		t.core.getCollectionIDStr(t.ctx, t.Req.GetTargetDbName(), t.Req.GetTargetCollectionName(), 0),
+	).Collect()
+	sort.Strings(dbNames)
+	sort.Strings(collections)
+
+	lockerKeys := []LockerKey{NewClusterLockerKey(false)}
+	for _, dbName := range dbNames {
+		lockerKeys = append(lockerKeys, NewDatabaseLockerKey(dbName, false))
+	}
+	for _, collection := range collections {
+		lockerKeys = append(lockerKeys, NewCollectionLockerKey(collection, true))
+	}
+	return NewLockerKeyChain(lockerKeys...)
+}
+
+// checkTransferSchema checks whether the segments of the source collection can be transferred to the target collection.
+// The segments are re-parented if the schemas are identical, otherwise the binlogs are copied with the target schema,
+// which requires the fields of the collections share the same storage layout.
+func checkTransferSchema(source, target *model.Collection) (copyBinlogs bool, err error) {
+	for _, field := range source.Fields {
+		if field.IsPartitionKey {
+			return false, merr.WrapErrParameterInvalidMsg("partition of the collection with partition key cannot be transferred")
+		}
+	}
+	if len(source.VirtualChannelNames) != len(target.VirtualChannelNames) {
+		return false, merr.WrapErrParameterInvalidMsg("the shard number of the source and target collection mismatch, %d vs %d",
+			len(source.VirtualChannelNames), len(target.VirtualChannelNames))
+	}
+	if source.EnableDynamicField != target.EnableDynamicField ||
+		!checkFunctionsEqual(source.Functions, target.Functions) {
+		return false, merr.WrapErrParameterInvalidMsg("the schema of the source and target collection mismatch")
+	}
+	if model.CheckFieldsEqual(source.Fields, target.Fields) {
+		return false, nil
+	}
+	if !checkFieldsStorageEqual(source.Fields, target.Fields) {
+		return false, merr.WrapErrParameterInvalidMsg("the schema of the source and target collection mismatch")
+	}
+	return true, nil
+}
+
+// checkFieldsStorageEqual checks whether the binlogs written with the fields can be read with the other fields.
+func checkFieldsStorageEqual(fieldsA, fieldsB []*model.Field) bool {
+	if len(fieldsA) != len(fieldsB) {
+		return false
+	}
+	mapA := lo.KeyBy(fieldsA, func(field *model.Field) int64 { return field.FieldID })
+	for _, field := range fieldsB {
+		other, ok := mapA[field.FieldID]
+		if !ok || field.Name != other.Name ||
+			field.DataType != other.DataType ||
+			field.ElementType != other.ElementType ||
+			field.IsPrimaryKey != other.IsPrimaryKey ||
+			field.IsDynamic != other.IsDynamic ||
+			field.IsFunctionOutput != other.IsFunctionOutput ||
+			field.Nullable != other.Nullable {
+			return false
+		}
+		dim, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, field.TypeParams)
+		otherDim, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, other.TypeParams)
+		if dim != otherDim {
+			return false
+		}
+	}
+	return true
+}
+
+func checkFunctionsEqual(functionsA, functionsB []*model.Function) bool {
+	if len(functionsA) != len(functionsB) {
+		return false
+	}
+	for i := range functionsA {
+		if !functionsA[i].Equal(*functionsB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func getPartitionByName(coll *model.Collection, partitionName string) *model.Partition {
+	for _, partition := range coll.Partitions {
+		if partition.PartitionName == partitionName && partition.Available() {
+			return partition
+		}
+	}
+	return nil
+}
+
+// replayBatchSize is the max estimated size of the messages appended into the target vchannel at once.
+const replayBatchSize = 4 << 20
+
+// partitionDataReplayer replays the growing data of the source partition into the target partition.
+// The insert messages of the transferred segments are skipped, and the deletes of the source partition are always replayed,
+// the collection level deletes are skipped since they may hit the rows of the target partition not from the source partition.
+// The data written into the source partition after the end timestamp is not replayed.
+type partitionDataReplayer struct {
+	sourcePartitionID int64
+	target            *model.Collection
+	targetPartition   *model.Partition
+	channelMapping    map[string]string
+	transferred       typeutil.UniqueSet
+	endTs             uint64
+}
+
+// Replay reads the source vchannel from the position until the end timestamp,
+// and appends the rewritten messages into the target vchannel.
+func (r *partitionDataReplayer) Replay(ctx context.Context, position *msgpb.MsgPosition) error {
+	vchannel := position.GetChannelName()
+	targetVChannel, ok := r.channelMapping[vchannel]
+	if !ok {
+		return merr.WrapErrChannelNotFound(vchannel)
+	}
+	log := log.Ctx(ctx).With(zap.String("vchannel", vchannel), zap.String("targetVChannel", targetVChannel))
+
+	startFrom, err := adaptor.GetMessageIDFromMQWrapperIDBytes(streaming.WAL().WALName(), position.GetMsgID())
+	if err != nil {
+		return err
+	}
+	handler := make(adaptor.ChanMessageHandler, 64)
+	scanner := streaming.WAL().Read(ctx, streaming.ReadOption{
+		PChannel:      funcutil.ToPhysicalChannel(vchannel),
+		DeliverPolicy: options.DeliverPolicyStartFrom(startFrom),
+		DeliverFilters: []options.DeliverFilter{
+			options.DeliverFilterTimeTickGTE(position.GetTimestamp()),
+			options.DeliverFilterMessageType(message.MessageTypeInsert, message.MessageTypeDelete,
+				message.MessageTypeTxn, message.MessageTypeTimeTick),
+		},
+		MessageHandler: handler,
+	})
+	defer scanner.Close()
+
+	msgs := make([]message.MutableMessage, 0)
+	batchSize, replayed := 0, 0
+	flush := func() error {
+		if len(msgs) == 0 {
+			return nil
+		}
+		if err := streaming.WAL().AppendMessages(ctx, msgs...).UnwrapFirstError(); err != nil {
+			log.Warn("failed to replay partition data", zap.Int("replayed", replayed), zap.Error(err))
+			return err
+		}
+		replayed += len(msgs)
+		msgs, batchSize = msgs[:0], 0
+		return nil
+	}
+	for {
+		var msg message.ImmutableMessage
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok = <-handler:
+			if !ok {
+				if err := scanner.Error(); err != nil {
+					return err
+				}
+				return merr.WrapErrServiceInternal("wal scanner is closed unexpectedly")
+			}
+		}
+		if msg.TimeTick() >= r.endTs {
+			break
+		}
+		if msg.VChannel() != vchannel {
+			continue
+		}
+		rewritten, err := r.rewrite(msg, targetVChannel)
+		if err != nil {
+			return err
+		}
+		for _, m := range rewritten {
+			msgs = append(msgs, m)
+			batchSize += m.EstimateSize()
+		}
+		if batchSize >= replayBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	log.Info("replay partition data done", zap.Int("messages", replayed))
+	return nil
+}
+
+func (r *partitionDataReplayer) rewrite(msg message.ImmutableMessage, targetVChannel string) ([]message.MutableMessage, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeTxn:
+		msgs := make([]message.MutableMessage, 0)
+		err := message.AsImmutableTxnMessage(msg).RangeOver(func(m message.ImmutableMessage) error {
+			rewritten, err := r.rewrite(m, targetVChannel)
+			msgs = append(msgs, rewritten...)
+			return err
+		})
+		return msgs, err
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := insertMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		partitions := insertMsg.Header().GetPartitions()
+		if body.GetPartitionID() != r.sourcePartitionID || len(partitions) == 0 ||
+			r.transferred.Contain(partitions[0].GetSegmentAssignment().GetSegmentId()) {
+			return nil, nil
+		}
+		body.DbName = ""
+		body.CollectionName = r.target.Name
+		body.CollectionID = r.target.CollectionID
+		body.PartitionName = r.targetPartition.PartitionName
+		body.PartitionID = r.targetPartition.PartitionID
+		body.ShardName = targetVChannel
+		body.SegmentID = 0
+		newMsg, err := message.NewInsertMessageBuilderV1().
+			WithVChannel(targetVChannel).
+			WithHeader(&message.InsertMessageHeader{
+				CollectionId: r.target.CollectionID,
+				Partitions: []*message.PartitionSegmentAssignment{
+					{
+						PartitionId: r.targetPartition.PartitionID,
+						Rows:        body.GetNumRows(),
+					},
+				},
+			}).
+			WithBody(body).
+			BuildMutable()
+		if err != nil {
+			return nil, err
+		}
+		return []message.MutableMessage{newMsg}, nil
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := deleteMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		if body.GetPartitionID() != r.sourcePartitionID {
+			return nil, nil
+		}
+		body.DbName = ""
+		body.CollectionName = r.target.Name
+		body.CollectionID = r.target.CollectionID
+		body.PartitionName = r.targetPartition.PartitionName
+		body.PartitionID = r.targetPartition.PartitionID
+		body.ShardName = targetVChannel
+		newMsg, err := message.NewDeleteMessageBuilderV1().
+			WithVChannel(targetVChannel).
+			WithHeader(&message.DeleteMessageHeader{
+				CollectionId: r.target.CollectionID,
+				Rows:         uint64(body.GetNumRows()),
+			}).
+			WithBody(body).
+			BuildMutable()
+		if err != nil {
+			return nil, err
+		}
+		return []message.MutableMessage{newMsg}, nil
+	default:
+		return nil, nil
+	}
+}
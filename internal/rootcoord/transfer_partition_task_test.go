@@ -0,0 +1,340 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func Test_transferPartitionTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &transferPartitionTask{
+			Req: &rootcoordpb.TransferPartitionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty target", func(t *testing.T) {
+		task := &transferPartitionTask{
+			Req: &rootcoordpb.TransferPartitionRequest{
+				Base:          &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				PartitionName: "p1",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("move default partition", func(t *testing.T) {
+		task := &transferPartitionTask{
+			Req: &rootcoordpb.TransferPartitionRequest{
+				Base:                 &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				PartitionName:        Params.CommonCfg.DefaultPartitionName.GetValue(),
+				TargetCollectionName: "coll2",
+				TargetPartitionName:  "p1",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func newTransferTestCollection(collectionID int64, name string, partitions ...*model.Partition) *model.Collection {
+	return &model.Collection{
+		CollectionID: collectionID,
+		Name:         name,
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+		Partitions:          partitions,
+		VirtualChannelNames: []string{name + "_v0"},
+		State:               pb.CollectionState_CollectionCreated,
+	}
+}
+
+func Test_transferPartitionTask_Execute(t *testing.T) {
+	t.Run("schema mismatch", func(t *testing.T) {
+		source := newTransferTestCollection(111, "coll1", &model.Partition{PartitionID: 1, PartitionName: "p1"})
+		target := newTransferTestCollection(222, "coll2")
+		target.Fields = target.Fields[:1]
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll1", mock.Anything).Return(source, nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll2", mock.Anything).Return(target, nil)
+		core := newTestCore(withMeta(mockMeta))
+		task := &transferPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.TransferPartitionRequest{
+				CollectionName: "coll1", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p1",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("partition not found", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll1", mock.Anything).Return(newTransferTestCollection(111, "coll1"), nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll2", mock.Anything).Return(newTransferTestCollection(222, "coll2"), nil)
+		core := newTestCore(withMeta(mockMeta))
+		task := &transferPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.TransferPartitionRequest{
+				CollectionName: "coll1", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p1",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrPartitionNotFound)
+	})
+
+	t.Run("transfer segments failed", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll1", mock.Anything).Return(
+			newTransferTestCollection(111, "coll1", &model.Partition{PartitionID: 1, PartitionName: "p1"}), nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll2", mock.Anything).Return(
+			newTransferTestCollection(222, "coll2", &model.Partition{PartitionID: 2, PartitionName: "p2"}), nil)
+		broker := newMockBroker()
+		broker.TransferSegmentsFunc = func(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+			return nil, errors.New("mock")
+		}
+		core := newTestCore(withMeta(mockMeta), withBroker(broker))
+		task := &transferPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.TransferPartitionRequest{
+				CollectionName: "coll1", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p2", Copy: true,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("move restores the source partition on failure", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll1", mock.Anything).Return(
+			newTransferTestCollection(111, "coll1", &model.Partition{PartitionID: 1, PartitionName: "p1"}), nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll2", mock.Anything).Return(
+			newTransferTestCollection(222, "coll2", &model.Partition{PartitionID: 2, PartitionName: "p2"}), nil)
+		// the writes of the source partition are blocked before the cut, and restored after the failure.
+		mockMeta.EXPECT().ChangePartitionState(mock.Anything, int64(111), int64(1), pb.PartitionState_PartitionDropping, mock.Anything).Return(nil).Once()
+		mockMeta.EXPECT().ChangePartitionState(mock.Anything, int64(111), int64(1), pb.PartitionState_PartitionCreated, mock.Anything).Return(nil).Once()
+		broker := newMockBroker()
+		broker.TransferSegmentsFunc = func(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+			return nil, errors.New("mock")
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(mockMeta), withBroker(broker), withTsoAllocator(newMockTsoAllocator()))
+		task := &transferPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.TransferPartitionRequest{
+				CollectionName: "coll1", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p2",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		mockMeta := mockrootcoord.NewIMetaTable(t)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll1", mock.Anything).Return(
+			newTransferTestCollection(111, "coll1", &model.Partition{PartitionID: 1, PartitionName: "p1"}), nil)
+		mockMeta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll2", mock.Anything).Return(
+			newTransferTestCollection(222, "coll2", &model.Partition{PartitionID: 2, PartitionName: "p2"}), nil)
+		mockMeta.EXPECT().ListAliasesByID(mock.Anything, mock.Anything).Return(nil)
+		broker := newMockBroker()
+		broker.TransferSegmentsFunc = func(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+			assert.Equal(t, int64(1), req.GetPartitionID())
+			assert.Equal(t, int64(2), req.GetTargetPartitionID())
+			assert.Equal(t, map[string]string{"coll1_v0": "coll2_v0"}, req.GetChannelMapping())
+			// the identical schema re-parents the segments.
+			assert.False(t, req.GetCopyBinlogs())
+			return &datapb.TransferSegmentsResponse{Status: merr.Success()}, nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(mockMeta), withBroker(broker))
+		task := &transferPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.TransferPartitionRequest{
+				CollectionName: "coll1", PartitionName: "p1", TargetCollectionName: "coll2", TargetPartitionName: "p2", Copy: true,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_transferPartitionTask_rollbackTransferSegments(t *testing.T) {
+	broker := newMockBroker()
+	called := false
+	broker.TransferSegmentsFunc = func(ctx context.Context, req *datapb.TransferSegmentsRequest) (*datapb.TransferSegmentsResponse, error) {
+		called = true
+		assert.Equal(t, []int64{1000}, req.GetRollbackSegmentIDs())
+		assert.Equal(t, int64(10), req.GetRollbackImportJobID())
+		return &datapb.TransferSegmentsResponse{Status: merr.Success()}, nil
+	}
+	core := newTestCore(withBroker(broker))
+	task := &transferPartitionTask{baseTask: newBaseTask(context.Background(), core)}
+
+	task.rollbackTransferSegments(context.Background(), &datapb.TransferSegmentsResponse{})
+	assert.False(t, called)
+	task.rollbackTransferSegments(context.Background(), &datapb.TransferSegmentsResponse{SegmentIDs: []int64{1000}, ImportJobID: 10})
+	assert.True(t, called)
+}
+
+func Test_checkTransferSchema(t *testing.T) {
+	source := newTransferTestCollection(111, "coll1")
+	target := newTransferTestCollection(222, "coll2")
+	copyBinlogs, err := checkTransferSchema(source, target)
+	assert.NoError(t, err)
+	assert.False(t, copyBinlogs)
+
+	// the binlogs are copied if the schemas differ in the attributes not affecting the storage.
+	target.Fields[1].Description = "vector"
+	copyBinlogs, err = checkTransferSchema(source, target)
+	assert.NoError(t, err)
+	assert.True(t, copyBinlogs)
+
+	target = newTransferTestCollection(222, "coll2")
+	target.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}}
+	_, err = checkTransferSchema(source, target)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	target = newTransferTestCollection(222, "coll2")
+	target.Fields[1].DataType = schemapb.DataType_BinaryVector
+	_, err = checkTransferSchema(source, target)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	target = newTransferTestCollection(222, "coll2")
+	target.VirtualChannelNames = []string{"v0", "v1"}
+	_, err = checkTransferSchema(source, target)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	target = newTransferTestCollection(222, "coll2")
+	target.Functions = []*model.Function{{Name: "bm25"}}
+	_, err = checkTransferSchema(source, target)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	target = newTransferTestCollection(222, "coll2")
+	source.Fields[0].IsPartitionKey = true
+	target.Fields[0].IsPartitionKey = true
+	_, err = checkTransferSchema(source, target)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func Test_transferPartitionTask_GetLockerKey(t *testing.T) {
+	mockMeta := mockrootcoord.NewIMetaTable(t)
+	mockMeta.EXPECT().GetCollectionByName(mock.Anything, "db2", "coll2", mock.Anything).Return(newTransferTestCollection(222, "coll2"), nil)
+	mockMeta.EXPECT().GetCollectionByName(mock.Anything, "db1", "coll1", mock.Anything).Return(newTransferTestCollection(111, "coll1"), nil)
+	core := newTestCore(withMeta(mockMeta))
+	task := &transferPartitionTask{
+		baseTask: newBaseTask(context.Background(), core),
+		Req: &rootcoordpb.TransferPartitionRequest{
+			DbName: "db2", CollectionName: "coll2", TargetDbName: "db1", TargetCollectionName: "coll1",
+		},
+	}
+	// only the two collections are locked, and the keys of the same level are sorted.
+	assert.Equal(t, "$-0-false|db1-1-false|db2-1-false|111-2-true|222-2-true", GetLockerKeyString(task.GetLockerKey()))
+}
+
+func Test_partitionDataReplayer_rewrite(t *testing.T) {
+	replayer := &partitionDataReplayer{
+		sourcePartitionID: 1,
+		target:            &model.Collection{CollectionID: 222, Name: "coll2"},
+		targetPartition:   &model.Partition{PartitionID: 2, PartitionName: "p2"},
+		transferred:       typeutil.NewUniqueSet(1000),
+	}
+	newInsert := func(partitionID, segmentID int64) message.ImmutableMessage {
+		return message.NewInsertMessageBuilderV1().
+			WithVChannel("coll1_v0").
+			WithHeader(&message.InsertMessageHeader{
+				CollectionId: 111,
+				Partitions: []*message.PartitionSegmentAssignment{{
+					PartitionId:       partitionID,
+					Rows:              1,
+					SegmentAssignment: &message.SegmentAssignment{SegmentId: segmentID},
+				}},
+			}).
+			WithBody(&msgpb.InsertRequest{CollectionID: 111, PartitionID: partitionID, SegmentID: segmentID, NumRows: 1}).
+			MustBuildMutable().
+			WithTimeTick(100).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+	}
+
+	// the insert of other partition is skipped.
+	msgs, err := replayer.rewrite(newInsert(3, 1001), "coll2_v0")
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	// the insert of transferred segment is skipped.
+	msgs, err = replayer.rewrite(newInsert(1, 1000), "coll2_v0")
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	msgs, err = replayer.rewrite(newInsert(1, 1001), "coll2_v0")
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, "coll2_v0", msgs[0].VChannel())
+	insertMsg := message.MustAsMutableInsertMessageV1(msgs[0])
+	assert.Equal(t, int64(222), insertMsg.Header().GetCollectionId())
+	assert.Equal(t, int64(2), insertMsg.Header().GetPartitions()[0].GetPartitionId())
+	body := insertMsg.MustBody()
+	assert.Equal(t, int64(2), body.GetPartitionID())
+	assert.Equal(t, int64(0), body.GetSegmentID())
+
+	deleteMsg := message.NewDeleteMessageBuilderV1().
+		WithVChannel("coll1_v0").
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 111, Rows: 1}).
+		WithBody(&msgpb.DeleteRequest{CollectionID: 111, PartitionID: common.AllPartitionsID, NumRows: 1}).
+		MustBuildMutable().
+		WithTimeTick(100).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(2))
+	// the collection level delete is skipped.
+	msgs, err = replayer.rewrite(deleteMsg, "coll2_v0")
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	deleteMsg = message.NewDeleteMessageBuilderV1().
+		WithVChannel("coll1_v0").
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 111, Rows: 1}).
+		WithBody(&msgpb.DeleteRequest{CollectionID: 111, PartitionID: 1, NumRows: 1}).
+		MustBuildMutable().
+		WithTimeTick(100).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(3))
+	msgs, err = replayer.rewrite(deleteMsg, "coll2_v0")
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, int64(2), message.MustAsMutableDeleteMessageV1(msgs[0]).MustBody().GetPartitionID())
+}
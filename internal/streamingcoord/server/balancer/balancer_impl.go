@@ -336,8 +336,9 @@ func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 	}
 
 	// call the balance strategy to generate the expected layout.
+	// the wal of a follower cluster is written by the primary cluster, so it's always read-only.
 	accessMode := types.AccessModeRO
-	if b.channelMetaManager.IsStreamingEnabledOnce() {
+	if b.channelMetaManager.IsStreamingEnabledOnce() && !paramtable.Get().CommonCfg.FollowerEnabled.GetAsBool() {
 		accessMode = types.AccessModeRW
 	}
 	currentLayout := generateCurrentLayout(pchannelView, nodeStatus, accessMode)
@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cdcpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -182,10 +183,23 @@ type Proxy interface {
 	Component
 	proxypb.ProxyServer
 	milvuspb.MilvusServiceServer
+	cdcpb.ChangeDataCaptureServer
 
 	ImportV2(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+
+	RenamePartition(context.Context, *rootcoordpb.RenamePartitionRequest) (*commonpb.Status, error)
+	RenameField(context.Context, *rootcoordpb.RenameFieldRequest) (*commonpb.Status, error)
+	AddCollectionFunction(context.Context, *rootcoordpb.AddCollectionFunctionRequest) (*commonpb.Status, error)
+	GetFunctionBackfillProgress(context.Context, *rootcoordpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)
+	TransferPartition(context.Context, *rootcoordpb.TransferPartitionRequest) (*commonpb.Status, error)
+	BeginReadSession(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)
+	EndReadSession(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)
+	FederatedSearch(context.Context, *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error)
+	CreateProjection(context.Context, *rootcoordpb.CreateProjectionRequest) (*commonpb.Status, error)
+	DropProjection(context.Context, *rootcoordpb.DropProjectionRequest) (*commonpb.Status, error)
+	ListProjections(context.Context, *rootcoordpb.ListProjectionsRequest) (*rootcoordpb.ListProjectionsResponse, error)
 }
 
 // ProxyComponent defines the interface of proxy component.
@@ -21,6 +21,7 @@ import "C"
 import (
 	"context"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
@@ -62,6 +63,10 @@ type Future interface {
 	//   Caller should free the result after used (defined by caller), otherwise the memory of result is leaked.
 	BlockAndLeakyGet() (unsafe.Pointer, error)
 
+	// CPUTime returns the cpu time of the executor thread spent on the underlying cgo function,
+	// it blocks until the future is ready, and should be called before Release.
+	CPUTime() time.Duration
+
 	// Release the resource of the future.
 	// !!! Release is not concurrent safe with other methods.
 	// It should be called only once after all method of future is returned.
@@ -147,6 +152,17 @@ func (f *futureImpl) BlockAndLeakyGet() (unsafe.Pointer, error) {
 	return ptr, err
 }
 
+// CPUTime returns the cpu time of the executor thread spent on the underlying cgo function.
+func (f *futureImpl) CPUTime() time.Duration {
+	f.blockUntilReady()
+
+	var us C.int64_t
+	getCGOCaller().call("future_cpu_time_us", func() {
+		us = C.future_cpu_time_us(f.future)
+	})
+	return time.Duration(us) * time.Microsecond
+}
+
 // Release the resource of the future.
 func (f *futureImpl) Release() {
 	// block until ready to release the future.
@@ -67,6 +67,8 @@ func TestFutureWithSuccessCase(t *testing.T) {
 	assert.Equal(t, 100, getCInt(result))
 	// The inner function sleep 1 seconds, so the future cost must be greater than 0.5 seconds.
 	assert.Greater(t, time.Since(start).Seconds(), 0.5)
+	// The sleeping doesn't cost the cpu time.
+	assert.Less(t, future.CPUTime(), 500*time.Millisecond)
 	// free the result after used.
 	freeCInt(result)
 	runtime.GC()
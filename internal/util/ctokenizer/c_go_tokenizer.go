@@ -0,0 +1,81 @@
+package ctokenizer
+
+/*
+#cgo pkg-config: milvus_core
+#include <stdlib.h>	// free
+#include "segcore/tokenizer_c.h"
+
+extern uintptr_t goTokenizerCreate(char*, char**);
+extern uintptr_t goTokenizerClone(uintptr_t);
+extern void goTokenizerDestroy(uintptr_t);
+extern void goTokenizerTokenize(uintptr_t, char*, uintptr_t, void*);
+
+static inline void register_go_tokenizer_callbacks() {
+	register_go_tokenizer((CGoTokenizerCreateFn)goTokenizerCreate,
+		(CGoTokenizerCloneFn)goTokenizerClone,
+		(CGoTokenizerDestroyFn)goTokenizerDestroy,
+		(CGoTokenizerTokenizeFn)goTokenizerTokenize);
+}
+*/
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/gotokenizer"
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+// the text index is built by the tantivy analyzers in segcore, which call back into go
+// for the go analyzers, the go tokenizers are passed to segcore by the cgo handles.
+func init() {
+	C.register_go_tokenizer_callbacks()
+}
+
+//export goTokenizerCreate
+func goTokenizerCreate(params *C.char, errMsg **C.char) C.uintptr_t {
+	tokenizer, err := gotokenizer.NewTokenizer(C.GoString(params))
+	if err != nil {
+		*errMsg = C.CString(err.Error())
+		return 0
+	}
+	return C.uintptr_t(cgo.NewHandle(tokenizer))
+}
+
+//export goTokenizerClone
+func goTokenizerClone(handle C.uintptr_t) C.uintptr_t {
+	tokenizer := cgo.Handle(handle).Value().(tokenizerapi.Tokenizer)
+	clone, err := tokenizer.Clone()
+	if err != nil {
+		log.Warn("failed to clone go tokenizer", zap.Error(err))
+		return 0
+	}
+	return C.uintptr_t(cgo.NewHandle(clone))
+}
+
+//export goTokenizerDestroy
+func goTokenizerDestroy(handle C.uintptr_t) {
+	h := cgo.Handle(handle)
+	h.Value().(tokenizerapi.Tokenizer).Destroy()
+	h.Delete()
+}
+
+//export goTokenizerTokenize
+func goTokenizerTokenize(handle C.uintptr_t, text *C.char, textLen C.uintptr_t, sink unsafe.Pointer) {
+	tokenizer := cgo.Handle(handle).Value().(tokenizerapi.Tokenizer)
+	stream := tokenizer.NewTokenStream(C.GoStringN(text, C.int(textLen)))
+	defer stream.Destroy()
+
+	for stream.Advance() {
+		token := stream.DetailedToken()
+		cToken := C.CString(token.GetToken())
+		C.append_go_token(sink, cToken, C.uintptr_t(len(token.GetToken())),
+			C.int64_t(token.GetStartOffset()), C.int64_t(token.GetEndOffset()),
+			C.int64_t(token.GetPosition()), C.int64_t(token.GetPositionLength()))
+		C.free(unsafe.Pointer(cToken))
+	}
+}
@@ -11,10 +11,16 @@ import "C"
 import (
 	"unsafe"
 
+	"github.com/milvus-io/milvus/internal/util/gotokenizer"
 	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
 )
 
 func NewTokenizer(param string) (tokenizerapi.Tokenizer, error) {
+	// the go analyzers are created without the native tantivy analyzers
+	if gotokenizer.IsGoTokenizer(param) {
+		return gotokenizer.NewTokenizer(param)
+	}
+
 	paramPtr := C.CString(param)
 	defer C.free(unsafe.Pointer(paramPtr))
 
@@ -28,6 +34,10 @@ func NewTokenizer(param string) (tokenizerapi.Tokenizer, error) {
 }
 
 func ValidateTokenizer(param string) error {
+	if gotokenizer.IsGoTokenizer(param) {
+		return gotokenizer.ValidateTokenizer(param)
+	}
+
 	paramPtr := C.CString(param)
 	defer C.free(unsafe.Pointer(paramPtr))
 
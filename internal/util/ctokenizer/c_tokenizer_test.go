@@ -35,6 +35,42 @@ func TestTokenizer(t *testing.T) {
 			fmt.Println(tokenStream.Token())
 		}
 	}
+
+	// go tokenizer.
+	{
+		m := "{\"type\": \"go\", \"tokenizer\": {\"type\": \"dict\", \"dict\": [\"北京大学\"]}}"
+		tokenizer, err := NewTokenizer(m)
+		assert.NoError(t, err)
+		defer tokenizer.Destroy()
+
+		tokenStream := tokenizer.NewTokenStream("张华考上了北京大学")
+		defer tokenStream.Destroy()
+		tokens := []string{}
+		for tokenStream.Advance() {
+			tokens = append(tokens, tokenStream.Token())
+		}
+		assert.Equal(t, []string{"张", "华", "考", "上", "了", "北京大学"}, tokens)
+	}
+
+	// go tokenizer used by the native analyzer, like building the text index.
+	{
+		m := "{\"tokenizer\": {\"type\": \"language_identifier\", \"analyzers\": {\"default\": {\"type\": \"go\", \"filter\": [\"lowercase\"]}}}}"
+		tokenizer, err := NewTokenizer(m)
+		assert.NoError(t, err)
+		defer tokenizer.Destroy()
+
+		tokenStream := tokenizer.NewTokenStream("Football, Basketball")
+		defer tokenStream.Destroy()
+		tokens := []string{}
+		for tokenStream.Advance() {
+			tokens = append(tokens, tokenStream.Token())
+		}
+		assert.Equal(t, []string{"football", "basketball"}, tokens)
+
+		m = "{\"tokenizer\": {\"type\": \"language_identifier\", \"analyzers\": {\"default\": {\"type\": \"go\", \"name\": \"invalid\"}}}}"
+		_, err = NewTokenizer(m)
+		assert.Error(t, err)
+	}
 }
 
 func TestValidateTokenizer(t *testing.T) {
@@ -57,4 +93,15 @@ func TestValidateTokenizer(t *testing.T) {
 		err := ValidateTokenizer(m)
 		assert.Error(t, err)
 	}
+
+	// go tokenizer
+	{
+		m := "{\"type\": \"go\", \"filter\": [\"lowercase\"]}"
+		err := ValidateTokenizer(m)
+		assert.NoError(t, err)
+
+		m = "{\"type\": \"go\", \"tokenizer\": \"invalid\"}"
+		err = ValidateTokenizer(m)
+		assert.Error(t, err)
+	}
 }
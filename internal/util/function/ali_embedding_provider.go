@@ -57,7 +57,7 @@ func NewAliDashScopeEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functio
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, dashscopeAKEnvStr)
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, dashscopeAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
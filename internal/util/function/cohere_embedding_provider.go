@@ -43,7 +43,7 @@ type CohereEmbeddingProvider struct {
 
 func createCohereEmbeddingClient(apiKey string, url string) (*cohere.CohereEmbedding, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", cohereAIAKEnvStr)
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", CohereAIAKEnvStr)
 	}
 
 	if url == "" {
@@ -59,7 +59,7 @@ func NewCohereEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchem
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, cohereAIAKEnvStr)
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, CohereAIAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
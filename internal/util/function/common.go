@@ -51,6 +51,7 @@ const (
 	embeddingURLParamKey string = "url"
 	credentialParamKey   string = "credential"
 	truncateParamKey     string = "truncate"
+	enableCacheParamKey  string = "enable_cache"
 )
 
 // ali text embedding
@@ -94,19 +95,25 @@ const (
 // voyageAI
 const (
 	truncationParamKey string = "truncation"
-	voyageAIAKEnvStr   string = "MILVUSAI_VOYAGEAI_API_KEY"
+	VoyageAIAKEnvStr   string = "MILVUSAI_VOYAGEAI_API_KEY"
 )
 
 // cohere
 
 const (
-	cohereAIAKEnvStr string = "MILVUSAI_COHERE_API_KEY"
+	CohereAIAKEnvStr string = "MILVUSAI_COHERE_API_KEY"
 )
 
 // siliconflow
 
 const (
-	siliconflowAKEnvStr string = "MILVUSAI_SILICONFLOW_API_KEY"
+	SiliconflowAKEnvStr string = "MILVUSAI_SILICONFLOW_API_KEY"
+)
+
+// jina
+
+const (
+	JinaAIAKEnvStr string = "MILVUSAI_JINAAI_API_KEY"
 )
 
 // TEI and vllm
@@ -122,7 +129,29 @@ const (
 	EnableVllmEnvStr string = "MILVUSAI_ENABLE_VLLM"
 )
 
-func parseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
+// ollama and openai compatible services
+
+const (
+	keepAliveParamKey  string = "keep_alive"
+	authHeaderParamKey string = "auth_header"
+
+	ollamaAKEnvStr           string = "MILVUSAI_OLLAMA_API_KEY"
+	openaiCompatibleAKEnvStr string = "MILVUSAI_OPENAI_COMPATIBLE_API_KEY"
+
+	EnableOllamaEnvStr           string = "MILVUSAI_ENABLE_OLLAMA"
+	EnableOpenAICompatibleEnvStr string = "MILVUSAI_ENABLE_OPENAI_COMPATIBLE"
+)
+
+// isProviderEnabled returns whether the self-hosted model service is enabled, milvus.yaml > env.
+func isProviderEnabled(confParams map[string]string, envKey string) bool {
+	if value, ok := confParams["enable"]; ok {
+		return strings.ToLower(value) == "true"
+	}
+	return strings.ToLower(os.Getenv(envKey)) != "false"
+}
+
+// ParseAKAndURL returns the api key and the url of the model service, it's shared by the embedding and the rerank providers.
+func ParseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
 	// function param > yaml > env
 	var err error
 	var apiKey, url string
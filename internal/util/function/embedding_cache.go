@@ -0,0 +1,160 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+var (
+	embeddingCacheOnce   sync.Once
+	globalEmbeddingCache *embeddingCache
+)
+
+// getEmbeddingCache returns the embedding cache of the node, it's shared by all the TextEmbedding functions opting in.
+func getEmbeddingCache() *embeddingCache {
+	embeddingCacheOnce.Do(func() {
+		params := paramtable.Get()
+		globalEmbeddingCache = newEmbeddingCache(params.FunctionCfg.TextEmbeddingCacheCapacity.GetAsInt(),
+			params.FunctionCfg.TextEmbeddingCacheTTL.GetAsDuration(time.Second))
+	})
+	return globalEmbeddingCache
+}
+
+// embeddingCache is a LRU cache with TTL from the text to its embedding,
+// the embedding is a []float32, []int8 or a sparse map[uint32]float32.
+// The key is made of the model identity and the sha256 of the text, so the texts are not kept in memory.
+type embeddingCache struct {
+	lru *expirable.LRU[string, any]
+}
+
+func newEmbeddingCache(capacity int, ttl time.Duration) *embeddingCache {
+	return &embeddingCache{
+		lru: expirable.NewLRU[string, any](capacity, nil, ttl),
+	}
+}
+
+// embeddingCacheKeyPrefix identifies the model generating the embeddings, which is made of the provider, the model name,
+// the dim, the vector type and the digest of the other function params, as the params like the prompts or the truncation
+// also affect the embeddings. The credentials are excluded, so the functions sharing a model share the cached embeddings.
+func embeddingCacheKeyPrefix(provider string, outputField *schemapb.FieldSchema, dim int64, params []*commonpb.KeyValuePair) string {
+	var modelName string
+	others := make([]string, 0, len(params))
+	for _, param := range params {
+		key := strings.ToLower(param.Key)
+		switch key {
+		case modelNameParamKey:
+			modelName = param.Value
+		case Provider, credentialParamKey, enableCacheParamKey:
+		default:
+			others = append(others, key+"="+param.Value)
+		}
+	}
+	sort.Strings(others)
+	digest := sha256.Sum256([]byte(strings.Join(others, "\n")))
+	return fmt.Sprintf("%s/%s/%d/%s/%s/", provider, modelName, dim, outputField.GetDataType().String(), hex.EncodeToString(digest[:8]))
+}
+
+func embeddingCacheKey(prefix string, mode TextEmbeddingMode, text string) string {
+	digest := sha256.Sum256([]byte(text))
+	return prefix + strconv.Itoa(int(mode)) + "/" + hex.EncodeToString(digest[:])
+}
+
+// callEmbeddingWithCache embeds the texts missing in the cache only, the duplicated texts are embedded once.
+func (c *embeddingCache) callEmbeddingWithCache(provider textEmbeddingProvider, providerName string, prefix string, dataType schemapb.DataType, texts []string, mode TextEmbeddingMode) (any, error) {
+	keys := make([]string, len(texts))
+	cached := make([]any, len(texts))
+	missTexts := make([]string, 0)
+	missIndexes := make(map[string]int)
+	for i, text := range texts {
+		keys[i] = embeddingCacheKey(prefix, mode, text)
+		if emb, ok := c.lru.Get(keys[i]); ok {
+			cached[i] = emb
+			continue
+		}
+		if _, ok := missIndexes[keys[i]]; !ok {
+			missIndexes[keys[i]] = len(missTexts)
+			missTexts = append(missTexts, text)
+		}
+	}
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	role := paramtable.GetRole()
+	metrics.FunctionEmbeddingCacheCounter.WithLabelValues(nodeID, role, providerName, metrics.CacheHitLabel).Add(float64(len(texts) - len(missTexts)))
+	metrics.FunctionEmbeddingCacheCounter.WithLabelValues(nodeID, role, providerName, metrics.CacheMissLabel).Add(float64(len(missTexts)))
+
+	var missEmbds any
+	if len(missTexts) > 0 {
+		var err error
+		if missEmbds, err = provider.CallEmbedding(missTexts, mode); err != nil {
+			return nil, err
+		}
+	}
+
+	switch dataType {
+	case schemapb.DataType_FloatVector:
+		return fillEmbeddings[[]float32](c, keys, cached, missIndexes, missEmbds)
+	case schemapb.DataType_Int8Vector:
+		return fillEmbeddings[[]int8](c, keys, cached, missIndexes, missEmbds)
+	case schemapb.DataType_SparseFloatVector:
+		return fillEmbeddings[map[uint32]float32](c, keys, cached, missIndexes, missEmbds)
+	default:
+		return nil, fmt.Errorf("Text embedding cache doesn't support %s vector", dataType.String())
+	}
+}
+
+func fillEmbeddings[T []float32 | []int8 | map[uint32]float32](c *embeddingCache, keys []string, cached []any, missIndexes map[string]int, missEmbds any) ([]T, error) {
+	var embds []T
+	if missEmbds != nil {
+		var ok bool
+		if embds, ok = missEmbds.([]T); !ok {
+			return nil, fmt.Errorf("Unexpected embedding type: %T", missEmbds)
+		}
+	}
+	if len(embds) != len(missIndexes) {
+		return nil, fmt.Errorf("The number of texts and embeddings does not match text:[%d], embedding:[%d]", len(missIndexes), len(embds))
+	}
+	for key, idx := range missIndexes {
+		c.lru.Add(key, embds[idx])
+	}
+
+	ret := make([]T, len(keys))
+	for i, key := range keys {
+		if cached[i] != nil {
+			ret[i] = cached[i].(T)
+		} else {
+			ret[i] = embds[missIndexes[key]]
+		}
+	}
+	return ret, nil
+}
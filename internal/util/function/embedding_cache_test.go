@@ -0,0 +1,140 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+type countingEmbeddingProvider struct {
+	dim   int64
+	int8  bool
+	calls int
+	texts int
+}
+
+func (p *countingEmbeddingProvider) MaxBatch() int {
+	return 128
+}
+
+func (p *countingEmbeddingProvider) FieldDim() int64 {
+	return p.dim
+}
+
+func (p *countingEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	p.calls++
+	p.texts += len(texts)
+	if p.int8 {
+		return mockEmbedding[int8](texts, int(p.dim)), nil
+	}
+	return mockEmbedding[float32](texts, int(p.dim)), nil
+}
+
+func TestEmbeddingCacheKeyPrefix(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "vector", DataType: schemapb.DataType_FloatVector}
+	params := []*commonpb.KeyValuePair{
+		{Key: Provider, Value: openAIProvider},
+		{Key: modelNameParamKey, Value: "text-embedding-3-small"},
+		{Key: credentialParamKey, Value: "a"},
+		{Key: userParamKey, Value: "u"},
+	}
+	prefix := embeddingCacheKeyPrefix(openAIProvider, field, 4, params)
+
+	// the credential doesn't matter
+	params[2].Value = "b"
+	assert.Equal(t, prefix, embeddingCacheKeyPrefix(openAIProvider, field, 4, params))
+
+	// the other params affect the embeddings
+	params[3].Value = "v"
+	assert.NotEqual(t, prefix, embeddingCacheKeyPrefix(openAIProvider, field, 4, params))
+	params[3].Value = "u"
+	assert.NotEqual(t, prefix, embeddingCacheKeyPrefix(openAIProvider, field, 8, params))
+	assert.NotEqual(t, prefix, embeddingCacheKeyPrefix(openAIProvider, &schemapb.FieldSchema{DataType: schemapb.DataType_Int8Vector}, 4, params))
+
+	assert.NotEqual(t, embeddingCacheKey(prefix, InsertMode, "text"), embeddingCacheKey(prefix, SearchMode, "text"))
+}
+
+func TestEmbeddingCache(t *testing.T) {
+	cache := newEmbeddingCache(4, time.Hour)
+	provider := &countingEmbeddingProvider{dim: 4}
+
+	ret, err := cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"a", "b", "a"}, SearchMode)
+	assert.NoError(t, err)
+	embds := ret.([][]float32)
+	assert.Equal(t, 3, len(embds))
+	assert.Equal(t, embds[0], embds[2])
+	// the duplicated text is embedded once
+	assert.Equal(t, 1, provider.calls)
+	assert.Equal(t, 2, provider.texts)
+
+	ret, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"b", "c"}, SearchMode)
+	assert.NoError(t, err)
+	assert.Equal(t, embds[1], ret.([][]float32)[0])
+	assert.Equal(t, 2, provider.calls)
+	assert.Equal(t, 3, provider.texts)
+
+	// all hit
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"a", "b", "c"}, SearchMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, provider.calls)
+
+	// the insert mode is cached separately
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"a"}, InsertMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, provider.calls)
+
+	// "a" in search mode is the least recently used one and evicted
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"d"}, SearchMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, cache.lru.Len())
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_FloatVector, []string{"a"}, SearchMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, provider.calls)
+
+	// type mismatch
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "q/", schemapb.DataType_Int8Vector, []string{"a"}, SearchMode)
+	assert.Error(t, err)
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "q/", schemapb.DataType_BinaryVector, []string{"a"}, SearchMode)
+	assert.Error(t, err)
+}
+
+func TestEmbeddingCacheInt8AndTTL(t *testing.T) {
+	cache := newEmbeddingCache(100, 100*time.Millisecond)
+	provider := &countingEmbeddingProvider{dim: 4, int8: true}
+
+	ret, err := cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_Int8Vector, []string{"a", "b"}, InsertMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(ret.([][]int8)))
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_Int8Vector, []string{"a", "b"}, InsertMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, provider.calls)
+
+	assert.Eventually(t, func() bool {
+		return cache.lru.Len() == 0
+	}, 5*time.Second, 50*time.Millisecond)
+	_, err = cache.callEmbeddingWithCache(provider, "mock", "p/", schemapb.DataType_Int8Vector, []string{"a", "b"}, InsertMode)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, provider.calls)
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type FunctionBase struct {
@@ -70,7 +71,7 @@ func NewFunctionBase(coll *schemapb.CollectionSchema, fSchema *schemapb.Function
 	base.collectionName = coll.Name
 	base.functionName = fSchema.Name
 	base.provider = provider
-	base.functionTypeName = fSchema.GetType().String()
+	base.functionTypeName = typeutil.FunctionTypeName(fSchema.GetType())
 	return &base, nil
 }
 
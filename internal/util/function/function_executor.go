@@ -25,6 +25,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -36,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type Runner interface {
@@ -54,6 +56,7 @@ type Runner interface {
 }
 
 type FunctionExecutor struct {
+	schema  *schemapb.CollectionSchema
 	runners map[int64]Runner
 }
 
@@ -67,6 +70,18 @@ func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSc
 			return nil, err
 		}
 		return f, nil
+	case typeutil.FunctionTypeMultimodalEmbedding:
+		f, err := NewMultimodalEmbeddingFunction(coll, schema)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	case typeutil.FunctionTypeSparseEmbedding:
+		f, err := NewSparseEmbeddingFunction(coll, schema)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
 	default:
 		return nil, fmt.Errorf("unknown functionRunner type %s", schema.GetType().String())
 	}
@@ -93,6 +108,7 @@ func ValidateFunctions(schema *schemapb.CollectionSchema) error {
 
 func NewFunctionExecutor(schema *schemapb.CollectionSchema) (*FunctionExecutor, error) {
 	executor := &FunctionExecutor{
+		schema:  schema,
 		runners: make(map[int64]Runner),
 	}
 	for _, fSchema := range schema.Functions {
@@ -107,7 +123,7 @@ func NewFunctionExecutor(schema *schemapb.CollectionSchema) (*FunctionExecutor,
 	return executor, nil
 }
 
-func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, runner Runner, msg *msgstream.InsertMsg) ([]*schemapb.FieldData, error) {
+func (executor *FunctionExecutor) getInsertInputs(runner Runner, msg *msgstream.InsertMsg) ([]*schemapb.FieldData, error) {
 	inputs := make([]*schemapb.FieldData, 0, len(runner.GetSchema().GetInputFieldNames()))
 	for _, name := range runner.GetSchema().GetInputFieldNames() {
 		for _, field := range msg.FieldsData {
@@ -119,7 +135,98 @@ func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, run
 	if len(inputs) != len(runner.GetSchema().InputFieldIds) {
 		return nil, errors.New("Input field not found")
 	}
+	return inputs, nil
+}
+
+// getUpsertInputs returns the inputs of the function for upsert, the input field which is not supplied
+// or has null value is computed as its default value. The output would be inconsistent with the stored
+// input if the function is computed without the default value, so it's rejected.
+func (executor *FunctionExecutor) getUpsertInputs(runner Runner, fields map[string]*schemapb.FieldData, numRows int) ([]*schemapb.FieldData, error) {
+	functionSchema := runner.GetSchema()
+	inputs := make([]*schemapb.FieldData, 0, len(functionSchema.GetInputFieldNames()))
+	for _, name := range functionSchema.GetInputFieldNames() {
+		var fieldSchema *schemapb.FieldSchema
+		for _, field := range executor.schema.GetFields() {
+			if field.GetName() == name {
+				fieldSchema = field
+				break
+			}
+		}
+		if fieldSchema == nil {
+			return nil, fmt.Errorf("Input field [%s] of function [%s] not found in the collection", name, functionSchema.GetName())
+		}
+
+		field, ok := fields[name]
+		if !ok {
+			if fieldSchema.GetDefaultValue() == nil {
+				return nil, merr.WrapErrParameterInvalidMsg("input field [%s] of function [%s] is not supplied, the function output field [%s] must be supplied in upsert",
+					name, functionSchema.GetName(), runner.GetOutputFields()[0].GetName())
+			}
+			field = &schemapb.FieldData{
+				Type:      fieldSchema.GetDataType(),
+				FieldName: fieldSchema.GetName(),
+				FieldId:   fieldSchema.GetFieldID(),
+				ValidData: make([]bool, numRows),
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}},
+					},
+				},
+			}
+		}
+
+		input, err := fillFunctionInput(functionSchema, fieldSchema, field, numRows)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// fillFunctionInput expands the null values of the input field to the default value,
+// the supplied field data is not modified since it's validated and filled again later.
+func fillFunctionInput(functionSchema *schemapb.FunctionSchema, fieldSchema *schemapb.FieldSchema, field *schemapb.FieldData, numRows int) (*schemapb.FieldData, error) {
+	validData := field.GetValidData()
+	if lo.EveryBy(validData, func(valid bool) bool { return valid }) {
+		return field, nil
+	}
+	if fieldSchema.GetDefaultValue() == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("input field [%s] of function [%s] has null value, which can't be computed by the function",
+			fieldSchema.GetName(), functionSchema.GetName())
+	}
+	if len(validData) != numRows {
+		return nil, merr.WrapErrParameterInvalidMsg("the length of valid_data of field(%s) is wrong, expected %d, got %d", fieldSchema.GetName(), numRows, len(validData))
+	}
+
+	texts := field.GetScalars().GetStringData().GetData()
+	defaultValue := fieldSchema.GetDefaultValue().GetStringData()
+	filled := make([]string, 0, numRows)
+	idx := 0
+	for _, valid := range validData {
+		if !valid {
+			filled = append(filled, defaultValue)
+			continue
+		}
+		if idx >= len(texts) {
+			return nil, merr.WrapErrParameterInvalidMsg("the length of field(%s) mismatches its valid_data", fieldSchema.GetName())
+		}
+		filled = append(filled, texts[idx])
+		idx++
+	}
+	return &schemapb.FieldData{
+		Type:      field.GetType(),
+		FieldName: field.GetFieldName(),
+		FieldId:   field.GetFieldId(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: filled}},
+			},
+		},
+	}, nil
+}
 
+func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, runner Runner, inputs []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
 	tr := timerecord.NewTimeRecorder("function ProcessInsert")
 	outputs, err := runner.ProcessInsert(ctx, inputs)
 	if err != nil {
@@ -131,28 +238,37 @@ func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, run
 	return outputs, nil
 }
 
-func (executor *FunctionExecutor) ProcessInsert(ctx context.Context, msg *msgstream.InsertMsg) error {
+func (executor *FunctionExecutor) processFunctions(ctx context.Context, msg *msgstream.InsertMsg, runners []Runner, getInputs func(Runner) ([]*schemapb.FieldData, error)) error {
 	numRows := msg.NumRows
-	for _, runner := range executor.runners {
+	for _, runner := range runners {
 		if numRows > uint64(runner.MaxBatch()) {
 			return fmt.Errorf("numRows [%d] > function [%s]'s max batch [%d]", numRows, runner.GetSchema().Name, runner.MaxBatch())
 		}
 	}
 
-	outputs := make(chan []*schemapb.FieldData, len(executor.runners))
-	errChan := make(chan error, len(executor.runners))
+	runnerInputs := make([][]*schemapb.FieldData, len(runners))
+	for i, runner := range runners {
+		inputs, err := getInputs(runner)
+		if err != nil {
+			return err
+		}
+		runnerInputs[i] = inputs
+	}
+
+	outputs := make(chan []*schemapb.FieldData, len(runners))
+	errChan := make(chan error, len(runners))
 	var wg sync.WaitGroup
-	for _, runner := range executor.runners {
+	for i, runner := range runners {
 		wg.Add(1)
-		go func(runner Runner) {
+		go func(runner Runner, inputs []*schemapb.FieldData) {
 			defer wg.Done()
-			data, err := executor.processSingleFunction(ctx, runner, msg)
+			data, err := executor.processSingleFunction(ctx, runner, inputs)
 			if err != nil {
 				errChan <- err
 				return
 			}
 			outputs <- data
-		}(runner)
+		}(runner, runnerInputs[i])
 	}
 	wg.Wait()
 	close(errChan)
@@ -173,6 +289,53 @@ func (executor *FunctionExecutor) ProcessInsert(ctx context.Context, msg *msgstr
 	return nil
 }
 
+func (executor *FunctionExecutor) ProcessInsert(ctx context.Context, msg *msgstream.InsertMsg) error {
+	return executor.processFunctions(ctx, msg, lo.Values(executor.runners), func(runner Runner) ([]*schemapb.FieldData, error) {
+		return executor.getInsertInputs(runner, msg)
+	})
+}
+
+// ProcessUpsert only runs the functions whose outputs are stale in the upsert. The output supplied by the request
+// is kept only if none of the inputs is supplied, so the rows which don't change the inputs are not recomputed.
+// Once any input is supplied, the output is recomputed from the inputs and replaces the supplied one, otherwise
+// the output could be inconsistent with the inputs. The request is rejected if the inputs can't be determined.
+func (executor *FunctionExecutor) ProcessUpsert(ctx context.Context, msg *msgstream.InsertMsg) error {
+	fields := make(map[string]*schemapb.FieldData, len(msg.FieldsData))
+	for _, field := range msg.FieldsData {
+		fields[field.GetFieldName()] = field
+	}
+
+	staleRunners := make([]Runner, 0, len(executor.runners))
+	recomputed := make(map[string]struct{})
+	for _, runner := range executor.runners {
+		outputName := runner.GetOutputFields()[0].GetName()
+		if _, ok := fields[outputName]; ok {
+			inputSupplied := lo.SomeBy(runner.GetSchema().GetInputFieldNames(), func(name string) bool {
+				_, ok := fields[name]
+				return ok
+			})
+			if !inputSupplied {
+				continue
+			}
+			recomputed[outputName] = struct{}{}
+		}
+		staleRunners = append(staleRunners, runner)
+	}
+	if len(staleRunners) == 0 {
+		return nil
+	}
+
+	if len(recomputed) > 0 {
+		msg.FieldsData = lo.Filter(msg.FieldsData, func(field *schemapb.FieldData, _ int) bool {
+			_, ok := recomputed[field.GetFieldName()]
+			return !ok
+		})
+	}
+	return executor.processFunctions(ctx, msg, staleRunners, func(runner Runner) ([]*schemapb.FieldData, error) {
+		return executor.getUpsertInputs(runner, fields, int(msg.NRows()))
+	})
+}
+
 func (executor *FunctionExecutor) processSingleSearch(ctx context.Context, runner Runner, placeholderGroup []byte) ([]byte, error) {
 	pb := &commonpb.PlaceholderGroup{}
 	proto.Unmarshal(placeholderGroup, pb)
@@ -273,7 +436,7 @@ func (executor *FunctionExecutor) ProcessBulkInsert(data *storage.InsertData) er
 	for _, runner := range executor.runners {
 		output, err := executor.processSingleBulkInsert(runner, data)
 		if err != nil {
-			return nil
+			return err
 		}
 		for k, v := range output {
 			data.Data[k] = v
@@ -27,7 +27,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
@@ -36,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -168,6 +171,125 @@ func (s *FunctionExecutorSuite) TestExecutor() {
 	s.Equal(len(msg.FieldsData), 3)
 }
 
+func (s *FunctionExecutorSuite) TestProcessUpsert() {
+	ts := CreateOpenAIEmbeddingServer()
+	defer ts.Close()
+	schema := s.creataSchema(ts.URL)
+	exec, err := NewFunctionExecutor(schema)
+	s.NoError(err)
+	vector := &schemapb.FieldData{
+		Type:      schemapb.DataType_FloatVector,
+		FieldId:   102,
+		FieldName: "vector",
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  4,
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: make([]float32, 8)}},
+			},
+		},
+	}
+
+	// all outputs are stale
+	{
+		msg := s.createMsg([]string{"sentence", "sentence"})
+		msg.NumRows = 2
+		s.NoError(exec.ProcessUpsert(context.Background(), msg))
+		s.Equal(3, len(msg.FieldsData))
+	}
+
+	// the supplied output is recomputed since its input is supplied too
+	{
+		msg := s.createMsg([]string{"sentence", "sentence"})
+		msg.NumRows = 2
+		msg.FieldsData = append(msg.FieldsData, vector)
+		s.NoError(exec.ProcessUpsert(context.Background(), msg))
+		s.Equal(3, len(msg.FieldsData))
+		names := lo.Map(msg.FieldsData, func(field *schemapb.FieldData, _ int) string { return field.GetFieldName() })
+		s.ElementsMatch([]string{"text", "vector", "vector2"}, names)
+		for _, field := range msg.FieldsData {
+			s.NotSame(vector, field)
+		}
+	}
+
+	// the supplied output is kept if none of its inputs is supplied
+	{
+		vector2 := proto.Clone(vector).(*schemapb.FieldData)
+		vector2.FieldId, vector2.FieldName = 103, "vector2"
+		msg := s.createMsg([]string{})
+		msg.NumRows = 2
+		msg.FieldsData = []*schemapb.FieldData{vector, vector2}
+		s.NoError(exec.ProcessUpsert(context.Background(), msg))
+		s.Equal([]*schemapb.FieldData{vector, vector2}, msg.FieldsData)
+	}
+
+	// the input isn't supplied
+	{
+		msg := s.createMsg([]string{})
+		msg.NumRows = 2
+		msg.FieldsData = []*schemapb.FieldData{vector}
+		err := exec.ProcessUpsert(context.Background(), msg)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		s.ErrorContains(err, "input field [text] of function [test] is not supplied")
+	}
+
+	// the nullable input has null value
+	{
+		schema := s.creataSchema(ts.URL)
+		schema.Fields[1].Nullable = true
+		exec, err := NewFunctionExecutor(schema)
+		s.NoError(err)
+		msg := s.createMsg([]string{"sentence"})
+		msg.NumRows = 2
+		msg.FieldsData[0].ValidData = []bool{false, true}
+		err = exec.ProcessUpsert(context.Background(), msg)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		s.ErrorContains(err, "has null value")
+	}
+
+	// the null value and the input not supplied are computed as the default value
+	{
+		schema := s.creataSchema(ts.URL)
+		schema.Fields[1].Nullable = true
+		schema.Fields[1].DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "default"}}
+		exec, err := NewFunctionExecutor(schema)
+		s.NoError(err)
+		msg := s.createMsg([]string{"sentence"})
+		msg.NumRows = 2
+		msg.FieldsData[0].ValidData = []bool{false, true}
+		s.NoError(exec.ProcessUpsert(context.Background(), msg))
+		s.Equal(3, len(msg.FieldsData))
+		s.Equal([]string{"sentence"}, msg.FieldsData[0].GetScalars().GetStringData().GetData())
+
+		msg = s.createMsg([]string{})
+		msg.NumRows = 2
+		msg.FieldsData = []*schemapb.FieldData{}
+		s.NoError(exec.ProcessUpsert(context.Background(), msg))
+		s.Equal(2, len(msg.FieldsData))
+	}
+}
+
+func (s *FunctionExecutorSuite) TestFillFunctionInput() {
+	functionSchema := &schemapb.FunctionSchema{Name: "test"}
+	fieldSchema := &schemapb.FieldSchema{
+		Name: "text", DataType: schemapb.DataType_VarChar, Nullable: true,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "default"}},
+	}
+	field := s.createMsg([]string{"a", "b"}).FieldsData[0]
+	field.ValidData = []bool{true, false, true}
+	input, err := fillFunctionInput(functionSchema, fieldSchema, field, 3)
+	s.NoError(err)
+	s.Equal([]string{"a", "default", "b"}, input.GetScalars().GetStringData().GetData())
+	s.Equal([]string{"a", "b"}, field.GetScalars().GetStringData().GetData())
+
+	_, err = fillFunctionInput(functionSchema, fieldSchema, field, 2)
+	s.ErrorContains(err, "the length of valid_data of field(text) is wrong")
+
+	field.ValidData = []bool{true, true, true}
+	input, err = fillFunctionInput(functionSchema, fieldSchema, field, 3)
+	s.NoError(err)
+	s.Equal(field, input)
+}
+
 func (s *FunctionExecutorSuite) TestErrorEmbedding() {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var req openai.EmbeddingRequest
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/function/models/ali"
 	"github.com/milvus-io/milvus/internal/util/function/models/cohere"
+	"github.com/milvus-io/milvus/internal/util/function/models/ollama"
 	"github.com/milvus-io/milvus/internal/util/function/models/openai"
 	"github.com/milvus-io/milvus/internal/util/function/models/siliconflow"
 	"github.com/milvus-io/milvus/internal/util/function/models/tei"
@@ -238,6 +239,121 @@ func CreateTEIEmbeddingServer(dim int) *httptest.Server {
 	return ts
 }
 
+// CreateTEISparseEmbeddingServer creates a server serving the embed_sparse api, the i-th text gets i+1 non-zero dims.
+func CreateTEISparseEmbeddingServer() *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embed_sparse" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req tei.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		embs := make([][]tei.SparseValue, 0, len(req.Inputs))
+		for i := range req.Inputs {
+			emb := make([]tei.SparseValue, 0, i+1)
+			for j := 0; j <= i; j++ {
+				emb = append(emb, tei.SparseValue{Index: uint32(j * 10), Value: float32(j) + 0.5})
+			}
+			embs = append(embs, emb)
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(embs)
+		w.Write(data)
+	}))
+	return ts
+}
+
+func CreateOllamaEmbeddingServer(dim int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/embed" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req ollama.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		res := ollama.EmbeddingResponse{
+			Model:      req.Model,
+			Embeddings: mockEmbedding[float32](req.Input, dim),
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
+// CreateOpenAICompatibleEmbeddingServer creates a server serving a model of the dim,
+// which rejects the dimensions parameter if acceptDimensions is false.
+func CreateOpenAICompatibleEmbeddingServer(dim int, acceptDimensions bool) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		embDim := dim
+		if req.Dimensions != 0 {
+			if !acceptDimensions {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error": "This model does not support 'dimensions'"}`))
+				return
+			}
+			embDim = req.Dimensions
+		}
+		embs := mockEmbedding[float32](req.Input, embDim)
+		var res openai.EmbeddingResponse
+		res.Object = "list"
+		res.Model = req.Model
+		for i := 0; i < len(req.Input); i++ {
+			res.Data = append(res.Data, openai.EmbeddingData{
+				Object:    "embedding",
+				Embedding: embs[i],
+				Index:     i,
+			})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
+// CreateOpenAICompatibleImageEmbeddingServer creates a server serving a multimodal model of the dim,
+// the embeddings of the images are all ones, while the ones of the texts are the mock embeddings.
+func CreateOpenAICompatibleImageEmbeddingServer(dim int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []json.RawMessage `json:"input"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		var res openai.EmbeddingResponse
+		res.Object = "list"
+		for i, input := range req.Input {
+			emb := mockEmbedding[float32]([]string{""}, dim)[0]
+			var image openai.ImageInput
+			if json.Unmarshal(input, &image) == nil && image.Image != "" {
+				for j := range emb {
+					emb[j] = 1
+				}
+			}
+			res.Data = append(res.Data, openai.EmbeddingData{
+				Object:    "embedding",
+				Embedding: emb,
+				Index:     i,
+			})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
 type MockBedrockClient struct {
 	dim int
 }
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+type EmbeddingRequest struct {
+	// Name of the model to use.
+	Model string `json:"model"`
+
+	// Texts to embed.
+	Input []string `json:"input"`
+
+	// Truncates the end of each input to fit within the context length, returns an error if false and the context length is exceeded.
+	Truncate *bool `json:"truncate,omitempty"`
+
+	// How long the model stays loaded in memory following the request, e.g. "5m".
+	KeepAlive string `json:"keep_alive,omitempty"`
+
+	// The number of dimensions of the embeddings, only supported by the models trained with matryoshka representation.
+	Dimensions int `json:"dimensions,omitempty"`
+}
+
+type EmbeddingResponse struct {
+	Model      string      `json:"model"`
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+type OllamaEmbedding struct {
+	apiKey     string
+	url        string
+	authHeader string
+}
+
+// NewOllamaEmbeddingClient creates the client of the embed api of the ollama server listening on the endpoint,
+// the endpoint may carry the path prefix of the reverse proxy in front of the server, e.g. http://gateway/ollama.
+// The api key is optional and sent in the auth header, which is Authorization with the Bearer scheme by default.
+func NewOllamaEmbeddingClient(apiKey string, endpoint string, authHeader string) (*OllamaEmbedding, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if base.Host == "" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+
+	if !strings.HasSuffix(base.Path, "/api/embed") {
+		base.Path = strings.TrimSuffix(base.Path, "/") + "/api/embed"
+	}
+	if authHeader == "" {
+		authHeader = "Authorization"
+	}
+
+	return &OllamaEmbedding{
+		apiKey:     apiKey,
+		url:        base.String(),
+		authHeader: authHeader,
+	}, nil
+}
+
+func (c *OllamaEmbedding) Embedding(modelName string, texts []string, dim int, truncate *bool, keepAlive string, timeoutSec int64) (*EmbeddingResponse, error) {
+	r := EmbeddingRequest{
+		Model:      modelName,
+		Input:      texts,
+		Truncate:   truncate,
+		KeepAlive:  keepAlive,
+		Dimensions: dim,
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	// the ollama server has no authentication, the key is for the reverse proxy in front of it.
+	if c.apiKey != "" {
+		if strings.EqualFold(c.authHeader, "Authorization") {
+			headers[c.authHeader] = fmt.Sprintf("Bearer %s", c.apiKey)
+		} else {
+			headers[c.authHeader] = c.apiKey
+		}
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
+	if err != nil {
+		return nil, err
+	}
+	var res EmbeddingResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ollama
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingClientCheck(t *testing.T) {
+	{
+		c, err := NewOllamaEmbeddingClient("", "http://localhost:11434", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:11434/api/embed", c.url)
+	}
+
+	{
+		c, err := NewOllamaEmbeddingClient("", "http://gateway/ollama/", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://gateway/ollama/api/embed", c.url)
+	}
+
+	{
+		c, err := NewOllamaEmbeddingClient("", "http://gateway/ollama/api/embed", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://gateway/ollama/api/embed", c.url)
+	}
+
+	{
+		_, err := NewOllamaEmbeddingClient("", "mock", "")
+		assert.Error(t, err)
+	}
+
+	{
+		_, err := NewOllamaEmbeddingClient("", "http://", "")
+		assert.Error(t, err)
+	}
+}
+
+func TestEmbeddingOK(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/embed", r.URL.Path)
+		assert.Equal(t, "Bearer mock_key", r.Header.Get("Authorization"))
+		var req EmbeddingRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		assert.Equal(t, "5m", req.KeepAlive)
+		assert.Equal(t, 2, req.Dimensions)
+		data, _ := json.Marshal(EmbeddingResponse{Model: req.Model, Embeddings: [][]float32{{0.0, 0.1}, {1.0, 1.1}}})
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	c, _ := NewOllamaEmbeddingClient("mock_key", ts.URL, "")
+	ret, err := c.Embedding("nomic-embed-text", []string{"sentence1", "sentence2"}, 2, nil, "5m", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.0, 0.1}, {1.0, 1.1}}, ret.Embeddings)
+}
+
+func TestEmbeddingAuthHeader(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ollama/api/embed", r.URL.Path)
+		assert.Equal(t, "mock_key", r.Header.Get("X-Api-Key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		data, _ := json.Marshal(EmbeddingResponse{Embeddings: [][]float32{{0.0, 0.1}}})
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	c, _ := NewOllamaEmbeddingClient("mock_key", ts.URL+"/ollama", "X-Api-Key")
+	_, err := c.Embedding("nomic-embed-text", []string{"sentence"}, 0, nil, "", 0)
+	assert.NoError(t, err)
+}
+
+func TestEmbeddingFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c, _ := NewOllamaEmbeddingClient("", ts.URL, "")
+	_, err := c.Embedding("nomic-embed-text", []string{"sentence"}, 0, nil, "", 0)
+	assert.Error(t, err)
+}
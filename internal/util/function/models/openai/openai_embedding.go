@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -47,6 +48,29 @@ type EmbeddingRequest struct {
 	Dimensions int `json:"dimensions,omitempty"`
 }
 
+// ImageEmbeddingRequest embeds the images by the multimodal models, such as CLIP, served by the openai compatible services.
+type ImageEmbeddingRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+
+	// Input images to embed.
+	Input []ImageInput `json:"input"`
+
+	// A unique identifier representing your end-user.
+	User string `json:"user,omitempty"`
+
+	// The format to return the embeddings in. Can be either float or base64.
+	EncodingFormat string `json:"encoding_format,omitempty"`
+
+	// The number of dimensions the resulting output embeddings should have.
+	Dimensions int `json:"dimensions,omitempty"`
+}
+
+// ImageInput is an image to embed, which is the http/https url or the base64 data uri of the image.
+type ImageInput struct {
+	Image string `json:"image"`
+}
+
 type Usage struct {
 	// The number of tokens used by the prompt.
 	PromptTokens int `json:"prompt_tokens"`
@@ -137,7 +161,10 @@ func (c *openAIBase) genReq(modelName string, texts []string, dim int, user stri
 }
 
 func (c *openAIBase) embedding(url string, headers map[string]string, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
-	r := c.genReq(modelName, texts, dim, user)
+	return c.send(url, headers, c.genReq(modelName, texts, dim, user), timeoutSec)
+}
+
+func (c *openAIBase) send(url string, headers map[string]string, r any, timeoutSec int64) (*EmbeddingResponse, error) {
 	data, err := json.Marshal(r)
 	if err != nil {
 		return nil, err
@@ -216,3 +243,64 @@ func (c *AzureOpenAIEmbeddingClient) Embedding(modelName string, texts []string,
 	}
 	return c.embedding(url, headers, modelName, texts, dim, user, timeoutSec)
 }
+
+// OpenAICompatibleEmbeddingClient calls the embeddings api of the services compatible with openai, such as vllm,
+// the api key is optional and sent in the auth header, which is Authorization with the Bearer scheme by default.
+type OpenAICompatibleEmbeddingClient struct {
+	openAIBase
+	authHeader string
+}
+
+func NewOpenAICompatibleEmbeddingClient(apiKey string, url string, authHeader string) *OpenAICompatibleEmbeddingClient {
+	if authHeader == "" {
+		authHeader = "Authorization"
+	}
+	return &OpenAICompatibleEmbeddingClient{
+		openAIBase: openAIBase{
+			apiKey: apiKey,
+			url:    url,
+		},
+		authHeader: authHeader,
+	}
+}
+
+func (c *OpenAICompatibleEmbeddingClient) Check() error {
+	if c.url == "" {
+		return errors.New("url is empty")
+	}
+	return nil
+}
+
+func (c *OpenAICompatibleEmbeddingClient) headers() map[string]string {
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	if c.apiKey != "" {
+		if strings.EqualFold(c.authHeader, "Authorization") {
+			headers[c.authHeader] = fmt.Sprintf("Bearer %s", c.apiKey)
+		} else {
+			headers[c.authHeader] = c.apiKey
+		}
+	}
+	return headers
+}
+
+func (c *OpenAICompatibleEmbeddingClient) Embedding(modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+	return c.embedding(c.url, c.headers(), modelName, texts, dim, user, timeoutSec)
+}
+
+// ImageEmbedding embeds the images by the multimodal model, each image is sent as an object {"image": <url or data uri>}
+// in the input, which is the format of the multimodal embeddings api of the compatible services.
+func (c *OpenAICompatibleEmbeddingClient) ImageEmbedding(modelName string, images []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+	r := ImageEmbeddingRequest{
+		Model:          modelName,
+		Input:          make([]ImageInput, 0, len(images)),
+		User:           user,
+		EncodingFormat: "float",
+		Dimensions:     dim,
+	}
+	for _, image := range images {
+		r.Input = append(r.Input, ImageInput{Image: image})
+	}
+	return c.send(c.url, c.headers(), &r, timeoutSec)
+}
@@ -19,6 +19,7 @@ package openai
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"sync/atomic"
@@ -218,8 +219,8 @@ func TestEmbeddingFailed(t *testing.T) {
 func TestTimeout(t *testing.T) {
 	var st int32 = 0
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// (Timeout 1s + 2s + 4s + Wait 1s * 3)
-		time.Sleep(11 * time.Second)
+		// the client stops retrying once its timeout 1s is reached, without waiting for the backoff
+		time.Sleep(3 * time.Second)
 		atomic.AddInt32(&st, 1)
 		w.WriteHeader(http.StatusUnauthorized)
 	}))
@@ -251,3 +252,70 @@ func TestTimeout(t *testing.T) {
 		assert.Equal(t, atomic.LoadInt32(&st), int32(1))
 	}
 }
+
+func TestCompatibleEmbeddingHeader(t *testing.T) {
+	{
+		c := NewOpenAICompatibleEmbeddingClient("", "", "")
+		assert.Error(t, c.Check())
+	}
+
+	var auth, apiKey atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth.Store(r.Header.Get("Authorization"))
+		apiKey.Store(r.Header.Get("X-Api-Key"))
+		res := EmbeddingResponse{Data: []EmbeddingData{{Embedding: []float32{1.1, 2.2}, Index: 0}}}
+		data, _ := json.Marshal(res)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	{
+		c := NewOpenAICompatibleEmbeddingClient("mock_key", ts.URL, "")
+		assert.NoError(t, c.Check())
+		_, err := c.Embedding("mock_model", []string{"sentence"}, 0, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer mock_key", auth.Load())
+	}
+
+	{
+		c := NewOpenAICompatibleEmbeddingClient("mock_key", ts.URL, "X-Api-Key")
+		_, err := c.Embedding("mock_model", []string{"sentence"}, 0, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "", auth.Load())
+		assert.Equal(t, "mock_key", apiKey.Load())
+	}
+
+	{
+		// no key is sent without the api key
+		c := NewOpenAICompatibleEmbeddingClient("", ts.URL, "")
+		_, err := c.Embedding("mock_model", []string{"sentence"}, 0, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "", auth.Load())
+	}
+}
+
+func TestCompatibleImageEmbedding(t *testing.T) {
+	var body atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body.Store(data)
+		res := EmbeddingResponse{Data: []EmbeddingData{{Embedding: []float32{2.2}, Index: 1}, {Embedding: []float32{1.1}, Index: 0}}}
+		data, _ = json.Marshal(res)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	c := NewOpenAICompatibleEmbeddingClient("mock_key", ts.URL, "")
+	resp, err := c.ImageEmbedding("mock_model", []string{"https://example.com/a.png", "data:image/png;base64,AAAA"}, 0, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1.1}, resp.Data[0].Embedding)
+	assert.Equal(t, []float32{2.2}, resp.Data[1].Embedding)
+
+	var req ImageEmbeddingRequest
+	assert.NoError(t, json.Unmarshal(body.Load().([]byte), &req))
+	assert.Equal(t, "mock_model", req.Model)
+	assert.Equal(t, []ImageInput{{Image: "https://example.com/a.png"}, {Image: "data:image/png;base64,AAAA"}}, req.Input)
+	assert.Equal(t, 0, req.Dimensions)
+}
@@ -34,9 +34,16 @@ type EmbeddingRequest struct {
 	PromptName          string   `json:"prompt_name,omitempty"`
 }
 
+// SparseValue is a non-zero dimension of the sparse embedding returned by the embed_sparse api.
+type SparseValue struct {
+	Index uint32  `json:"index"`
+	Value float32 `json:"value"`
+}
+
 type TEIEmbedding struct {
-	apiKey string
-	url    string
+	apiKey    string
+	url       string
+	sparseURL string
 }
 
 func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error) {
@@ -52,14 +59,43 @@ func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error
 	}
 
 	base.Path = "/embed"
+	embedURL := base.String()
+	base.Path = "/embed_sparse"
 
 	return &TEIEmbedding{
-		apiKey: apiKey,
-		url:    base.String(),
+		apiKey:    apiKey,
+		url:       embedURL,
+		sparseURL: base.String(),
 	}, nil
 }
 
 func (c *TEIEmbedding) Embedding(texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([][]float32, error) {
+	body, err := c.send(c.url, texts, truncate, truncationDirection, prompt, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res [][]float32
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, err
+}
+
+// SparseEmbedding calls the embed_sparse api of the TEI server serving a sparse model, such as SPLADE.
+func (c *TEIEmbedding) SparseEmbedding(texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([][]SparseValue, error) {
+	body, err := c.send(c.sparseURL, texts, truncate, truncationDirection, prompt, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res [][]SparseValue
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *TEIEmbedding) send(url string, texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([]byte, error) {
 	var r EmbeddingRequest
 	if prompt != "" {
 		var newTexts []string
@@ -93,14 +129,5 @@ func (c *TEIEmbedding) Embedding(texts []string, truncate bool, truncationDirect
 	if c.apiKey != "" {
 		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
 	}
-	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
-	if err != nil {
-		return nil, err
-	}
-	var res [][]float32
-	err = json.Unmarshal(body, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, err
+	return utils.RetrySend(ctx, data, http.MethodPost, url, headers, 3)
 }
@@ -91,3 +91,17 @@ func TestEmbeddingFailed(t *testing.T) {
 		assert.True(t, err != nil)
 	}
 }
+
+func TestSparseEmbedding(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/embed_sparse", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[[{"index": 1, "value": 0.5}, {"index": 100, "value": 1.5}], [{"index": 2, "value": 0.1}]]`))
+	}))
+	defer ts.Close()
+
+	c, _ := NewTEIEmbeddingClient("mock_key", ts.URL)
+	ret, err := c.SparseEmbedding([]string{"sentence1", "sentence2"}, false, "", "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]SparseValue{{{Index: 1, Value: 0.5}, {Index: 100, Value: 1.5}}, {{Index: 2, Value: 0.1}}}, ret)
+}
@@ -41,11 +41,22 @@ func send(req *http.Request) ([]byte, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Call service failed, errs:[%s, %s]", resp.Status, body)
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
 	}
 	return body, nil
 }
 
+// HTTPError is the error of the service responding with a non-200 status.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("Call service failed, errs:[%s, %s]", e.Status, e.Body)
+}
+
 func RetrySend(ctx context.Context, data []byte, httpMethod string, url string, headers map[string]string, maxRetries int) ([]byte, error) {
 	var err error
 	var body []byte
@@ -63,7 +74,12 @@ func RetrySend(ctx context.Context, data []byte, httpMethod string, url string,
 		}
 		backoffDelay := 1 << uint(i) * time.Second
 		jitter := time.Duration(rand.Int63n(int64(backoffDelay / 4)))
-		time.Sleep(backoffDelay + jitter)
+		// stop backing off once the ctx is done, the next request would fail anyway.
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoffDelay + jitter):
+		}
 	}
 	return nil, err
 }
@@ -0,0 +1,305 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// the providers able to embed the images by multimodal models, the tei provider works with the CLIP servers
+// compatible with the embed api of TEI.
+var multimodalEmbeddingProviders = []string{openAICompatibleProvider, teiProvider}
+
+// checkImage is the 1x1 png embedded by Check, to make sure the model of the provider accepts the images.
+const checkImage = "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mP8z8BQDwAEhQGAhKmMIQAAAABJRU5ErkJggg=="
+
+func MultimodalEmbeddingOutputsCheck(fields []*schemapb.FieldSchema) error {
+	if len(fields) != 1 || fields[0].DataType != schemapb.DataType_FloatVector {
+		return errors.New("MultimodalEmbedding function output field must be a FloatVector field")
+	}
+	return nil
+}
+
+// Multimodal embedding for text-to-image retrieval, the images are embedded on insert,
+// while the queries are texts embedded by the text encoder of the same model.
+type multimodalEmbeddingProvider interface {
+	textEmbeddingProvider
+	CallImageEmbedding(images []string) ([][]float32, error)
+}
+
+// MultimodalEmbeddingFunction embeds the images of the input field, which are:
+//   - the http/https urls, the base64 data uris or the base64 encoded images in a VARCHAR/TEXT field.
+//   - the encoded images in a BinaryVector field, each row is padded with zeros to the dim of the field.
+type MultimodalEmbeddingFunction struct {
+	FunctionBase
+
+	embProvider multimodalEmbeddingProvider
+}
+
+func NewMultimodalEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*MultimodalEmbeddingFunction, error) {
+	if len(functionSchema.GetOutputFieldNames()) != 1 {
+		return nil, fmt.Errorf("Multimodal function should only have one output field, but now is %d", len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base, err := NewFunctionBase(coll, functionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := MultimodalEmbeddingOutputsCheck(base.outputFields); err != nil {
+		return nil, err
+	}
+
+	var embP multimodalEmbeddingProvider
+	var newProviderErr error
+	conf := paramtable.Get().FunctionCfg.GetTextEmbeddingProviderConfig(base.provider)
+	credentials := credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials())
+	switch base.provider {
+	case teiProvider:
+		embP, newProviderErr = NewTEIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case openAICompatibleProvider:
+		embP, newProviderErr = NewOpenAICompatibleEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	default:
+		return nil, fmt.Errorf("Unsupported multimodal embedding service provider: [%s] , list of supported %v", base.provider, multimodalEmbeddingProviders)
+	}
+
+	if newProviderErr != nil {
+		return nil, newProviderErr
+	}
+	return &MultimodalEmbeddingFunction{
+		FunctionBase: *base,
+		embProvider:  embP,
+	}, nil
+}
+
+// Check embeds both an image and a text, the image encoder and the text encoder must have the dim of the output field.
+func (runner *MultimodalEmbeddingFunction) Check() error {
+	images, err := runner.embProvider.CallImageEmbedding([]string{checkImage})
+	if err != nil {
+		return err
+	}
+	texts, err := runner.embProvider.CallEmbedding([]string{"check"}, SearchMode)
+	if err != nil {
+		return err
+	}
+	for _, embds := range [][][]float32{images, texts.([][]float32)} {
+		if len(embds) != 1 {
+			return fmt.Errorf("The number of inputs and embeddings does not match input:[1], embedding:[%d]", len(embds))
+		}
+		if len(embds[0]) != int(runner.embProvider.FieldDim()) {
+			return fmt.Errorf("The dim set in the schema is inconsistent with the dim of the model, dim in schema is %d, dim of model is %d", runner.embProvider.FieldDim(), len(embds[0]))
+		}
+	}
+	return nil
+}
+
+func (runner *MultimodalEmbeddingFunction) MaxBatch() int {
+	return runner.embProvider.MaxBatch()
+}
+
+func (runner *MultimodalEmbeddingFunction) GetCollectionName() string {
+	return runner.collectionName
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionProvider() string {
+	return runner.provider
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionTypeName() string {
+	return runner.functionTypeName
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionName() string {
+	return runner.functionName
+}
+
+// encodeImage returns the base64 data uri of the encoded image, the format is detected from its content.
+func encodeImage(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", fmt.Errorf("The input is not an image, the detected content type is %s", contentType)
+	}
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// textImages returns the images sent to the provider, the urls and the data uris are sent as is,
+// the others are decoded as the base64 encoded images.
+func textImages(texts []string) ([]string, error) {
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the input data, MultimodalEmbedding function does not support empty image")
+	}
+	images := make([]string, 0, len(texts))
+	for i, text := range texts {
+		if strings.HasPrefix(text, "http://") || strings.HasPrefix(text, "https://") ||
+			(strings.HasPrefix(text, "data:image/") && strings.Contains(text, ";base64,")) {
+			images = append(images, text)
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("The image input of row [%d] should be a http/https url, a base64 data uri or a base64 encoded image", i)
+		}
+		image, err := encodeImage(data)
+		if err != nil {
+			return nil, fmt.Errorf("The image input of row [%d] is invalid, err: %w", i, err)
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// binaryImages returns the data uris of the encoded images of the rows of the binary vector field.
+func binaryImages(data []byte, dim int) ([]string, error) {
+	rowBytes := dim / 8
+	if rowBytes == 0 || len(data)%rowBytes != 0 {
+		return nil, fmt.Errorf("The binary image input of %d bytes doesn't match the dim %d", len(data), dim)
+	}
+	images := make([]string, 0, len(data)/rowBytes)
+	for i := 0; i < len(data); i += rowBytes {
+		image, err := encodeImage(data[i : i+rowBytes])
+		if err != nil {
+			return nil, fmt.Errorf("The image input of row [%d] is invalid, err: %w", i/rowBytes, err)
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+func (runner *MultimodalEmbeddingFunction) embedImages(images []string) ([][]float32, error) {
+	numRows := len(images)
+	if numRows > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
+	}
+	return runner.embProvider.CallImageEmbedding(images)
+}
+
+func (runner *MultimodalEmbeddingFunction) ProcessInsert(ctx context.Context, inputs []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("Multimodal embedding function only receives one input field, but got [%d]", len(inputs))
+	}
+
+	var images []string
+	var err error
+	switch inputs[0].GetType() {
+	case schemapb.DataType_VarChar, schemapb.DataType_Text:
+		images, err = textImages(inputs[0].GetScalars().GetStringData().GetData())
+	case schemapb.DataType_BinaryVector:
+		images, err = binaryImages(inputs[0].GetVectors().GetBinaryVector(), int(inputs[0].GetVectors().GetDim()))
+	default:
+		return nil, fmt.Errorf("Multimodal embedding only supports varchar, text or binary vector field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].GetType())])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(images) == 0 {
+		return nil, errors.New("Input images is empty")
+	}
+
+	embds, err := runner.embedImages(images)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]float32, 0, len(embds)*int(runner.embProvider.FieldDim()))
+	for _, emb := range embds {
+		data = append(data, emb...)
+	}
+	outputField := runner.GetOutputFields()[0]
+	return []*schemapb.FieldData{{
+		FieldId:   outputField.FieldID,
+		FieldName: outputField.Name,
+		Type:      outputField.DataType,
+		IsDynamic: outputField.IsDynamic,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Data: &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{
+						Data: data,
+					},
+				},
+				Dim: runner.embProvider.FieldDim(),
+			},
+		},
+	}}, nil
+}
+
+func (runner *MultimodalEmbeddingFunction) ProcessSearch(ctx context.Context, placeholderGroup *commonpb.PlaceholderGroup) (*commonpb.PlaceholderGroup, error) {
+	texts := funcutil.GetVarCharFromPlaceholder(placeholderGroup.Placeholders[0]) // Already checked externally
+	numRows := len(texts)
+	if numRows > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
+	}
+	// make sure all texts are not empty
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the queries, MultimodalEmbedding function does not support empty text")
+	}
+	embds, err := runner.embProvider.CallEmbedding(texts, SearchMode)
+	if err != nil {
+		return nil, err
+	}
+	return funcutil.Float32VectorsToPlaceholderGroup(embds.([][]float32)), nil
+}
+
+func (runner *MultimodalEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldData) (map[storage.FieldID]storage.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("MultimodalEmbedding function only receives one input, bug got [%d]", len(inputs))
+	}
+
+	var images []string
+	var err error
+	switch input := inputs[0].(type) {
+	case *storage.StringFieldData:
+		// In storage.FieldData, null is also stored as an empty string
+		images, err = textImages(input.Data)
+	case *storage.BinaryVectorFieldData:
+		images, err = binaryImages(input.Data, input.Dim)
+	default:
+		return nil, fmt.Errorf("MultimodalEmbedding function only supports varchar, text or binary vector field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].GetDataType())])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	embds, err := runner.embProvider.CallImageEmbedding(images)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]float32, 0, len(embds)*int(runner.embProvider.FieldDim()))
+	for _, emb := range embds {
+		data = append(data, emb...)
+	}
+	return map[storage.FieldID]storage.FieldData{
+		runner.outputFields[0].FieldID: &storage.FloatVectorFieldData{
+			Data: data,
+			Dim:  int(runner.embProvider.FieldDim()),
+		},
+	}, nil
+}
@@ -0,0 +1,247 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestMultimodalEmbeddingFunction(t *testing.T) {
+	suite.Run(t, new(MultimodalEmbeddingFunctionSuite))
+}
+
+type MultimodalEmbeddingFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	png    []byte
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) SetupTest() {
+	paramtable.Init()
+	var err error
+	s.png, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(checkImage, "data:image/png;base64,"))
+	s.Require().NoError(err)
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "image_url", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{
+				FieldID: 103, Name: "image", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "1024"},
+				},
+			},
+		},
+	}
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) functionSchema(provider string, url string, input string) *schemapb.FunctionSchema {
+	inputID := s.schema.Fields[1].FieldID
+	if input == s.schema.Fields[3].Name {
+		inputID = s.schema.Fields[3].FieldID
+	}
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             typeutil.FunctionTypeMultimodalEmbedding,
+		InputFieldNames:  []string{input},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{inputID},
+		OutputFieldIds:   []int64{102},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: provider},
+			{Key: modelNameParamKey, Value: TestModel},
+			{Key: EndpointParamKey, Value: url},
+		},
+	}
+}
+
+// binaryImage pads the png with zeros to the dim of the binary vector field.
+func (s *MultimodalEmbeddingFunctionSuite) binaryImage() []byte {
+	row := make([]byte, 1024/8)
+	copy(row, s.png)
+	return row
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestNewMultimodalEmbeddingFunction() {
+	ts := CreateOpenAICompatibleImageEmbeddingServer(4)
+	defer ts.Close()
+
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+	s.NoError(err)
+	s.Equal("MultimodalEmbedding", runner.GetFunctionTypeName())
+	s.Equal(openAICompatibleProvider, runner.GetFunctionProvider())
+
+	// only the openai compatible and tei providers support image input
+	_, err = NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAIProvider, ts.URL, "image_url"))
+	s.Error(err)
+
+	// only the float vector output is supported
+	fSchema := s.functionSchema(openAICompatibleProvider, ts.URL, "image_url")
+	fSchema.OutputFieldNames = []string{"image"}
+	_, err = NewMultimodalEmbeddingFunction(s.schema, fSchema)
+	s.Error(err)
+
+	s.NoError(MultimodalEmbeddingOutputsCheck(s.schema.Fields[2:3]))
+	s.Error(MultimodalEmbeddingOutputsCheck(s.schema.Fields[3:4]))
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestCheck() {
+	{
+		ts := CreateOpenAICompatibleImageEmbeddingServer(4)
+		defer ts.Close()
+		runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+		s.NoError(err)
+		s.NoError(runner.Check())
+	}
+	{
+		ts := CreateTEIEmbeddingServer(4)
+		defer ts.Close()
+		runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(teiProvider, ts.URL, "image"))
+		s.NoError(err)
+		s.NoError(runner.Check())
+	}
+	{
+		ts := CreateOpenAICompatibleImageEmbeddingServer(3)
+		defer ts.Close()
+		runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+		s.NoError(err)
+		s.Error(runner.Check())
+	}
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestProcessInsert() {
+	ts := CreateOpenAICompatibleImageEmbeddingServer(4)
+	defer ts.Close()
+
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+	s.NoError(err)
+
+	// the urls, the data uris and the base64 encoded images
+	images := []string{"https://example.com/1.png", checkImage, base64.StdEncoding.EncodeToString(s.png)}
+	ret, err := runner.ProcessInsert(context.Background(), createData(images))
+	s.NoError(err)
+	s.Equal(int64(102), ret[0].GetFieldId())
+	s.Equal(filledEmbeddings(1, 3), ret[0].GetVectors().GetFloatVector().GetData())
+
+	for _, invalid := range [][]string{{"not an image"}, {base64.StdEncoding.EncodeToString([]byte("text"))}, {""}} {
+		_, err = runner.ProcessInsert(context.Background(), createData(invalid))
+		s.Error(err)
+	}
+
+	// the encoded images in the binary vector field
+	binary := &schemapb.FieldData{
+		Type:    schemapb.DataType_BinaryVector,
+		FieldId: 103,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  1024,
+				Data: &schemapb.VectorField_BinaryVector{BinaryVector: append(s.binaryImage(), s.binaryImage()...)},
+			},
+		},
+	}
+	ret, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{binary})
+	s.NoError(err)
+	s.Equal(filledEmbeddings(1, 2), ret[0].GetVectors().GetFloatVector().GetData())
+
+	binary.GetVectors().Data = &schemapb.VectorField_BinaryVector{BinaryVector: make([]byte, 1024/8)}
+	_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{binary})
+	s.Error(err)
+
+	_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{{Type: schemapb.DataType_Int64}})
+	s.Error(err)
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestProcessSearch() {
+	ts := CreateOpenAICompatibleImageEmbeddingServer(4)
+	defer ts.Close()
+
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+	s.NoError(err)
+
+	// the queries are embedded as texts
+	f := &schemapb.FieldData{
+		Type:    schemapb.DataType_VarChar,
+		FieldId: 101,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: []string{"a red car"}},
+				},
+			},
+		},
+	}
+	placeholderGroupBytes, err := funcutil.FieldDataToPlaceholderGroupBytes(f)
+	s.NoError(err)
+	placeholderGroup := commonpb.PlaceholderGroup{}
+	proto.Unmarshal(placeholderGroupBytes, &placeholderGroup)
+	ret, err := runner.ProcessSearch(context.Background(), &placeholderGroup)
+	s.NoError(err)
+	s.Equal(funcutil.Float32VectorsToPlaceholderGroup([][]float32{{0, 1, 2, 3}}).GetPlaceholders()[0].GetValues(), ret.GetPlaceholders()[0].GetValues())
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestProcessBulkInsert() {
+	ts := CreateOpenAICompatibleImageEmbeddingServer(4)
+	defer ts.Close()
+
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, s.functionSchema(openAICompatibleProvider, ts.URL, "image_url"))
+	s.NoError(err)
+
+	ret, err := runner.ProcessBulkInsert([]storage.FieldData{&storage.StringFieldData{Data: []string{"https://example.com/1.png", checkImage}}})
+	s.NoError(err)
+	s.Equal(filledEmbeddings(1, 2), ret[102].GetDataRows())
+
+	ret, err = runner.ProcessBulkInsert([]storage.FieldData{&storage.BinaryVectorFieldData{Data: s.binaryImage(), Dim: 1024}})
+	s.NoError(err)
+	s.Equal(filledEmbeddings(1, 1), ret[102].GetDataRows())
+
+	_, err = runner.ProcessBulkInsert([]storage.FieldData{&storage.StringFieldData{Data: []string{"ftp://example.com/1.png"}}})
+	s.Error(err)
+
+	_, err = runner.ProcessBulkInsert([]storage.FieldData{&storage.Int64FieldData{Data: []int64{1}}})
+	s.Error(err)
+}
+
+// lo4 returns the rows of the embeddings of dim 4 filled with the value.
+func filledEmbeddings(value float32, rows int) []float32 {
+	data := make([]float32, 0, rows*4)
+	for i := 0; i < rows*4; i++ {
+		data = append(data, value)
+	}
+	return data
+}
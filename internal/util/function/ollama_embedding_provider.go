@@ -0,0 +1,189 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+package function
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/ollama"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const defaultOllamaEndpoint string = "http://localhost:11434"
+
+type OllamaEmbeddingProvider struct {
+	fieldDim int64
+
+	client        *ollama.OllamaEmbedding
+	modelName     string
+	embedDimParam int64
+	truncate      *bool
+	keepAlive     string
+	// the dimensions parameter is only supported by the matryoshka models,
+	// the first request carrying it falls back to the native dimension of the model if it's rejected.
+	dimensionsKey string
+
+	ingestionPrompt string
+	searchPrompt    string
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+func createOllamaEmbeddingClient(apiKey string, endpoint string, authHeader string, confParams map[string]string) (*ollama.OllamaEmbedding, error) {
+	if !isProviderEnabled(confParams, EnableOllamaEnvStr) {
+		return nil, errors.New("Ollama model serving is not enabled")
+	}
+	if endpoint == "" {
+		endpoint = defaultOllamaEndpoint
+	}
+	return ollama.NewOllamaEmbeddingClient(apiKey, endpoint, authHeader)
+}
+
+func NewOllamaEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*OllamaEmbeddingProvider, error) {
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	var modelName, endpoint, authHeader, keepAlive, ingestionPrompt, searchPrompt string
+	var truncate *bool
+	var dim int64
+	maxBatch := 32
+
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case dimParamKey:
+			dim, err = parseAndCheckFieldDim(param.Value, fieldDim, fieldSchema.Name)
+			if err != nil {
+				return nil, err
+			}
+		case EndpointParamKey:
+			endpoint = param.Value
+		case authHeaderParamKey:
+			authHeader = param.Value
+		case keepAliveParamKey:
+			keepAlive = param.Value
+		case ingestionPromptParamKey:
+			ingestionPrompt = param.Value
+		case searchPromptParamKey:
+			searchPrompt = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid number", maxClientBatchSizeParamKey, param.Value)
+			}
+		case truncateParamKey:
+			t, err := strconv.ParseBool(param.Value)
+			if err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", truncateParamKey, param.Value)
+			}
+			truncate = &t
+		default:
+		}
+	}
+	if modelName == "" {
+		return nil, fmt.Errorf("[%s] is required by the ollama provider", modelNameParamKey)
+	}
+
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, ollamaAKEnvStr)
+	if err != nil {
+		return nil, err
+	}
+	// the endpoint in function params > the url in milvus.yaml
+	if endpoint == "" {
+		endpoint = url
+	}
+	if authHeader == "" {
+		authHeader = params[authHeaderParamKey]
+	}
+	c, err := createOllamaEmbeddingClient(apiKey, endpoint, authHeader, params)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := OllamaEmbeddingProvider{
+		client:          c,
+		fieldDim:        fieldDim,
+		modelName:       modelName,
+		embedDimParam:   dim,
+		dimensionsKey:   endpoint + "#" + modelName,
+		truncate:        truncate,
+		keepAlive:       keepAlive,
+		ingestionPrompt: ingestionPrompt,
+		searchPrompt:    searchPrompt,
+		maxBatch:        maxBatch,
+		timeoutSec:      30,
+	}
+	return &provider, nil
+}
+
+func (provider *OllamaEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *OllamaEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *OllamaEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	prompt := provider.searchPrompt
+	if mode == InsertMode {
+		prompt = provider.ingestionPrompt
+	}
+	if prompt != "" {
+		prompted := make([]string, 0, len(texts))
+		for _, text := range texts {
+			prompted = append(prompted, prompt+text)
+		}
+		texts = prompted
+	}
+
+	numRows := len(texts)
+	data := make([][]float32, 0, numRows)
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		batch := texts[i:end]
+		resp, err := embeddingWithDimensions(provider.dimensionsKey, provider.modelName, provider.embedDimParam, func(dim int) (*ollama.EmbeddingResponse, error) {
+			return provider.client.Embedding(provider.modelName, batch, dim, provider.truncate, provider.keepAlive, provider.timeoutSec)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp.Embeddings) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(resp.Embeddings))
+		}
+		for _, item := range resp.Embeddings {
+			if len(item) != int(provider.fieldDim) {
+				return nil, fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+					provider.fieldDim, len(item))
+			}
+			data = append(data, item)
+		}
+	}
+	return data, nil
+}
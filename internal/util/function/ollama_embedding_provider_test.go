@@ -0,0 +1,223 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+package function
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/ollama"
+)
+
+func TestOllamaTextEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OllamaTextEmbeddingProviderSuite))
+}
+
+type OllamaTextEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func createOllamaProvider(url string, schema *schemapb.FieldSchema, extraParams ...*commonpb.KeyValuePair) (*OllamaEmbeddingProvider, error) {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: append([]*commonpb.KeyValuePair{
+			{Key: Provider, Value: ollamaProvider},
+			{Key: modelNameParamKey, Value: "nomic-embed-text"},
+			{Key: EndpointParamKey, Value: url},
+		}, extraParams...),
+	}
+	return NewOllamaEmbeddingProvider(schema, functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) TestEmbedding() {
+	ts := CreateOllamaEmbeddingServer(4)
+	defer ts.Close()
+
+	provider, err := createOllamaProvider(ts.URL, s.schema.Fields[2])
+	s.NoError(err)
+	{
+		r, err := provider.CallEmbedding([]string{"sentence"}, InsertMode)
+		s.NoError(err)
+		ret := r.([][]float32)
+		s.Equal(1, len(ret))
+		s.Equal(4, len(ret[0]))
+	}
+	{
+		r, err := provider.CallEmbedding([]string{"sentence 1", "sentence 2", "sentence 3"}, SearchMode)
+		s.NoError(err)
+		s.Equal(3, len(r.([][]float32)))
+	}
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) TestEmbeddingBatchAndPrompt() {
+	var requests atomic.Int32
+	var inputs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req ollama.EmbeddingRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		inputs = append(inputs, req.Input...)
+		s.Equal("10m", req.KeepAlive)
+		s.True(*req.Truncate)
+		data, _ := json.Marshal(ollama.EmbeddingResponse{Model: req.Model, Embeddings: mockEmbedding[float32](req.Input, 4)})
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	provider, err := createOllamaProvider(ts.URL, s.schema.Fields[2],
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+		&commonpb.KeyValuePair{Key: keepAliveParamKey, Value: "10m"},
+		&commonpb.KeyValuePair{Key: truncateParamKey, Value: "true"},
+		&commonpb.KeyValuePair{Key: ingestionPromptParamKey, Value: "search_document: "},
+		&commonpb.KeyValuePair{Key: searchPromptParamKey, Value: "search_query: "},
+	)
+	s.NoError(err)
+	s.Equal(10, provider.MaxBatch())
+
+	r, err := provider.CallEmbedding([]string{"a", "b", "c", "d", "e"}, InsertMode)
+	s.NoError(err)
+	s.Equal(5, len(r.([][]float32)))
+	s.Equal(int32(3), requests.Load())
+	s.Equal("search_document: a", inputs[0])
+
+	_, err = provider.CallEmbedding([]string{"a"}, SearchMode)
+	s.NoError(err)
+	s.Equal("search_query: a", inputs[5])
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) TestEmbeddingDimensionsAndAuthHeader() {
+	var dims []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/gateway/api/embed", r.URL.Path)
+		s.Equal("mock_key", r.Header.Get("X-Api-Key"))
+		var req ollama.EmbeddingRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		dims = append(dims, req.Dimensions)
+		// the model doesn't support the dimensions parameter.
+		if req.Dimensions != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"this model does not support dimensions"}`))
+			return
+		}
+		data, _ := json.Marshal(ollama.EmbeddingResponse{Model: req.Model, Embeddings: mockEmbedding[float32](req.Input, 4)})
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	os.Setenv(ollamaAKEnvStr, "mock_key")
+	defer os.Unsetenv(ollamaAKEnvStr)
+	provider, err := createOllamaProvider(ts.URL+"/gateway", s.schema.Fields[2],
+		&commonpb.KeyValuePair{Key: dimParamKey, Value: "4"},
+		&commonpb.KeyValuePair{Key: authHeaderParamKey, Value: "X-Api-Key"},
+	)
+	s.NoError(err)
+	for i := 0; i < 2; i++ {
+		r, err := provider.CallEmbedding([]string{"sentence"}, InsertMode)
+		s.NoError(err)
+		s.Equal(1, len(r.([][]float32)))
+	}
+	// the rejected dimensions parameter is not sent again.
+	s.Equal([]int{4, 0, 0}, dims)
+	s.Equal(dimensionsRejected, dimensionsState(provider.dimensionsKey))
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
+	ts := CreateOllamaEmbeddingServer(3)
+	defer ts.Close()
+
+	provider, err := createOllamaProvider(ts.URL, s.schema.Fields[2])
+	s.NoError(err)
+	_, err = provider.CallEmbedding([]string{"sentence"}, InsertMode)
+	s.Error(err)
+}
+
+func (s *OllamaTextEmbeddingProviderSuite) TestNewOllamaEmbeddingProvider() {
+	// the default endpoint
+	provider, err := createOllamaProvider("", s.schema.Fields[2])
+	s.NoError(err)
+	s.Equal(int64(4), provider.FieldDim())
+	s.Equal(32*5, provider.MaxBatch())
+
+	_, err = createOllamaProvider("mock", s.schema.Fields[2])
+	s.Error(err)
+
+	_, err = createOllamaProvider("", s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "8"})
+	s.Error(err)
+
+	_, err = createOllamaProvider("", s.schema.Fields[2], &commonpb.KeyValuePair{Key: truncateParamKey, Value: "Invalid"})
+	s.Error(err)
+
+	_, err = createOllamaProvider("", s.schema.Fields[2], &commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "0"})
+	s.Error(err)
+
+	// model name is required
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		Params:           []*commonpb.KeyValuePair{{Key: Provider, Value: ollamaProvider}},
+	}
+	_, err = NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.Error(err)
+
+	// disabled
+	functionSchema.Params = append(functionSchema.Params, &commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"})
+	_, err = NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{"enable": "false"}, credentials.NewCredentials(map[string]string{}))
+	s.Error(err)
+	os.Setenv(EnableOllamaEnvStr, "false")
+	defer os.Unsetenv(EnableOllamaEnvStr)
+	_, err = NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.Error(err)
+	// milvus.yaml > env
+	_, err = NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{"enable": "true"}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+}
@@ -0,0 +1,245 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+package function
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/openai"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// the states of the dimensions parameter negotiated with the service.
+const (
+	dimensionsUnknown int32 = iota
+	dimensionsAccepted
+	dimensionsRejected
+)
+
+// negotiatedDimensions is the state of the dimensions parameter negotiated with each model of the self-hosted services,
+// keyed by the endpoint and the model, since the providers are created for every request.
+var negotiatedDimensions = typeutil.NewConcurrentMap[string, int32]()
+
+func dimensionsState(key string) int32 {
+	state, ok := negotiatedDimensions.Get(key)
+	if !ok {
+		return dimensionsUnknown
+	}
+	return state
+}
+
+// embeddingWithDimensions calls the service with the dimensions parameter unless it's rejected by the model before,
+// the first rejection falls back to the native dimension of the model, which is still checked against the field.
+func embeddingWithDimensions[T any](key string, modelName string, dim int64, call func(dim int) (T, error)) (T, error) {
+	if dim == 0 || dimensionsState(key) == dimensionsRejected {
+		return call(0)
+	}
+	resp, err := call(int(dim))
+	if err == nil {
+		negotiatedDimensions.GetOrInsert(key, dimensionsAccepted)
+		return resp, nil
+	}
+	if dimensionsState(key) != dimensionsUnknown || !isDimensionsRejected(err) {
+		return resp, err
+	}
+	resp, retryErr := call(0)
+	if retryErr != nil {
+		return resp, err
+	}
+	log.Info("the service rejects the dimensions parameter, fall back to the native dimension of the model",
+		zap.String("model", modelName), zap.Int64("dim", dim), zap.Error(err))
+	negotiatedDimensions.Insert(key, dimensionsRejected)
+	return resp, nil
+}
+
+// isDimensionsRejected returns whether the service rejects the request for the dimensions parameter,
+// the other errors, e.g. the auth or the rate limit ones, never fall back.
+func isDimensionsRejected(err error) bool {
+	var httpErr *utils.HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	return httpErr.StatusCode >= 400 && httpErr.StatusCode < 500 &&
+		strings.Contains(strings.ToLower(string(httpErr.Body)), "dimensions")
+}
+
+type OpenAICompatibleEmbeddingProvider struct {
+	fieldDim int64
+
+	client        *openai.OpenAICompatibleEmbeddingClient
+	modelName     string
+	embedDimParam int64
+	user          string
+	// many compatible services serve models without the support of the dimensions parameter,
+	// the first request carrying it falls back to the native dimension of the model if it's rejected.
+	dimensionsKey string
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+func createOpenAICompatibleEmbeddingClient(apiKey string, endpoint string, authHeader string, confParams map[string]string) (*openai.OpenAICompatibleEmbeddingClient, error) {
+	if !isProviderEnabled(confParams, EnableOpenAICompatibleEnvStr) {
+		return nil, errors.New("OpenAI compatible model serving is not enabled")
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("[%s] is required by the openai compatible provider", EndpointParamKey)
+	}
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	// the endpoint is the base url of the service, e.g. http://localhost:8000/v1
+	if !strings.HasSuffix(base.Path, "/embeddings") {
+		base.Path = strings.TrimSuffix(base.Path, "/") + "/embeddings"
+	}
+	return openai.NewOpenAICompatibleEmbeddingClient(apiKey, base.String(), authHeader), nil
+}
+
+func NewOpenAICompatibleEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*OpenAICompatibleEmbeddingProvider, error) {
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	var modelName, user, endpoint, authHeader string
+	var dim int64
+	maxBatch := 32
+
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case dimParamKey:
+			dim, err = parseAndCheckFieldDim(param.Value, fieldDim, fieldSchema.Name)
+			if err != nil {
+				return nil, err
+			}
+		case userParamKey:
+			user = param.Value
+		case EndpointParamKey:
+			endpoint = param.Value
+		case authHeaderParamKey:
+			authHeader = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid number", maxClientBatchSizeParamKey, param.Value)
+			}
+		default:
+		}
+	}
+	if modelName == "" {
+		return nil, fmt.Errorf("[%s] is required by the openai compatible provider", modelNameParamKey)
+	}
+
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, openaiCompatibleAKEnvStr)
+	if err != nil {
+		return nil, err
+	}
+	// the endpoint in function params > the url in milvus.yaml
+	if endpoint == "" {
+		endpoint = url
+	}
+	if authHeader == "" {
+		authHeader = params[authHeaderParamKey]
+	}
+	c, err := createOpenAICompatibleEmbeddingClient(apiKey, endpoint, authHeader, params)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := OpenAICompatibleEmbeddingProvider{
+		client:        c,
+		fieldDim:      fieldDim,
+		modelName:     modelName,
+		embedDimParam: dim,
+		user:          user,
+		dimensionsKey: endpoint + "#" + modelName,
+		maxBatch:      maxBatch,
+		timeoutSec:    30,
+	}
+	return &provider, nil
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) call(inputs []string, image bool, dim int) (*openai.EmbeddingResponse, error) {
+	if image {
+		return provider.client.ImageEmbedding(provider.modelName, inputs, dim, provider.user, provider.timeoutSec)
+	}
+	return provider.client.Embedding(provider.modelName, inputs, dim, provider.user, provider.timeoutSec)
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) embedding(inputs []string, image bool) (*openai.EmbeddingResponse, error) {
+	return embeddingWithDimensions(provider.dimensionsKey, provider.modelName, provider.embedDimParam, func(dim int) (*openai.EmbeddingResponse, error) {
+		return provider.call(inputs, image, dim)
+	})
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) callEmbedding(inputs []string, image bool) ([][]float32, error) {
+	numRows := len(inputs)
+	data := make([][]float32, 0, numRows)
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		resp, err := provider.embedding(inputs[i:end], image)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp.Data) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(resp.Data))
+		}
+		for _, item := range resp.Data {
+			if len(item.Embedding) != int(provider.fieldDim) {
+				return nil, fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+					provider.fieldDim, len(item.Embedding))
+			}
+			data = append(data, item.Embedding)
+		}
+	}
+	return data, nil
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) CallEmbedding(texts []string, _ TextEmbeddingMode) (any, error) {
+	return provider.callEmbedding(texts, false)
+}
+
+// CallImageEmbedding embeds the images, which are the http/https urls or the base64 data uris, by the multimodal model.
+func (provider *OpenAICompatibleEmbeddingProvider) CallImageEmbedding(images []string) ([][]float32, error) {
+	return provider.callEmbedding(images, true)
+}
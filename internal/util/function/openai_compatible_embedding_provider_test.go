@@ -0,0 +1,218 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+package function
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+)
+
+func TestOpenAICompatibleTextEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OpenAICompatibleTextEmbeddingProviderSuite))
+}
+
+type OpenAICompatibleTextEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func createOpenAICompatibleProvider(url string, schema *schemapb.FieldSchema, extraParams ...*commonpb.KeyValuePair) (*OpenAICompatibleEmbeddingProvider, error) {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: append([]*commonpb.KeyValuePair{
+			{Key: Provider, Value: openAICompatibleProvider},
+			{Key: modelNameParamKey, Value: "BAAI/bge-m3"},
+			{Key: EndpointParamKey, Value: url},
+		}, extraParams...),
+	}
+	return NewOpenAICompatibleEmbeddingProvider(schema, functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{"mock.apikey": "mock"}))
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestEmbedding() {
+	ts := CreateOpenAICompatibleEmbeddingServer(4, true)
+	defer ts.Close()
+
+	for _, url := range []string{ts.URL, ts.URL + "/v1", ts.URL + "/v1/", ts.URL + "/v1/embeddings"} {
+		provider, err := createOpenAICompatibleProvider(url, s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "4"})
+		s.NoError(err)
+		r, err := provider.CallEmbedding([]string{"sentence 1", "sentence 2", "sentence 3"}, InsertMode)
+		s.NoError(err)
+		ret := r.([][]float32)
+		s.Equal(3, len(ret))
+		s.Equal(4, len(ret[0]))
+		s.Equal(dimensionsAccepted, dimensionsState(provider.dimensionsKey))
+	}
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestEmbeddingBatchAndAuthHeader() {
+	var requests atomic.Int32
+	stub := CreateOpenAICompatibleEmbeddingServer(4, true)
+	defer stub.Close()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		s.Equal("/v1/embeddings", r.URL.Path)
+		s.Equal("mock", r.Header.Get("X-Api-Key"))
+		s.Empty(r.Header.Get("Authorization"))
+		stub.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	provider, err := createOpenAICompatibleProvider(ts.URL+"/v1", s.schema.Fields[2],
+		&commonpb.KeyValuePair{Key: credentialParamKey, Value: "mock"},
+		&commonpb.KeyValuePair{Key: authHeaderParamKey, Value: "X-Api-Key"},
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+	)
+	s.NoError(err)
+	s.Equal(10, provider.MaxBatch())
+	r, err := provider.CallEmbedding([]string{"a", "b", "c", "d", "e"}, InsertMode)
+	s.NoError(err)
+	s.Equal(5, len(r.([][]float32)))
+	s.Equal(int32(3), requests.Load())
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestDimensionsNegotiation() {
+	// the model serves 4 dims natively but rejects the dimensions parameter.
+	stub := CreateOpenAICompatibleEmbeddingServer(4, false)
+	defer stub.Close()
+	var dimensionsRequests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "dimensions") {
+			dimensionsRequests.Add(1)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		stub.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	provider, err := createOpenAICompatibleProvider(ts.URL, s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "4"})
+	s.NoError(err)
+	r, err := provider.CallEmbedding([]string{"sentence"}, InsertMode)
+	s.NoError(err)
+	s.Equal(4, len(r.([][]float32)[0]))
+	s.Equal(dimensionsRejected, dimensionsState(provider.dimensionsKey))
+	requests := dimensionsRequests.Load()
+
+	// the following requests of the providers with the same endpoint and model don't carry the dimensions parameter any more.
+	provider, err = createOpenAICompatibleProvider(ts.URL, s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "4"})
+	s.NoError(err)
+	_, err = provider.CallEmbedding([]string{"sentence"}, SearchMode)
+	s.NoError(err)
+	s.Equal(requests, dimensionsRequests.Load())
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestDimensionsNotFallback() {
+	// the errors not about the dimensions parameter never fall back.
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": "invalid api key"}`))
+	}))
+	defer ts.Close()
+
+	provider, err := createOpenAICompatibleProvider(ts.URL, s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "4"})
+	s.NoError(err)
+	_, err = provider.CallEmbedding([]string{"sentence"}, InsertMode)
+	s.ErrorContains(err, "invalid api key")
+	s.Equal(dimensionsUnknown, dimensionsState(provider.dimensionsKey))
+	// only the retries of the request with the dimensions parameter.
+	s.Equal(int32(3), requests.Load())
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
+	ts := CreateOpenAICompatibleEmbeddingServer(3, true)
+	defer ts.Close()
+
+	provider, err := createOpenAICompatibleProvider(ts.URL, s.schema.Fields[2])
+	s.NoError(err)
+	_, err = provider.CallEmbedding([]string{"sentence"}, InsertMode)
+	s.Error(err)
+}
+
+func (s *OpenAICompatibleTextEmbeddingProviderSuite) TestNewOpenAICompatibleEmbeddingProvider() {
+	provider, err := createOpenAICompatibleProvider("http://mymock.com/v1", s.schema.Fields[2])
+	s.NoError(err)
+	s.Equal(int64(4), provider.FieldDim())
+	s.Equal(32*5, provider.MaxBatch())
+
+	// the endpoint is required
+	_, err = createOpenAICompatibleProvider("", s.schema.Fields[2])
+	s.Error(err)
+
+	_, err = createOpenAICompatibleProvider("mock", s.schema.Fields[2])
+	s.Error(err)
+
+	_, err = createOpenAICompatibleProvider("http://mymock.com/v1", s.schema.Fields[2], &commonpb.KeyValuePair{Key: dimParamKey, Value: "8"})
+	s.Error(err)
+
+	_, err = createOpenAICompatibleProvider("http://mymock.com/v1", s.schema.Fields[2], &commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "Invalid"})
+	s.Error(err)
+
+	// the url in milvus.yaml
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: openAICompatibleProvider},
+			{Key: modelNameParamKey, Value: "BAAI/bge-m3"},
+		},
+	}
+	_, err = NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{"url": "http://mymock.com/v1"}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+
+	os.Setenv(EnableOpenAICompatibleEnvStr, "false")
+	defer os.Unsetenv(EnableOpenAICompatibleEnvStr)
+	_, err = NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{"url": "http://mymock.com/v1"}, credentials.NewCredentials(map[string]string{}))
+	s.Error(err)
+}
@@ -99,7 +99,7 @@ func newOpenAIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchem
 
 	var c openai.OpenAIEmbeddingInterface
 	if !isAzure {
-		apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, openaiAKEnvStr)
+		apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, openaiAKEnvStr)
 		if err != nil {
 			return nil, err
 		}
@@ -108,7 +108,7 @@ func newOpenAIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchem
 			return nil, err
 		}
 	} else {
-		apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, azureOpenaiAKEnvStr)
+		apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, azureOpenaiAKEnvStr)
 		if err != nil {
 			return nil, err
 		}
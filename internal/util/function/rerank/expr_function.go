@@ -0,0 +1,451 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	antlrparser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+)
+
+const (
+	expressionKey string = "expression"
+
+	// scoreVariable refers to the search score in the expression,
+	// the score is converted to the larger the better and merged by max among the sub searches.
+	scoreVariable string = "score"
+)
+
+type exprKind int
+
+const (
+	numberExpr exprKind = iota
+	boolExpr
+)
+
+func (k exprKind) String() string {
+	if k == boolExpr {
+		return "bool"
+	}
+	return "number"
+}
+
+// exprNode is a compiled node of the expression, the bool values are evaluated as 1 and 0.
+// vars[0] is the search score, and vars[i] is the value of the i-th input field.
+type exprNode struct {
+	kind exprKind
+	eval func(vars []float64) float64
+}
+
+type exprCallee struct {
+	argKinds []exprKind
+	retKind  exprKind
+	fn       func(args []float64) float64
+}
+
+func unaryMath(fn func(float64) float64) exprCallee {
+	return exprCallee{
+		argKinds: []exprKind{numberExpr},
+		retKind:  numberExpr,
+		fn:       func(args []float64) float64 { return fn(args[0]) },
+	}
+}
+
+func binaryMath(fn func(float64, float64) float64) exprCallee {
+	return exprCallee{
+		argKinds: []exprKind{numberExpr, numberExpr},
+		retKind:  numberExpr,
+		fn:       func(args []float64) float64 { return fn(args[0], args[1]) },
+	}
+}
+
+var exprCallees = map[string]exprCallee{
+	"abs":   unaryMath(math.Abs),
+	"sqrt":  unaryMath(math.Sqrt),
+	"exp":   unaryMath(math.Exp),
+	"log":   unaryMath(math.Log),
+	"log10": unaryMath(math.Log10),
+	"log1p": unaryMath(math.Log1p),
+	"ceil":  unaryMath(math.Ceil),
+	"floor": unaryMath(math.Floor),
+	"pow":   binaryMath(math.Pow),
+	"min":   binaryMath(math.Min),
+	"max":   binaryMath(math.Max),
+	"if": {
+		argKinds: []exprKind{boolExpr, numberExpr, numberExpr},
+		retKind:  numberExpr,
+		fn: func(args []float64) float64 {
+			if args[0] != 0 {
+				return args[1]
+			}
+			return args[2]
+		},
+	},
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// exprCompiler compiles the syntax tree parsed by planparserv2 into exprNode,
+// only the arithmetic, relational, logical operators and the functions of exprCallees are allowed.
+type exprCompiler struct {
+	// field name -> index of vars
+	varIndex map[string]int
+	varKinds []exprKind
+	// names of the collection fields, to tell the fields not in the input fields apart from the unknown ones
+	collFields map[string]bool
+}
+
+func (c *exprCompiler) compileOperand(ctx antlrparser.IExprContext, kind exprKind, op string) (*exprNode, error) {
+	node, err := c.compile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if node.kind != kind {
+		return nil, fmt.Errorf("Operator %s expects %s operands, but [%s] is %s", op, kind, ctx.GetText(), node.kind)
+	}
+	return node, nil
+}
+
+func (c *exprCompiler) compileBinary(left, right antlrparser.IExprContext, kind exprKind, op string) (*exprNode, *exprNode, error) {
+	l, err := c.compileOperand(left, kind, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := c.compileOperand(right, kind, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func (c *exprCompiler) compile(tree antlrparser.IExprContext) (*exprNode, error) {
+	switch ctx := tree.(type) {
+	case *antlrparser.IntegerContext:
+		v, err := strconv.ParseInt(ctx.GetText(), 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: numberExpr, eval: func([]float64) float64 { return float64(v) }}, nil
+	case *antlrparser.FloatingContext:
+		v, err := strconv.ParseFloat(ctx.GetText(), 64)
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: numberExpr, eval: func([]float64) float64 { return v }}, nil
+	case *antlrparser.BooleanContext:
+		b, err := strconv.ParseBool(ctx.GetText())
+		if err != nil {
+			return nil, err
+		}
+		v := boolToFloat(b)
+		return &exprNode{kind: boolExpr, eval: func([]float64) float64 { return v }}, nil
+	case *antlrparser.IdentifierContext:
+		return c.compileIdentifier(ctx.GetText())
+	case *antlrparser.ParensContext:
+		return c.compile(ctx.Expr())
+	case *antlrparser.UnaryContext:
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserADD:
+			return c.compileOperand(ctx.Expr(), numberExpr, ctx.GetOp().GetText())
+		case antlrparser.PlanParserSUB:
+			child, err := c.compileOperand(ctx.Expr(), numberExpr, ctx.GetOp().GetText())
+			if err != nil {
+				return nil, err
+			}
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return -child.eval(vars) }}, nil
+		case antlrparser.PlanParserNOT:
+			child, err := c.compileOperand(ctx.Expr(), boolExpr, ctx.GetOp().GetText())
+			if err != nil {
+				return nil, err
+			}
+			return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return 1 - child.eval(vars) }}, nil
+		}
+	case *antlrparser.PowerContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), numberExpr, ctx.POW().GetText())
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return math.Pow(l.eval(vars), r.eval(vars)) }}, nil
+	case *antlrparser.MulDivModContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), numberExpr, ctx.GetOp().GetText())
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserMUL:
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return l.eval(vars) * r.eval(vars) }}, nil
+		case antlrparser.PlanParserDIV:
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return l.eval(vars) / r.eval(vars) }}, nil
+		case antlrparser.PlanParserMOD:
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return math.Mod(l.eval(vars), r.eval(vars)) }}, nil
+		}
+	case *antlrparser.AddSubContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), numberExpr, ctx.GetOp().GetText())
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserADD:
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return l.eval(vars) + r.eval(vars) }}, nil
+		case antlrparser.PlanParserSUB:
+			return &exprNode{kind: numberExpr, eval: func(vars []float64) float64 { return l.eval(vars) - r.eval(vars) }}, nil
+		}
+	case *antlrparser.RelationalContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), numberExpr, ctx.GetOp().GetText())
+		if err != nil {
+			return nil, err
+		}
+		var cmp func(a, b float64) bool
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserLT:
+			cmp = func(a, b float64) bool { return a < b }
+		case antlrparser.PlanParserLE:
+			cmp = func(a, b float64) bool { return a <= b }
+		case antlrparser.PlanParserGT:
+			cmp = func(a, b float64) bool { return a > b }
+		case antlrparser.PlanParserGE:
+			cmp = func(a, b float64) bool { return a >= b }
+		}
+		if cmp != nil {
+			return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return boolToFloat(cmp(l.eval(vars), r.eval(vars))) }}, nil
+		}
+	case *antlrparser.EqualityContext:
+		l, err := c.compile(ctx.Expr(0))
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.compileOperand(ctx.Expr(1), l.kind, ctx.GetOp().GetText())
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserEQ:
+			return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return boolToFloat(l.eval(vars) == r.eval(vars)) }}, nil
+		case antlrparser.PlanParserNE:
+			return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return boolToFloat(l.eval(vars) != r.eval(vars)) }}, nil
+		}
+	case *antlrparser.LogicalAndContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), boolExpr, ctx.AND().GetText())
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return boolToFloat(l.eval(vars) != 0 && r.eval(vars) != 0) }}, nil
+	case *antlrparser.LogicalOrContext:
+		l, r, err := c.compileBinary(ctx.Expr(0), ctx.Expr(1), boolExpr, ctx.OR().GetText())
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: boolExpr, eval: func(vars []float64) float64 { return boolToFloat(l.eval(vars) != 0 || r.eval(vars) != 0) }}, nil
+	case *antlrparser.CallContext:
+		return c.compileCall(ctx)
+	}
+	return nil, fmt.Errorf("Unsupported syntax in expr rerank: [%s]", tree.GetText())
+}
+
+func (c *exprCompiler) compileIdentifier(name string) (*exprNode, error) {
+	idx, ok := c.varIndex[name]
+	if !ok {
+		if c.collFields[name] {
+			return nil, fmt.Errorf("Field [%s] is used in the expression, but it's not in the input fields of the expr rerank", name)
+		}
+		return nil, fmt.Errorf("Field [%s] used in the expression does not exist in the collection", name)
+	}
+	return &exprNode{kind: c.varKinds[idx], eval: func(vars []float64) float64 { return vars[idx] }}, nil
+}
+
+func (c *exprCompiler) compileCall(ctx *antlrparser.CallContext) (*exprNode, error) {
+	name := strings.ToLower(ctx.Identifier().GetText())
+	callee, ok := exprCallees[name]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported function [%s] in expr rerank", ctx.Identifier().GetText())
+	}
+	argExprs := ctx.AllExpr()
+	if len(argExprs) != len(callee.argKinds) {
+		return nil, fmt.Errorf("Function %s expects %d arguments, but got %d", name, len(callee.argKinds), len(argExprs))
+	}
+	args := make([]*exprNode, 0, len(argExprs))
+	for i, argExpr := range argExprs {
+		arg, err := c.compile(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		if arg.kind != callee.argKinds[i] {
+			return nil, fmt.Errorf("Argument %d of function %s should be %s, but [%s] is %s", i+1, name, callee.argKinds[i], argExpr.GetText(), arg.kind)
+		}
+		args = append(args, arg)
+	}
+	return &exprNode{kind: callee.retKind, eval: func(vars []float64) float64 {
+		values := make([]float64, len(args))
+		for i, arg := range args {
+			values[i] = arg.eval(vars)
+		}
+		return callee.fn(values)
+	}}, nil
+}
+
+// ExprFunction reranks by a formula over the search score and the scalar fields, e.g.
+// score * (1 + 0.1 * log1p(popularity)) * if(in_stock, 1, 0.5)
+type ExprFunction[T PKType] struct {
+	RerankBase
+
+	expression string
+	node       *exprNode
+}
+
+func newExprFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, exprName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := ""
+	for _, param := range funcSchema.Params {
+		if strings.ToLower(param.Key) == expressionKey {
+			expression = param.Value
+		}
+	}
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("Expr rerank lost param: %s", expressionKey)
+	}
+
+	compiler := &exprCompiler{
+		varIndex:   map[string]int{scoreVariable: 0},
+		varKinds:   []exprKind{numberExpr},
+		collFields: map[string]bool{},
+	}
+	for _, field := range collSchema.GetFields() {
+		compiler.collFields[field.GetName()] = true
+	}
+	for i, name := range base.GetInputFieldNames() {
+		if name == scoreVariable {
+			return nil, fmt.Errorf("Input field name [%s] of expr rerank conflicts with the search score", name)
+		}
+		switch base.GetInputFieldTypes()[i] {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double:
+			compiler.varKinds = append(compiler.varKinds, numberExpr)
+		case schemapb.DataType_Bool:
+			compiler.varKinds = append(compiler.varKinds, boolExpr)
+		default:
+			return nil, fmt.Errorf("Expr rerank: unsupported input field type:%s, only support numberic and bool field", base.GetInputFieldTypes()[i].String())
+		}
+		compiler.varIndex[name] = i + 1
+	}
+
+	tree, err := planparserv2.ParseSyntaxTree(expression)
+	if err != nil {
+		return nil, err
+	}
+	node, err := compiler.compile(tree)
+	if err != nil {
+		return nil, err
+	}
+	if node.kind != numberExpr {
+		return nil, fmt.Errorf("Expression [%s] of expr rerank should be evaluated to a number, but got %s", expression, node.kind)
+	}
+
+	if base.pkType == schemapb.DataType_Int64 {
+		return &ExprFunction[int64]{RerankBase: *base, expression: expression, node: node}, nil
+	}
+	return &ExprFunction[string]{RerankBase: *base, expression: expression, node: node}, nil
+}
+
+// fieldValueAsFloat returns the field value at idx as a number, the null value is regarded as 0 (false).
+func fieldValueAsFloat(data any, valid []bool, idx int) float64 {
+	if valid != nil && !valid[idx] {
+		return 0
+	}
+	switch d := data.(type) {
+	case []int32:
+		return float64(d[idx])
+	case []int64:
+		return float64(d[idx])
+	case []float32:
+		return float64(d[idx])
+	case []float64:
+		return d[idx]
+	case []bool:
+		return boolToFloat(d[idx])
+	}
+	return 0
+}
+
+// clampExprScore clamps the score into the float32 range, the invalid scores, e.g. the log of
+// a negative value, are clamped to the lowest score, so the entities rank last instead of failing the search.
+func clampExprScore(score float64) float32 {
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return -math.MaxFloat32
+	}
+	return float32(max(min(score, math.MaxFloat32), -math.MaxFloat32))
+}
+
+func (e *ExprFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns, idGroup map[any]any) (*IDScores[T], error) {
+	srcScores := maxMerge[T](cols)
+	exprScores := map[T]float32{}
+	vars := make([]float64, len(e.GetInputFieldIDs())+1)
+	for _, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		ids := col.ids.([]T)
+		for idx, id := range ids {
+			if _, ok := exprScores[id]; ok {
+				continue
+			}
+			vars[0] = float64(srcScores[id])
+			for i, data := range col.data {
+				vars[i+1] = fieldValueAsFloat(data, col.valid[i], idx)
+			}
+			exprScores[id] = clampExprScore(e.node.eval(vars))
+		}
+	}
+	if searchParams.isGrouping() {
+		return newGroupingIDScores(exprScores, searchParams, idGroup)
+	}
+	return newIDScores(exprScores, searchParams), nil
+}
+
+func (e *ExprFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		for i, col := range cols {
+			metricType := searchParams.searchMetrics[i]
+			for j, score := range col.scores {
+				col.scores[j] = toGreaterScore(score, metricType)
+			}
+		}
+		idScore, err := e.processOneSearchData(ctx, searchParams, cols, inputs.idGroupValue)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,195 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/testutils"
+)
+
+func TestExprFunction(t *testing.T) {
+	suite.Run(t, new(ExprFunctionSuite))
+}
+
+type ExprFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *ExprFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "popularity", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: "in_stock", DataType: schemapb.DataType_Bool},
+			{FieldID: 104, Name: "price", DataType: schemapb.DataType_Float},
+		},
+	}
+}
+
+func newExprFunctionSchema(expression string, inputs ...string) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: inputs,
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: exprName},
+			{Key: expressionKey, Value: expression},
+		},
+	}
+}
+
+func genExprSearchResultData(ids []int64, scores []float32, popularity []int64, inStock []bool) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       int64(len(ids)),
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: ids},
+			},
+		},
+		Topks: []int64{int64(len(ids))},
+		FieldsData: []*schemapb.FieldData{
+			testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_Int64, "popularity", 102, popularity),
+			testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_Bool, "in_stock", 103, inStock),
+		},
+	}
+}
+
+func (s *ExprFunctionSuite) TestNewExprErrors() {
+	cases := []struct {
+		expression string
+		inputs     []string
+		errMsg     string
+	}{
+		{"", []string{"popularity"}, "Expr rerank lost param: expression"},
+		{"score * (1 + popularity", []string{"popularity"}, "cannot parse expression"},
+		{"score * noExist", []string{"popularity"}, "Field [noExist] used in the expression does not exist in the collection"},
+		{"score * price", []string{"popularity"}, "Field [price] is used in the expression, but it's not in the input fields of the expr rerank"},
+		{"score * sigmoid(popularity)", []string{"popularity"}, "Unsupported function [sigmoid] in expr rerank"},
+		{"score * pow(popularity)", []string{"popularity"}, "Function pow expects 2 arguments, but got 1"},
+		{"score * if(popularity, 1, 0.5)", []string{"popularity"}, "Argument 1 of function if should be bool"},
+		{"score + in_stock", []string{"in_stock"}, "Operator + expects number operands"},
+		{"score > 0.5", []string{"popularity"}, "should be evaluated to a number"},
+		{"score * 'abc'", []string{"popularity"}, "Unsupported syntax in expr rerank"},
+		{"score * len(text)", []string{"text"}, "unsupported input field type:VarChar"},
+	}
+	for _, c := range cases {
+		_, err := createFunction(s.schema, newExprFunctionSchema(c.expression, c.inputs...))
+		s.ErrorContains(err, c.errMsg, c.expression)
+	}
+
+	{
+		schema := &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "score", DataType: schemapb.DataType_Float},
+			},
+		}
+		_, err := createFunction(schema, newExprFunctionSchema("score", "score"))
+		s.ErrorContains(err, "conflicts with the search score")
+	}
+
+	{
+		f, err := createFunction(s.schema, newExprFunctionSchema("score * (1 + 0.1 * log1p(popularity)) * if(in_stock && !(popularity < 10), 1, 0.5)", "popularity", "in_stock"))
+		s.NoError(err)
+		s.Equal(exprName, f.GetRankName())
+		s.True(f.IsSupportGroup())
+		s.Equal([]int64{102, 103}, f.GetInputFieldIDs())
+	}
+}
+
+func (s *ExprFunctionSuite) TestExprProcess() {
+	functionSchema := newExprFunctionSchema("score * (1 + 0.1 * log1p(popularity)) * if(in_stock, 1, 0.5)", "popularity", "in_stock")
+	f, err := createFunction(s.schema, functionSchema)
+	s.NoError(err)
+
+	// expr scores: [0.45, 1.1692, 0.8678, 0.5073]
+	{
+		data := genExprSearchResultData([]int64{1, 2, 3, 4}, []float32{0.9, 0.8, 0.7, 0.6}, []int64{0, 100, 10, 1000}, []bool{false, true, true, false})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Topks)
+		s.Equal([]int64{2, 3, 4}, ret.searchResultData.Ids.GetIntId().Data)
+		s.InDeltaSlice([]float32{1.1692, 0.8678, 0.5073}, ret.searchResultData.Scores, 0.001)
+	}
+
+	// multiple search results, the score is the max of the sub searches
+	{
+		data1 := genExprSearchResultData([]int64{1, 2}, []float32{0.9, 0.8}, []int64{0, 100}, []bool{false, true})
+		data2 := genExprSearchResultData([]int64{1, 4}, []float32{0.95, 0.6}, []int64{0, 1000}, []bool{false, false})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE", "IP"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{2, 4, 1}, ret.searchResultData.Ids.GetIntId().Data)
+		s.InDeltaSlice([]float32{1.1692, 0.5073, 0.475}, ret.searchResultData.Scores, 0.001)
+	}
+
+	// grouping
+	{
+		data := genExprSearchResultData([]int64{1, 2, 3, 4}, []float32{0.9, 0.8, 0.7, 0.6}, []int64{0, 100, 10, 1000}, []bool{false, true, true, false})
+		data.GroupByFieldValue = testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_Int64, "group", 105, []int64{10, 10, 20, 20})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), true)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 2, 0, -1, 105, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{2, 3}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+
+	// the invalid scores rank last
+	{
+		f, err := createFunction(s.schema, newExprFunctionSchema("score / popularity", "popularity"))
+		s.NoError(err)
+		data := genExprSearchResultData([]int64{1, 2, 3}, []float32{0.9, 0.8, 0}, []int64{0, 100, 0}, []bool{false, true, true})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal(int64(2), ret.searchResultData.Ids.GetIntId().Data[0])
+		s.InDelta(0.008, ret.searchResultData.Scores[0], 0.0001)
+		s.Equal([]float32{-math.MaxFloat32, -math.MaxFloat32}, ret.searchResultData.Scores[1:])
+	}
+
+	// the null values are regarded as 0 (false)
+	{
+		data := genExprSearchResultData([]int64{1, 2}, []float32{0.9, 0.8}, []int64{1000, 100}, []bool{true, true})
+		data.FieldsData[0].ValidData = []bool{false, true}
+		data.FieldsData[1].ValidData = []bool{true, false}
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{1, 2}, ret.searchResultData.Ids.GetIntId().Data)
+		s.InDeltaSlice([]float32{0.9, 0.5846}, ret.searchResultData.Scores, 0.001)
+	}
+}
@@ -38,6 +38,8 @@ import (
 const (
 	decayFunctionName string = "decay"
 	modelFunctionName string = "model"
+	exprName          string = "expr"
+	mmrName           string = "mmr"
 	rrfName           string = "rrf"
 	weightedName      string = "weighted"
 )
@@ -104,6 +106,11 @@ type Reranker interface {
 	GetRankName() string
 }
 
+// candidateWindowReranker is implemented by the rerankers which need more candidates than the results, such as mmr
+type candidateWindowReranker interface {
+	candidateWindow(topk int64) int64
+}
+
 func getRerankName(funcSchema *schemapb.FunctionSchema) string {
 	for _, param := range funcSchema.Params {
 		switch strings.ToLower(param.Key) {
@@ -140,8 +147,12 @@ func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.
 		rerankFunc, newRerankErr = newRRFFunction(collSchema, funcSchema)
 	case weightedName:
 		rerankFunc, newRerankErr = newWeightedFunction(collSchema, funcSchema)
+	case exprName:
+		rerankFunc, newRerankErr = newExprFunction(collSchema, funcSchema)
+	case mmrName:
+		rerankFunc, newRerankErr = newMMRFunction(collSchema, funcSchema)
 	default:
-		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s]", rerankerName, decayFunctionName, modelFunctionName)
+		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, exprName, mmrName)
 	}
 
 	if newRerankErr != nil {
@@ -274,6 +285,17 @@ func (fScore *FunctionScore) GetAllInputFieldIDs() []int64 {
 	return fScore.reranker.GetInputFieldIDs()
 }
 
+// CandidateWindow returns the number of candidates the reranker needs to produce the topk(limit + offset) results.
+func (fScore *FunctionScore) CandidateWindow(topk int64) int64 {
+	if fScore == nil {
+		return topk
+	}
+	if r, ok := fScore.reranker.(candidateWindowReranker); ok {
+		return r.candidateWindow(topk)
+	}
+	return topk
+}
+
 func (fScore *FunctionScore) IsSupportGroup() bool {
 	if fScore == nil {
 		return true
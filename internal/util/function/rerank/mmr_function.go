@@ -0,0 +1,269 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+const (
+	lambdaKey     string = "lambda"
+	windowSizeKey string = "window_size"
+
+	defaultMMRLambda float64 = 0.5
+	// the candidates are three times of the results if window_size is not set
+	defaultMMRWindowFactor int64 = 3
+)
+
+// MMRFunction reranks the candidates by maximal marginal relevance,
+// each step selects the candidate maximizing lambda * relevance - (1 - lambda) * max similarity to the selected ones.
+// The similarity is the cosine similarity of the input vector field, or 1 if the scalar input field is equal otherwise 0.
+type MMRFunction[T PKType] struct {
+	RerankBase
+
+	lambda     float64
+	windowSize int64
+	similarity func(a, b any) float64
+}
+
+func newMMRFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, mmrName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(base.GetInputFieldNames()) != 1 {
+		return nil, fmt.Errorf("MMR function only supports single input, but gets [%s] input", base.GetInputFieldNames())
+	}
+
+	var similarity func(a, b any) float64
+	switch inputType := base.GetInputFieldTypes()[0]; inputType {
+	case schemapb.DataType_FloatVector:
+		similarity = cosineSimilarity
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_VarChar, schemapb.DataType_String:
+		similarity = keySimilarity
+	default:
+		return nil, fmt.Errorf("MMR rerank: unsupported input field type:%s, only support float vector or scalar grouping key field", inputType.String())
+	}
+
+	lambda := defaultMMRLambda
+	windowSize := int64(0)
+	for _, param := range funcSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case lambdaKey:
+			if lambda, err = strconv.ParseFloat(param.Value, 64); err != nil {
+				return nil, fmt.Errorf("Param lambda:%s is not a number", param.Value)
+			}
+		case windowSizeKey:
+			if windowSize, err = strconv.ParseInt(param.Value, 10, 64); err != nil {
+				return nil, fmt.Errorf("Param window_size:%s is not a number", param.Value)
+			}
+		default:
+		}
+	}
+	if lambda < 0 || lambda > 1 {
+		return nil, fmt.Errorf("MMR function param: lambda must 0 <= lambda <= 1, but got %f", lambda)
+	}
+	if windowSize < 0 {
+		return nil, fmt.Errorf("MMR function param: window_size must >= 0, but got %d", windowSize)
+	}
+
+	if base.pkType == schemapb.DataType_Int64 {
+		return &MMRFunction[int64]{RerankBase: *base, lambda: lambda, windowSize: windowSize, similarity: similarity}, nil
+	}
+	return &MMRFunction[string]{RerankBase: *base, lambda: lambda, windowSize: windowSize, similarity: similarity}, nil
+}
+
+// candidateWindow returns the number of candidates to search for the topk results.
+func (mmr *MMRFunction[T]) candidateWindow(topk int64) int64 {
+	if mmr.windowSize > 0 {
+		return max(mmr.windowSize, topk)
+	}
+	return topk * defaultMMRWindowFactor
+}
+
+type mmrVector struct {
+	data []float32
+	norm float64
+}
+
+func cosineSimilarity(a, b any) float64 {
+	va, vb := a.(*mmrVector), b.(*mmrVector)
+	if va.norm == 0 || vb.norm == 0 {
+		return 0
+	}
+	dot := float64(0)
+	for i := range va.data {
+		dot += float64(va.data[i]) * float64(vb.data[i])
+	}
+	return dot / (va.norm * vb.norm)
+}
+
+func keySimilarity(a, b any) float64 {
+	if a == b {
+		return 1
+	}
+	return 0
+}
+
+func mmrValue(data any, idx int) any {
+	switch d := data.(type) {
+	case [][]float32:
+		norm := float64(0)
+		for _, v := range d[idx] {
+			norm += float64(v) * float64(v)
+		}
+		return &mmrVector{data: d[idx], norm: math.Sqrt(norm)}
+	case []bool:
+		return d[idx]
+	case []int32:
+		return d[idx]
+	case []int64:
+		return d[idx]
+	case []string:
+		return d[idx]
+	}
+	return nil
+}
+
+func (mmr *MMRFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns, idGroup map[any]any) (*IDScores[T], error) {
+	relevance := maxMerge[T](cols)
+	values := make(map[T]any, len(relevance))
+	for _, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		ids := col.ids.([]T)
+		for idx, id := range ids {
+			if _, ok := values[id]; !ok {
+				values[id] = mmrValue(col.data[0], idx)
+			}
+		}
+	}
+
+	candidates := make([]T, 0, len(relevance))
+	for id := range relevance {
+		candidates = append(candidates, id)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if relevance[candidates[i]] == relevance[candidates[j]] {
+			return candidates[i] < candidates[j]
+		}
+		return relevance[candidates[i]] > relevance[candidates[j]]
+	})
+
+	// the relevance is normalized to [0, 1] to be comparable with the similarity
+	normRelevance := make([]float64, len(candidates))
+	if len(candidates) > 0 {
+		maxScore, minScore := float64(relevance[candidates[0]]), float64(relevance[candidates[len(candidates)-1]])
+		for i, id := range candidates {
+			if maxScore == minScore {
+				normRelevance[i] = 1
+			} else {
+				normRelevance[i] = (float64(relevance[id]) - minScore) / (maxScore - minScore)
+			}
+		}
+	}
+
+	if searchParams.isGrouping() {
+		// the groups are built from the candidates in the selection order, so all the candidates are selected,
+		// the mmr score of each selection is not greater than the previous one.
+		order, scores := mmr.selectCandidates(candidates, normRelevance, values, int64(len(candidates)))
+		mmrScores := make(map[T]float32, len(order))
+		for k, i := range order {
+			mmrScores[candidates[i]] = float32(scores[k])
+		}
+		return newGroupingIDScores(mmrScores, searchParams, idGroup)
+	}
+
+	topk := min(searchParams.offset+searchParams.limit, int64(len(candidates)))
+	order, scores := mmr.selectCandidates(candidates, normRelevance, values, topk)
+	ret := &IDScores[T]{
+		make([]T, 0, searchParams.limit),
+		make([]float32, 0, searchParams.limit),
+		0,
+	}
+	for k := searchParams.offset; k < int64(len(order)); k++ {
+		score := float32(scores[k])
+		if searchParams.roundDecimal != -1 {
+			multiplier := math.Pow(10.0, float64(searchParams.roundDecimal))
+			score = float32(math.Floor(float64(score)*multiplier+0.5) / multiplier)
+		}
+		ret.ids = append(ret.ids, candidates[order[k]])
+		ret.scores = append(ret.scores, score)
+	}
+	ret.size = int64(len(ret.ids))
+	return ret, nil
+}
+
+// selectCandidates greedily selects n candidates, it returns the indexes of the selected candidates and their mmr scores in the selection order.
+func (mmr *MMRFunction[T]) selectCandidates(candidates []T, normRelevance []float64, values map[T]any, n int64) ([]int, []float64) {
+	maxSimilarity := make([]float64, len(candidates))
+	selected := make([]bool, len(candidates))
+	order := make([]int, 0, n)
+	scores := make([]float64, 0, n)
+	for k := int64(0); k < n; k++ {
+		best := -1
+		bestScore := math.Inf(-1)
+		for i := range candidates {
+			if selected[i] {
+				continue
+			}
+			score := mmr.lambda*normRelevance[i] - (1-mmr.lambda)*maxSimilarity[i]
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		selected[best] = true
+		for i := range candidates {
+			if !selected[i] {
+				maxSimilarity[i] = max(maxSimilarity[i], mmr.similarity(values[candidates[i]], values[candidates[best]]))
+			}
+		}
+		order = append(order, best)
+		scores = append(scores, bestScore)
+	}
+	return order, scores
+}
+
+func (mmr *MMRFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		for i, col := range cols {
+			metricType := searchParams.searchMetrics[i]
+			for j, score := range col.scores {
+				col.scores[j] = toGreaterScore(score, metricType)
+			}
+		}
+		idScore, err := mmr.processOneSearchData(ctx, searchParams, cols, inputs.idGroupValue)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
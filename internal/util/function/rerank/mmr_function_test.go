@@ -0,0 +1,240 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/testutils"
+)
+
+func TestMMRFunction(t *testing.T) {
+	suite.Run(t, new(MMRFunctionSuite))
+}
+
+type MMRFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *MMRFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+			{FieldID: 103, Name: "price", DataType: schemapb.DataType_Float},
+		},
+	}
+}
+
+func newMMRFunctionSchema(input string, params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: []string{input},
+		Params:          append([]*commonpb.KeyValuePair{{Key: reranker, Value: mmrName}}, params...),
+	}
+}
+
+func genMMRSearchResultData(ids []int64, scores []float32, field *schemapb.FieldData) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       int64(len(ids)),
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: ids},
+			},
+		},
+		Topks:      []int64{int64(len(ids))},
+		FieldsData: []*schemapb.FieldData{field},
+	}
+}
+
+func (s *MMRFunctionSuite) TestNewMMRErrors() {
+	{
+		functionSchema := newMMRFunctionSchema("doc_id")
+		functionSchema.InputFieldNames = []string{"doc_id", "vector"}
+		_, err := createFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "MMR function only supports single input")
+	}
+	{
+		_, err := createFunction(s.schema, newMMRFunctionSchema("price"))
+		s.ErrorContains(err, "MMR rerank: unsupported input field type:Float")
+	}
+	{
+		_, err := createFunction(s.schema, newMMRFunctionSchema("doc_id", &commonpb.KeyValuePair{Key: lambdaKey, Value: "NotNum"}))
+		s.ErrorContains(err, "Param lambda:NotNum is not a number")
+	}
+	{
+		_, err := createFunction(s.schema, newMMRFunctionSchema("doc_id", &commonpb.KeyValuePair{Key: lambdaKey, Value: "1.5"}))
+		s.ErrorContains(err, "lambda must 0 <= lambda <= 1")
+	}
+	{
+		_, err := createFunction(s.schema, newMMRFunctionSchema("doc_id", &commonpb.KeyValuePair{Key: windowSizeKey, Value: "-1"}))
+		s.ErrorContains(err, "window_size must >= 0")
+	}
+	{
+		f, err := createFunction(s.schema, newMMRFunctionSchema("doc_id"))
+		s.NoError(err)
+		s.Equal(mmrName, f.GetRankName())
+		s.True(f.IsSupportGroup())
+	}
+}
+
+func (s *MMRFunctionSuite) TestCandidateWindow() {
+	{
+		fScore, err := NewFunctionScore(s.schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{newMMRFunctionSchema("doc_id")},
+		})
+		s.NoError(err)
+		s.Equal(int64(30), fScore.CandidateWindow(10))
+	}
+	{
+		fScore, err := NewFunctionScore(s.schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{newMMRFunctionSchema("doc_id", &commonpb.KeyValuePair{Key: windowSizeKey, Value: "50"})},
+		})
+		s.NoError(err)
+		s.Equal(int64(50), fScore.CandidateWindow(10))
+		s.Equal(int64(100), fScore.CandidateWindow(100))
+	}
+	{
+		fScore, err := NewFunctionScoreWithlegacy(s.schema, []*commonpb.KeyValuePair{})
+		s.NoError(err)
+		s.Equal(int64(10), fScore.CandidateWindow(10))
+	}
+	var fScore *FunctionScore
+	s.Equal(int64(10), fScore.CandidateWindow(10))
+}
+
+func (s *MMRFunctionSuite) TestMMRByKey() {
+	f, err := createFunction(s.schema, newMMRFunctionSchema("doc_id"))
+	s.NoError(err)
+
+	// normalized relevance: [1, 0.9833, 0.9667, 0.3333, 0.1667, 0]
+	// the chunks of doc a are near-duplicates, only the best one is selected before the other docs
+	genInputs := func() *rerankInputs {
+		field := testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_VarChar, "doc_id", 101, []string{"a", "a", "a", "b", "c", "b"})
+		data := genMMRSearchResultData([]int64{1, 2, 3, 4, 5, 6}, []float32{0.9, 0.89, 0.88, 0.5, 0.4, 0.3}, field)
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		return inputs
+	}
+	{
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), genInputs())
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Topks)
+		s.Equal([]int64{1, 4, 5}, ret.searchResultData.Ids.GetIntId().Data)
+		s.InDeltaSlice([]float32{0.5, 0.1667, 0.0833}, ret.searchResultData.Scores, 0.001)
+	}
+	{
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 2, 1, 2, -1, 1, false, "", []string{"COSINE"}), genInputs())
+		s.NoError(err)
+		s.Equal([]int64{4, 5}, ret.searchResultData.Ids.GetIntId().Data)
+		s.Equal([]float32{0.17, 0.08}, ret.searchResultData.Scores)
+	}
+	{
+		// lambda 1 keeps the order of the relevance
+		f, err := createFunction(s.schema, newMMRFunctionSchema("doc_id", &commonpb.KeyValuePair{Key: lambdaKey, Value: "1"}))
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 10, 0, -1, -1, 1, false, "", []string{"COSINE"}), genInputs())
+		s.NoError(err)
+		s.Equal([]int64{1, 2, 3, 4, 5, 6}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+}
+
+func (s *MMRFunctionSuite) TestMMRGrouping() {
+	f, err := createFunction(s.schema, newMMRFunctionSchema("doc_id"))
+	s.NoError(err)
+
+	// the selection order is [1, 4, 5, 2, 3, 6] with the mmr scores [0.5, 0.1667, 0.0833, -0.0083, -0.0167, -0.5],
+	// the groups are {1, 4}, {5, 6} and {2, 3}, the max scores of the groups decide the top 2 groups.
+	field := testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_VarChar, "doc_id", 101, []string{"a", "a", "a", "b", "c", "b"})
+	data := genMMRSearchResultData([]int64{1, 2, 3, 4, 5, 6}, []float32{0.9, 0.89, 0.88, 0.5, 0.4, 0.3}, field)
+	data.GroupByFieldValue = testutils.GenerateScalarFieldDataWithValue(schemapb.DataType_Int64, "group", 104, []int64{10, 20, 20, 10, 30, 30})
+	inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), true)
+	s.NoError(err)
+	ret, err := f.Process(context.Background(), NewSearchParams(1, 2, 0, -1, 104, 2, false, "", []string{"COSINE"}), inputs)
+	s.NoError(err)
+	s.Equal([]int64{1, 4, 5, 6}, ret.searchResultData.Ids.GetIntId().Data)
+	s.InDeltaSlice([]float32{0.5, 0.1667, 0.0833, -0.5}, ret.searchResultData.Scores, 0.001)
+}
+
+func (s *MMRFunctionSuite) TestMMRByVector() {
+	f, err := createFunction(s.schema, newMMRFunctionSchema("vector"))
+	s.NoError(err)
+
+	genData := func(ids []int64, scores []float32, vectors []float32) *schemapb.SearchResultData {
+		field := &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: "vector",
+			FieldId:   102,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  2,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+				},
+			},
+		}
+		return genMMRSearchResultData(ids, scores, field)
+	}
+
+	// search
+	{
+		data := genData([]int64{1, 2, 3}, []float32{0.9, 0.85, 0.8}, []float32{1, 0, 1, 0.01, 0, 1})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{1, 3, 2}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+
+	// hybrid search, the relevance is the max of the sub searches
+	{
+		data1 := genData([]int64{1, 2}, []float32{0.9, 0.85}, []float32{1, 0, 1, 0.01})
+		data2 := genData([]int64{3, 1}, []float32{0.1, 0.3}, []float32{0, 1, 1, 0})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 2, 1, -1, -1, 1, false, "", []string{"COSINE", "L2"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{1, 2}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+
+	// empty results
+	{
+		data := genData([]int64{}, []float32{}, []float32{})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0}, ret.searchResultData.Topks)
+	}
+}
@@ -30,22 +30,42 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
 	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 const (
-	providerParamName string = "provider"
-	vllmProviderName  string = "vllm"
-	teiProviderName   string = "tei"
-
-	queryKeyName    string = "queries"
-	maxBatchKeyName string = "max_batch"
+	providerParamName       string = "provider"
+	vllmProviderName        string = "vllm"
+	teiProviderName         string = "tei"
+	cohereProviderName      string = "cohere"
+	voyageaiProviderName    string = "voyageai"
+	jinaProviderName        string = "jina"
+	siliconflowProviderName string = "siliconflow"
+
+	queryKeyName        string = "queries"
+	maxBatchKeyName     string = "max_batch"
+	modelNameKeyName    string = "model_name"
+	maxDocLengthKeyName string = "max_doc_length"
+	timeoutKeyName      string = "timeout_ms"
+
+	defaultRerankTimeout = 30 * time.Second
 )
 
+// errRerankTimeout is returned if the rerank model doesn't respond in the timeout,
+// the model function fails open and keeps the original order of the search results.
+// It applies to all the providers, including the self-hosted vllm and tei ones, which used to wait for 30s
+// and fail the search before.
+var errRerankTimeout = errors.New("rerank model timeout")
+
 type modelProvider interface {
 	rerank(context.Context, string, []string) ([]float32, error)
 	getURL() string
@@ -54,9 +74,15 @@ type modelProvider interface {
 type baseModel struct {
 	url      string
 	maxBatch int
+	// the documents longer than maxDocLength characters are truncated, 0 means no truncation.
+	maxDocLength int
+	timeout      time.Duration
 
 	queryKey string
 	docKey   string
+	// the other fields of the request body, like the model name.
+	extraBody map[string]any
+	headers   map[string]string
 
 	parseScores func([]byte) ([]float32, error)
 }
@@ -66,14 +92,24 @@ func (base *baseModel) getURL() string {
 }
 
 func (base *baseModel) rerank(ctx context.Context, query string, docs []string) ([]float32, error) {
-	requestBodies, err := genRerankRequestBody(query, docs, base.maxBatch, base.queryKey, base.docKey)
+	requestBodies, err := genRerankRequestBody(query, truncateDocs(docs, base.maxDocLength), base.maxBatch, base.queryKey, base.docKey, base.extraBody)
 	if err != nil {
 		return nil, err
 	}
+	timeout := base.timeout
+	if timeout <= 0 {
+		timeout = defaultRerankTimeout
+	}
+	// the timeout covers all the batches and the retries
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 	scores := []float32{}
 	for _, requestBody := range requestBodies {
-		rerankResp, err := base.callService(ctx, requestBody, 30)
+		rerankResp, err := base.callService(timeoutCtx, requestBody)
 		if err != nil {
+			if ctx.Err() == nil && timeoutCtx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("%w after %s: %v", errRerankTimeout, timeout, err)
+			}
 			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
 		}
 		scores = append(scores, rerankResp...)
@@ -85,12 +121,13 @@ func (base *baseModel) rerank(ctx context.Context, query string, docs []string)
 	return scores, nil
 }
 
-func (base *baseModel) callService(ctx context.Context, requestBody []byte, timeoutSec int64) ([]float32, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
-	defer cancel()
+func (base *baseModel) callService(ctx context.Context, requestBody []byte) ([]float32, error) {
 	headers := map[string]string{
 		"Content-Type": "application/json",
 	}
+	for k, v := range base.headers {
+		headers[k] = v
+	}
 	body, err := utils.RetrySend(ctx, requestBody, http.MethodPost, base.url, headers, 3)
 	if err != nil {
 		return nil, err
@@ -136,13 +173,19 @@ func newVllmProvider(params []*commonpb.KeyValuePair, conf map[string]string) (m
 	if err != nil {
 		return nil, err
 	}
+	maxDocLength, timeout, err := parseCommonParams(params)
+	if err != nil {
+		return nil, err
+	}
 	base, _ := url.Parse(endpoint)
 	base.Path = "/v2/rerank"
 	model := baseModel{
-		url:      base.String(),
-		maxBatch: maxBatch,
-		queryKey: "query",
-		docKey:   "documents",
+		url:          base.String(),
+		maxBatch:     maxBatch,
+		maxDocLength: maxDocLength,
+		timeout:      timeout,
+		queryKey:     "query",
+		docKey:       "documents",
 		parseScores: func(body []byte) ([]float32, error) {
 			var rerankResp vllmRerankResponse
 			if err := json.Unmarshal(body, &rerankResp); err != nil {
@@ -181,13 +224,19 @@ func newTeiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (mo
 	if err != nil {
 		return nil, err
 	}
+	maxDocLength, timeout, err := parseCommonParams(params)
+	if err != nil {
+		return nil, err
+	}
 	base, _ := url.Parse(endpoint)
 	base.Path = "/rerank"
 	model := baseModel{
-		url:      base.String(),
-		maxBatch: maxBatch,
-		queryKey: "query",
-		docKey:   "texts",
+		url:          base.String(),
+		maxBatch:     maxBatch,
+		maxDocLength: maxDocLength,
+		timeout:      timeout,
+		queryKey:     "query",
+		docKey:       "texts",
 		parseScores: func(body []byte) ([]float32, error) {
 			var results []TEIResponse
 			if err := json.Unmarshal(body, &results); err != nil {
@@ -206,6 +255,133 @@ func newTeiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (mo
 	return &teiProvider{baseModel: model}, nil
 }
 
+// hostedProviderInfo describes the rerank api of a hosted model service,
+// the apis share the same request and response format except for the field name of the results.
+type hostedProviderInfo struct {
+	name       string
+	defaultURL string
+	akEnvKey   string
+	// the fields always set in the request body
+	extraBody map[string]any
+}
+
+var hostedProviders = map[string]hostedProviderInfo{
+	cohereProviderName: {
+		name:       cohereProviderName,
+		defaultURL: "https://api.cohere.com/v2/rerank",
+		akEnvKey:   function.CohereAIAKEnvStr,
+	},
+	voyageaiProviderName: {
+		name:       voyageaiProviderName,
+		defaultURL: "https://api.voyageai.com/v1/rerank",
+		akEnvKey:   function.VoyageAIAKEnvStr,
+		extraBody:  map[string]any{"truncation": true},
+	},
+	jinaProviderName: {
+		name:       jinaProviderName,
+		defaultURL: "https://api.jina.ai/v1/rerank",
+		akEnvKey:   function.JinaAIAKEnvStr,
+		extraBody:  map[string]any{"return_documents": false},
+	},
+	siliconflowProviderName: {
+		name:       siliconflowProviderName,
+		defaultURL: "https://api.siliconflow.cn/v1/rerank",
+		akEnvKey:   function.SiliconflowAKEnvStr,
+		extraBody:  map[string]any{"return_documents": false},
+	},
+}
+
+type hostedRerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+type hostedRerankResponse struct {
+	// cohere, jina and siliconflow
+	Results []hostedRerankResult `json:"results"`
+	// voyageai
+	Data []hostedRerankResult `json:"data"`
+}
+
+type hostedProvider struct {
+	baseModel
+}
+
+func newHostedProvider(info hostedProviderInfo, params []*commonpb.KeyValuePair, conf map[string]string) (modelProvider, error) {
+	modelName := ""
+	maxBatch := 32
+	for _, param := range params {
+		switch strings.ToLower(param.Key) {
+		case modelNameKeyName:
+			modelName = param.Value
+		case maxBatchKeyName:
+			batch, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Rerank params error, maxBatch: %s is not a number", param.Value)
+			}
+			maxBatch = int(batch)
+		}
+	}
+	if modelName == "" {
+		return nil, fmt.Errorf("Rerank function lost params %s", modelNameKeyName)
+	}
+	if maxBatch <= 0 {
+		return nil, fmt.Errorf("Rerank function params max_batch must > 0, but got %d", maxBatch)
+	}
+	maxDocLength, timeout, err := parseCommonParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, endpoint, err := function.ParseAKAndURL(credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()), params, conf, info.akEnvKey)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", info.akEnvKey)
+	}
+	if endpoint == "" {
+		endpoint = info.defaultURL
+	}
+	if base, err := url.Parse(endpoint); err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+
+	extraBody := map[string]any{"model": modelName}
+	for k, v := range info.extraBody {
+		extraBody[k] = v
+	}
+	model := baseModel{
+		url:          endpoint,
+		maxBatch:     maxBatch,
+		maxDocLength: maxDocLength,
+		timeout:      timeout,
+		queryKey:     "query",
+		docKey:       "documents",
+		extraBody:    extraBody,
+		headers:      map[string]string{"Authorization": fmt.Sprintf("Bearer %s", apiKey)},
+		parseScores: func(body []byte) ([]float32, error) {
+			var rerankResp hostedRerankResponse
+			if err := json.Unmarshal(body, &rerankResp); err != nil {
+				return nil, fmt.Errorf("Rerank error, parsing %s response failed: %v", info.name, err)
+			}
+			results := rerankResp.Results
+			if len(results) == 0 {
+				results = rerankResp.Data
+			}
+			sort.Slice(results, func(i, j int) bool {
+				return results[i].Index < results[j].Index
+			})
+			scores := make([]float32, 0, len(results))
+			for _, result := range results {
+				scores = append(scores, result.RelevanceScore)
+			}
+			return scores, nil
+		},
+	}
+	return &hostedProvider{baseModel: model}, nil
+}
+
 func isEnable(conf map[string]string, envKey string) bool {
 	// milvus.yaml > env
 	value, exists := conf["enable"]
@@ -250,7 +426,64 @@ func parseParams(params []*commonpb.KeyValuePair) (string, int, error) {
 	return endpoint, maxBatch, nil
 }
 
-func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string) ([][]byte, error) {
+// parseCommonParams parses the params shared by all the rerank providers.
+func parseCommonParams(params []*commonpb.KeyValuePair) (int, time.Duration, error) {
+	maxDocLength := 0
+	timeout := defaultRerankTimeout
+	for _, param := range params {
+		switch strings.ToLower(param.Key) {
+		case maxDocLengthKeyName:
+			length, err := strconv.Atoi(param.Value)
+			if err != nil || length <= 0 {
+				return 0, 0, fmt.Errorf("Rerank function params %s must be a positive integer, but got %s", maxDocLengthKeyName, param.Value)
+			}
+			maxDocLength = length
+		case timeoutKeyName:
+			ms, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil || ms <= 0 {
+				return 0, 0, fmt.Errorf("Rerank function params %s must be a positive integer, but got %s", timeoutKeyName, param.Value)
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+		}
+	}
+	return maxDocLength, timeout, nil
+}
+
+// truncateDocs truncates the documents to maxLength characters, so long documents don't exceed the context of the model.
+func truncateDocs(docs []string, maxLength int) []string {
+	if maxLength <= 0 {
+		return docs
+	}
+	truncated := make([]string, len(docs))
+	for i, doc := range docs {
+		if runes := []rune(doc); len(runes) > maxLength {
+			truncated[i] = string(runes[:maxLength])
+		} else {
+			truncated[i] = doc
+		}
+	}
+	return truncated
+}
+
+// originalScores merges the scores of the search results, which keeps the original order of the results.
+func originalScores[T PKType](cols []*columns, searchMetrics []string) map[T]float32 {
+	scores := make(map[T]float32)
+	for i, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		ids := col.ids.([]T)
+		for idx, id := range ids {
+			score := toGreaterScore(col.scores[idx], searchMetrics[i])
+			if s, ok := scores[id]; !ok || score > s {
+				scores[id] = score
+			}
+		}
+	}
+	return scores
+}
+
+func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string, extraBody map[string]any) ([][]byte, error) {
 	requestBodies := [][]byte{}
 	for i := 0; i < len(documents); i += maxSize {
 		end := i + maxSize
@@ -261,6 +494,9 @@ func genRerankRequestBody(query string, documents []string, maxSize int, queryKe
 			queryKey: query,
 			docKey:   documents[i:end],
 		}
+		for k, v := range extraBody {
+			requestBody[k] = v
+		}
 		jsonData, err := json.Marshal(requestBody)
 		if err != nil {
 			return nil, fmt.Errorf("Create model rerank request failed, err: %s", err)
@@ -280,6 +516,14 @@ func newProvider(params []*commonpb.KeyValuePair) (modelProvider, error) {
 				return newVllmProvider(params, conf)
 			case teiProviderName:
 				return newTeiProvider(params, conf)
+			case cohereProviderName:
+				return newHostedProvider(hostedProviders[cohereProviderName], params, conf)
+			case voyageaiProviderName:
+				return newHostedProvider(hostedProviders[voyageaiProviderName], params, conf)
+			case jinaProviderName:
+				return newHostedProvider(hostedProviders[jinaProviderName], params, conf)
+			case siliconflowProviderName:
+				return newHostedProvider(hostedProviders[siliconflowProviderName], params, conf)
 			default:
 				return nil, fmt.Errorf("Unknow rerank provider:%s", param.Value)
 			}
@@ -350,14 +594,18 @@ func (model *ModelFunction[T]) processOneSearchData(ctx context.Context, searchP
 		ids = append(ids, id)
 		texts = append(texts, text)
 	}
+	rerankScores := map[T]float32{}
 	scores, err := model.provider.rerank(ctx, query, texts)
 	if err != nil {
-		return nil, err
-	}
-
-	rerankScores := map[T]float32{}
-	for idx, id := range ids {
-		rerankScores[id] = scores[idx]
+		if !errors.Is(err, errRerankTimeout) {
+			return nil, err
+		}
+		log.Ctx(ctx).Warn("rerank model timeout, keep the original order of the search results", zap.String("url", model.provider.getURL()), zap.Error(err))
+		rerankScores = originalScores[T](cols, searchParams.searchMetrics)
+	} else {
+		for idx, id := range ids {
+			rerankScores[id] = scores[idx]
+		}
 	}
 	if searchParams.isGrouping() {
 		return newGroupingIDScores(rerankScores, searchParams, idGroup)
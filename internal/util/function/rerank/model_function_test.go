@@ -26,7 +26,9 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -271,6 +273,187 @@ func (s *RerankModelSuite) TestCallTEI() {
 	}
 }
 
+func (s *RerankModelSuite) TestNewHostedProvider() {
+	os.Setenv(function.CohereAIAKEnvStr, "mock")
+	defer os.Unsetenv(function.CohereAIAKEnvStr)
+	{
+		_, err := newProvider([]*commonpb.KeyValuePair{{Key: providerParamName, Value: cohereProviderName}})
+		s.ErrorContains(err, "Rerank function lost params model_name")
+	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: cohereProviderName},
+			{Key: modelNameKeyName, Value: "rerank-v3.5"},
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		s.Equal("https://api.cohere.com/v2/rerank", provider.getURL())
+	}
+	{
+		// the url in milvus.yaml
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{"cohere.url": "http://mymock.com/v2/rerank"}
+		}
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: cohereProviderName},
+			{Key: modelNameKeyName, Value: "rerank-v3.5"},
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		s.Equal("http://mymock.com/v2/rerank", provider.getURL())
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{}
+		}
+	}
+	{
+		_, err := newProvider([]*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: jinaProviderName},
+			{Key: modelNameKeyName, Value: "jina-reranker-v2-base-multilingual"},
+		})
+		s.ErrorContains(err, "Missing credentials")
+	}
+	{
+		_, err := newProvider([]*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: cohereProviderName},
+			{Key: modelNameKeyName, Value: "rerank-v3.5"},
+			{Key: maxDocLengthKeyName, Value: "0"},
+		})
+		s.ErrorContains(err, "max_doc_length must be a positive integer")
+	}
+	{
+		_, err := newProvider([]*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: cohereProviderName},
+			{Key: modelNameKeyName, Value: "rerank-v3.5"},
+			{Key: timeoutKeyName, Value: "NotNum"},
+		})
+		s.ErrorContains(err, "timeout_ms must be a positive integer")
+	}
+	{
+		_, err := newProvider([]*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: cohereProviderName},
+			{Key: modelNameKeyName, Value: "rerank-v3.5"},
+			{Key: maxBatchKeyName, Value: "0"},
+		})
+		s.ErrorContains(err, "Rerank function params max_batch must > 0")
+	}
+}
+
+func (s *RerankModelSuite) TestCallHostedProviders() {
+	paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+		return map[string]string{"mock.apikey": "mock"}
+	}
+	defer func() {
+		paramtable.Get().CredentialCfg.Credential.GetFunc = nil
+	}()
+	for _, provider := range []string{cohereProviderName, voyageaiProviderName, jinaProviderName, siliconflowProviderName} {
+		requests := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			s.Equal("Bearer mock", r.Header.Get("Authorization"))
+			req := map[string]any{}
+			body, _ := io.ReadAll(r.Body)
+			defer r.Body.Close()
+			json.Unmarshal(body, &req)
+			s.Equal("mock-model", req["model"])
+			s.Equal("q", req["query"])
+			results := []map[string]any{}
+			for i, doc := range req["documents"].([]any) {
+				s.LessOrEqual(len([]rune(doc.(string))), 4)
+				results = append(results, map[string]any{"index": i, "relevance_score": float32(len(req["documents"].([]any))-i) / 10})
+			}
+			resultsKey := "results"
+			if provider == voyageaiProviderName {
+				resultsKey = "data"
+			}
+			jsonData, _ := json.Marshal(map[string]any{resultsKey: results})
+			w.WriteHeader(http.StatusOK)
+			w.Write(jsonData)
+		}))
+
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{provider + ".url": ts.URL}
+		}
+		p, err := newProvider([]*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: provider},
+			{Key: modelNameKeyName, Value: "mock-model"},
+			{Key: "credential", Value: "mock"},
+			{Key: maxBatchKeyName, Value: "2"},
+			{Key: maxDocLengthKeyName, Value: "4"},
+		})
+		s.NoError(err)
+		scores, err := p.rerank(context.Background(), "q", []string{"doc1", "document2", "文档三号很长"})
+		s.NoError(err)
+		s.Equal([]float32{0.2, 0.1, 0.1}, scores)
+		s.Equal(2, requests)
+		ts.Close()
+	}
+	paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+		return map[string]string{}
+	}
+}
+
+func (s *RerankModelSuite) TestRerankTimeout() {
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"index":0,"score":0.0},{"index":1,"score":0.2}]`))
+	}))
+	defer ts.Close()
+
+	// the self-hosted providers fail open on timeout as the others
+	for _, provider := range []string{"tei", "vllm"} {
+		functionSchema := &schemapb.FunctionSchema{
+			Name:            "test",
+			Type:            schemapb.FunctionType_Rerank,
+			InputFieldNames: []string{"text"},
+			Params: []*commonpb.KeyValuePair{
+				{Key: providerParamName, Value: provider},
+				{Key: function.EndpointParamKey, Value: ts.URL},
+				{Key: queryKeyName, Value: `["q1"]`},
+				{Key: timeoutKeyName, Value: "100"},
+			},
+		}
+		f, err := newModelFunction(schema, functionSchema)
+		s.NoError(err)
+
+		// the retries stop backing off once the timeout is reached
+		start := time.Now()
+		_, err = f.(*ModelFunction[int64]).provider.rerank(context.Background(), "q1", []string{"t1", "t2"})
+		s.ErrorIs(err, errRerankTimeout)
+		s.Less(time.Since(start), time.Second)
+
+		// the cancellation of the search isn't a timeout of the model
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = f.(*ModelFunction[int64]).provider.rerank(ctx, "q1", []string{"t1", "t2"})
+		s.Error(err)
+		s.NotErrorIs(err, errRerankTimeout)
+
+		// fail open to the original order
+		nq := int64(1)
+		data := function.GenSearchResultData(nq, 5, schemapb.DataType_VarChar, "text", 101)
+		data.Scores = []float32{0.9, 0.8, 0.7, 0.6, 0.5}
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0, 1, 2}, ret.searchResultData.Ids.GetIntId().GetData())
+		s.Equal([]float32{0.9, 0.8, 0.7}, ret.searchResultData.Scores)
+	}
+}
+
+func TestTruncateDocs(t *testing.T) {
+	docs := []string{"short", "a long document", "文档三号很长"}
+	assert.Equal(t, docs, truncateDocs(docs, 0))
+	assert.Equal(t, []string{"short", "a lon", "文档三号很"}, truncateDocs(docs, 5))
+}
+
 func (s *RerankModelSuite) TestNewModelFunction() {
 	schema := &schemapb.CollectionSchema{
 		Name: "test",
@@ -34,7 +34,9 @@ type PKType interface {
 
 // Data for a single search result for a single query, with multi fields
 type columns struct {
-	data   []any
+	data []any
+	// valid is the valid data of the nullable input fields, nil for the fields not nullable.
+	valid  [][]bool
 	size   int64
 	ids    any
 	scores []float32
@@ -102,6 +104,7 @@ func newRerankInputs(multipSearchResultData []*schemapb.SearchResultData, inputF
 					return nil, err
 				}
 				cols[i][retIdx].data = append(cols[i][retIdx].data, d)
+				cols[i][retIdx].valid = append(cols[i][retIdx].valid, getValidData(fieldData, start, size))
 			}
 			start += size
 		}
@@ -319,6 +322,15 @@ func newGroupingIDScores[T PKType](idScores map[T]float32, searchParams *SearchP
 	return &ret, nil
 }
 
+// getValidData returns the valid data of the nullable field, the null values are filled with
+// the default values in the field data of the search results.
+func getValidData(inputField *schemapb.FieldData, start int64, size int64) []bool {
+	if len(inputField.GetValidData()) == 0 {
+		return nil
+	}
+	return inputField.GetValidData()[start : start+size]
+}
+
 func getField(inputField *schemapb.FieldData, start int64, size int64) (any, error) {
 	switch inputField.Type {
 	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
@@ -351,6 +363,16 @@ func getField(inputField *schemapb.FieldData, start int64, size int64) (any, err
 			return inputField.GetScalars().GetStringData().Data[start : start+size], nil
 		}
 		return []string{}, nil
+	case schemapb.DataType_FloatVector:
+		vectors := make([][]float32, 0, size)
+		if inputField.GetVectors() != nil && inputField.GetVectors().GetFloatVector() != nil {
+			dim := inputField.GetVectors().GetDim()
+			data := inputField.GetVectors().GetFloatVector().GetData()
+			for i := start; i < start+size; i++ {
+				vectors = append(vectors, data[i*dim:(i+1)*dim])
+			}
+		}
+		return vectors, nil
 	default:
 		return nil, fmt.Errorf("Unsupported field type:%s", inputField.Type.String())
 	}
@@ -41,7 +41,7 @@ type SiliconflowEmbeddingProvider struct {
 
 func createSiliconflowEmbeddingClient(apiKey string, url string) (*siliconflow.SiliconflowEmbedding, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("Missing credentials conifg or configure the %s environment variable in the Milvus service.", siliconflowAKEnvStr)
+		return nil, fmt.Errorf("Missing credentials conifg or configure the %s environment variable in the Milvus service.", SiliconflowAKEnvStr)
 	}
 
 	if url == "" {
@@ -57,7 +57,7 @@ func NewSiliconflowEmbeddingProvider(fieldSchema *schemapb.FieldSchema, function
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, siliconflowAKEnvStr)
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, SiliconflowAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,253 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// the providers able to generate the sparse embeddings by learned sparse models, like SPLADE or BGE-M3.
+var sparseEmbeddingProviders = []string{teiProvider}
+
+func SparseEmbeddingOutputsCheck(fields []*schemapb.FieldSchema) error {
+	if len(fields) != 1 || fields[0].DataType != schemapb.DataType_SparseFloatVector {
+		return errors.New("SparseEmbedding function output field must be a SparseFloatVector field")
+	}
+	return nil
+}
+
+// SparseEmbeddingFunction embeds the texts of the input field into sparse vectors by the model service,
+// the provider is created for the sparse output field, so its embeddings are []map[uint32]float32.
+type SparseEmbeddingFunction struct {
+	FunctionBase
+
+	embProvider textEmbeddingProvider
+
+	// cache is nil unless the function enables the embedding cache.
+	cache          *embeddingCache
+	cacheKeyPrefix string
+}
+
+func NewSparseEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*SparseEmbeddingFunction, error) {
+	if len(functionSchema.GetOutputFieldNames()) != 1 {
+		return nil, fmt.Errorf("Sparse function should only have one output field, but now is %d", len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base, err := NewFunctionBase(coll, functionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SparseEmbeddingOutputsCheck(base.outputFields); err != nil {
+		return nil, err
+	}
+
+	var embP textEmbeddingProvider
+	var newProviderErr error
+	conf := paramtable.Get().FunctionCfg.GetTextEmbeddingProviderConfig(base.provider)
+	credentials := credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials())
+	switch base.provider {
+	case teiProvider:
+		embP, newProviderErr = NewTEIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	default:
+		return nil, fmt.Errorf("Unsupported sparse embedding service provider: [%s] , list of supported %v", base.provider, sparseEmbeddingProviders)
+	}
+
+	if newProviderErr != nil {
+		return nil, newProviderErr
+	}
+	runner := &SparseEmbeddingFunction{
+		FunctionBase: *base,
+		embProvider:  embP,
+	}
+
+	enableCache := false
+	for _, param := range functionSchema.GetParams() {
+		if strings.ToLower(param.Key) == enableCacheParamKey {
+			if enableCache, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", enableCacheParamKey, param.Value)
+			}
+		}
+	}
+	if enableCache {
+		runner.cache = getEmbeddingCache()
+		runner.cacheKeyPrefix = embeddingCacheKeyPrefix(base.provider, base.outputFields[0], embP.FieldDim(), functionSchema.GetParams())
+	}
+	return runner, nil
+}
+
+// callEmbedding embeds the texts through the embedding cache if it's enabled.
+func (runner *SparseEmbeddingFunction) callEmbedding(texts []string, mode TextEmbeddingMode) ([]map[uint32]float32, error) {
+	var embds any
+	var err error
+	if runner.cache == nil {
+		embds, err = runner.embProvider.CallEmbedding(texts, mode)
+	} else {
+		embds, err = runner.cache.callEmbeddingWithCache(runner.embProvider, runner.provider, runner.cacheKeyPrefix, runner.GetOutputFields()[0].DataType, texts, mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sparse, ok := embds.([]map[uint32]float32)
+	if !ok {
+		return nil, fmt.Errorf("Provider [%s] doesn't return sparse embeddings", runner.provider)
+	}
+	return sparse, nil
+}
+
+// Check embeds a text, the sparse embedding has no fixed dim, so only the number of the embeddings is checked.
+func (runner *SparseEmbeddingFunction) Check() error {
+	embds, err := runner.callEmbedding([]string{"check"}, InsertMode)
+	if err != nil {
+		return err
+	}
+	if len(embds) != 1 {
+		return fmt.Errorf("The number of texts and embeddings does not match text:[1], embedding:[%d]", len(embds))
+	}
+	return nil
+}
+
+func (runner *SparseEmbeddingFunction) MaxBatch() int {
+	return runner.embProvider.MaxBatch()
+}
+
+func (runner *SparseEmbeddingFunction) GetCollectionName() string {
+	return runner.collectionName
+}
+
+func (runner *SparseEmbeddingFunction) GetFunctionProvider() string {
+	return runner.provider
+}
+
+func (runner *SparseEmbeddingFunction) GetFunctionTypeName() string {
+	return runner.functionTypeName
+}
+
+func (runner *SparseEmbeddingFunction) GetFunctionName() string {
+	return runner.functionName
+}
+
+func (runner *SparseEmbeddingFunction) ProcessInsert(ctx context.Context, inputs []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("Sparse embedding function only receives one input field, but got [%d]", len(inputs))
+	}
+
+	if !isValidInputDataType(inputs[0].Type) {
+		return nil, fmt.Errorf("Sparse embedding only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].Type)])
+	}
+
+	texts := inputs[0].GetScalars().GetStringData().GetData()
+	if texts == nil {
+		return nil, errors.New("Input texts is empty")
+	}
+
+	// make sure all texts are not empty
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the input data, SparseEmbedding function does not support empty text")
+	}
+	numRows := len(texts)
+	if numRows > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
+	}
+
+	embds, err := runner.callEmbedding(texts, InsertMode)
+	if err != nil {
+		return nil, err
+	}
+	sparseArray := buildSparseFloatArray(embds)
+	outputField := runner.GetOutputFields()[0]
+	return []*schemapb.FieldData{{
+		FieldId:   outputField.FieldID,
+		FieldName: outputField.Name,
+		Type:      outputField.DataType,
+		IsDynamic: outputField.IsDynamic,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Data: &schemapb.VectorField_SparseFloatVector{
+					SparseFloatVector: sparseArray,
+				},
+				Dim: sparseArray.GetDim(),
+			},
+		},
+	}}, nil
+}
+
+func (runner *SparseEmbeddingFunction) ProcessSearch(ctx context.Context, placeholderGroup *commonpb.PlaceholderGroup) (*commonpb.PlaceholderGroup, error) {
+	texts := funcutil.GetVarCharFromPlaceholder(placeholderGroup.Placeholders[0]) // Already checked externally
+	numRows := len(texts)
+	if numRows > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
+	}
+	// make sure all texts are not empty
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the queries, SparseEmbedding function does not support empty text")
+	}
+	embds, err := runner.callEmbedding(texts, SearchMode)
+	if err != nil {
+		return nil, err
+	}
+	return funcutil.SparseVectorsToPlaceholderGroup(buildSparseFloatArray(embds).GetContents()), nil
+}
+
+func (runner *SparseEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldData) (map[storage.FieldID]storage.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("SparseEmbedding function only receives one input, bug got [%d]", len(inputs))
+	}
+
+	if !isValidInputDataType(inputs[0].GetDataType()) {
+		return nil, fmt.Errorf("SparseEmbedding function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].GetDataType())])
+	}
+
+	texts, ok := inputs[0].GetDataRows().([]string)
+	if !ok {
+		return nil, errors.New("Input texts is empty")
+	}
+
+	// make sure all texts are not empty
+	// In storage.FieldData, null is also stored as an empty string
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the input data, SparseEmbedding function does not support empty text")
+	}
+	// the embedding cache is bypassed, the imported texts are rarely repeated and would evict the hot embeddings.
+	embds, err := runner.embProvider.CallEmbedding(texts, InsertMode)
+	if err != nil {
+		return nil, err
+	}
+	sparse, ok := embds.([]map[uint32]float32)
+	if !ok {
+		return nil, fmt.Errorf("Provider [%s] doesn't return sparse embeddings", runner.provider)
+	}
+	return map[storage.FieldID]storage.FieldData{
+		runner.outputFields[0].FieldID: &storage.SparseFloatVectorFieldData{
+			SparseFloatArray: *buildSparseFloatArray(sparse),
+		},
+	}, nil
+}
@@ -0,0 +1,155 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestSparseEmbeddingFunction(t *testing.T) {
+	suite.Run(t, new(SparseEmbeddingFunctionSuite))
+}
+
+type SparseEmbeddingFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *SparseEmbeddingFunctionSuite) SetupTest() {
+	paramtable.Init()
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+}
+
+func (s *SparseEmbeddingFunctionSuite) functionSchema(params []*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             typeutil.FunctionTypeSparseEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"sparse"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params:           params,
+	}
+}
+
+func (s *SparseEmbeddingFunctionSuite) TestProcess() {
+	ts := CreateTEISparseEmbeddingServer()
+	defer ts.Close()
+
+	runner, err := NewSparseEmbeddingFunction(s.schema, s.functionSchema([]*commonpb.KeyValuePair{
+		{Key: Provider, Value: teiProvider},
+		{Key: EndpointParamKey, Value: ts.URL},
+		{Key: enableCacheParamKey, Value: "true"},
+	}))
+	s.NoError(err)
+	s.NoError(runner.Check())
+	s.Equal("SparseEmbedding", runner.GetFunctionTypeName())
+
+	texts := []string{"sentence 1", "sentence 2", "sentence 3"}
+	{
+		data := createData(texts)
+		ret, err := runner.ProcessInsert(context.Background(), data)
+		s.NoError(err)
+		sparse := ret[0].GetVectors().GetSparseFloatVector()
+		s.Equal(3, len(sparse.GetContents()))
+		s.Equal(int64(21), sparse.GetDim())
+		s.Equal(3, typeutil.SparseFloatRowElementCount(sparse.GetContents()[2]))
+	}
+
+	{
+		f := &schemapb.FieldData{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: texts},
+					},
+				},
+			},
+		}
+		placeholderGroupBytes, err := funcutil.FieldDataToPlaceholderGroupBytes(f)
+		s.NoError(err)
+		placeholderGroup := commonpb.PlaceholderGroup{}
+		proto.Unmarshal(placeholderGroupBytes, &placeholderGroup)
+		ret, err := runner.ProcessSearch(context.Background(), &placeholderGroup)
+		s.NoError(err)
+		s.Equal(commonpb.PlaceholderType_SparseFloatVector, ret.GetPlaceholders()[0].GetType())
+		s.Equal(3, len(ret.GetPlaceholders()[0].GetValues()))
+	}
+
+	{
+		input := &storage.StringFieldData{Data: texts}
+		ret, err := runner.ProcessBulkInsert([]storage.FieldData{input})
+		s.NoError(err)
+		s.Equal(3, ret[102].RowNum())
+	}
+
+	{
+		_, err := runner.ProcessInsert(context.Background(), createData([]string{"sentence 1", ""}))
+		s.Error(err)
+	}
+}
+
+func (s *SparseEmbeddingFunctionSuite) TestNewSparseEmbeddingFunction() {
+	// only the tei provider supports sparse embedding
+	_, err := NewSparseEmbeddingFunction(s.schema, s.functionSchema([]*commonpb.KeyValuePair{
+		{Key: Provider, Value: openAIProvider},
+		{Key: modelNameParamKey, Value: TestModel},
+		{Key: credentialParamKey, Value: "mock"},
+	}))
+	s.Error(err)
+
+	// the output field must be a sparse vector field
+	fSchema := s.functionSchema([]*commonpb.KeyValuePair{
+		{Key: Provider, Value: teiProvider},
+		{Key: EndpointParamKey, Value: "http://mock"},
+	})
+	fSchema.OutputFieldNames = []string{"int64"}
+	fSchema.OutputFieldIds = []int64{100}
+	_, err = NewSparseEmbeddingFunction(s.schema, fSchema)
+	s.Error(err)
+
+	// the TextEmbedding function doesn't output sparse vectors
+	fSchema = s.functionSchema([]*commonpb.KeyValuePair{
+		{Key: Provider, Value: teiProvider},
+		{Key: EndpointParamKey, Value: "http://mock"},
+	})
+	fSchema.Type = schemapb.FunctionType_TextEmbedding
+	_, err = NewTextEmbeddingFunction(s.schema, fSchema)
+	s.Error(err)
+}
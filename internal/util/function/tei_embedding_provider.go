@@ -34,6 +34,8 @@ import (
 
 type TeiEmbeddingProvider struct {
 	fieldDim int64
+	// the sparse embeddings are generated by the embed_sparse api if the output field is a sparse vector field.
+	sparse bool
 
 	client *tei.TEIEmbedding
 
@@ -56,9 +58,13 @@ func createTEIEmbeddingClient(apiKey string, endpoint string) (*tei.TEIEmbedding
 }
 
 func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*TeiEmbeddingProvider, error) {
-	fieldDim, err := typeutil.GetDim(fieldSchema)
-	if err != nil {
-		return nil, err
+	var fieldDim int64
+	var err error
+	sparse := fieldSchema.GetDataType() == schemapb.DataType_SparseFloatVector
+	if !sparse {
+		if fieldDim, err = typeutil.GetDim(fieldSchema); err != nil {
+			return nil, err
+		}
 	}
 	var endpoint, ingestionPrompt, searchPrompt string
 	// TEI default client batch size
@@ -91,7 +97,7 @@ func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *
 		}
 	}
 
-	apiKey, _, err := parseAKAndURL(credentials, functionSchema.Params, params, "")
+	apiKey, _, err := ParseAKAndURL(credentials, functionSchema.Params, params, "")
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +109,7 @@ func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *
 	provider := TeiEmbeddingProvider{
 		client:   c,
 		fieldDim: fieldDim,
+		sparse:   sparse,
 
 		ingestionPrompt:     ingestionPrompt,
 		searchPrompt:        searchPrompt,
@@ -123,21 +130,33 @@ func (provider *TeiEmbeddingProvider) FieldDim() int64 {
 }
 
 func (provider *TeiEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
-	numRows := len(texts)
-	data := make([][]float32, 0, numRows)
+	if provider.sparse {
+		return provider.callSparseEmbedding(texts, mode)
+	}
 	var prompt string
 	if mode == InsertMode {
 		prompt = provider.ingestionPrompt
 	} else {
 		prompt = provider.searchPrompt
 	}
+	return provider.callEmbedding(texts, provider.truncate, prompt)
+}
 
+// CallImageEmbedding embeds the images by the CLIP servers compatible with the embed api of TEI,
+// the prompt and the truncation apply to the texts only, the images are sent as is.
+func (provider *TeiEmbeddingProvider) CallImageEmbedding(images []string) ([][]float32, error) {
+	return provider.callEmbedding(images, false, "")
+}
+
+func (provider *TeiEmbeddingProvider) callEmbedding(inputs []string, truncate bool, prompt string) ([][]float32, error) {
+	numRows := len(inputs)
+	data := make([][]float32, 0, numRows)
 	for i := 0; i < numRows; i += provider.maxBatch {
 		end := i + provider.maxBatch
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(texts[i:end], provider.truncate, provider.truncationDirection, prompt, provider.timeoutSec)
+		resp, err := provider.client.Embedding(inputs[i:end], truncate, provider.truncationDirection, prompt, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
@@ -154,3 +173,34 @@ func (provider *TeiEmbeddingProvider) CallEmbedding(texts []string, mode TextEmb
 	}
 	return data, nil
 }
+
+func (provider *TeiEmbeddingProvider) callSparseEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	numRows := len(texts)
+	data := make([]map[uint32]float32, 0, numRows)
+	prompt := provider.searchPrompt
+	if mode == InsertMode {
+		prompt = provider.ingestionPrompt
+	}
+
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		resp, err := provider.client.SparseEmbedding(texts[i:end], provider.truncate, provider.truncationDirection, prompt, provider.timeoutSec)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(resp))
+		}
+		for _, item := range resp {
+			row := make(map[uint32]float32, len(item))
+			for _, v := range item {
+				row[v.Index] = v.Value
+			}
+			data = append(data, row)
+		}
+	}
+	return data, nil
+}
@@ -22,6 +22,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -38,15 +40,17 @@ const (
 )
 
 const (
-	openAIProvider       string = "openai"
-	azureOpenAIProvider  string = "azure_openai"
-	aliDashScopeProvider string = "dashscope"
-	bedrockProvider      string = "bedrock"
-	vertexAIProvider     string = "vertexai"
-	voyageAIProvider     string = "voyageai"
-	cohereProvider       string = "cohere"
-	siliconflowProvider  string = "siliconflow"
-	teiProvider          string = "tei"
+	openAIProvider           string = "openai"
+	azureOpenAIProvider      string = "azure_openai"
+	aliDashScopeProvider     string = "dashscope"
+	bedrockProvider          string = "bedrock"
+	vertexAIProvider         string = "vertexai"
+	voyageAIProvider         string = "voyageai"
+	cohereProvider           string = "cohere"
+	siliconflowProvider      string = "siliconflow"
+	teiProvider              string = "tei"
+	ollamaProvider           string = "ollama"
+	openAICompatibleProvider string = "openai_compatible"
 )
 
 func hasEmptyString(texts []string) bool {
@@ -76,6 +80,10 @@ type TextEmbeddingFunction struct {
 	FunctionBase
 
 	embProvider textEmbeddingProvider
+
+	// cache is nil unless the function enables the embedding cache.
+	cache          *embeddingCache
+	cacheKeyPrefix string
 }
 
 func isValidInputDataType(dataType schemapb.DataType) bool {
@@ -119,17 +127,43 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 		embP, newProviderErr = NewSiliconflowEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	case teiProvider:
 		embP, newProviderErr = NewTEIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case ollamaProvider:
+		embP, newProviderErr = NewOllamaEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case openAICompatibleProvider:
+		embP, newProviderErr = NewOpenAICompatibleEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	default:
-		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider)
+		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider, ollamaProvider, openAICompatibleProvider)
 	}
 
 	if newProviderErr != nil {
 		return nil, newProviderErr
 	}
-	return &TextEmbeddingFunction{
+	runner := &TextEmbeddingFunction{
 		FunctionBase: *base,
 		embProvider:  embP,
-	}, nil
+	}
+
+	enableCache := false
+	for _, param := range functionSchema.GetParams() {
+		if strings.ToLower(param.Key) == enableCacheParamKey {
+			if enableCache, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", enableCacheParamKey, param.Value)
+			}
+		}
+	}
+	if enableCache {
+		runner.cache = getEmbeddingCache()
+		runner.cacheKeyPrefix = embeddingCacheKeyPrefix(base.provider, base.outputFields[0], embP.FieldDim(), functionSchema.GetParams())
+	}
+	return runner, nil
+}
+
+// callEmbedding embeds the texts through the embedding cache if it's enabled.
+func (runner *TextEmbeddingFunction) callEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	if runner.cache == nil {
+		return runner.embProvider.CallEmbedding(texts, mode)
+	}
+	return runner.cache.callEmbeddingWithCache(runner.embProvider, runner.provider, runner.cacheKeyPrefix, runner.GetOutputFields()[0].DataType, texts, mode)
 }
 
 func (runner *TextEmbeddingFunction) Check() error {
@@ -238,7 +272,7 @@ func (runner *TextEmbeddingFunction) ProcessInsert(ctx context.Context, inputs [
 		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
 	}
 
-	embds, err := runner.embProvider.CallEmbedding(texts, InsertMode)
+	embds, err := runner.callEmbedding(texts, InsertMode)
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +290,7 @@ func (runner *TextEmbeddingFunction) ProcessSearch(ctx context.Context, placehol
 	if hasEmptyString(texts) {
 		return nil, errors.New("There is an empty string in the queries, TextEmbedding function does not support empty text")
 	}
-	embds, err := runner.embProvider.CallEmbedding(texts, SearchMode)
+	embds, err := runner.callEmbedding(texts, SearchMode)
 	if err != nil {
 		return nil, err
 	}
@@ -287,6 +321,7 @@ func (runner *TextEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldDat
 	if hasEmptyString(texts) {
 		return nil, errors.New("There is an empty string in the input data, TextEmbedding function does not support empty text")
 	}
+	// the embedding cache is bypassed, the imported texts are rarely repeated and would evict the hot embeddings.
 	embds, err := runner.embProvider.CallEmbedding(texts, InsertMode)
 	if err != nil {
 		return nil, err
@@ -571,6 +571,69 @@ func (s *TextEmbeddingFunctionSuite) TestNewTextEmbeddings() {
 		s.Error(err)
 	}
 
+	{
+		fSchema := &schemapb.FunctionSchema{
+			Name:             "test",
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{"text"},
+			OutputFieldNames: []string{"vector"},
+			InputFieldIds:    []int64{101},
+			OutputFieldIds:   []int64{102},
+			Params: []*commonpb.KeyValuePair{
+				{Key: Provider, Value: ollamaProvider},
+				{Key: modelNameParamKey, Value: TestModel},
+			},
+		}
+		_, err := NewTextEmbeddingFunction(s.schema, fSchema)
+		s.NoError(err)
+		fSchema.Params = []*commonpb.KeyValuePair{}
+		_, err = NewTextEmbeddingFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+
+	{
+		fSchema := &schemapb.FunctionSchema{
+			Name:             "test",
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{"text"},
+			OutputFieldNames: []string{"vector"},
+			InputFieldIds:    []int64{101},
+			OutputFieldIds:   []int64{102},
+			Params: []*commonpb.KeyValuePair{
+				{Key: Provider, Value: openAICompatibleProvider},
+				{Key: modelNameParamKey, Value: TestModel},
+				{Key: EndpointParamKey, Value: "http://mock.com/v1"},
+			},
+		}
+		_, err := NewTextEmbeddingFunction(s.schema, fSchema)
+		s.NoError(err)
+		fSchema.Params = []*commonpb.KeyValuePair{}
+		_, err = NewTextEmbeddingFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+
+	{
+		fSchema := &schemapb.FunctionSchema{
+			Name:             "test",
+			Type:             schemapb.FunctionType_TextEmbedding,
+			InputFieldNames:  []string{"text"},
+			OutputFieldNames: []string{"vector"},
+			InputFieldIds:    []int64{101},
+			OutputFieldIds:   []int64{102},
+			Params: []*commonpb.KeyValuePair{
+				{Key: Provider, Value: "tei"},
+				{Key: "endpoint", Value: "http://mock.com"},
+				{Key: enableCacheParamKey, Value: "true"},
+			},
+		}
+		runner, err := NewTextEmbeddingFunction(s.schema, fSchema)
+		s.NoError(err)
+		s.NotNil(runner.cache)
+		fSchema.Params[2].Value = "invalid"
+		_, err = NewTextEmbeddingFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+
 	// Invalid params
 	{
 		fSchema := &schemapb.FunctionSchema{
@@ -935,19 +998,19 @@ func (s *TextEmbeddingFunctionSuite) TestProcessBulkInsertFloat32() {
 func (s *TextEmbeddingFunctionSuite) TestParseCredentail() {
 	{
 		cred := credentials.NewCredentials(map[string]string{})
-		ak, url, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{}, "")
+		ak, url, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{}, "")
 		s.Equal(ak, "")
 		s.Equal(url, "")
 		s.NoError(err)
 	}
 	{
 		cred := credentials.NewCredentials(map[string]string{})
-		_, _, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "NotExist"}, "")
+		_, _, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "NotExist"}, "")
 		s.ErrorContains(err, "is not a apikey crediential, can not find key")
 	}
 	{
 		cred := credentials.NewCredentials(map[string]string{"mock.apikey": "mock"})
-		_, _, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "mock"}, "")
+		_, _, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "mock"}, "")
 		s.NoError(err)
 	}
 }
@@ -45,7 +45,7 @@ type VoyageAIEmbeddingProvider struct {
 
 func createVoyageAIEmbeddingClient(apiKey string, url string) (*voyageai.VoyageAIEmbedding, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", voyageAIAKEnvStr)
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", VoyageAIAKEnvStr)
 	}
 
 	if url == "" {
@@ -61,7 +61,7 @@ func NewVoyageAIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSch
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, voyageAIAKEnvStr)
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, VoyageAIAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,179 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	lowercaseFilter = "lowercase"
+	stopFilter      = "stop"
+	stemmerFilter   = "stemmer"
+	lengthFilter    = "length"
+
+	stopWordsKey = "stop_words"
+	languageKey  = "language"
+	maxKey       = "max"
+
+	englishStopWords = "_english_"
+	englishLanguage  = "english"
+)
+
+// the stop words of english, same as the english stop words of lucene
+var defaultEnglishStopWords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it",
+	"no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these",
+	"they", "this", "to", "was", "will", "with",
+}
+
+type filter interface {
+	filter(tokens []Token) []Token
+}
+
+func newFilter(params any) (filter, error) {
+	var filterType string
+	var filterParams map[string]any
+	switch p := params.(type) {
+	case string:
+		filterType = p
+	case map[string]any:
+		t, ok := p[typeKey].(string)
+		if !ok {
+			return nil, fmt.Errorf("go analyzer filter type should be set as string")
+		}
+		filterType, filterParams = t, p
+	default:
+		return nil, fmt.Errorf("go analyzer filter should be string or object, but got %v", params)
+	}
+
+	switch filterType {
+	case lowercaseFilter:
+		return &lowercaseTokenFilter{}, nil
+	case stopFilter:
+		return newStopFilter(filterParams)
+	case stemmerFilter:
+		return newStemmerFilter(filterParams)
+	case lengthFilter:
+		return newLengthFilter(filterParams)
+	default:
+		return nil, fmt.Errorf("unknown go analyzer filter: %s", filterType)
+	}
+}
+
+// mapTokens replaces the text of the tokens, the tokens mapped to empty text are removed.
+func mapTokens(tokens []Token, f func(text string) string) []Token {
+	ret := tokens[:0]
+	for _, token := range tokens {
+		token.Text = f(token.Text)
+		if token.Text != "" {
+			ret = append(ret, token)
+		}
+	}
+	return ret
+}
+
+type lowercaseTokenFilter struct{}
+
+func (f *lowercaseTokenFilter) filter(tokens []Token) []Token {
+	return mapTokens(tokens, strings.ToLower)
+}
+
+// stopTokenFilter removes the stop words, the positions of the remaining tokens are kept.
+type stopTokenFilter struct {
+	stopWords map[string]struct{}
+}
+
+func newStopFilter(params map[string]any) (*stopTokenFilter, error) {
+	list, ok := params[stopWordsKey].([]any)
+	if !ok {
+		return nil, fmt.Errorf("stop filter should set stop_words as a list of words")
+	}
+	f := &stopTokenFilter{stopWords: make(map[string]struct{})}
+	for _, word := range list {
+		w, ok := word.(string)
+		if !ok {
+			return nil, fmt.Errorf("stop filter param stop_words should be a list of words, but got %v", word)
+		}
+		if w == englishStopWords {
+			for _, stopWord := range defaultEnglishStopWords {
+				f.stopWords[stopWord] = struct{}{}
+			}
+			continue
+		}
+		f.stopWords[w] = struct{}{}
+	}
+	return f, nil
+}
+
+func (f *stopTokenFilter) filter(tokens []Token) []Token {
+	return mapTokens(tokens, func(text string) string {
+		if _, ok := f.stopWords[text]; ok {
+			return ""
+		}
+		return text
+	})
+}
+
+// stemmerTokenFilter reduces the lower case words to their stems.
+type stemmerTokenFilter struct {
+	stem func(word string) string
+}
+
+func newStemmerFilter(params map[string]any) (*stemmerTokenFilter, error) {
+	language, ok := params[languageKey].(string)
+	if !ok {
+		return nil, fmt.Errorf("stemmer filter should set language as string")
+	}
+	switch strings.ToLower(language) {
+	case englishLanguage:
+		return &stemmerTokenFilter{stem: porterStem}, nil
+	default:
+		return nil, fmt.Errorf("go analyzer stemmer doesn't support language: %s", language)
+	}
+}
+
+func (f *stemmerTokenFilter) filter(tokens []Token) []Token {
+	return mapTokens(tokens, f.stem)
+}
+
+// lengthTokenFilter removes the tokens longer than the max length in characters.
+type lengthTokenFilter struct {
+	maxLen int
+}
+
+func newLengthFilter(params map[string]any) (*lengthTokenFilter, error) {
+	// the numbers are decoded as float64 from json
+	maxLen, ok := params[maxKey].(float64)
+	if !ok || maxLen <= 0 || maxLen != float64(int(maxLen)) {
+		return nil, fmt.Errorf("length filter should set max as a positive integer")
+	}
+	return &lengthTokenFilter{maxLen: int(maxLen)}, nil
+}
+
+func (f *lengthTokenFilter) filter(tokens []Token) []Token {
+	return mapTokens(tokens, func(text string) string {
+		if utf8.RuneCountInString(text) > f.maxLen {
+			return ""
+		}
+		return text
+	})
+}
@@ -0,0 +1,123 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+// Package gotokenizer provides the analyzers implemented in go, which are selected by the analyzer params {"type": "go"}.
+//
+// The bm25 functions and RunAnalyzer tokenize the text in go by ctokenizer.NewTokenizer, and the text index of the
+// fields enabling match is built by the native tantivy analyzers in segcore, which call back into the go analyzers
+// registered by ctokenizer.
+package gotokenizer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	typeKey = "type"
+	nameKey = "name"
+
+	// goType is the analyzer type of the go tokenizers, the analyzer params
+	// {"type": "go", "name": "my_analyzer"} use the registered go tokenizer my_analyzer,
+	// and {"type": "go", "tokenizer": ..., "filter": [...]} use the builtin go segmenters and filters.
+	goType = "go"
+)
+
+// Factory creates the tokenizer by the analyzer params of the field.
+type Factory func(params map[string]any) (tokenizerapi.Tokenizer, error)
+
+var factories = typeutil.NewConcurrentMap[string, Factory]()
+
+// Register registers the go tokenizer by name, it's called in the init of the packages or plugins
+// providing the custom tokenizers. The tokenizers defined in the config function.analyzer.go
+// are available without registration.
+func Register(name string, factory Factory) error {
+	if name == "" || factory == nil {
+		return fmt.Errorf("go tokenizer name and factory should not be empty")
+	}
+	if _, loaded := factories.GetOrInsert(name, factory); loaded {
+		return fmt.Errorf("go tokenizer %s is already registered", name)
+	}
+	return nil
+}
+
+// Unregister removes the registered go tokenizer.
+func Unregister(name string) {
+	factories.Remove(name)
+}
+
+func parseParams(params string) (map[string]any, error) {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(params), &m); err != nil {
+		return nil, fmt.Errorf("analyzer params should be json object: %w", err)
+	}
+	return m, nil
+}
+
+// IsGoTokenizer returns whether the analyzer params use the go tokenizers.
+func IsGoTokenizer(params string) bool {
+	m, err := parseParams(params)
+	if err != nil {
+		return false
+	}
+	t, ok := m[typeKey].(string)
+	return ok && t == goType
+}
+
+// NewTokenizer creates the go tokenizer by the analyzer params,
+// the registered tokenizers take precedence over the ones defined in the config.
+func NewTokenizer(params string) (tokenizerapi.Tokenizer, error) {
+	m, err := parseParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	name, ok := m[nameKey]
+	if !ok {
+		return newTokenizer(m)
+	}
+	nameStr, ok := name.(string)
+	if !ok {
+		return nil, fmt.Errorf("go analyzer name should be string, but got %v", name)
+	}
+	if factory, ok := factories.Get(nameStr); ok {
+		return factory(m)
+	}
+	if definition, ok := paramtable.Get().FunctionCfg.GetGoAnalyzerParams(nameStr); ok {
+		defParams, err := parseParams(definition)
+		if err != nil {
+			return nil, fmt.Errorf("go analyzer %s defined in config is invalid: %w", nameStr, err)
+		}
+		return newTokenizer(defParams)
+	}
+	return nil, fmt.Errorf("go analyzer %s not found, it should be registered or defined in config function.analyzer.go", nameStr)
+}
+
+// ValidateTokenizer checks whether the go tokenizer can be created by the analyzer params.
+func ValidateTokenizer(params string) error {
+	tokenizer, err := NewTokenizer(params)
+	if err != nil {
+		return err
+	}
+	tokenizer.Destroy()
+	return nil
+}
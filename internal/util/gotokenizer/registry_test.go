@@ -0,0 +1,108 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// upperTokenizer is a custom tokenizer splitting the text by comma and turning the tokens to upper case.
+type upperTokenizer struct{}
+
+func (t *upperTokenizer) NewTokenStream(text string) tokenizerapi.TokenStream {
+	tokens := []Token{}
+	for i, word := range strings.Split(text, ",") {
+		tokens = append(tokens, Token{Text: strings.ToUpper(word), Position: int64(i)})
+	}
+	return NewTokenStream(tokens)
+}
+
+func (t *upperTokenizer) Clone() (tokenizerapi.Tokenizer, error) {
+	return t, nil
+}
+
+func (t *upperTokenizer) Destroy() {}
+
+func TestIsGoTokenizer(t *testing.T) {
+	assert.True(t, IsGoTokenizer(`{"type": "go", "name": "test"}`))
+	assert.False(t, IsGoTokenizer(`{"type": "english"}`))
+	assert.False(t, IsGoTokenizer(`{"tokenizer": "standard"}`))
+	assert.False(t, IsGoTokenizer(`invalid`))
+}
+
+func TestRegister(t *testing.T) {
+	paramtable.Init()
+
+	var receivedParams map[string]any
+	factory := func(params map[string]any) (tokenizerapi.Tokenizer, error) {
+		receivedParams = params
+		return &upperTokenizer{}, nil
+	}
+	require.NoError(t, Register("upper", factory))
+	defer Unregister("upper")
+	assert.ErrorContains(t, Register("upper", factory), "go tokenizer upper is already registered")
+	assert.Error(t, Register("", factory))
+	assert.Error(t, Register("nil", nil))
+
+	tokenizer, err := NewTokenizer(`{"type": "go", "name": "upper", "param": 1}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A", "B"}, collectTokens(tokenizer.NewTokenStream("a,b")))
+	assert.Equal(t, map[string]any{"type": "go", "name": "upper", "param": float64(1)}, receivedParams)
+
+	_, err = NewTokenizer(`{"type": "go", "name": "unknown"}`)
+	assert.ErrorContains(t, err, "go analyzer unknown not found")
+	_, err = NewTokenizer(`{"type": "go", "name": 1}`)
+	assert.ErrorContains(t, err, "go analyzer name should be string")
+	_, err = NewTokenizer(`{"type": "go"`)
+	assert.ErrorContains(t, err, "analyzer params should be json object")
+}
+
+func TestConfigTokenizer(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().FunctionCfg.GoAnalyzers.GetFunc = func() map[string]string {
+		return map[string]string{
+			"cjk":     `{"tokenizer": {"type": "dict", "dict": ["向量"]}, "filter": [{"type": "stop", "stop_words": ["的"]}]}`,
+			"invalid": `{"tokenizer": "unknown"}`,
+		}
+	}
+	defer func() { paramtable.Get().FunctionCfg.GoAnalyzers.GetFunc = nil }()
+
+	tokenizer, err := NewTokenizer(`{"type": "go", "name": "CJK"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"向量", "检", "索"}, collectTokens(tokenizer.NewTokenStream("向量的检索")))
+
+	err = ValidateTokenizer(`{"type": "go", "name": "invalid"}`)
+	assert.ErrorContains(t, err, "unknown go analyzer tokenizer: unknown")
+
+	// the registered tokenizer takes precedence
+	require.NoError(t, Register("cjk", func(params map[string]any) (tokenizerapi.Tokenizer, error) {
+		return &upperTokenizer{}, nil
+	}))
+	defer Unregister("cjk")
+	tokenizer, err = NewTokenizer(`{"type": "go", "name": "cjk"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"向量的检索"}, collectTokens(tokenizer.NewTokenStream("向量的检索")))
+}
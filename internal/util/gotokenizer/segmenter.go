@@ -0,0 +1,260 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	standardType   = "standard"
+	whitespaceType = "whitespace"
+	dictType       = "dict"
+
+	dictKey     = "dict"
+	dictPathKey = "dict_path"
+)
+
+type segmenter interface {
+	segment(text string) []Token
+}
+
+func newSegmenter(params any) (segmenter, error) {
+	var segType string
+	var segParams map[string]any
+	switch p := params.(type) {
+	case nil:
+		segType = standardType
+	case string:
+		segType = p
+	case map[string]any:
+		t, ok := p[typeKey].(string)
+		if !ok {
+			return nil, fmt.Errorf("go analyzer tokenizer type should be set as string")
+		}
+		segType, segParams = t, p
+	default:
+		return nil, fmt.Errorf("go analyzer tokenizer should be string or object, but got %v", params)
+	}
+
+	switch segType {
+	case standardType:
+		return &wordSegmenter{}, nil
+	case whitespaceType:
+		return &whitespaceSegmenter{}, nil
+	case dictType:
+		return newDictSegmenter(segParams)
+	default:
+		return nil, fmt.Errorf("unknown go analyzer tokenizer: %s", segType)
+	}
+}
+
+// isCJK returns whether the rune is written without spaces between words.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// tokenCollector appends the tokens with increasing positions.
+type tokenCollector struct {
+	text   string
+	tokens []Token
+}
+
+func (c *tokenCollector) add(start, end int) {
+	c.tokens = append(c.tokens, Token{
+		Text:        c.text[start:end],
+		StartOffset: int64(start),
+		EndOffset:   int64(end),
+		Position:    int64(len(c.tokens)),
+	})
+}
+
+// segmentWords splits the text into the runs of letters and numbers,
+// the runs of CJK characters are segmented by segmentCJK.
+func segmentWords(text string, segmentCJK func(c *tokenCollector, start, end int)) []Token {
+	c := &tokenCollector{text: text}
+	wordStart, cjkStart := -1, -1
+	for i, r := range text {
+		if cjkStart >= 0 && !isCJK(r) {
+			segmentCJK(c, cjkStart, i)
+			cjkStart = -1
+		}
+		if wordStart >= 0 && (!isWordRune(r) || isCJK(r)) {
+			c.add(wordStart, i)
+			wordStart = -1
+		}
+		switch {
+		case isCJK(r):
+			if cjkStart < 0 {
+				cjkStart = i
+			}
+		case isWordRune(r):
+			if wordStart < 0 {
+				wordStart = i
+			}
+		}
+	}
+	if cjkStart >= 0 {
+		segmentCJK(c, cjkStart, len(text))
+	}
+	if wordStart >= 0 {
+		c.add(wordStart, len(text))
+	}
+	return c.tokens
+}
+
+// segmentCJKChars emits each CJK character as a token.
+func segmentCJKChars(c *tokenCollector, start, end int) {
+	for i := start; i < end; {
+		_, size := utf8.DecodeRuneInString(c.text[i:])
+		c.add(i, i+size)
+		i += size
+	}
+}
+
+// wordSegmenter splits the text by the unicode letters and numbers, the CJK characters are split one by one.
+type wordSegmenter struct{}
+
+func (s *wordSegmenter) segment(text string) []Token {
+	return segmentWords(text, segmentCJKChars)
+}
+
+// whitespaceSegmenter splits the text by the whitespaces.
+type whitespaceSegmenter struct{}
+
+func (s *whitespaceSegmenter) segment(text string) []Token {
+	c := &tokenCollector{text: text}
+	start := -1
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				c.add(start, i)
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		c.add(start, len(text))
+	}
+	return c.tokens
+}
+
+// dictSegmenter segments the CJK text by the forward maximum matching of the dictionary words,
+// the characters not matched by any word are split one by one, the other text is split like the standard tokenizer.
+type dictSegmenter struct {
+	words      map[string]struct{}
+	maxWordLen int
+}
+
+func newDictSegmenter(params map[string]any) (*dictSegmenter, error) {
+	s := &dictSegmenter{words: make(map[string]struct{})}
+	if words, ok := params[dictKey]; ok {
+		list, ok := words.([]any)
+		if !ok {
+			return nil, fmt.Errorf("dict tokenizer param dict should be a list of words")
+		}
+		for _, word := range list {
+			w, ok := word.(string)
+			if !ok {
+				return nil, fmt.Errorf("dict tokenizer param dict should be a list of words, but got %v", word)
+			}
+			s.addWord(w)
+		}
+	}
+	if path, ok := params[dictPathKey]; ok {
+		p, ok := path.(string)
+		if !ok {
+			return nil, fmt.Errorf("dict tokenizer param dict_path should be string")
+		}
+		if err := s.loadDict(p); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.words) == 0 {
+		return nil, fmt.Errorf("dict tokenizer should set dict or dict_path")
+	}
+	return s, nil
+}
+
+func (s *dictSegmenter) addWord(word string) {
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return
+	}
+	s.words[word] = struct{}{}
+	s.maxWordLen = max(s.maxWordLen, utf8.RuneCountInString(word))
+}
+
+// loadDict loads the words from the file, one word per line,
+// the fields after the word such as the frequency are ignored.
+func (s *dictSegmenter) loadDict(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open the dict of dict tokenizer: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 {
+			s.addWord(fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read the dict of dict tokenizer: %w", err)
+	}
+	return nil
+}
+
+func (s *dictSegmenter) segmentCJK(c *tokenCollector, start, end int) {
+	for i := start; i < end; {
+		// the end offsets of the candidates, the longest one is tried first
+		ends := make([]int, 0, s.maxWordLen)
+		for j := i; j < end && len(ends) < s.maxWordLen; {
+			_, size := utf8.DecodeRuneInString(c.text[j:])
+			j += size
+			ends = append(ends, j)
+		}
+		matched := ends[0]
+		for k := len(ends) - 1; k > 0; k-- {
+			if _, ok := s.words[c.text[i:ends[k]]]; ok {
+				matched = ends[k]
+				break
+			}
+		}
+		c.add(i, matched)
+		i = matched
+	}
+}
+
+func (s *dictSegmenter) segment(text string) []Token {
+	return segmentWords(text, s.segmentCJK)
+}
@@ -0,0 +1,73 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+)
+
+var _ tokenizerapi.TokenStream = (*TokenStream)(nil)
+
+// Token is a token produced by the go tokenizers, the offsets are the byte offsets in the text.
+type Token struct {
+	Text        string
+	StartOffset int64
+	EndOffset   int64
+	Position    int64
+}
+
+// TokenStream iterates the tokens produced by the go tokenizers,
+// it's exported for the custom tokenizers to build their token streams.
+type TokenStream struct {
+	tokens []Token
+	idx    int
+}
+
+func NewTokenStream(tokens []Token) *TokenStream {
+	return &TokenStream{
+		tokens: tokens,
+		idx:    -1,
+	}
+}
+
+func (s *TokenStream) Advance() bool {
+	if s.idx+1 >= len(s.tokens) {
+		return false
+	}
+	s.idx++
+	return true
+}
+
+func (s *TokenStream) Token() string {
+	return s.tokens[s.idx].Text
+}
+
+func (s *TokenStream) DetailedToken() *milvuspb.AnalyzerToken {
+	token := s.tokens[s.idx]
+	return &milvuspb.AnalyzerToken{
+		Token:          token.Text,
+		StartOffset:    token.StartOffset,
+		EndOffset:      token.EndOffset,
+		Position:       token.Position,
+		PositionLength: 1,
+	}
+}
+
+func (s *TokenStream) Destroy() {}
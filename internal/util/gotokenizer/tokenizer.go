@@ -0,0 +1,83 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+)
+
+const (
+	tokenizerKey = "tokenizer"
+	filterKey    = "filter"
+)
+
+var _ tokenizerapi.Tokenizer = (*Tokenizer)(nil)
+
+// Tokenizer is the analyzer composed by the builtin go segmenters and filters,
+// the segmenter splits the text into tokens and then the filters transform the tokens in order.
+type Tokenizer struct {
+	segmenter segmenter
+	filters   []filter
+}
+
+// newTokenizer creates the tokenizer by the analyzer params, such as
+// {"tokenizer": {"type": "dict", "dict": ["向量", "数据库"]}, "filter": ["lowercase", {"type": "stop", "stop_words": ["_english_"]}]}.
+func newTokenizer(params map[string]any) (*Tokenizer, error) {
+	seg, err := newSegmenter(params[tokenizerKey])
+	if err != nil {
+		return nil, err
+	}
+
+	tokenizer := &Tokenizer{segmenter: seg}
+	if filterParams, ok := params[filterKey]; ok {
+		list, ok := filterParams.([]any)
+		if !ok {
+			return nil, fmt.Errorf("go analyzer filter should be a list, but got %v", filterParams)
+		}
+		for _, filterParam := range list {
+			f, err := newFilter(filterParam)
+			if err != nil {
+				return nil, err
+			}
+			tokenizer.filters = append(tokenizer.filters, f)
+		}
+	}
+	return tokenizer, nil
+}
+
+func (t *Tokenizer) analyze(text string) []Token {
+	tokens := t.segmenter.segment(text)
+	for _, f := range t.filters {
+		tokens = f.filter(tokens)
+	}
+	return tokens
+}
+
+func (t *Tokenizer) NewTokenStream(text string) tokenizerapi.TokenStream {
+	return NewTokenStream(t.analyze(text))
+}
+
+// Clone returns the tokenizer itself since it's immutable after created.
+func (t *Tokenizer) Clone() (tokenizerapi.Tokenizer, error) {
+	return t, nil
+}
+
+func (t *Tokenizer) Destroy() {}
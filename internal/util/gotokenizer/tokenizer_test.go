@@ -0,0 +1,144 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package gotokenizer
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tokenizerapi"
+)
+
+func collectTokens(stream tokenizerapi.TokenStream) []string {
+	defer stream.Destroy()
+	tokens := []string{}
+	for stream.Advance() {
+		tokens = append(tokens, stream.Token())
+	}
+	return tokens
+}
+
+func TestSegmenter(t *testing.T) {
+	cases := []struct {
+		params   string
+		text     string
+		expected []string
+	}{
+		{`{"type": "go"}`, "Hello, Milvus 2.6!", []string{"Hello", "Milvus", "2", "6"}},
+		{`{"type": "go", "tokenizer": "standard"}`, "milvus是向量数据库", []string{"milvus", "是", "向", "量", "数", "据", "库"}},
+		{`{"type": "go", "tokenizer": "whitespace"}`, " Hello,  Milvus\t2.6! ", []string{"Hello,", "Milvus", "2.6!"}},
+		{
+			`{"type": "go", "tokenizer": {"type": "dict", "dict": ["向量", "数据库", "向量数据库", "数据"]}}`,
+			"milvus是向量数据库，也是AI数据的存储",
+			[]string{"milvus", "是", "向量数据库", "也", "是", "AI", "数据", "的", "存", "储"},
+		},
+		{`{"type": "go", "tokenizer": {"type": "dict", "dict": ["東京"]}}`, "東京タワー", []string{"東京", "タ", "ワ", "ー"}},
+	}
+	for _, c := range cases {
+		tokenizer, err := NewTokenizer(c.params)
+		require.NoError(t, err, c.params)
+		assert.Equal(t, c.expected, collectTokens(tokenizer.NewTokenStream(c.text)), c.params)
+	}
+}
+
+func TestDictPath(t *testing.T) {
+	dictPath := path.Join(t.TempDir(), "dict.txt")
+	require.NoError(t, os.WriteFile(dictPath, []byte("向量 100 n\n\n数据库 50\n"), 0o600))
+
+	tokenizer, err := NewTokenizer(`{"type": "go", "tokenizer": {"type": "dict", "dict_path": "` + dictPath + `"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"向量", "数据库"}, collectTokens(tokenizer.NewTokenStream("向量数据库")))
+
+	_, err = NewTokenizer(`{"type": "go", "tokenizer": {"type": "dict", "dict_path": "/not/exist"}}`)
+	assert.ErrorContains(t, err, "failed to open the dict")
+}
+
+func TestFilter(t *testing.T) {
+	params := `{"type": "go", "filter": ["lowercase", {"type": "stop", "stop_words": ["_english_", "vector"]}, {"type": "stemmer", "language": "English"}, {"type": "length", "max": 8}]}`
+	tokenizer, err := NewTokenizer(params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"milvu", "search", "run", "connect", "databas"},
+		collectTokens(tokenizer.NewTokenStream("Milvus is searching the running connections of Vector databases internationalization")))
+
+	// the positions of the removed tokens are kept
+	stream := tokenizer.NewTokenStream("The Databases")
+	require.True(t, stream.Advance())
+	assert.Equal(t, &milvuspb.AnalyzerToken{Token: "databas", StartOffset: 4, EndOffset: 13, Position: 1, PositionLength: 1}, stream.DetailedToken())
+	assert.False(t, stream.Advance())
+}
+
+func TestTokenizerErrors(t *testing.T) {
+	cases := []struct {
+		params string
+		errMsg string
+	}{
+		{`{"type": "go", "tokenizer": "jieba"}`, "unknown go analyzer tokenizer: jieba"},
+		{`{"type": "go", "tokenizer": 1}`, "go analyzer tokenizer should be string or object"},
+		{`{"type": "go", "tokenizer": {"dict": ["a"]}}`, "go analyzer tokenizer type should be set as string"},
+		{`{"type": "go", "tokenizer": {"type": "dict"}}`, "dict tokenizer should set dict or dict_path"},
+		{`{"type": "go", "tokenizer": {"type": "dict", "dict": "a"}}`, "dict tokenizer param dict should be a list of words"},
+		{`{"type": "go", "filter": "lowercase"}`, "go analyzer filter should be a list"},
+		{`{"type": "go", "filter": ["unknown"]}`, "unknown go analyzer filter: unknown"},
+		{`{"type": "go", "filter": [{"type": "stop"}]}`, "stop filter should set stop_words"},
+		{`{"type": "go", "filter": [{"type": "stemmer", "language": "french"}]}`, "doesn't support language: french"},
+		{`{"type": "go", "filter": [{"type": "length", "max": 1.5}]}`, "length filter should set max as a positive integer"},
+	}
+	for _, c := range cases {
+		err := ValidateTokenizer(c.params)
+		assert.ErrorContains(t, err, c.errMsg, c.params)
+	}
+}
+
+func TestPorterStem(t *testing.T) {
+	cases := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"ties":           "ti",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"filing":         "file",
+		"happy":          "happi",
+		"sky":            "sky",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"generalization": "gener",
+		"effective":      "effect",
+		"electricity":    "electr",
+		"adjustment":     "adjust",
+		"adoption":       "adopt",
+		"controll":       "control",
+		"is":             "is",
+		"Milvus":         "Milvus",
+	}
+	for word, stem := range cases {
+		assert.Equal(t, stem, porterStem(word), word)
+	}
+}
@@ -190,6 +190,22 @@ func (m *GrpcQueryCoordClient) UpdateLoadConfig(ctx context.Context, req *queryp
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcQueryCoordClient) ReportCollectionAccess(ctx context.Context, req *querypb.ReportCollectionAccessRequest, opts ...grpc.CallOption) (*querypb.ReportCollectionAccessResponse, error) {
+	return &querypb.ReportCollectionAccessResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) BeginReadSession(ctx context.Context, req *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	return &querypb.BeginReadSessionResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetReadSession(ctx context.Context, req *querypb.GetReadSessionRequest, opts ...grpc.CallOption) (*querypb.GetReadSessionResponse, error) {
+	return &querypb.GetReadSessionResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) EndReadSession(ctx context.Context, req *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryCoordClient) ListLoadedSegments(ctx context.Context, req *querypb.ListLoadedSegmentsRequest, opts ...grpc.CallOption) (*querypb.ListLoadedSegmentsResponse, error) {
 	return &querypb.ListLoadedSegmentsResponse{}, m.Err
 }
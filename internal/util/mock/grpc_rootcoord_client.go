@@ -57,6 +57,34 @@ func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb
 	return merr.Success(), nil
 }
 
+func (m *GrpcRootCoordClient) RenameField(ctx context.Context, in *rootcoordpb.RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) AddCollectionFunction(ctx context.Context, in *rootcoordpb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) RenamePartition(ctx context.Context, in *rootcoordpb.RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) TransferPartition(ctx context.Context, in *rootcoordpb.TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) CreateProjection(ctx context.Context, in *rootcoordpb.CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) DropProjection(ctx context.Context, in *rootcoordpb.DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) ListProjections(ctx context.Context, in *rootcoordpb.ListProjectionsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListProjectionsResponse, error) {
+	return &rootcoordpb.ListProjectionsResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -18,6 +19,9 @@ import (
 
 const (
 	maxReceiveChanBatchConsumeNum = 100
+	// removeCanceledTaskInterval is the interval to remove the canceled tasks from the policy,
+	// so the tasks abandoned by the client don't wait in the queue to be popped.
+	removeCanceledTaskInterval = 100 * time.Millisecond
 )
 
 // newScheduler create a scheduler with given schedule policy.
@@ -29,6 +33,7 @@ func newScheduler(policy schedulePolicy) Scheduler {
 		policy:           policy,
 		receiveChan:      make(chan addTaskReq, maxReceiveChanSize),
 		execChan:         make(chan Task),
+		finishChan:       make(chan struct{}, 1),
 		pool:             conc.NewPool[any](maxReadConcurrency, conc.WithPreAlloc(true)),
 		gpuPool:          conc.NewPool[any](paramtable.Get().QueryNodeCfg.MaxGpuReadConcurrency.GetAsInt(), conc.WithPreAlloc(true)),
 		schedulerCounter: schedulerCounter{},
@@ -48,6 +53,9 @@ type scheduler struct {
 	execChan    chan Task
 	pool        *conc.Pool[any]
 	gpuPool     *conc.Pool[any]
+	// finishChan wakes up the schedule loop once a task is finished,
+	// since the policy may have the throttled task ready to run.
+	finishChan chan struct{}
 
 	// wg is the waitgroup for internal worker goroutine
 	wg sync.WaitGroup
@@ -114,6 +122,8 @@ func (s *scheduler) Stop() {
 // schedule the owned task asynchronously and continuously.
 func (s *scheduler) schedule() {
 	defer s.wg.Done()
+	ticker := time.NewTicker(removeCanceledTaskInterval)
+	defer ticker.Stop()
 	var task Task
 	for {
 		s.setupReadyLenMetric()
@@ -139,6 +149,10 @@ func (s *scheduler) schedule() {
 			// Receive add operation request and return the process result.
 			// And consume recv chan as much as possible.
 			s.consumeRecvChan(req, maxReceiveChanBatchConsumeNum)
+		case <-s.finishChan:
+			// Try to pop the task again.
+		case <-ticker.C:
+			task = s.removeCanceled(task)
 		case execChan <- task:
 			// Task sent, drop the ownership of sent task.
 			// Update waiting task counter.
@@ -193,6 +207,37 @@ func (s *scheduler) handleAddTaskRequest(req addTaskReq, maxWaitTaskNum int64) b
 	return s.GetWaitingTaskTotal() < maxWaitTaskNum
 }
 
+// removeCanceled removes the canceled tasks from the policy and notifies them,
+// the popped task waiting to be sent is dropped too if it's canceled.
+// The canceled tasks merged into the alive ones are split out and notified, without removing the merged task.
+func (s *scheduler) removeCanceled(waitingTask Task) Task {
+	canceled, removed := s.policy.RemoveCanceled()
+	if splittable, ok := waitingTask.(SplittableMergeTask); ok && waitingTask.Canceled() == nil {
+		canceled = append(canceled, splittable.SplitCanceled()...)
+	}
+	var nq int64
+	for _, t := range canceled {
+		nq += t.NQ()
+		t.Done(t.Canceled())
+	}
+	s.updateWaitingTaskCounter(-int64(removed), -nq)
+	count := len(canceled)
+	if waitingTask != nil {
+		if err := waitingTask.Canceled(); err != nil {
+			s.updateWaitingTaskCounter(-1, -waitingTask.NQ())
+			// The waiting task has been popped from the policy, finish it by done.
+			s.done(waitingTask, err)
+			waitingTask = nil
+			count++
+		}
+	}
+	if count > 0 {
+		log.Info("remove canceled tasks from scheduler", zap.Int("count", count))
+		metrics.QueryNodeReadTaskCanceledCount.WithLabelValues(paramtable.GetStringNodeID()).Add(float64(count))
+	}
+	return waitingTask
+}
+
 // produceExecChan produce task from scheduler into exec chan as much as possible
 func (s *scheduler) produceExecChan() Task {
 	var task Task
@@ -226,16 +271,21 @@ func (s *scheduler) exec() {
 		// Skip this task if task is canceled.
 		if err := t.Canceled(); err != nil {
 			log.Warn("task canceled before executing", zap.Error(err))
-			t.Done(err)
+			s.done(t, err)
 			continue
 		}
 		if err := t.PreExecute(); err != nil {
 			log.Warn("failed to pre-execute task", zap.Error(err))
-			t.Done(err)
+			s.done(t, err)
 			continue
 		}
 
 		s.getPool(t).Submit(func() (any, error) {
+			// The task may be canceled while waiting for the idle worker.
+			if err := t.Canceled(); err != nil {
+				s.done(t, err)
+				return nil, err
+			}
 			// Update concurrency metric and notify task done.
 			metrics.QueryNodeReadTaskConcurrency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
 			collector.Counter.Inc(metricsinfo.ExecuteQueueType)
@@ -247,12 +297,24 @@ func (s *scheduler) exec() {
 			collector.Counter.Dec(metricsinfo.ExecuteQueueType)
 
 			// Notify task done.
-			t.Done(err)
+			s.done(t, err)
 			return nil, err
 		})
 	}
 }
 
+// done notifies the task and the policy that the task is finished.
+func (s *scheduler) done(t Task, err error) {
+	t.Done(err)
+	if policy, ok := s.policy.(finishAwarePolicy); ok {
+		policy.Finish(t)
+		select {
+		case s.finishChan <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (s *scheduler) getPool(t Task) *conc.Pool[any] {
 	if t.IsGpuIndex() {
 		return s.gpuPool
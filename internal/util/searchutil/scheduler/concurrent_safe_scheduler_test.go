@@ -24,6 +24,12 @@ func TestScheduler(t *testing.T) {
 	t.Run("fifo", func(t *testing.T) {
 		testScheduler(t, newFIFOPolicy())
 	})
+	t.Run("weighted-fair", func(t *testing.T) {
+		// the throttled tasks are scheduled once the running tasks finished.
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.SchedulePolicyMaxConcurrencyPerTenant.Key, "4")
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.SchedulePolicyMaxConcurrencyPerTenant.Key)
+		testScheduler(t, newWeightedFairPolicy())
+	})
 	t.Run("scheduler_not_working", func(t *testing.T) {
 		scheduler := newScheduler(newFIFOPolicy())
 
@@ -44,6 +50,46 @@ func TestScheduler(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("remove_canceled_task", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.MaxReadConcurrency.Key, "1")
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.MaxReadConcurrency.Key)
+
+		scheduler := newScheduler(newFIFOPolicy())
+		scheduler.Start()
+		defer scheduler.Stop()
+
+		// the running task blocks the following tasks in the queue.
+		running := newMockTask(mockTaskConfig{executeCost: 500 * time.Millisecond})
+		assert.NoError(t, scheduler.Add(running))
+
+		var executed atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		tasks := make([]Task, 0, 10)
+		for i := 0; i < 10; i++ {
+			task := newMockTask(mockTaskConfig{
+				ctx:         ctx,
+				executeCost: time.Millisecond,
+				execution: func(ctx context.Context) error {
+					executed.Inc()
+					return nil
+				},
+			})
+			assert.NoError(t, scheduler.Add(task))
+			tasks = append(tasks, task)
+		}
+		cancel()
+
+		// the canceled tasks are never executed.
+		for _, task := range tasks {
+			assert.ErrorIs(t, task.Wait(), context.Canceled)
+		}
+		assert.NoError(t, running.Wait())
+		assert.Equal(t, int32(0), executed.Load())
+		assert.Eventually(t, func() bool {
+			return scheduler.GetWaitingTaskTotal() == 0 && scheduler.GetWaitingTaskTotalNQ() == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+
 	suite.Run(t, new(SchedulerSuite))
 }
 
@@ -42,6 +42,11 @@ func (p *fifoPolicy) Pop() Task {
 	return task
 }
 
+// RemoveCanceled removes the canceled tasks from the queue.
+func (p *fifoPolicy) RemoveCanceled() ([]Task, int) {
+	return p.queue.removeCanceled()
+}
+
 // Len get ready task counts.
 func (p *fifoPolicy) Len() int {
 	return p.queue.len()
@@ -19,6 +19,7 @@ type mockTaskConfig struct {
 	mergeAble   bool
 	nq          int64
 	username    string
+	database    string
 	executeCost time.Duration
 	execution   func(ctx context.Context) error
 }
@@ -40,6 +41,7 @@ func newMockTask(c mockTaskConfig) Task {
 		mergeAble:   c.mergeAble,
 		nq:          c.nq,
 		username:    c.username,
+		database:    c.database,
 		execution:   c.execution,
 		tr:          timerecord.NewTimeRecorderWithTrace(c.ctx, "searchTask"),
 	}
@@ -52,6 +54,7 @@ type MockTask struct {
 	mergeAble   bool
 	nq          int64
 	username    string
+	database    string
 	execution   func(ctx context.Context) error
 	tr          *timerecord.TimeRecorder
 }
@@ -65,6 +68,14 @@ func (t *MockTask) Username() string {
 	return t.username
 }
 
+func (t *MockTask) DatabaseName() string {
+	return t.database
+}
+
+func (t *MockTask) ResourceGroup() string {
+	return ""
+}
+
 func (t *MockTask) IsGpuIndex() bool {
 	return false
 }
@@ -122,3 +133,59 @@ func (t *MockTask) SearchResult() *internalpb.SearchResults {
 func (t *MockTask) NQ() int64 {
 	return t.nq
 }
+
+var _ SplittableMergeTask = &mockSplittableTask{}
+
+// mockSplittableTask is a merged task whose merged tasks are canceled independently.
+type mockSplittableTask struct {
+	*MockTask
+	merged []*MockTask
+}
+
+func newMockSplittableTask(c mockTaskConfig) *mockSplittableTask {
+	return &mockSplittableTask{MockTask: newMockTask(c).(*MockTask)}
+}
+
+func (t *mockSplittableTask) MergeWith(t2 Task) bool {
+	if t2, ok := t2.(*MockTask); ok {
+		t.nq += t2.nq
+		t.merged = append(t.merged, t2)
+		return true
+	}
+	return false
+}
+
+func (t *mockSplittableTask) Canceled() error {
+	err := t.ctx.Err()
+	if err == nil {
+		return nil
+	}
+	for _, merged := range t.merged {
+		if merged.Canceled() == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (t *mockSplittableTask) SplitCanceled() []Task {
+	var canceled []Task
+	merged := t.merged[:0]
+	for _, task := range t.merged {
+		if task.Canceled() != nil {
+			t.nq -= task.nq
+			canceled = append(canceled, task)
+			continue
+		}
+		merged = append(merged, task)
+	}
+	t.merged = merged
+	return canceled
+}
+
+func (t *mockSplittableTask) Done(err error) {
+	t.MockTask.Done(err)
+	for _, merged := range t.merged {
+		merged.Done(err)
+	}
+}
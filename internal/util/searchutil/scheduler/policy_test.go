@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -20,6 +21,68 @@ func TestFIFOPolicy(t *testing.T) {
 	testCommonPolicyOperation(t, newFIFOPolicy())
 }
 
+func TestWeightedFairPolicy(t *testing.T) {
+	paramtable.Init()
+	testCommonPolicyOperation(t, newWeightedFairPolicy())
+
+	t.Run("weights", func(t *testing.T) {
+		paramtable.Get().SaveGroup(map[string]string{
+			paramtable.Get().QueryNodeCfg.SchedulePolicyTenantWeights.KeyPrefix + "db1": "3",
+		})
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.SchedulePolicyTenantWeights.KeyPrefix + "db1")
+
+		policy := newWeightedFairPolicy()
+		for i := 0; i < 40; i++ {
+			policy.Push(newMockTask(mockTaskConfig{database: "db1"}))
+			policy.Push(newMockTask(mockTaskConfig{database: "db2"}))
+		}
+		popped := make(map[string]int)
+		for i := 0; i < 20; i++ {
+			task := policy.Pop()
+			popped[task.DatabaseName()]++
+			policy.Finish(task)
+		}
+		assert.Equal(t, 15, popped["db1"])
+		assert.Equal(t, 5, popped["db2"])
+
+		// the big nq task takes more share of the tenant.
+		policy.Push(newMockTask(mockTaskConfig{database: "db3", nq: 100}))
+		for i := 0; i < 20; i++ {
+			policy.Push(newMockTask(mockTaskConfig{database: "db4"}))
+		}
+		popped = make(map[string]int)
+		for i := 0; i < 20; i++ {
+			task := policy.Pop()
+			popped[task.DatabaseName()]++
+			policy.Finish(task)
+		}
+		assert.Equal(t, 1, popped["db3"])
+	})
+
+	t.Run("max concurrency per tenant", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.SchedulePolicyMaxConcurrencyPerTenant.Key, "1")
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.SchedulePolicyMaxConcurrencyPerTenant.Key)
+
+		policy := newWeightedFairPolicy()
+		policy.Push(newMockTask(mockTaskConfig{database: "db1"}))
+		policy.Push(newMockTask(mockTaskConfig{database: "db1"}))
+		policy.Push(newMockTask(mockTaskConfig{database: "db2"}))
+
+		task1 := policy.Pop()
+		assert.Equal(t, "db1", task1.DatabaseName())
+		task2 := policy.Pop()
+		assert.Equal(t, "db2", task2.DatabaseName())
+		// db1 is throttled until the running task finished.
+		assert.Nil(t, policy.Pop())
+		assert.Equal(t, 1, policy.Len())
+
+		policy.Finish(task1)
+		task3 := policy.Pop()
+		assert.Equal(t, "db1", task3.DatabaseName())
+		assert.Equal(t, 0, policy.Len())
+	})
+}
+
 func testCrossUserMerge(t *testing.T, policy schedulePolicy) {
 	userN := 10
 	maxNQ := paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64()
@@ -120,4 +183,33 @@ func testCommonPolicyOperation(t *testing.T, policy schedulePolicy) {
 		assert.NotNil(t, policy.Pop())
 		assert.Equal(t, nAfterMerge-i, policy.Len())
 	}
+
+	// Remove canceled tasks
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := 1; i <= n; i++ {
+		username := fmt.Sprintf("user_%d", (i-1)%userN)
+		taskCtx := context.Background()
+		if i%2 == 0 {
+			taskCtx = ctx
+		}
+		policy.Push(newMockTask(mockTaskConfig{
+			ctx:      taskCtx,
+			username: username,
+		}))
+	}
+	canceled, removed := policy.RemoveCanceled()
+	assert.Empty(t, canceled)
+	assert.Zero(t, removed)
+	assert.Equal(t, n, policy.Len())
+	cancel()
+	canceled, removed = policy.RemoveCanceled()
+	assert.Len(t, canceled, n/2)
+	assert.Equal(t, n/2, removed)
+	assert.Equal(t, n/2, policy.Len())
+	for i := 1; i <= n/2; i++ {
+		task := policy.Pop()
+		assert.NotNil(t, task)
+		assert.NoError(t, task.Canceled())
+	}
+	assert.Equal(t, 0, policy.Len())
 }
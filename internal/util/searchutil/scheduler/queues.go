@@ -48,6 +48,32 @@ func (q *mergeTaskQueue) pop() {
 	}
 }
 
+// removeCanceled removes the canceled tasks from taskQueue and returns them,
+// the canceled tasks merged into the alive ones are split out and returned too.
+// The count of the tasks removed from taskQueue is returned.
+func (q *mergeTaskQueue) removeCanceled() ([]Task, int) {
+	var canceled []Task
+	tasks := q.tasks[:0]
+	for _, task := range q.tasks {
+		if task.Canceled() != nil {
+			canceled = append(canceled, task)
+			continue
+		}
+		if splittable, ok := task.(SplittableMergeTask); ok {
+			canceled = append(canceled, splittable.SplitCanceled()...)
+		}
+		tasks = append(tasks, task)
+	}
+	removed := q.len() - len(tasks)
+	// Clear the tail so the removed tasks can be garbage collected.
+	clear(q.tasks[len(tasks):])
+	q.tasks = tasks
+	if removed > 0 && q.len() == 0 {
+		q.cleanupTimestamp = time.Now()
+	}
+	return canceled, removed
+}
+
 // Return true if user based task is empty and empty for d time.
 func (q *mergeTaskQueue) expire(d time.Duration) bool {
 	if q.len() != 0 {
@@ -207,3 +233,19 @@ func (q *fairPollingTaskQueue) pop(queueExpire time.Duration) (task Task) {
 	q.checkpoint = checkpoint
 	return
 }
+
+// removeCanceled removes the canceled tasks from all the groups and returns them.
+func (q *fairPollingTaskQueue) removeCanceled() ([]Task, int) {
+	if q.count == 0 {
+		return nil, 0
+	}
+	var canceled []Task
+	removed := 0
+	for _, r := range q.route {
+		tasks, n := r.Value.(*mergeTaskQueue).removeCanceled()
+		canceled = append(canceled, tasks...)
+		removed += n
+	}
+	q.count -= removed
+	return canceled, removed
+}
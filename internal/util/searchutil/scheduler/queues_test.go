@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -74,6 +75,42 @@ func TestMergeTaskQueue(t *testing.T) {
 	}
 }
 
+func TestMergeTaskQueueRemoveCanceled(t *testing.T) {
+	q := newMergeTaskQueue("test_user")
+	headCtx, cancelHead := context.WithCancel(context.Background())
+	head := newMockSplittableTask(mockTaskConfig{ctx: headCtx, nq: 1})
+	q.push(head)
+
+	ctxs := make([]context.CancelFunc, 0, 3)
+	children := make([]Task, 0, 3)
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		child := newMockTask(mockTaskConfig{ctx: ctx, nq: 2})
+		assert.True(t, q.tryMerge(tryIntoMergeTask(child), 100))
+		ctxs = append(ctxs, cancel)
+		children = append(children, child)
+	}
+	assert.Equal(t, int64(7), head.NQ())
+
+	// the canceled head is kept to search for the alive tasks merged into it.
+	cancelHead()
+	ctxs[0]()
+	canceled, removed := q.removeCanceled()
+	assert.Equal(t, []Task{children[0]}, canceled)
+	assert.Zero(t, removed)
+	assert.Equal(t, 1, q.len())
+	assert.Equal(t, int64(5), head.NQ())
+	assert.NoError(t, head.Canceled())
+
+	// the merged task is removed once all the tasks merged are canceled.
+	ctxs[1]()
+	ctxs[2]()
+	canceled, removed = q.removeCanceled()
+	assert.Equal(t, []Task{head}, canceled)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, 0, q.len())
+}
+
 func TestFairPollingTaskQueue(t *testing.T) {
 	q := newFairPollingTaskQueue()
 	assert.Equal(t, 0, q.len())
@@ -5,6 +5,7 @@ import "github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 const (
 	schedulePolicyNameFIFO            = "fifo"
 	schedulePolicyNameUserTaskPolling = "user-task-polling"
+	schedulePolicyNameWeightedFair    = "weighted-fair"
 )
 
 // NewScheduler create a scheduler by policyName.
@@ -20,6 +21,10 @@ func NewScheduler(policyName string) Scheduler {
 		return newScheduler(
 			newUserTaskPollingPolicy(),
 		)
+	case schedulePolicyNameWeightedFair:
+		return newScheduler(
+			newWeightedFairPolicy(),
+		)
 	default:
 		panic("invalid schedule task policy")
 	}
@@ -66,9 +71,24 @@ type schedulePolicy interface {
 	// Pop get the task next ready to run.
 	Pop() Task
 
+	// RemoveCanceled removes the canceled tasks from the queue and returns them,
+	// so the abandoned requests never occupy the queue until being popped.
+	// The canceled tasks merged into the alive ones are split out and returned too,
+	// removed is the count of the queued tasks removed, which doesn't count the split tasks.
+	RemoveCanceled() (canceled []Task, removed int)
+
 	Len() int
 }
 
+// finishAwarePolicy is a schedulePolicy which tracks the running tasks.
+type finishAwarePolicy interface {
+	schedulePolicy
+
+	// Finish is called once a popped task is finished.
+	// Concurrent safe.
+	Finish(task Task)
+}
+
 // MergeTask is a Task which can be merged with other task
 type MergeTask interface {
 	Task
@@ -78,12 +98,28 @@ type MergeTask interface {
 	MergeWith(Task) bool
 }
 
+// SplittableMergeTask is a MergeTask whose merged tasks can be canceled independently.
+type SplittableMergeTask interface {
+	MergeTask
+
+	// SplitCanceled removes the canceled tasks merged into the task and returns them.
+	// The task is kept to run the search for the alive ones even if it's canceled itself,
+	// it's canceled only if all the tasks merged into it are canceled.
+	SplitCanceled() []Task
+}
+
 // A task is execute unit of scheduler.
 type Task interface {
 	// Return the username which task is belong to.
 	// Return "" if the task do not contain any user info.
 	Username() string
 
+	// Return the database name of the collection which task is belong to.
+	DatabaseName() string
+
+	// Return the resource group of the collection replica which task is running on.
+	ResourceGroup() string
+
 	// Return whether the task would be running on GPU.
 	IsGpuIndex() bool
 
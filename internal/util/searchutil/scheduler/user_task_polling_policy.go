@@ -65,6 +65,11 @@ func (p *userTaskPollingPolicy) Pop() Task {
 	return p.queue.pop(expire)
 }
 
+// RemoveCanceled removes the canceled tasks from the queue.
+func (p *userTaskPollingPolicy) RemoveCanceled() ([]Task, int) {
+	return p.queue.removeCanceled()
+}
+
 // Len get ready task counts.
 func (p *userTaskPollingPolicy) Len() int {
 	return p.queue.len()
@@ -0,0 +1,194 @@
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var _ finishAwarePolicy = &weightedFairPolicy{}
+
+const (
+	tenantKeyDatabase      = "database"
+	tenantKeyResourceGroup = "resource_group"
+
+	tenantWeightsRefreshInterval = 10 * time.Second
+)
+
+func newWeightedFairPolicy() *weightedFairPolicy {
+	return &weightedFairPolicy{
+		tenantKey: paramtable.Get().QueryNodeCfg.SchedulePolicyTenantKey.GetValue(),
+		queues:    make(map[string]*tenantTaskQueue),
+		running:   typeutil.NewConcurrentMap[string, *atomic.Int64](),
+	}
+}
+
+// tenantTaskQueue is the task queue of a tenant.
+type tenantTaskQueue struct {
+	*mergeTaskQueue
+	// vtime is the virtual time of the tenant, which is advanced by nq/weight once a task of the tenant is scheduled.
+	vtime float64
+	// throttled is the front task throttled last time, so each throttled task is counted once.
+	throttled Task
+}
+
+// weightedFairPolicy is a start-time fair queuing policy among the tenants.
+// The tenant with the least virtual time is scheduled first, so the tenants share the query node by their weights
+// on nq granularity, and the tenant submitting big nq searches is scheduled less often.
+// The running tasks of each tenant are limited by the max concurrency per tenant,
+// the tasks beyond it wait in the queue and never reach the segcore.
+type weightedFairPolicy struct {
+	tenantKey string
+	queues    map[string]*tenantTaskQueue
+	count     int
+	// vtime is the virtual time of the latest scheduled task,
+	// the tenant becomes active starts from it, so an idle tenant doesn't accumulate the credits.
+	vtime float64
+
+	weights          map[string]float64
+	weightsUpdatedAt time.Time
+
+	// running is accessed by the executing tasks concurrently.
+	running *typeutil.ConcurrentMap[string, *atomic.Int64]
+}
+
+func (p *weightedFairPolicy) tenant(task Task) string {
+	if p.tenantKey == tenantKeyResourceGroup {
+		return task.ResourceGroup()
+	}
+	return task.DatabaseName()
+}
+
+// weight returns the weight of the tenant, the keys of the config are case-insensitive.
+func (p *weightedFairPolicy) weight(tenant string) float64 {
+	if time.Since(p.weightsUpdatedAt) > tenantWeightsRefreshInterval {
+		p.weights = make(map[string]float64)
+		for name, value := range paramtable.Get().QueryNodeCfg.SchedulePolicyTenantWeights.GetValue() {
+			if weight, err := strconv.ParseFloat(value, 64); err == nil && weight > 0 {
+				p.weights[strings.ToLower(name)] = weight
+			}
+		}
+		p.weightsUpdatedAt = time.Now()
+	}
+	if weight, ok := p.weights[strings.ToLower(tenant)]; ok {
+		return weight
+	}
+	return 1
+}
+
+func (p *weightedFairPolicy) runningCounter(tenant string) *atomic.Int64 {
+	counter, ok := p.running.Get(tenant)
+	if !ok {
+		counter, _ = p.running.GetOrInsert(tenant, atomic.NewInt64(0))
+	}
+	return counter
+}
+
+func (p *weightedFairPolicy) Push(task Task) (int, error) {
+	tenant := p.tenant(task)
+	queue, ok := p.queues[tenant]
+
+	// Try to merge task with the same tenant.
+	if t := tryIntoMergeTask(task); t != nil && ok {
+		maxNQ := paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64()
+		if queue.tryMerge(t, maxNQ) {
+			return 0, nil
+		}
+	}
+
+	if !ok {
+		queue = &tenantTaskQueue{
+			mergeTaskQueue: newMergeTaskQueue(tenant),
+			vtime:          p.vtime,
+		}
+		p.queues[tenant] = queue
+	} else if queue.len() == 0 {
+		queue.vtime = max(queue.vtime, p.vtime)
+	}
+	queue.push(task)
+	p.count++
+	metrics.QueryNodeTenantReadTaskReadyLen.WithLabelValues(paramtable.GetStringNodeID(), tenant).Set(float64(queue.len()))
+	return 1, nil
+}
+
+func (p *weightedFairPolicy) Pop() Task {
+	if p.count == 0 {
+		return nil
+	}
+	expire := paramtable.Get().QueryNodeCfg.SchedulePolicyTaskQueueExpire.GetAsDuration(time.Second)
+	limit := paramtable.Get().QueryNodeCfg.SchedulePolicyMaxConcurrencyPerTenant.GetAsInt64()
+
+	var target *tenantTaskQueue
+	for tenant, queue := range p.queues {
+		if queue.len() == 0 {
+			if queue.expire(expire) {
+				delete(p.queues, tenant)
+				metrics.QueryNodeTenantReadTaskReadyLen.DeleteLabelValues(paramtable.GetStringNodeID(), tenant)
+			}
+			continue
+		}
+		if limit > 0 && p.runningCounter(tenant).Load() >= limit {
+			if queue.throttled != queue.front() {
+				queue.throttled = queue.front()
+				metrics.QueryNodeTenantReadTaskThrottledCount.WithLabelValues(paramtable.GetStringNodeID(), tenant).Inc()
+			}
+			continue
+		}
+		if target == nil || queue.vtime < target.vtime || (queue.vtime == target.vtime && queue.name < target.name) {
+			target = queue
+		}
+	}
+	// All the tenants with pending tasks are throttled.
+	if target == nil {
+		return nil
+	}
+
+	task := target.front()
+	target.pop()
+	p.count--
+	p.vtime = target.vtime
+	target.vtime += float64(max(task.NQ(), 1)) / p.weight(target.name)
+
+	nodeID := paramtable.GetStringNodeID()
+	running := p.runningCounter(target.name).Inc()
+	metrics.QueryNodeTenantReadTaskConcurrency.WithLabelValues(nodeID, target.name).Set(float64(running))
+	metrics.QueryNodeTenantReadTaskReadyLen.WithLabelValues(nodeID, target.name).Set(float64(target.len()))
+	return task
+}
+
+// Finish is called once a popped task is finished, concurrent safe.
+func (p *weightedFairPolicy) Finish(task Task) {
+	tenant := p.tenant(task)
+	running := p.runningCounter(tenant).Dec()
+	metrics.QueryNodeTenantReadTaskConcurrency.WithLabelValues(paramtable.GetStringNodeID(), tenant).Set(float64(running))
+}
+
+// RemoveCanceled removes the canceled tasks from the queues of all tenants.
+func (p *weightedFairPolicy) RemoveCanceled() ([]Task, int) {
+	if p.count == 0 {
+		return nil, 0
+	}
+	var canceled []Task
+	removed := 0
+	for tenant, queue := range p.queues {
+		tasks, n := queue.removeCanceled()
+		canceled = append(canceled, tasks...)
+		if n == 0 {
+			continue
+		}
+		removed += n
+		metrics.QueryNodeTenantReadTaskReadyLen.WithLabelValues(paramtable.GetStringNodeID(), tenant).Set(float64(queue.len()))
+	}
+	p.count -= removed
+	return canceled, removed
+}
+
+func (p *weightedFairPolicy) Len() int {
+	return p.count
+}
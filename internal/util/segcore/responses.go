@@ -9,11 +9,19 @@ package segcore
 import "C"
 
 import (
+	"time"
+
 	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
 )
 
 type SearchResult struct {
 	cSearchResult C.CSearchResult
+	cpuTime       time.Duration
+}
+
+// CPUTime returns the cpu time of the segcore thread executing the search.
+func (r *SearchResult) CPUTime() time.Duration {
+	return r.cpuTime
 }
 
 func (r *SearchResult) Release() {
@@ -23,6 +31,12 @@ func (r *SearchResult) Release() {
 
 type RetrieveResult struct {
 	cRetrieveResult *C.CRetrieveResult
+	cpuTime         time.Duration
+}
+
+// CPUTime returns the cpu time of the segcore thread executing the retrieve.
+func (r *RetrieveResult) CPUTime() time.Duration {
+	return r.cpuTime
 }
 
 func (r *RetrieveResult) GetResult() (*segcorepb.RetrieveResults, error) {
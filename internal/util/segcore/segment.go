@@ -126,7 +126,7 @@ func (s *cSegmentImpl) Search(ctx context.Context, searchReq *SearchRequest) (*S
 	if err != nil {
 		return nil, err
 	}
-	return &SearchResult{cSearchResult: (C.CSearchResult)(result)}, nil
+	return &SearchResult{cSearchResult: (C.CSearchResult)(result), cpuTime: future.CPUTime()}, nil
 }
 
 // Retrieve retrieves entities from the segment.
@@ -155,7 +155,7 @@ func (s *cSegmentImpl) Retrieve(ctx context.Context, plan *RetrievePlan) (*Retri
 	if err != nil {
 		return nil, err
 	}
-	return &RetrieveResult{cRetrieveResult: (*C.CRetrieveResult)(result)}, nil
+	return &RetrieveResult{cRetrieveResult: (*C.CRetrieveResult)(result), cpuTime: future.CPUTime()}, nil
 }
 
 // RetrieveByOffsets retrieves entities from the segment by offsets.
@@ -187,7 +187,7 @@ func (s *cSegmentImpl) RetrieveByOffsets(ctx context.Context, plan *RetrievePlan
 	if err != nil {
 		return nil, err
 	}
-	return &RetrieveResult{cRetrieveResult: (*C.CRetrieveResult)(result)}, nil
+	return &RetrieveResult{cRetrieveResult: (*C.CRetrieveResult)(result), cpuTime: future.CPUTime()}, nil
 }
 
 // Insert inserts entities into the segment.
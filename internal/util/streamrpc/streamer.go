@@ -101,10 +101,14 @@ func mergeCostAggregation(a *internalpb.CostAggregation, b *internalpb.CostAggre
 	}
 
 	return &internalpb.CostAggregation{
-		ResponseTime:         a.GetResponseTime() + b.GetResponseTime(),
-		ServiceTime:          a.GetServiceTime() + b.GetServiceTime(),
-		TotalNQ:              a.GetTotalNQ(),
-		TotalRelatedDataSize: a.GetTotalRelatedDataSize() + b.GetTotalRelatedDataSize(),
+		ResponseTime:            a.GetResponseTime() + b.GetResponseTime(),
+		ServiceTime:             a.GetServiceTime() + b.GetServiceTime(),
+		TotalNQ:                 a.GetTotalNQ(),
+		TotalRelatedDataSize:    a.GetTotalRelatedDataSize() + b.GetTotalRelatedDataSize(),
+		TotalSegmentsScanned:    a.GetTotalSegmentsScanned() + b.GetTotalSegmentsScanned(),
+		TotalRowsScanned:        a.GetTotalRowsScanned() + b.GetTotalRowsScanned(),
+		TotalStorageBytes:       a.GetTotalStorageBytes() + b.GetTotalStorageBytes(),
+		TotalQuerynodeCpuTimeUs: a.GetTotalQuerynodeCpuTimeUs() + b.GetTotalQuerynodeCpuTimeUs(),
 	}
 }
 
@@ -165,13 +165,15 @@ func (s *ResultCacheServerSuite) TestMerge() {
 	s.Equal(cost, mergeCostAggregation(nil, cost))
 	s.Equal(cost, mergeCostAggregation(cost, nil))
 
-	a := &internalpb.CostAggregation{ResponseTime: 1, ServiceTime: 1, TotalNQ: 2, TotalRelatedDataSize: 1}
-	b := &internalpb.CostAggregation{ResponseTime: 2, ServiceTime: 2, TotalNQ: 2, TotalRelatedDataSize: 2}
+	a := &internalpb.CostAggregation{ResponseTime: 1, ServiceTime: 1, TotalNQ: 2, TotalRelatedDataSize: 1, TotalSegmentsScanned: 1, TotalQuerynodeCpuTimeUs: 10}
+	b := &internalpb.CostAggregation{ResponseTime: 2, ServiceTime: 2, TotalNQ: 2, TotalRelatedDataSize: 2, TotalSegmentsScanned: 2, TotalQuerynodeCpuTimeUs: 20}
 	c := mergeCostAggregation(a, b)
 	s.Equal(int64(3), c.ResponseTime)
 	s.Equal(int64(3), c.ServiceTime)
 	s.Equal(int64(2), c.TotalNQ)
 	s.Equal(int64(3), c.TotalRelatedDataSize)
+	s.Equal(int64(3), c.TotalSegmentsScanned)
+	s.Equal(int64(30), c.TotalQuerynodeCpuTimeUs)
 }
 
 func TestResultCacheServerSuite(t *testing.T) {
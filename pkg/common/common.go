@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -204,6 +205,8 @@ const (
 	// collection level load properties
 	CollectionReplicaNumber  = "collection.replica.number"
 	CollectionResourceGroups = "collection.resource_groups"
+	// the collection is released after no search or query for the minutes, and reloaded on the next access
+	CollectionIdleReleaseMinutesKey = "collection.idleRelease.minutes"
 )
 
 // common properties
@@ -216,6 +219,7 @@ const (
 	ReplicateIDKey             = "replicate.id"
 	ReplicateEndTSKey          = "replicate.endTS"
 	IndexNonEncoding           = "index.nonEncoding"
+	HedgedRequestEnabledKey    = "hedgedrequest.enabled"
 )
 
 const (
@@ -223,6 +227,24 @@ const (
 	TraceIDKey    string = "uber-trace-id"
 )
 
+// keys of the resource usage summary in the status extra info of search and query responses
+const (
+	ResourceUsageSegmentsScannedKey  = "segments_scanned"
+	ResourceUsageRowsScannedKey      = "rows_scanned"
+	ResourceUsageStorageBytesKey     = "storage_bytes"
+	ResourceUsageQueryNodeCPUTimeKey = "querynode_cpu_time_us"
+	ResourceUsageReduceTimeKey       = "reduce_time_us"
+)
+
+// ResourceUsageKeys are the keys of the resource usage summary in order.
+var ResourceUsageKeys = []string{
+	ResourceUsageSegmentsScannedKey,
+	ResourceUsageRowsScannedKey,
+	ResourceUsageStorageBytesKey,
+	ResourceUsageQueryNodeCPUTimeKey,
+	ResourceUsageReduceTimeKey,
+}
+
 func IsSystemField(fieldID int64) bool {
 	return fieldID < StartOfUserFieldID
 }
@@ -289,6 +311,16 @@ func IsCollectionLazyLoadEnabled(kvs ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+// IsHedgedRequestEnabled returns whether the slow shard requests of the collection are hedged to another replica.
+func IsHedgedRequestEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == HedgedRequestEnabledKey && strings.ToLower(kv.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
 func IsPartitionKeyIsolationKvEnabled(kvs ...*commonpb.KeyValuePair) (bool, error) {
 	for _, kv := range kvs {
 		if kv.Key == PartitionKeyIsolationKey {
@@ -454,6 +486,21 @@ func GetReplicateEndTS(kvs []*commonpb.KeyValuePair) (uint64, bool) {
 	return 0, false
 }
 
+// GetCollectionIdleReleaseDuration returns how long the collection stays loaded without any search or query,
+// false if the collection is never released for idle.
+func GetCollectionIdleReleaseDuration(kvs ...*commonpb.KeyValuePair) (time.Duration, bool) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionIdleReleaseMinutesKey {
+			minutes, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil || minutes <= 0 {
+				return 0, false
+			}
+			return time.Duration(minutes) * time.Minute, true
+		}
+	}
+	return 0, false
+}
+
 func ValidateAutoIndexMmapConfig(autoIndexConfigEnable, isVectorField bool, indexParams map[string]string) error {
 	if !autoIndexConfigEnable {
 		return nil
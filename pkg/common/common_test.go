@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -178,6 +179,19 @@ func TestShouldFieldBeLoaded(t *testing.T) {
 	}
 }
 
+func TestCollectionIdleReleaseProperty(t *testing.T) {
+	d, ok := GetCollectionIdleReleaseDuration(&commonpb.KeyValuePair{Key: CollectionIdleReleaseMinutesKey, Value: "30"})
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Minute, d)
+
+	_, ok = GetCollectionIdleReleaseDuration(&commonpb.KeyValuePair{Key: CollectionIdleReleaseMinutesKey, Value: "0"})
+	assert.False(t, ok)
+	_, ok = GetCollectionIdleReleaseDuration(&commonpb.KeyValuePair{Key: CollectionIdleReleaseMinutesKey, Value: "abc"})
+	assert.False(t, ok)
+	_, ok = GetCollectionIdleReleaseDuration(&commonpb.KeyValuePair{Key: "foo", Value: "30"})
+	assert.False(t, ok)
+}
+
 func TestReplicateProperty(t *testing.T) {
 	t.Run("ReplicateID", func(t *testing.T) {
 		{
@@ -41,6 +41,9 @@ const (
 	QueryLabel     = "query"
 	CacheHitLabel  = "hit"
 	CacheMissLabel = "miss"
+	HedgeSentLabel = "hedge_sent"
+	HedgeWinLabel  = "hedge_win"
+	HedgeSkipLabel = "hedge_skip"
 	TimetickLabel  = "timetick"
 	AllLabel       = "all"
 
@@ -127,6 +130,8 @@ const (
 	cgoNameLabelName         = `cgo_name`
 	cgoTypeLabelName         = `cgo_type`
 	queueTypeLabelName       = `queue_type`
+	projectionNameLabelName  = "projection_name"
+	tenantLabelName          = "tenant"
 
 	// model function/UDF labels
 	functionTypeName = "function_type_name"
@@ -171,6 +176,15 @@ var (
 			lockOp,
 		})
 
+	// FunctionEmbeddingCacheCounter records the hits and misses of the text embedding cache,
+	// which works on any node running the TextEmbedding functions.
+	FunctionEmbeddingCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Name:      "function_embedding_cache_count",
+			Help:      "the number of texts looked up in the text embedding cache",
+		}, []string{nodeIDLabelName, roleNameLabelName, functionProvider, cacheStateLabelName})
+
 	metricRegisterer prometheus.Registerer
 )
 
@@ -191,5 +205,6 @@ func Register(r prometheus.Registerer) {
 	r.MustRegister(BuildInfo)
 	r.MustRegister(RuntimeInfo)
 	r.MustRegister(ThreadNum)
+	r.MustRegister(FunctionEmbeddingCacheCounter)
 	metricRegisterer = r
 }
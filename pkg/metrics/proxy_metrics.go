@@ -206,6 +206,33 @@ var (
 			Help:      "now time minus tt per physical channel",
 		}, []string{nodeIDLabelName, channelNameLabelName})
 
+	// ProxyFollowerReplicationLag records how far a follower cluster falls behind the primary wal, differentiated by Channel.
+	ProxyFollowerReplicationLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "follower_replication_lag_ms",
+			Help:      "now time minus the latest wal time tick seen by a follower cluster per physical channel",
+		}, []string{nodeIDLabelName, channelNameLabelName})
+
+	// ProxyResultCacheMemorySize record the memory size of the cached search and query results.
+	ProxyResultCacheMemorySize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "result_cache_memory_size",
+			Help:      "memory size in bytes of the cached search and query results",
+		}, []string{nodeIDLabelName})
+
+	// ProxyHedgedRequestCount record the number of hedged shard requests sent, won over the first request, and skipped by the budget.
+	ProxyHedgedRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "hedged_request_count",
+			Help:      "count of hedged shard requests",
+		}, []string{nodeIDLabelName, statusLabelName})
+
 	// ProxyApplyPrimaryKeyLatency record the latency that apply primary key.
 	ProxyApplyPrimaryKeyLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -484,6 +511,9 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyUpdateCacheLatency)
 
 	registry.MustRegister(ProxySyncTimeTickLag)
+	registry.MustRegister(ProxyFollowerReplicationLag)
+	registry.MustRegister(ProxyResultCacheMemorySize)
+	registry.MustRegister(ProxyHedgedRequestCount)
 	registry.MustRegister(ProxyApplyPrimaryKeyLatency)
 	registry.MustRegister(ProxyApplyTimestampLatency)
 
@@ -80,6 +80,26 @@ var (
 			statusLabelName,
 		})
 
+	QueryCoordIdleReleaseCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "idle_release_count",
+			Help:      "count of collections released for no search or query",
+		}, []string{
+			statusLabelName,
+		})
+
+	QueryCoordIdleReloadCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "idle_reload_count",
+			Help:      "count of collections released for idle and reloaded on access",
+		}, []string{
+			statusLabelName,
+		})
+
 	QueryCoordLoadLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -165,6 +185,8 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordNumPartitions)
 	registry.MustRegister(QueryCoordLoadCount)
 	registry.MustRegister(QueryCoordReleaseCount)
+	registry.MustRegister(QueryCoordIdleReleaseCount)
+	registry.MustRegister(QueryCoordIdleReloadCount)
 	registry.MustRegister(QueryCoordLoadLatency)
 	registry.MustRegister(QueryCoordReleaseLatency)
 	registry.MustRegister(QueryCoordTaskNum)
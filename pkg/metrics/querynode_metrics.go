@@ -286,6 +286,49 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeTenantReadTaskReadyLen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tenant_read_task_ready_len",
+			Help:      "number of ready read tasks of each tenant in weighted-fair scheduler",
+		}, []string{
+			nodeIDLabelName,
+			tenantLabelName,
+		})
+
+	QueryNodeTenantReadTaskConcurrency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tenant_read_task_concurrency",
+			Help:      "number of concurrent executing read tasks of each tenant in weighted-fair scheduler",
+		}, []string{
+			nodeIDLabelName,
+			tenantLabelName,
+		})
+
+	QueryNodeTenantReadTaskThrottledCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tenant_read_task_throttled_count",
+			Help:      "count of the read tasks of each tenant delayed by the max concurrency per tenant in weighted-fair scheduler",
+		}, []string{
+			nodeIDLabelName,
+			tenantLabelName,
+		})
+
+	QueryNodeReadTaskCanceledCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "read_task_canceled_count",
+			Help:      "count of the read tasks removed from the ready queue since canceled before executing",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	QueryNodeReadTaskConcurrency = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -847,6 +890,10 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeReadTaskUnsolveLen)
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
 	registry.MustRegister(QueryNodeReadTaskConcurrency)
+	registry.MustRegister(QueryNodeTenantReadTaskReadyLen)
+	registry.MustRegister(QueryNodeTenantReadTaskConcurrency)
+	registry.MustRegister(QueryNodeTenantReadTaskThrottledCount)
+	registry.MustRegister(QueryNodeReadTaskCanceledCount)
 	registry.MustRegister(QueryNodeEstimateCPUUsage)
 	registry.MustRegister(QueryNodeSearchGroupNQ)
 	registry.MustRegister(QueryNodeSearchNQ)
@@ -243,6 +243,15 @@ var (
 			Name:      "disk_quota",
 			Help:      "disk quota",
 		}, []string{"node_id", "scope"})
+
+	// RootCoordProjectionLag records the lag of the projections applying the changes of the source collection.
+	RootCoordProjectionLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "projection_lag_ms",
+			Help:      "now time minus the consistent ts of the projection",
+		}, []string{databaseLabelName, projectionNameLabelName})
 )
 
 // RegisterRootCoord registers RootCoord metrics
@@ -284,6 +293,7 @@ func RegisterMixCoord(registry *prometheus.Registry) {
 
 	registry.MustRegister(QueryNodeMemoryHighWaterLevel)
 	registry.MustRegister(DiskQuota)
+	registry.MustRegister(RootCoordProjectionLag)
 
 	RegisterStreamingServiceClient(registry)
 	RegisterQueryCoord(registry)
@@ -295,3 +305,10 @@ func CleanupRootCoordDBMetrics(dbName string) {
 		databaseLabelName: dbName,
 	})
 }
+
+func CleanupRootCoordProjectionMetrics(dbName string, projectionName string) {
+	RootCoordProjectionLag.Delete(prometheus.Labels{
+		databaseLabelName:       dbName,
+		projectionNameLabelName: projectionName,
+	})
+}
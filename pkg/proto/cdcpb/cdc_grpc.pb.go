@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.4
+// source: cdc.proto
+
+package cdcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChangeDataCapture_WatchChanges_FullMethodName = "/milvus.proto.cdc.ChangeDataCapture/WatchChanges"
+)
+
+// ChangeDataCaptureClient is the client API for ChangeDataCapture service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangeDataCaptureClient interface {
+	// WatchChanges streams the row-level and ddl changes of a collection decoded from the wal.
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (ChangeDataCapture_WatchChangesClient, error)
+}
+
+type changeDataCaptureClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangeDataCaptureClient(cc grpc.ClientConnInterface) ChangeDataCaptureClient {
+	return &changeDataCaptureClient{cc}
+}
+
+func (c *changeDataCaptureClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (ChangeDataCapture_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChangeDataCapture_ServiceDesc.Streams[0], ChangeDataCapture_WatchChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &changeDataCaptureWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChangeDataCapture_WatchChangesClient interface {
+	Recv() (*WatchChangesResponse, error)
+	grpc.ClientStream
+}
+
+type changeDataCaptureWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *changeDataCaptureWatchChangesClient) Recv() (*WatchChangesResponse, error) {
+	m := new(WatchChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChangeDataCaptureServer is the server API for ChangeDataCapture service.
+// All implementations should embed UnimplementedChangeDataCaptureServer
+// for forward compatibility
+type ChangeDataCaptureServer interface {
+	// WatchChanges streams the row-level and ddl changes of a collection decoded from the wal.
+	WatchChanges(*WatchChangesRequest, ChangeDataCapture_WatchChangesServer) error
+}
+
+// UnimplementedChangeDataCaptureServer should be embedded to have forward compatible implementations.
+type UnimplementedChangeDataCaptureServer struct {
+}
+
+func (UnimplementedChangeDataCaptureServer) WatchChanges(*WatchChangesRequest, ChangeDataCapture_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+
+// UnsafeChangeDataCaptureServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangeDataCaptureServer will
+// result in compilation errors.
+type UnsafeChangeDataCaptureServer interface {
+	mustEmbedUnimplementedChangeDataCaptureServer()
+}
+
+func RegisterChangeDataCaptureServer(s grpc.ServiceRegistrar, srv ChangeDataCaptureServer) {
+	s.RegisterService(&ChangeDataCapture_ServiceDesc, srv)
+}
+
+func _ChangeDataCapture_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChangeDataCaptureServer).WatchChanges(m, &changeDataCaptureWatchChangesServer{stream})
+}
+
+type ChangeDataCapture_WatchChangesServer interface {
+	Send(*WatchChangesResponse) error
+	grpc.ServerStream
+}
+
+type changeDataCaptureWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *changeDataCaptureWatchChangesServer) Send(m *WatchChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ChangeDataCapture_ServiceDesc is the grpc.ServiceDesc for ChangeDataCapture service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangeDataCapture_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.cdc.ChangeDataCapture",
+	HandlerType: (*ChangeDataCaptureServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _ChangeDataCapture_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cdc.proto",
+}
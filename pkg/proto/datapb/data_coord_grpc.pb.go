@@ -36,6 +36,7 @@ const (
 	DataCoord_GetRecoveryInfo_FullMethodName             = "/milvus.proto.data.DataCoord/GetRecoveryInfo"
 	DataCoord_GetRecoveryInfoV2_FullMethodName           = "/milvus.proto.data.DataCoord/GetRecoveryInfoV2"
 	DataCoord_GetChannelRecoveryInfo_FullMethodName      = "/milvus.proto.data.DataCoord/GetChannelRecoveryInfo"
+	DataCoord_TransferSegments_FullMethodName            = "/milvus.proto.data.DataCoord/TransferSegments"
 	DataCoord_GetFlushedSegments_FullMethodName          = "/milvus.proto.data.DataCoord/GetFlushedSegments"
 	DataCoord_GetSegmentsByStates_FullMethodName         = "/milvus.proto.data.DataCoord/GetSegmentsByStates"
 	DataCoord_GetFlushAllState_FullMethodName            = "/milvus.proto.data.DataCoord/GetFlushAllState"
@@ -62,6 +63,7 @@ const (
 	DataCoord_DescribeIndex_FullMethodName               = "/milvus.proto.data.DataCoord/DescribeIndex"
 	DataCoord_GetIndexStatistics_FullMethodName          = "/milvus.proto.data.DataCoord/GetIndexStatistics"
 	DataCoord_GetIndexBuildProgress_FullMethodName       = "/milvus.proto.data.DataCoord/GetIndexBuildProgress"
+	DataCoord_GetFunctionBackfillProgress_FullMethodName = "/milvus.proto.data.DataCoord/GetFunctionBackfillProgress"
 	DataCoord_ListIndexes_FullMethodName                 = "/milvus.proto.data.DataCoord/ListIndexes"
 	DataCoord_GcConfirm_FullMethodName                   = "/milvus.proto.data.DataCoord/GcConfirm"
 	DataCoord_ReportDataNodeTtMsgs_FullMethodName        = "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs"
@@ -90,6 +92,8 @@ type DataCoordClient interface {
 	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
 	GetRecoveryInfoV2(ctx context.Context, in *GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*GetRecoveryInfoResponseV2, error)
 	GetChannelRecoveryInfo(ctx context.Context, in *GetChannelRecoveryInfoRequest, opts ...grpc.CallOption) (*GetChannelRecoveryInfoResponse, error)
+	// TransferSegments copies the sealed segments of a partition into another partition which has the same schema.
+	TransferSegments(ctx context.Context, in *TransferSegmentsRequest, opts ...grpc.CallOption) (*TransferSegmentsResponse, error)
 	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
 	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
 	GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)
@@ -120,6 +124,7 @@ type DataCoordClient interface {
 	GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
+	GetFunctionBackfillProgress(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)
 	ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error)
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -256,6 +261,15 @@ func (c *dataCoordClient) GetChannelRecoveryInfo(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *dataCoordClient) TransferSegments(ctx context.Context, in *TransferSegmentsRequest, opts ...grpc.CallOption) (*TransferSegmentsResponse, error) {
+	out := new(TransferSegmentsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_TransferSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
 	out := new(GetFlushedSegmentsResponse)
 	err := c.cc.Invoke(ctx, DataCoord_GetFlushedSegments_FullMethodName, in, out, opts...)
@@ -490,6 +504,15 @@ func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb
 	return out, nil
 }
 
+func (c *dataCoordClient) GetFunctionBackfillProgress(ctx context.Context, in *indexpb.GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	out := new(indexpb.GetFunctionBackfillProgressResponse)
+	err := c.cc.Invoke(ctx, DataCoord_GetFunctionBackfillProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
 	out := new(indexpb.ListIndexesResponse)
 	err := c.cc.Invoke(ctx, DataCoord_ListIndexes_FullMethodName, in, out, opts...)
@@ -572,6 +595,8 @@ type DataCoordServer interface {
 	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
 	GetRecoveryInfoV2(context.Context, *GetRecoveryInfoRequestV2) (*GetRecoveryInfoResponseV2, error)
 	GetChannelRecoveryInfo(context.Context, *GetChannelRecoveryInfoRequest) (*GetChannelRecoveryInfoResponse, error)
+	// TransferSegments copies the sealed segments of a partition into another partition which has the same schema.
+	TransferSegments(context.Context, *TransferSegmentsRequest) (*TransferSegmentsResponse, error)
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
 	GetSegmentsByStates(context.Context, *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error)
 	GetFlushAllState(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)
@@ -602,6 +627,7 @@ type DataCoordServer interface {
 	GetIndexStatistics(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
+	GetFunctionBackfillProgress(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)
 	ListIndexes(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error)
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
@@ -655,6 +681,9 @@ func (UnimplementedDataCoordServer) GetRecoveryInfoV2(context.Context, *GetRecov
 func (UnimplementedDataCoordServer) GetChannelRecoveryInfo(context.Context, *GetChannelRecoveryInfoRequest) (*GetChannelRecoveryInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannelRecoveryInfo not implemented")
 }
+func (UnimplementedDataCoordServer) TransferSegments(context.Context, *TransferSegmentsRequest) (*TransferSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSegments not implemented")
+}
 func (UnimplementedDataCoordServer) GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
 }
@@ -733,6 +762,9 @@ func (UnimplementedDataCoordServer) GetIndexStatistics(context.Context, *indexpb
 func (UnimplementedDataCoordServer) GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
 }
+func (UnimplementedDataCoordServer) GetFunctionBackfillProgress(context.Context, *indexpb.GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunctionBackfillProgress not implemented")
+}
 func (UnimplementedDataCoordServer) ListIndexes(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIndexes not implemented")
 }
@@ -1000,6 +1032,24 @@ func _DataCoord_GetChannelRecoveryInfo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_TransferSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).TransferSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_TransferSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).TransferSegments(ctx, req.(*TransferSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFlushedSegmentsRequest)
 	if err := dec(in); err != nil {
@@ -1468,6 +1518,24 @@ func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_GetFunctionBackfillProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetFunctionBackfillProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFunctionBackfillProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_GetFunctionBackfillProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFunctionBackfillProgress(ctx, req.(*indexpb.GetFunctionBackfillProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(indexpb.ListIndexesRequest)
 	if err := dec(in); err != nil {
@@ -1653,6 +1721,10 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChannelRecoveryInfo",
 			Handler:    _DataCoord_GetChannelRecoveryInfo_Handler,
 		},
+		{
+			MethodName: "TransferSegments",
+			Handler:    _DataCoord_TransferSegments_Handler,
+		},
 		{
 			MethodName: "GetFlushedSegments",
 			Handler:    _DataCoord_GetFlushedSegments_Handler,
@@ -1757,6 +1829,10 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIndexBuildProgress",
 			Handler:    _DataCoord_GetIndexBuildProgress_Handler,
 		},
+		{
+			MethodName: "GetFunctionBackfillProgress",
+			Handler:    _DataCoord_GetFunctionBackfillProgress_Handler,
+		},
 		{
 			MethodName: "ListIndexes",
 			Handler:    _DataCoord_ListIndexes_Handler,
@@ -59,6 +59,10 @@ const (
 	QueryCoord_TransferChannel_FullMethodName            = "/milvus.proto.query.QueryCoord/TransferChannel"
 	QueryCoord_CheckQueryNodeDistribution_FullMethodName = "/milvus.proto.query.QueryCoord/CheckQueryNodeDistribution"
 	QueryCoord_UpdateLoadConfig_FullMethodName           = "/milvus.proto.query.QueryCoord/UpdateLoadConfig"
+	QueryCoord_ReportCollectionAccess_FullMethodName     = "/milvus.proto.query.QueryCoord/ReportCollectionAccess"
+	QueryCoord_BeginReadSession_FullMethodName           = "/milvus.proto.query.QueryCoord/BeginReadSession"
+	QueryCoord_EndReadSession_FullMethodName             = "/milvus.proto.query.QueryCoord/EndReadSession"
+	QueryCoord_GetReadSession_FullMethodName             = "/milvus.proto.query.QueryCoord/GetReadSession"
 )
 
 // QueryCoordClient is the client API for QueryCoord service.
@@ -105,6 +109,10 @@ type QueryCoordClient interface {
 	TransferChannel(ctx context.Context, in *TransferChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CheckQueryNodeDistribution(ctx context.Context, in *CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateLoadConfig(ctx context.Context, in *UpdateLoadConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReportCollectionAccess(ctx context.Context, in *ReportCollectionAccessRequest, opts ...grpc.CallOption) (*ReportCollectionAccessResponse, error)
+	BeginReadSession(ctx context.Context, in *BeginReadSessionRequest, opts ...grpc.CallOption) (*BeginReadSessionResponse, error)
+	EndReadSession(ctx context.Context, in *EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetReadSession(ctx context.Context, in *GetReadSessionRequest, opts ...grpc.CallOption) (*GetReadSessionResponse, error)
 }
 
 type queryCoordClient struct {
@@ -448,6 +456,42 @@ func (c *queryCoordClient) UpdateLoadConfig(ctx context.Context, in *UpdateLoadC
 	return out, nil
 }
 
+func (c *queryCoordClient) ReportCollectionAccess(ctx context.Context, in *ReportCollectionAccessRequest, opts ...grpc.CallOption) (*ReportCollectionAccessResponse, error) {
+	out := new(ReportCollectionAccessResponse)
+	err := c.cc.Invoke(ctx, QueryCoord_ReportCollectionAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) BeginReadSession(ctx context.Context, in *BeginReadSessionRequest, opts ...grpc.CallOption) (*BeginReadSessionResponse, error) {
+	out := new(BeginReadSessionResponse)
+	err := c.cc.Invoke(ctx, QueryCoord_BeginReadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) EndReadSession(ctx context.Context, in *EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, QueryCoord_EndReadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReadSession(ctx context.Context, in *GetReadSessionRequest, opts ...grpc.CallOption) (*GetReadSessionResponse, error) {
+	out := new(GetReadSessionResponse)
+	err := c.cc.Invoke(ctx, QueryCoord_GetReadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 // All implementations should embed UnimplementedQueryCoordServer
 // for forward compatibility
@@ -492,6 +536,10 @@ type QueryCoordServer interface {
 	TransferChannel(context.Context, *TransferChannelRequest) (*commonpb.Status, error)
 	CheckQueryNodeDistribution(context.Context, *CheckQueryNodeDistributionRequest) (*commonpb.Status, error)
 	UpdateLoadConfig(context.Context, *UpdateLoadConfigRequest) (*commonpb.Status, error)
+	ReportCollectionAccess(context.Context, *ReportCollectionAccessRequest) (*ReportCollectionAccessResponse, error)
+	BeginReadSession(context.Context, *BeginReadSessionRequest) (*BeginReadSessionResponse, error)
+	EndReadSession(context.Context, *EndReadSessionRequest) (*commonpb.Status, error)
+	GetReadSession(context.Context, *GetReadSessionRequest) (*GetReadSessionResponse, error)
 }
 
 // UnimplementedQueryCoordServer should be embedded to have forward compatible implementations.
@@ -609,6 +657,18 @@ func (UnimplementedQueryCoordServer) CheckQueryNodeDistribution(context.Context,
 func (UnimplementedQueryCoordServer) UpdateLoadConfig(context.Context, *UpdateLoadConfigRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLoadConfig not implemented")
 }
+func (UnimplementedQueryCoordServer) ReportCollectionAccess(context.Context, *ReportCollectionAccessRequest) (*ReportCollectionAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCollectionAccess not implemented")
+}
+func (UnimplementedQueryCoordServer) BeginReadSession(context.Context, *BeginReadSessionRequest) (*BeginReadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginReadSession not implemented")
+}
+func (UnimplementedQueryCoordServer) EndReadSession(context.Context, *EndReadSessionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndReadSession not implemented")
+}
+func (UnimplementedQueryCoordServer) GetReadSession(context.Context, *GetReadSessionRequest) (*GetReadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadSession not implemented")
+}
 
 // UnsafeQueryCoordServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryCoordServer will
@@ -1287,6 +1347,78 @@ func _QueryCoord_UpdateLoadConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_ReportCollectionAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCollectionAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ReportCollectionAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryCoord_ReportCollectionAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ReportCollectionAccess(ctx, req.(*ReportCollectionAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_BeginReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).BeginReadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryCoord_BeginReadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).BeginReadSession(ctx, req.(*BeginReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_EndReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).EndReadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryCoord_EndReadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).EndReadSession(ctx, req.(*EndReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryCoord_GetReadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReadSession(ctx, req.(*GetReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryCoord_ServiceDesc is the grpc.ServiceDesc for QueryCoord service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1442,6 +1574,22 @@ var QueryCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLoadConfig",
 			Handler:    _QueryCoord_UpdateLoadConfig_Handler,
 		},
+		{
+			MethodName: "ReportCollectionAccess",
+			Handler:    _QueryCoord_ReportCollectionAccess_Handler,
+		},
+		{
+			MethodName: "BeginReadSession",
+			Handler:    _QueryCoord_BeginReadSession_Handler,
+		},
+		{
+			MethodName: "EndReadSession",
+			Handler:    _QueryCoord_EndReadSession_Handler,
+		},
+		{
+			MethodName: "GetReadSession",
+			Handler:    _QueryCoord_GetReadSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -10,8 +10,10 @@ import (
 	context "context"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	indexpb "github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	internalpb "github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	proxypb "github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	querypb "github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -75,6 +77,13 @@ const (
 	RootCoord_OperatePrivilegeGroup_FullMethodName         = "/milvus.proto.rootcoord.RootCoord/OperatePrivilegeGroup"
 	RootCoord_CheckHealth_FullMethodName                   = "/milvus.proto.rootcoord.RootCoord/CheckHealth"
 	RootCoord_RenameCollection_FullMethodName              = "/milvus.proto.rootcoord.RootCoord/RenameCollection"
+	RootCoord_RenamePartition_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/RenamePartition"
+	RootCoord_RenameField_FullMethodName                   = "/milvus.proto.rootcoord.RootCoord/RenameField"
+	RootCoord_AddCollectionFunction_FullMethodName         = "/milvus.proto.rootcoord.RootCoord/AddCollectionFunction"
+	RootCoord_TransferPartition_FullMethodName             = "/milvus.proto.rootcoord.RootCoord/TransferPartition"
+	RootCoord_CreateProjection_FullMethodName              = "/milvus.proto.rootcoord.RootCoord/CreateProjection"
+	RootCoord_DropProjection_FullMethodName                = "/milvus.proto.rootcoord.RootCoord/DropProjection"
+	RootCoord_ListProjections_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/ListProjections"
 	RootCoord_CreateDatabase_FullMethodName                = "/milvus.proto.rootcoord.RootCoord/CreateDatabase"
 	RootCoord_DropDatabase_FullMethodName                  = "/milvus.proto.rootcoord.RootCoord/DropDatabase"
 	RootCoord_ListDatabases_FullMethodName                 = "/milvus.proto.rootcoord.RootCoord/ListDatabases"
@@ -195,6 +204,13 @@ type RootCoordClient interface {
 	OperatePrivilegeGroup(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
 	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddCollectionFunction(ctx context.Context, in *AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TransferPartition(ctx context.Context, in *TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateProjection(ctx context.Context, in *CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropProjection(ctx context.Context, in *DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListProjections(ctx context.Context, in *ListProjectionsRequest, opts ...grpc.CallOption) (*ListProjectionsResponse, error)
 	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
@@ -679,6 +695,69 @@ func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.Ren
 	return out, nil
 }
 
+func (c *rootCoordClient) RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_RenamePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_RenameField_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AddCollectionFunction(ctx context.Context, in *AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_AddCollectionFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) TransferPartition(ctx context.Context, in *TransferPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_TransferPartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateProjection(ctx context.Context, in *CreateProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_CreateProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropProjection(ctx context.Context, in *DropProjectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_DropProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListProjections(ctx context.Context, in *ListProjectionsRequest, opts ...grpc.CallOption) (*ListProjectionsResponse, error) {
+	out := new(ListProjectionsResponse)
+	err := c.cc.Invoke(ctx, RootCoord_ListProjections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, RootCoord_CreateDatabase_FullMethodName, in, out, opts...)
@@ -845,6 +924,13 @@ type RootCoordServer interface {
 	OperatePrivilegeGroup(context.Context, *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error)
+	RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error)
+	AddCollectionFunction(context.Context, *AddCollectionFunctionRequest) (*commonpb.Status, error)
+	TransferPartition(context.Context, *TransferPartitionRequest) (*commonpb.Status, error)
+	CreateProjection(context.Context, *CreateProjectionRequest) (*commonpb.Status, error)
+	DropProjection(context.Context, *DropProjectionRequest) (*commonpb.Status, error)
+	ListProjections(context.Context, *ListProjectionsRequest) (*ListProjectionsResponse, error)
 	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
 	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
 	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
@@ -1013,6 +1099,27 @@ func (UnimplementedRootCoordServer) CheckHealth(context.Context, *milvuspb.Check
 func (UnimplementedRootCoordServer) RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
 }
+func (UnimplementedRootCoordServer) RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePartition not implemented")
+}
+func (UnimplementedRootCoordServer) RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameField not implemented")
+}
+func (UnimplementedRootCoordServer) AddCollectionFunction(context.Context, *AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionFunction not implemented")
+}
+func (UnimplementedRootCoordServer) TransferPartition(context.Context, *TransferPartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPartition not implemented")
+}
+func (UnimplementedRootCoordServer) CreateProjection(context.Context, *CreateProjectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjection not implemented")
+}
+func (UnimplementedRootCoordServer) DropProjection(context.Context, *DropProjectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropProjection not implemented")
+}
+func (UnimplementedRootCoordServer) ListProjections(context.Context, *ListProjectionsRequest) (*ListProjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjections not implemented")
+}
 func (UnimplementedRootCoordServer) CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
 }
@@ -1979,6 +2086,132 @@ func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_RenamePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenamePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_RenamePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenamePartition(ctx, req.(*RenamePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RenameField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_RenameField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameField(ctx, req.(*RenameFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AddCollectionFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddCollectionFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_AddCollectionFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddCollectionFunction(ctx, req.(*AddCollectionFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_TransferPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).TransferPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_TransferPartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).TransferPartition(ctx, req.(*TransferPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_CreateProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateProjection(ctx, req.(*CreateProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_DropProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropProjection(ctx, req.(*DropProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListProjections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListProjections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_ListProjections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListProjections(ctx, req.(*ListProjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreateDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -2302,6 +2535,34 @@ var RootCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameCollection",
 			Handler:    _RootCoord_RenameCollection_Handler,
 		},
+		{
+			MethodName: "RenamePartition",
+			Handler:    _RootCoord_RenamePartition_Handler,
+		},
+		{
+			MethodName: "RenameField",
+			Handler:    _RootCoord_RenameField_Handler,
+		},
+		{
+			MethodName: "AddCollectionFunction",
+			Handler:    _RootCoord_AddCollectionFunction_Handler,
+		},
+		{
+			MethodName: "TransferPartition",
+			Handler:    _RootCoord_TransferPartition_Handler,
+		},
+		{
+			MethodName: "CreateProjection",
+			Handler:    _RootCoord_CreateProjection_Handler,
+		},
+		{
+			MethodName: "DropProjection",
+			Handler:    _RootCoord_DropProjection_Handler,
+		},
+		{
+			MethodName: "ListProjections",
+			Handler:    _RootCoord_ListProjections_Handler,
+		},
 		{
 			MethodName: "CreateDatabase",
 			Handler:    _RootCoord_CreateDatabase_Handler,
@@ -2330,3 +2591,313 @@ var RootCoord_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
 }
+
+const (
+	MilvusExt_RenamePartition_FullMethodName             = "/milvus.proto.rootcoord.MilvusExt/RenamePartition"
+	MilvusExt_RenameField_FullMethodName                 = "/milvus.proto.rootcoord.MilvusExt/RenameField"
+	MilvusExt_FederatedSearch_FullMethodName             = "/milvus.proto.rootcoord.MilvusExt/FederatedSearch"
+	MilvusExt_AddCollectionFunction_FullMethodName       = "/milvus.proto.rootcoord.MilvusExt/AddCollectionFunction"
+	MilvusExt_GetFunctionBackfillProgress_FullMethodName = "/milvus.proto.rootcoord.MilvusExt/GetFunctionBackfillProgress"
+	MilvusExt_BeginReadSession_FullMethodName            = "/milvus.proto.rootcoord.MilvusExt/BeginReadSession"
+	MilvusExt_EndReadSession_FullMethodName              = "/milvus.proto.rootcoord.MilvusExt/EndReadSession"
+)
+
+// MilvusExtClient is the client API for MilvusExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MilvusExtClient interface {
+	RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	FederatedSearch(ctx context.Context, in *proxypb.FederatedSearchRequest, opts ...grpc.CallOption) (*proxypb.FederatedSearchResults, error)
+	AddCollectionFunction(ctx context.Context, in *AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetFunctionBackfillProgress(ctx context.Context, in *GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error)
+	BeginReadSession(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error)
+	EndReadSession(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type milvusExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMilvusExtClient(cc grpc.ClientConnInterface) MilvusExtClient {
+	return &milvusExtClient{cc}
+}
+
+func (c *milvusExtClient) RenamePartition(ctx context.Context, in *RenamePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_RenamePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) RenameField(ctx context.Context, in *RenameFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_RenameField_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) FederatedSearch(ctx context.Context, in *proxypb.FederatedSearchRequest, opts ...grpc.CallOption) (*proxypb.FederatedSearchResults, error) {
+	out := new(proxypb.FederatedSearchResults)
+	err := c.cc.Invoke(ctx, MilvusExt_FederatedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) AddCollectionFunction(ctx context.Context, in *AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_AddCollectionFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) GetFunctionBackfillProgress(ctx context.Context, in *GetFunctionBackfillProgressRequest, opts ...grpc.CallOption) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	out := new(indexpb.GetFunctionBackfillProgressResponse)
+	err := c.cc.Invoke(ctx, MilvusExt_GetFunctionBackfillProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) BeginReadSession(ctx context.Context, in *querypb.BeginReadSessionRequest, opts ...grpc.CallOption) (*querypb.BeginReadSessionResponse, error) {
+	out := new(querypb.BeginReadSessionResponse)
+	err := c.cc.Invoke(ctx, MilvusExt_BeginReadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtClient) EndReadSession(ctx context.Context, in *querypb.EndReadSessionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, MilvusExt_EndReadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusExtServer is the server API for MilvusExt service.
+// All implementations should embed UnimplementedMilvusExtServer
+// for forward compatibility
+type MilvusExtServer interface {
+	RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error)
+	RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error)
+	FederatedSearch(context.Context, *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error)
+	AddCollectionFunction(context.Context, *AddCollectionFunctionRequest) (*commonpb.Status, error)
+	GetFunctionBackfillProgress(context.Context, *GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error)
+	BeginReadSession(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error)
+	EndReadSession(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedMilvusExtServer should be embedded to have forward compatible implementations.
+type UnimplementedMilvusExtServer struct {
+}
+
+func (UnimplementedMilvusExtServer) RenamePartition(context.Context, *RenamePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePartition not implemented")
+}
+func (UnimplementedMilvusExtServer) RenameField(context.Context, *RenameFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameField not implemented")
+}
+func (UnimplementedMilvusExtServer) FederatedSearch(context.Context, *proxypb.FederatedSearchRequest) (*proxypb.FederatedSearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederatedSearch not implemented")
+}
+func (UnimplementedMilvusExtServer) AddCollectionFunction(context.Context, *AddCollectionFunctionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionFunction not implemented")
+}
+func (UnimplementedMilvusExtServer) GetFunctionBackfillProgress(context.Context, *GetFunctionBackfillProgressRequest) (*indexpb.GetFunctionBackfillProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunctionBackfillProgress not implemented")
+}
+func (UnimplementedMilvusExtServer) BeginReadSession(context.Context, *querypb.BeginReadSessionRequest) (*querypb.BeginReadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginReadSession not implemented")
+}
+func (UnimplementedMilvusExtServer) EndReadSession(context.Context, *querypb.EndReadSessionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndReadSession not implemented")
+}
+
+// UnsafeMilvusExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MilvusExtServer will
+// result in compilation errors.
+type UnsafeMilvusExtServer interface {
+	mustEmbedUnimplementedMilvusExtServer()
+}
+
+func RegisterMilvusExtServer(s grpc.ServiceRegistrar, srv MilvusExtServer) {
+	s.RegisterService(&MilvusExt_ServiceDesc, srv)
+}
+
+func _MilvusExt_RenamePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).RenamePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_RenamePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).RenamePartition(ctx, req.(*RenamePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_RenameField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).RenameField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_RenameField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).RenameField(ctx, req.(*RenameFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_FederatedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.FederatedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).FederatedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_FederatedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).FederatedSearch(ctx, req.(*proxypb.FederatedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_AddCollectionFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).AddCollectionFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_AddCollectionFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).AddCollectionFunction(ctx, req.(*AddCollectionFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_GetFunctionBackfillProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctionBackfillProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).GetFunctionBackfillProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_GetFunctionBackfillProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).GetFunctionBackfillProgress(ctx, req.(*GetFunctionBackfillProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_BeginReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(querypb.BeginReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).BeginReadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_BeginReadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).BeginReadSession(ctx, req.(*querypb.BeginReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExt_EndReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(querypb.EndReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServer).EndReadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilvusExt_EndReadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServer).EndReadSession(ctx, req.(*querypb.EndReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MilvusExt_ServiceDesc is the grpc.ServiceDesc for MilvusExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MilvusExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.rootcoord.MilvusExt",
+	HandlerType: (*MilvusExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RenamePartition",
+			Handler:    _MilvusExt_RenamePartition_Handler,
+		},
+		{
+			MethodName: "RenameField",
+			Handler:    _MilvusExt_RenameField_Handler,
+		},
+		{
+			MethodName: "FederatedSearch",
+			Handler:    _MilvusExt_FederatedSearch_Handler,
+		},
+		{
+			MethodName: "AddCollectionFunction",
+			Handler:    _MilvusExt_AddCollectionFunction_Handler,
+		},
+		{
+			MethodName: "GetFunctionBackfillProgress",
+			Handler:    _MilvusExt_GetFunctionBackfillProgress_Handler,
+		},
+		{
+			MethodName: "BeginReadSession",
+			Handler:    _MilvusExt_BeginReadSession_Handler,
+		},
+		{
+			MethodName: "EndReadSession",
+			Handler:    _MilvusExt_EndReadSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "root_coord.proto",
+}
@@ -76,6 +76,19 @@ func DeserializeToMQWrapperID(msgID []byte, walName string) (common.MessageID, e
 	}
 }
 
+// GetMessageIDFromMQWrapperIDBytes converts the messageID bytes of mq wrapper to message.MessageID
+func GetMessageIDFromMQWrapperIDBytes(walName string, msgIDBytes []byte) (message.MessageID, error) {
+	commonMsgID, err := DeserializeToMQWrapperID(msgIDBytes, walName)
+	if err != nil {
+		return nil, err
+	}
+	msgID := MustGetMessageIDFromMQWrapperID(commonMsgID)
+	if msgID == nil {
+		return nil, fmt.Errorf("unsupported mq type %s", walName)
+	}
+	return msgID, nil
+}
+
 func MustGetMessageIDFromMQWrapperIDBytes(walName string, msgIDBytes []byte) message.MessageID {
 	var commonMsgID common.MessageID
 	switch walName {
@@ -28,3 +28,19 @@ func TestIDConvension(t *testing.T) {
 	wpID := MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgwoodpecker.NewWpID(&logMsgId)))
 	assert.True(t, wpID.EQ(msgwoodpecker.NewWpID(&logMsgId)))
 }
+
+func TestGetMessageIDFromMQWrapperIDBytes(t *testing.T) {
+	id, err := GetMessageIDFromMQWrapperIDBytes("rocksmq", MustGetMQWrapperIDFromMessage(rmq.NewRmqID(1)).Serialize())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(rmq.NewRmqID(1)))
+
+	id, err = GetMessageIDFromMQWrapperIDBytes("kafka", MustGetMQWrapperIDFromMessage(msgkafka.NewKafkaID(1)).Serialize())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(msgkafka.NewKafkaID(1)))
+
+	_, err = GetMessageIDFromMQWrapperIDBytes("pulsar", []byte("invalid"))
+	assert.Error(t, err)
+
+	_, err = GetMessageIDFromMQWrapperIDBytes("unknown", []byte{1})
+	assert.Error(t, err)
+}
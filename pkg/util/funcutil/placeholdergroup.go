@@ -13,16 +13,18 @@ import (
 )
 
 func SparseVectorDataToPlaceholderGroupBytes(contents [][]byte) []byte {
-	placeholderGroup := &commonpb.PlaceholderGroup{
+	bytes, _ := proto.Marshal(SparseVectorsToPlaceholderGroup(contents))
+	return bytes
+}
+
+func SparseVectorsToPlaceholderGroup(contents [][]byte) *commonpb.PlaceholderGroup {
+	return &commonpb.PlaceholderGroup{
 		Placeholders: []*commonpb.PlaceholderValue{{
 			Tag:    "$0",
 			Type:   commonpb.PlaceholderType_SparseFloatVector,
 			Values: contents,
 		}},
 	}
-
-	bytes, _ := proto.Marshal(placeholderGroup)
-	return bytes
 }
 
 func Float32VectorsToPlaceholderGroup(embs [][]float32) *commonpb.PlaceholderGroup {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 )
 
 func Test_GetPrivilegeExtObj(t *testing.T) {
@@ -23,6 +24,32 @@ func Test_GetPrivilegeExtObj(t *testing.T) {
 	request2 := &milvuspb.GetPersistentSegmentInfoRequest{}
 	_, err = GetPrivilegeExtObj(request2)
 	assert.Error(t, err)
+
+	// the ddl served by MilvusExt declares the privilege ext too.
+	request3 := &rootcoordpb.RenamePartitionRequest{
+		DbName:         "test",
+		CollectionName: "col1",
+	}
+	privilegeExt, err = GetPrivilegeExtObj(request3)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeDropPartition, privilegeExt.ObjectPrivilege)
+	assert.Equal(t, "col1", GetObjectName(request3, privilegeExt.ObjectNameIndex))
+
+	request4 := &rootcoordpb.RenameFieldRequest{
+		DbName:         "test",
+		CollectionName: "col1",
+	}
+	privilegeExt, err = GetPrivilegeExtObj(request4)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeDropPartition, privilegeExt.ObjectPrivilege)
+	assert.Equal(t, "col1", GetObjectName(request4, privilegeExt.ObjectNameIndex))
+
+	privilegeExt, err = GetPrivilegeExtObj(&rootcoordpb.AddCollectionFunctionRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAddCollectionField, privilegeExt.ObjectPrivilege)
 }
 
 func Test_GetResourceName(t *testing.T) {
@@ -430,6 +430,14 @@ func WrapErrServiceUnimplemented(grpcErr error) error {
 	return wrapFieldsWithDesc(ErrServiceUnimplemented, grpcErr.Error())
 }
 
+func WrapErrServiceReadOnly(operation string, msg ...string) error {
+	err := wrapFields(ErrServiceReadOnly, value("operation", operation))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 // database related
 func WrapErrDatabaseNotFound(database any, msg ...string) error {
 	err := wrapFields(ErrDatabaseNotFound, value("database", database))
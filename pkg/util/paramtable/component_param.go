@@ -280,6 +280,12 @@ type commonConfig struct {
 	PanicWhenPluginFail       ParamItem `refreshable:"false"`
 	CollectionReplicateEnable ParamItem `refreshable:"true"`
 
+	FollowerEnabled           ParamItem `refreshable:"false"`
+	FollowerMaxReplicationLag ParamItem `refreshable:"true"`
+	FollowerPrimaryEndpoints  ParamItem `refreshable:"false"`
+	FollowerPrimaryMetaRoot   ParamItem `refreshable:"false"`
+	FollowerMetaSyncInterval  ParamItem `refreshable:"true"`
+
 	UsePartitionKeyAsClusteringKey ParamItem `refreshable:"true"`
 	UseVectorAsClusteringKey       ParamItem `refreshable:"true"`
 	EnableVectorClusteringKey      ParamItem `refreshable:"true"`
@@ -858,6 +864,55 @@ This helps Milvus-CDC synchronize incremental data`,
 	}
 	p.CollectionReplicateEnable.Init(base.mgr)
 
+	p.FollowerEnabled = ParamItem{
+		Key:          "common.follower.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: `Whether to run the cluster as a read-only follower of a primary cluster.
+A follower shares the object storage bucket and the wal with the primary, serves search and query with its own proxies and querynodes,
+rejects all DML and DDL requests, opens the wal read-only, and never garbage collects, compacts or indexes the shared segment files.`,
+		Export: true,
+	}
+	p.FollowerEnabled.Init(base.mgr)
+
+	p.FollowerMaxReplicationLag = ParamItem{
+		Key:          "common.follower.maxReplicationLag",
+		Version:      "2.6.0",
+		DefaultValue: "60",
+		Doc:          "The max replication lag in seconds of a follower cluster before the proxy reports itself as unhealthy, -1 means no limit.",
+		Export:       true,
+	}
+	p.FollowerMaxReplicationLag.Init(base.mgr)
+
+	p.FollowerPrimaryEndpoints = ParamItem{
+		Key:          "common.follower.primaryEtcdEndpoints",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc: `The etcd endpoints of the primary cluster, the etcd of the follower cluster is used if empty.
+The auth and tls settings of the etcd of the follower cluster are used to access the primary etcd.`,
+		Export: true,
+	}
+	p.FollowerPrimaryEndpoints.Init(base.mgr)
+
+	p.FollowerPrimaryMetaRoot = ParamItem{
+		Key:          "common.follower.primaryMetaRootPath",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc: `The meta root path of the primary cluster, e.g. by-dev/meta.
+The rootcoord and datacoord meta under it is mirrored read-only into the follower cluster, the mirroring is disabled if empty.`,
+		Export: true,
+	}
+	p.FollowerPrimaryMetaRoot.Init(base.mgr)
+
+	p.FollowerMetaSyncInterval = ParamItem{
+		Key:          "common.follower.metaSyncInterval",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc:          "The interval in seconds to reload the coordinator meta mirrored from the primary cluster.",
+		Export:       true,
+	}
+	p.FollowerMetaSyncInterval.Init(base.mgr)
+
 	p.TraceLogMode = ParamItem{
 		Key:          "common.traceLogMode",
 		Version:      "2.3.4",
@@ -1386,6 +1441,8 @@ type rootCoordConfig struct {
 	GracefulStopTimeout         ParamItem `refreshable:"true"`
 	UseLockScheduler            ParamItem `refreshable:"true"`
 	DefaultDBProperties         ParamItem `refreshable:"false"`
+
+	ProjectionCheckpointInterval ParamItem `refreshable:"true"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -1478,6 +1535,16 @@ Segments with smaller size than this parameter will not be indexed, and will be
 		Export:       false,
 	}
 	p.DefaultDBProperties.Init(base.mgr)
+
+	p.ProjectionCheckpointInterval = ParamItem{
+		Key:          "rootCoord.projectionCheckpointInterval",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc: `The interval in seconds to persist the checkpoints of the projections.
+The changes after the persisted checkpoint are applied again after the rootcoord restarts.`,
+		Export: true,
+	}
+	p.ProjectionCheckpointInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1498,6 +1565,22 @@ type AccessLogConfig struct {
 	CacheFlushInterval ParamItem `refreshable:"false"`
 }
 
+type SlowQueryLogConfig struct {
+	Enable        ParamItem `refreshable:"false"`
+	LocalPath     ParamItem `refreshable:"false"`
+	Filename      ParamItem `refreshable:"false"`
+	MaxSize       ParamItem `refreshable:"false"`
+	RotatedTime   ParamItem `refreshable:"false"`
+	MaxBackups    ParamItem `refreshable:"false"`
+	MinioEnable   ParamItem `refreshable:"false"`
+	RemotePath    ParamItem `refreshable:"false"`
+	RemoteMaxTime ParamItem `refreshable:"false"`
+
+	SearchThreshold       ParamItem `refreshable:"true"`
+	HybridSearchThreshold ParamItem `refreshable:"true"`
+	QueryThreshold        ParamItem `refreshable:"true"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -1548,6 +1631,25 @@ type proxyConfig struct {
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
 	SlowLogSpanInSeconds   ParamItem `refreshable:"true"`
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
+
+	WatchChangesBufferSize ParamItem `refreshable:"false"`
+
+	ResultCacheEnabled     ParamItem `refreshable:"true"`
+	ResultCacheMemoryLimit ParamItem `refreshable:"true"`
+	ResultCacheTTL         ParamItem `refreshable:"true"`
+
+	HedgedRequestMinDelay    ParamItem `refreshable:"true"`
+	HedgedRequestBudgetRatio ParamItem `refreshable:"true"`
+
+	CollectionAccessReportInterval ParamItem `refreshable:"false"`
+	IdleCollectionReloadTimeout    ParamItem `refreshable:"true"`
+
+	ReturnResourceUsage ParamItem `refreshable:"true"`
+
+	SlowQueryLog SlowQueryLogConfig
+
+	ReadSessionDefaultTTL ParamItem `refreshable:"true"`
+	ReadSessionMaxTTL     ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -2030,6 +2132,216 @@ If the number of result entries exceeds this limit, the search will be rejected.
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.WatchChangesBufferSize = ParamItem{
+		Key:          "proxy.watchChanges.bufferSize",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of wal messages buffered for a change subscription, the wal is not read until the consumer catches up once it's full",
+		DefaultValue: "64",
+		Export:       true,
+	}
+	p.WatchChangesBufferSize.Init(base.mgr)
+
+	p.ResultCacheEnabled = ParamItem{
+		Key:          "proxy.resultCache.enabled",
+		Version:      "2.6.0",
+		Doc:          "Whether to cache the search and query results of Bounded and Eventually consistency level in proxy",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.ResultCacheEnabled.Init(base.mgr)
+
+	p.ResultCacheMemoryLimit = ParamItem{
+		Key:          "proxy.resultCache.memoryLimit",
+		Version:      "2.6.0",
+		Doc:          "The maximum memory in bytes used by the cached results, the least recently used results are evicted once exceeded",
+		DefaultValue: "268435456",
+		Export:       true,
+	}
+	p.ResultCacheMemoryLimit.Init(base.mgr)
+
+	p.ResultCacheTTL = ParamItem{
+		Key:          "proxy.resultCache.ttl",
+		Version:      "2.6.0",
+		Doc:          "The maximum seconds a result is cached, which bounds the staleness caused by the writes through other proxies",
+		DefaultValue: "60",
+		Export:       true,
+	}
+	p.ResultCacheTTL.Init(base.mgr)
+
+	p.HedgedRequestMinDelay = ParamItem{
+		Key:          "proxy.hedgedRequest.minDelay",
+		Version:      "2.6.0",
+		Doc:          "The minimum milliseconds to wait before sending a hedged shard request to another replica, only for the collections with hedgedrequest.enabled property",
+		DefaultValue: "10",
+		Export:       true,
+	}
+	p.HedgedRequestMinDelay.Init(base.mgr)
+
+	p.HedgedRequestBudgetRatio = ParamItem{
+		Key:          "proxy.hedgedRequest.budgetRatio",
+		Version:      "2.6.0",
+		Doc:          "The maximum ratio of the hedged requests to the shard requests, the hedged requests beyond the budget are not sent",
+		DefaultValue: "0.1",
+		Export:       true,
+	}
+	p.HedgedRequestBudgetRatio.Init(base.mgr)
+
+	p.CollectionAccessReportInterval = ParamItem{
+		Key:          "proxy.collectionAccess.reportInterval",
+		Version:      "2.6.0",
+		Doc:          "The interval in seconds to report the searched and queried collections to the query coord, which keeps the collections with collection.idleRelease.minutes property loaded",
+		DefaultValue: "30",
+		Export:       true,
+	}
+	p.CollectionAccessReportInterval.Init(base.mgr)
+
+	p.IdleCollectionReloadTimeout = ParamItem{
+		Key:     "proxy.idleCollection.reloadTimeout",
+		Version: "2.6.0",
+		Doc: `The seconds to wait for the collection released for idle to be reloaded by the search or query.
+If it's 0, the request fails with a retriable error at once while the collection is reloading`,
+		DefaultValue: "60",
+		Export:       true,
+	}
+	p.IdleCollectionReloadTimeout.Init(base.mgr)
+
+	p.ReturnResourceUsage = ParamItem{
+		Key:     "proxy.returnResourceUsage",
+		Version: "2.6.0",
+		Doc: `Whether to return the resource usage summary of search and query in the status extra info of the response,
+including the segments and rows scanned, the bytes read from mmap or remote storage, the querynode cpu time spent on the segments and the reduce time.
+The summary is also recorded in the access log by $resource_usage`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.ReturnResourceUsage.Init(base.mgr)
+
+	p.SlowQueryLog.Enable = ParamItem{
+		Key:          "proxy.slowQueryLog.enable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to persist the slow search and query requests into the slow query log file, which can be replayed by the slowqueryreplay tool.",
+		Export:       true,
+	}
+	p.SlowQueryLog.Enable.Init(base.mgr)
+
+	p.SlowQueryLog.LocalPath = ParamItem{
+		Key:          "proxy.slowQueryLog.localPath",
+		Version:      "2.6.0",
+		DefaultValue: "/tmp/milvus_slow_query",
+		Doc:          "The local folder path where the slow query log file is stored.",
+		Export:       true,
+	}
+	p.SlowQueryLog.LocalPath.Init(base.mgr)
+
+	p.SlowQueryLog.Filename = ParamItem{
+		Key:          "proxy.slowQueryLog.filename",
+		Version:      "2.6.0",
+		DefaultValue: "slow_query.log",
+		Doc:          "The name of the slow query log file, each line of it is a JSON encoded slow query entry.",
+		Export:       true,
+	}
+	p.SlowQueryLog.Filename.Init(base.mgr)
+
+	p.SlowQueryLog.MaxSize = ParamItem{
+		Key:          "proxy.slowQueryLog.maxSize",
+		Version:      "2.6.0",
+		DefaultValue: "64",
+		Doc:          "The maximum size allowed for a single slow query log file, the file is rotated once the size reaches it. Unit: MB.",
+		Export:       true,
+	}
+	p.SlowQueryLog.MaxSize.Init(base.mgr)
+
+	p.SlowQueryLog.RotatedTime = ParamItem{
+		Key:          "proxy.slowQueryLog.rotatedTime",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "The maximum time interval allowed for rotating a single slow query log file. Unit: seconds",
+		Export:       true,
+	}
+	p.SlowQueryLog.RotatedTime.Init(base.mgr)
+
+	p.SlowQueryLog.MaxBackups = ParamItem{
+		Key:          "proxy.slowQueryLog.maxBackups",
+		Version:      "2.6.0",
+		DefaultValue: "8",
+		Doc:          "The maximum number of sealed slow query log files that can be retained.",
+		Export:       true,
+	}
+	p.SlowQueryLog.MaxBackups.Init(base.mgr)
+
+	p.SlowQueryLog.MinioEnable = ParamItem{
+		Key:          "proxy.slowQueryLog.minioEnable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to upload the sealed slow query log files to MinIO.",
+		Export:       true,
+	}
+	p.SlowQueryLog.MinioEnable.Init(base.mgr)
+
+	p.SlowQueryLog.RemotePath = ParamItem{
+		Key:          "proxy.slowQueryLog.remotePath",
+		Version:      "2.6.0",
+		DefaultValue: "slow_query_log/",
+		Doc:          "The path of the object storage for uploading slow query log files.",
+		Export:       true,
+	}
+	p.SlowQueryLog.RemotePath.Init(base.mgr)
+
+	p.SlowQueryLog.RemoteMaxTime = ParamItem{
+		Key:          "proxy.slowQueryLog.remoteMaxTime",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "The time interval allowed for keeping the uploaded slow query log files, in hours. Setting the value to 0 disables this feature.",
+		Export:       true,
+	}
+	p.SlowQueryLog.RemoteMaxTime.Init(base.mgr)
+
+	p.SlowQueryLog.SearchThreshold = ParamItem{
+		Key:          "proxy.slowQueryLog.threshold.search",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc:          "The search whose latency exceeds the threshold is written into the slow query log, in seconds.",
+		Export:       true,
+	}
+	p.SlowQueryLog.SearchThreshold.Init(base.mgr)
+
+	p.SlowQueryLog.HybridSearchThreshold = ParamItem{
+		Key:          "proxy.slowQueryLog.threshold.hybridSearch",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc:          "The hybrid search whose latency exceeds the threshold is written into the slow query log, in seconds.",
+		Export:       true,
+	}
+	p.SlowQueryLog.HybridSearchThreshold.Init(base.mgr)
+
+	p.SlowQueryLog.QueryThreshold = ParamItem{
+		Key:          "proxy.slowQueryLog.threshold.query",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc:          "The query whose latency exceeds the threshold is written into the slow query log, in seconds.",
+		Export:       true,
+	}
+	p.SlowQueryLog.QueryThreshold.Init(base.mgr)
+
+	p.ReadSessionDefaultTTL = ParamItem{
+		Key:          "proxy.readSession.defaultTTL",
+		Version:      "2.6.0",
+		DefaultValue: "300",
+		Doc:          "The default seconds a read session lasts if the ttl is not specified, the snapshot of the session is kept until it expires",
+		Export:       true,
+	}
+	p.ReadSessionDefaultTTL.Init(base.mgr)
+
+	p.ReadSessionMaxTTL = ParamItem{
+		Key:          "proxy.readSession.maxTTL",
+		Version:      "2.6.0",
+		DefaultValue: "3600",
+		Doc:          "The max seconds a read session could last, the collection keeps the sealed segments of the session snapshot loaded until it expires",
+		Export:       true,
+	}
+	p.ReadSessionMaxTTL.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2123,6 +2435,12 @@ type queryCoordConfig struct {
 
 	// query node task parallelism factor
 	QueryNodeTaskParallelismFactor ParamItem `refreshable:"true"`
+
+	IdleCollectionCheckInterval ParamItem `refreshable:"false"`
+
+	HotSegmentAccessCount  ParamItem `refreshable:"true"`
+	ColdSegmentAccessCount ParamItem `refreshable:"true"`
+	MovedSegmentCooldown   ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -2193,7 +2511,7 @@ If this parameter is set false, Milvus simply searches the growing segments with
 		Version:      "2.0.0",
 		DefaultValue: "ScoreBasedBalancer",
 		PanicIfEmpty: false,
-		Doc:          "auto balancer used for segments on queryNodes",
+		Doc:          "auto balancer used for segments on queryNodes, options: RoundRobinBalancer, RowCountBasedBalancer, ScoreBasedBalancer, MultipleTargetBalancer, ChannelLevelScoreBalancer, HotColdBalancer",
 		Export:       true,
 	}
 	p.Balancer.Init(base.mgr)
@@ -2755,6 +3073,44 @@ If this parameter is set false, Milvus simply searches the growing segments with
 		Export:       false,
 	}
 	p.QueryNodeTaskParallelismFactor.Init(base.mgr)
+
+	p.IdleCollectionCheckInterval = ParamItem{
+		Key:          "queryCoord.idleCollection.checkInterval",
+		Version:      "2.6.0",
+		DefaultValue: "60",
+		Doc:          "The interval in seconds to release the loaded collections without search or query for the collection.idleRelease.minutes property",
+		Export:       true,
+	}
+	p.IdleCollectionCheckInterval.Init(base.mgr)
+
+	p.HotSegmentAccessCount = ParamItem{
+		Key:          "queryCoord.hotColdBalancer.hotSegmentAccessCount",
+		Version:      "2.6.0",
+		DefaultValue: "100",
+		Doc: `The segments with the access count reported by the query node not less than it are hot, which are spread across the nodes by HotColdBalancer.
+The access count is the number of searches and queries on the segment halved every 5 minutes`,
+		Export: true,
+	}
+	p.HotSegmentAccessCount.Init(base.mgr)
+
+	p.ColdSegmentAccessCount = ParamItem{
+		Key:          "queryCoord.hotColdBalancer.coldSegmentAccessCount",
+		Version:      "2.6.0",
+		DefaultValue: "1",
+		Doc:          "The segments with the access count less than it are cold, which are placed on the nodes with mmap or lazyload enabled by HotColdBalancer",
+		Export:       true,
+	}
+	p.ColdSegmentAccessCount.Init(base.mgr)
+
+	p.MovedSegmentCooldown = ParamItem{
+		Key:          "queryCoord.hotColdBalancer.movedSegmentCooldown",
+		Version:      "2.6.0",
+		DefaultValue: "600",
+		Doc: `The seconds a segment moved by HotColdBalancer stays where it is, during which its access count before the move is kept,
+as the node it moves to counts the access from zero`,
+		Export: true,
+	}
+	p.MovedSegmentCooldown.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2864,6 +3220,10 @@ type queryNodeConfig struct {
 	SchedulePolicyEnableCrossUserGrouping ParamItem `refreshable:"true"`
 	SchedulePolicyMaxPendingTaskPerUser   ParamItem `refreshable:"true"`
 
+	SchedulePolicyTenantKey               ParamItem  `refreshable:"false"`
+	SchedulePolicyTenantWeights           ParamGroup `refreshable:"true"`
+	SchedulePolicyMaxConcurrencyPerTenant ParamItem  `refreshable:"true"`
+
 	// CGOPoolSize ratio to MaxReadConcurrency
 	CGOPoolSizeRatio ParamItem `refreshable:"true"`
 
@@ -3743,7 +4103,11 @@ user-task-polling:
 	Scheduling is fair on task granularity.
 	The policy is based on the username for authentication.
 	And an empty username is considered the same user.
-	When there are no multi-users, the policy decay into FIFO"`,
+	When there are no multi-users, the policy decay into FIFO"
+weighted-fair:
+	The tasks are queued by tenant, which is the database or the resource group of the collection.
+	The tenants share the query node by their weights on nq granularity,
+	and the running tasks of each tenant are limited by maxConcurrencyPerTenant.`,
 		Export: true,
 	}
 	p.SchedulePolicyName.Init(base.mgr)
@@ -3771,6 +4135,29 @@ user-task-polling:
 		Export:       true,
 	}
 	p.SchedulePolicyMaxPendingTaskPerUser.Init(base.mgr)
+	p.SchedulePolicyTenantKey = ParamItem{
+		Key:          "queryNode.scheduler.scheduleReadPolicy.tenantKey",
+		Version:      "2.6.0",
+		DefaultValue: "database",
+		Doc:          "The tenant of the tasks when using weighted-fair policy, options: database, resource_group",
+		Export:       true,
+	}
+	p.SchedulePolicyTenantKey.Init(base.mgr)
+	p.SchedulePolicyTenantWeights = ParamGroup{
+		KeyPrefix: "queryNode.scheduler.scheduleReadPolicy.tenantWeights.",
+		Version:   "2.6.0",
+		Doc:       "The weights of the tenants when using weighted-fair policy, e.g. tenantWeights.db1: 4. The tenants not set are weighted 1",
+		Export:    true,
+	}
+	p.SchedulePolicyTenantWeights.Init(base.mgr)
+	p.SchedulePolicyMaxConcurrencyPerTenant = ParamItem{
+		Key:          "queryNode.scheduler.scheduleReadPolicy.maxConcurrencyPerTenant",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "Max running tasks per tenant when using weighted-fair policy, the tasks beyond it wait in the queue. 0 means no limit",
+		Export:       true,
+	}
+	p.SchedulePolicyMaxConcurrencyPerTenant.Init(base.mgr)
 
 	p.CGOPoolSizeRatio = ParamItem{
 		Key:          "queryNode.segcore.cgoPoolSizeRatio",
@@ -5192,6 +5579,10 @@ type dataNodeConfig struct {
 	BuildParallel ParamItem `refreshable:"false"`
 
 	WorkerSlotUnit ParamItem `refreshable:"true"`
+
+	// function backfill
+	FunctionBackfillBatchSize        ParamItem `refreshable:"true"`
+	FunctionBackfillMaxRowsPerSecond ParamItem `refreshable:"true"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -5618,6 +6009,25 @@ if this parameter <= 0, will set it as 10`,
 		Doc:          "Indicates how many slots each worker occupies per 2c8g",
 	}
 	p.WorkerSlotUnit.Init(base.mgr)
+
+	p.FunctionBackfillBatchSize = ParamItem{
+		Key:          "dataNode.functionBackfill.batchSize",
+		Version:      "2.6.0",
+		DefaultValue: "1024",
+		Doc:          "The number of rows sent to the function at a time when backfilling the outputs of a function added to an existing collection",
+		Export:       true,
+	}
+	p.FunctionBackfillBatchSize.Init(base.mgr)
+
+	p.FunctionBackfillMaxRowsPerSecond = ParamItem{
+		Key:          "dataNode.functionBackfill.maxRowsPerSecond",
+		Version:      "2.6.0",
+		DefaultValue: "1000",
+		Doc: `The maximum number of rows per second processed by the function backfill tasks of a data node,
+to protect the external embedding service from being overloaded. No limit if it's not positive.`,
+		Export: true,
+	}
+	p.FunctionBackfillMaxRowsPerSecond.Init(base.mgr)
 }
 
 type streamingConfig struct {
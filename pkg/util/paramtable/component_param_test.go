@@ -146,6 +146,14 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 1, params.CommonCfg.StorageZstdConcurrency.GetAsInt())
 		params.Save("common.storage.zstd.concurrency", "2")
 		assert.Equal(t, 2, params.CommonCfg.StorageZstdConcurrency.GetAsInt())
+
+		assert.False(t, params.CommonCfg.FollowerEnabled.GetAsBool())
+		assert.Equal(t, 60*time.Second, params.CommonCfg.FollowerMaxReplicationLag.GetAsDuration(time.Second))
+		params.Save("common.follower.maxReplicationLag", "10")
+		assert.Equal(t, 10*time.Second, params.CommonCfg.FollowerMaxReplicationLag.GetAsDuration(time.Second))
+		assert.Empty(t, params.CommonCfg.FollowerPrimaryEndpoints.GetAsStrings())
+		assert.Empty(t, params.CommonCfg.FollowerPrimaryMetaRoot.GetValue())
+		assert.Equal(t, 5*time.Second, params.CommonCfg.FollowerMetaSyncInterval.GetAsDuration(time.Second))
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -165,6 +173,8 @@ func TestComponentParam(t *testing.T) {
 		params.Save("rootCoord.defaultDBProperties", "{\"key\":\"value\"}")
 		assert.Equal(t, "{\"key\":\"value\"}", Params.DefaultDBProperties.GetValue())
 
+		assert.Equal(t, 5*time.Second, Params.ProjectionCheckpointInterval.GetAsDuration(time.Second))
+
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
 	})
@@ -225,6 +235,23 @@ func TestComponentParam(t *testing.T) {
 		params.Save("proxy.skipPartitionKeyCheck", "true")
 		assert.True(t, Params.SkipPartitionKeyCheck.GetAsBool())
 
+		assert.Equal(t, 64, Params.WatchChangesBufferSize.GetAsInt())
+
+		assert.False(t, Params.ResultCacheEnabled.GetAsBool())
+		assert.Equal(t, int64(256*1024*1024), Params.ResultCacheMemoryLimit.GetAsInt64())
+		assert.Equal(t, 60*time.Second, Params.ResultCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Millisecond, Params.HedgedRequestMinDelay.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0.1, Params.HedgedRequestBudgetRatio.GetAsFloat())
+		assert.Equal(t, 30*time.Second, Params.CollectionAccessReportInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 60*time.Second, Params.IdleCollectionReloadTimeout.GetAsDuration(time.Second))
+		assert.False(t, Params.ReturnResourceUsage.GetAsBool())
+		assert.False(t, Params.SlowQueryLog.Enable.GetAsBool())
+		assert.Equal(t, "slow_query.log", Params.SlowQueryLog.Filename.GetValue())
+		assert.Equal(t, 5*time.Second, Params.SlowQueryLog.SearchThreshold.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Second, Params.SlowQueryLog.QueryThreshold.GetAsDuration(time.Second))
+		assert.Equal(t, 300*time.Second, Params.ReadSessionDefaultTTL.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.ReadSessionMaxTTL.GetAsDuration(time.Second))
+
 		assert.Equal(t, int64(10), Params.CheckWorkloadRequestNum.GetAsInt64())
 		assert.Equal(t, float64(0.1), Params.WorkloadToleranceFactor.GetAsFloat())
 
@@ -393,6 +420,11 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 1, Params.QueryNodeTaskParallelismFactor.GetAsInt())
 		params.Save("queryCoord.queryNodeTaskParallelismFactor", "2")
 		assert.Equal(t, 2, Params.QueryNodeTaskParallelismFactor.GetAsInt())
+
+		assert.Equal(t, 60*time.Second, Params.IdleCollectionCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, int64(100), Params.HotSegmentAccessCount.GetAsInt64())
+		assert.Equal(t, int64(1), Params.ColdSegmentAccessCount.GetAsInt64())
+		assert.Equal(t, 600*time.Second, Params.MovedSegmentCooldown.GetAsDuration(time.Second))
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -501,6 +533,11 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 1.0, Params.PartialResultRequiredDataRatio.GetAsFloat())
 		params.Save(Params.PartialResultRequiredDataRatio.Key, "0.8")
 		assert.Equal(t, 0.8, Params.PartialResultRequiredDataRatio.GetAsFloat())
+
+		assert.Equal(t, "database", Params.SchedulePolicyTenantKey.GetValue())
+		assert.Equal(t, 0, Params.SchedulePolicyMaxConcurrencyPerTenant.GetAsInt())
+		params.SaveGroup(map[string]string{Params.SchedulePolicyTenantWeights.KeyPrefix + "db1": "4"})
+		assert.Equal(t, map[string]string{"db1": "4"}, Params.SchedulePolicyTenantWeights.GetValue())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
@@ -625,6 +662,12 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(2), Params.ClusteringCompactionWorkerPoolSize.GetAsInt64())
 
 		assert.Equal(t, 2, Params.BloomFilterApplyParallelFactor.GetAsInt())
+
+		// function backfill
+		assert.Equal(t, 1024, Params.FunctionBackfillBatchSize.GetAsInt())
+		assert.Equal(t, 1000, Params.FunctionBackfillMaxRowsPerSecond.GetAsInt())
+		params.Save("dataNode.functionBackfill.maxRowsPerSecond", "0")
+		assert.Equal(t, 0, Params.FunctionBackfillMaxRowsPerSecond.GetAsInt())
 	})
 
 	t.Run("test streamingConfig", func(t *testing.T) {
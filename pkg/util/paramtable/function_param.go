@@ -21,8 +21,11 @@ import (
 )
 
 type functionConfig struct {
-	TextEmbeddingProviders ParamGroup `refreshable:"true"`
-	RerankModelProviders   ParamGroup `refreshable:"true"`
+	TextEmbeddingProviders     ParamGroup `refreshable:"true"`
+	TextEmbeddingCacheCapacity ParamItem  `refreshable:"false"`
+	TextEmbeddingCacheTTL      ParamItem  `refreshable:"false"`
+	RerankModelProviders       ParamGroup `refreshable:"true"`
+	GoAnalyzers                ParamGroup `refreshable:"true"`
 }
 
 func (p *functionConfig) init(base *BaseTable) {
@@ -68,6 +71,22 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your VertexAI embedding url"
 			case "vertexai.credential":
 				return "The name in the crendential configuration item"
+			case "ollama.enable":
+				return "Whether to enable Ollama model service"
+			case "ollama.url":
+				return "Your ollama server url, Default is http://localhost:11434"
+			case "ollama.credential":
+				return "The name in the crendential configuration item, only needed if the server is behind an authenticating proxy"
+			case "ollama.auth_header":
+				return "The header carrying the api key, Default is Authorization with the Bearer scheme"
+			case "openai_compatible.enable":
+				return "Whether to enable the openai compatible model service"
+			case "openai_compatible.url":
+				return "Your openai compatible embedding url, such as http://localhost:8000/v1"
+			case "openai_compatible.credential":
+				return "The name in the crendential configuration item"
+			case "openai_compatible.auth_header":
+				return "The header carrying the api key, Default is Authorization with the Bearer scheme"
 			default:
 				return ""
 			}
@@ -75,6 +94,25 @@ func (p *functionConfig) init(base *BaseTable) {
 	}
 	p.TextEmbeddingProviders.Init(base.mgr)
 
+	p.TextEmbeddingCacheCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.capacity",
+		Version:      "2.6.0",
+		DefaultValue: "10000",
+		Doc: `The max number of embeddings cached by each node, the cache is shared by all the TextEmbedding functions
+with the function param enable_cache set to true, the least recently used embeddings are evicted once it's full.`,
+		Export: true,
+	}
+	p.TextEmbeddingCacheCapacity.Init(base.mgr)
+
+	p.TextEmbeddingCacheTTL = ParamItem{
+		Key:          "function.textEmbedding.cache.ttl",
+		Version:      "2.6.0",
+		DefaultValue: "3600",
+		Doc:          "The time to live of the cached embeddings, in seconds",
+		Export:       true,
+	}
+	p.TextEmbeddingCacheTTL.Init(base.mgr)
+
 	p.RerankModelProviders = ParamGroup{
 		KeyPrefix: "function.rerank.model.providers.",
 		Version:   "2.6.0",
@@ -85,12 +123,36 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Whether to enable TEI rerank service"
 			case "vllm.enable":
 				return "Whether to enable vllm rerank service"
+			case "cohere.credential":
+				return "The name in the crendential configuration item"
+			case "cohere.url":
+				return "Your cohere rerank url, Default is the official rerank url"
+			case "voyageai.credential":
+				return "The name in the crendential configuration item"
+			case "voyageai.url":
+				return "Your voyageai rerank url, Default is the official rerank url"
+			case "jina.credential":
+				return "The name in the crendential configuration item"
+			case "jina.url":
+				return "Your jina rerank url, Default is the official rerank url"
+			case "siliconflow.credential":
+				return "The name in the crendential configuration item"
+			case "siliconflow.url":
+				return "Your siliconflow rerank url, Default is the official rerank url"
 			default:
 				return ""
 			}
 		},
 	}
 	p.RerankModelProviders.Init(base.mgr)
+
+	p.GoAnalyzers = ParamGroup{
+		KeyPrefix: "function.analyzer.go.",
+		Version:   "2.6.0",
+		Doc: `The go analyzers used by the analyzer params {"type": "go", "name": <name>}, the key is the name of the analyzer
+and the value is its definition in json, such as {"tokenizer": {"type": "dict", "dict_path": "/path/to/dict"}, "filter": ["lowercase"]}.`,
+	}
+	p.GoAnalyzers.Init(base.mgr)
 }
 
 const (
@@ -124,3 +186,9 @@ func (p *functionConfig) GetRerankModelProviders(providerName string) map[string
 	}
 	return matchedParam
 }
+
+// GetGoAnalyzerParams returns the definition of the go analyzer in json, the name is case insensitive as the config keys.
+func (p *functionConfig) GetGoAnalyzerParams(name string) (string, bool) {
+	params, ok := p.GoAnalyzers.GetValue()[strings.ToLower(name)]
+	return params, ok && params != ""
+}
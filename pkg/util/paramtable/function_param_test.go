@@ -31,6 +31,8 @@ func TestFunctionConfig(t *testing.T) {
 	openaiConf := cfg.GetTextEmbeddingProviderConfig("openai")
 	assert.Equal(t, openaiConf["credential"], "")
 	assert.Equal(t, openaiConf["url"], "")
+	ollamaConf := cfg.GetTextEmbeddingProviderConfig("ollama")
+	assert.Equal(t, ollamaConf["enable"], "true")
 
 	keys := []string{
 		"tei.enable",
@@ -51,9 +53,31 @@ func TestFunctionConfig(t *testing.T) {
 		"bedrock.credential",
 		"vertexai.url",
 		"vertexai.credential",
+		"ollama.enable",
+		"ollama.url",
+		"ollama.credential",
+		"openai_compatible.enable",
+		"openai_compatible.url",
+		"openai_compatible.credential",
+		"openai_compatible.auth_header",
 	}
 	for _, key := range keys {
 		assert.True(t, cfg.TextEmbeddingProviders.GetDoc(key) != "")
 	}
 	assert.True(t, cfg.TextEmbeddingProviders.GetDoc("Unknow") == "")
+
+	assert.Equal(t, 10000, cfg.TextEmbeddingCacheCapacity.GetAsInt())
+	assert.Equal(t, int64(3600), cfg.TextEmbeddingCacheTTL.GetAsInt64())
+
+	_, ok := cfg.GetGoAnalyzerParams("cjk")
+	assert.False(t, ok)
+	cfg.GoAnalyzers.GetFunc = func() map[string]string {
+		return map[string]string{"cjk": `{"tokenizer": "standard"}`, "empty": ""}
+	}
+	defer func() { cfg.GoAnalyzers.GetFunc = nil }()
+	analyzer, ok := cfg.GetGoAnalyzerParams("CJK")
+	assert.True(t, ok)
+	assert.Equal(t, `{"tokenizer": "standard"}`, analyzer)
+	_, ok = cfg.GetGoAnalyzerParams("empty")
+	assert.False(t, ok)
 }
@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+// the function types extended by internalpb.FunctionTypeExt, which are not defined by milvus-proto yet.
+const (
+	FunctionTypeMultimodalEmbedding = schemapb.FunctionType(internalpb.FunctionTypeExt_MultimodalEmbedding)
+	FunctionTypeSparseEmbedding     = schemapb.FunctionType(internalpb.FunctionTypeExt_SparseEmbedding)
+)
+
+// FunctionTypeName returns the name of the function type, including the extended ones.
+func FunctionTypeName(functionType schemapb.FunctionType) string {
+	if _, ok := schemapb.FunctionType_name[int32(functionType)]; ok {
+		return functionType.String()
+	}
+	if name, ok := internalpb.FunctionTypeExt_name[int32(functionType)]; ok {
+		return name
+	}
+	return functionType.String()
+}
+
+// ParseFunctionType returns the function type of the name, including the extended ones.
+func ParseFunctionType(name string) (schemapb.FunctionType, bool) {
+	if value, ok := schemapb.FunctionType_value[name]; ok {
+		return schemapb.FunctionType(value), true
+	}
+	if value, ok := internalpb.FunctionTypeExt_value[name]; ok && value != int32(internalpb.FunctionTypeExt_FunctionTypeExtUnknown) {
+		return schemapb.FunctionType(value), true
+	}
+	return schemapb.FunctionType_Unknown, false
+}
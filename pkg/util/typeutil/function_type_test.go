@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestFunctionType(t *testing.T) {
+	assert.Equal(t, "TextEmbedding", FunctionTypeName(schemapb.FunctionType_TextEmbedding))
+	assert.Equal(t, "MultimodalEmbedding", FunctionTypeName(FunctionTypeMultimodalEmbedding))
+	assert.Equal(t, "SparseEmbedding", FunctionTypeName(FunctionTypeSparseEmbedding))
+	assert.Equal(t, "9999", FunctionTypeName(schemapb.FunctionType(9999)))
+
+	functionType, ok := ParseFunctionType("BM25")
+	assert.True(t, ok)
+	assert.Equal(t, schemapb.FunctionType_BM25, functionType)
+
+	functionType, ok = ParseFunctionType("MultimodalEmbedding")
+	assert.True(t, ok)
+	assert.Equal(t, FunctionTypeMultimodalEmbedding, functionType)
+
+	_, ok = ParseFunctionType("FunctionTypeExtUnknown")
+	assert.False(t, ok)
+	_, ok = ParseFunctionType("Unknown function")
+	assert.False(t, ok)
+}